@@ -0,0 +1,322 @@
+package kernel
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/tiglabs/baudengine/util/encoding"
+)
+
+// DefaultTermsSize is the number of buckets returned by a terms aggregation when it does not set a size.
+const DefaultTermsSize = 10
+
+// Aggregation is the interface implemented by all the aggregations an engine can compute
+// over the documents matched by a query.
+type Aggregation interface {
+	isAggregation()
+}
+
+// TermsAggregation buckets the documents by the values of the field.
+// The Size buckets with the most documents are returned, and every partition returns
+// its ShardSize top buckets so that the merged counts are more accurate.
+type TermsAggregation struct {
+	FieldId uint32
+	// Size <= 0 means DefaultTermsSize
+	Size int
+	// ShardSize <= 0 means Size*3/2+10
+	ShardSize    int
+	Aggregations map[string]Aggregation
+}
+
+// HistogramAggregation buckets the documents by the numeric values of the field in intervals,
+// the key of a bucket is the float start of its interval.
+type HistogramAggregation struct {
+	FieldId      uint32
+	Interval     float64
+	Aggregations map[string]Aggregation
+}
+
+// DateHistogramAggregation buckets the documents by the time values of the field in intervals,
+// the key of a bucket is the int unix nanoseconds of the start of its interval.
+type DateHistogramAggregation struct {
+	FieldId      uint32
+	Interval     time.Duration
+	Aggregations map[string]Aggregation
+}
+
+// AggregationRange is a bucket of RangeAggregation, From is included and To is excluded.
+// The bounds are value encoded as the field data, the bound is not set when empty.
+type AggregationRange struct {
+	Key  string
+	From []byte
+	To   []byte
+}
+
+// RangeAggregation buckets the documents by the ranges the numeric values of the field fall in,
+// the key of a bucket is the bytes key of its range.
+type RangeAggregation struct {
+	FieldId      uint32
+	Ranges       []AggregationRange
+	Aggregations map[string]Aggregation
+}
+
+// MinAggregation, MaxAggregation, AvgAggregation, SumAggregation and StatsAggregation
+// compute the stats of the numeric values of the field, they only differ in the value returned.
+type MinAggregation struct {
+	FieldId uint32
+}
+
+type MaxAggregation struct {
+	FieldId uint32
+}
+
+type AvgAggregation struct {
+	FieldId uint32
+}
+
+type SumAggregation struct {
+	FieldId uint32
+}
+
+type StatsAggregation struct {
+	FieldId uint32
+}
+
+// CardinalityAggregation approximately counts the distinct values of the field with HyperLogLog.
+type CardinalityAggregation struct {
+	FieldId uint32
+	// Precision is between MinCardinalityPrecision and MaxCardinalityPrecision,
+	// 0 means DefaultCardinalityPrecision
+	Precision uint8
+}
+
+func (*TermsAggregation) isAggregation()         {}
+func (*HistogramAggregation) isAggregation()     {}
+func (*DateHistogramAggregation) isAggregation() {}
+func (*RangeAggregation) isAggregation()         {}
+func (*MinAggregation) isAggregation()           {}
+func (*MaxAggregation) isAggregation()           {}
+func (*AvgAggregation) isAggregation()           {}
+func (*SumAggregation) isAggregation()           {}
+func (*StatsAggregation) isAggregation()         {}
+func (*CardinalityAggregation) isAggregation()   {}
+
+// AggregationResult is the state of an aggregation. The results of the partitions are partial,
+// they are merged by MergeAggregations and then reduced by ReduceAggregations for the final result.
+type AggregationResult struct {
+	// buckets of terms, histogram, date_histogram and range aggregations
+	Buckets []*Bucket
+	// state of min, max, avg, sum and stats aggregations
+	Stats *StatsResult
+	// state of cardinality aggregation
+	Cardinality *HyperLogLog
+}
+
+// Bucket is a group of the documents, the key is value encoded as the field data.
+type Bucket struct {
+	Key          []byte
+	DocCount     int64
+	Aggregations map[string]*AggregationResult
+}
+
+// StatsResult is the stats of the numeric values, Min and Max are not set when Count is 0.
+type StatsResult struct {
+	Count int64
+	Sum   float64
+	Min   float64
+	Max   float64
+}
+
+func (s *StatsResult) Add(value float64) {
+	if s.Count == 0 || value < s.Min {
+		s.Min = value
+	}
+	if s.Count == 0 || value > s.Max {
+		s.Max = value
+	}
+	s.Count++
+	s.Sum += value
+}
+
+func (s *StatsResult) Merge(other *StatsResult) {
+	if other == nil || other.Count == 0 {
+		return
+	}
+	if s.Count == 0 || other.Min < s.Min {
+		s.Min = other.Min
+	}
+	if s.Count == 0 || other.Max > s.Max {
+		s.Max = other.Max
+	}
+	s.Count += other.Count
+	s.Sum += other.Sum
+}
+
+// Avg returns NaN when there is no value
+func (s *StatsResult) Avg() float64 {
+	if s.Count == 0 {
+		return math.NaN()
+	}
+	return s.Sum / float64(s.Count)
+}
+
+// Merge merges the partial result of the same aggregation from another partition
+func (r *AggregationResult) Merge(other *AggregationResult) error {
+	if other == nil {
+		return nil
+	}
+	if len(other.Buckets) > 0 {
+		index := make(map[string]*Bucket, len(r.Buckets))
+		for _, b := range r.Buckets {
+			index[string(b.Key)] = b
+		}
+		for _, ob := range other.Buckets {
+			b, ok := index[string(ob.Key)]
+			if !ok {
+				b = &Bucket{Key: ob.Key}
+				index[string(ob.Key)] = b
+				r.Buckets = append(r.Buckets, b)
+			}
+			b.DocCount += ob.DocCount
+			aggs, err := MergeAggregations(b.Aggregations, ob.Aggregations)
+			if err != nil {
+				return err
+			}
+			b.Aggregations = aggs
+		}
+	}
+	if other.Stats != nil {
+		if r.Stats == nil {
+			r.Stats = &StatsResult{}
+		}
+		r.Stats.Merge(other.Stats)
+	}
+	if other.Cardinality != nil {
+		if r.Cardinality == nil {
+			r.Cardinality = NewHyperLogLog(other.Cardinality.Precision())
+		}
+		if err := r.Cardinality.Merge(other.Cardinality); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MergeAggregations merges the partial results of src into dst, dst is returned
+func MergeAggregations(dst, src map[string]*AggregationResult) (map[string]*AggregationResult, error) {
+	if len(src) == 0 {
+		return dst, nil
+	}
+	if dst == nil {
+		dst = make(map[string]*AggregationResult, len(src))
+	}
+	for name, result := range src {
+		r, ok := dst[name]
+		if !ok {
+			r = &AggregationResult{}
+			dst[name] = r
+		}
+		if err := r.Merge(result); err != nil {
+			return nil, fmt.Errorf("merge aggregation %s error: %v", name, err)
+		}
+	}
+	return dst, nil
+}
+
+// ReduceAggregations orders and trims the buckets of the results by their aggregations.
+// The partial results of a partition keep ShardSize buckets of the terms aggregations,
+// while the final results keep Size buckets.
+func ReduceAggregations(aggs map[string]Aggregation, results map[string]*AggregationResult, partial bool) {
+	for name, agg := range aggs {
+		result, ok := results[name]
+		if !ok {
+			continue
+		}
+		var subAggs map[string]Aggregation
+		switch a := agg.(type) {
+		case *TermsAggregation:
+			// the buckets with the same count are ordered by key for stable results
+			sort.SliceStable(result.Buckets, func(i, j int) bool {
+				bi, bj := result.Buckets[i], result.Buckets[j]
+				if bi.DocCount != bj.DocCount {
+					return bi.DocCount > bj.DocCount
+				}
+				return bytes.Compare(bi.Key, bj.Key) < 0
+			})
+			size := a.Size
+			if size <= 0 {
+				size = DefaultTermsSize
+			}
+			if partial {
+				size = a.shardSize()
+			}
+			if len(result.Buckets) > size {
+				result.Buckets = result.Buckets[:size]
+			}
+			subAggs = a.Aggregations
+		case *HistogramAggregation:
+			sortBucketsByNumber(result.Buckets)
+			subAggs = a.Aggregations
+		case *DateHistogramAggregation:
+			sortBucketsByNumber(result.Buckets)
+			subAggs = a.Aggregations
+		case *RangeAggregation:
+			order := make(map[string]int, len(a.Ranges))
+			for i, r := range a.Ranges {
+				order[string(encoding.EncodeBytesValue(nil, 0, []byte(r.Key)))] = i
+			}
+			sort.SliceStable(result.Buckets, func(i, j int) bool {
+				return order[string(result.Buckets[i].Key)] < order[string(result.Buckets[j].Key)]
+			})
+			subAggs = a.Aggregations
+		}
+		if len(subAggs) > 0 {
+			for _, b := range result.Buckets {
+				ReduceAggregations(subAggs, b.Aggregations, partial)
+			}
+		}
+	}
+}
+
+func (a *TermsAggregation) shardSize() int {
+	if a.ShardSize > 0 {
+		return a.ShardSize
+	}
+	size := a.Size
+	if size <= 0 {
+		size = DefaultTermsSize
+	}
+	return size*3/2 + 10
+}
+
+// sortBucketsByNumber orders the buckets by the int or float value of the keys
+func sortBucketsByNumber(buckets []*Bucket) {
+	keys := make(map[*Bucket]float64, len(buckets))
+	for _, b := range buckets {
+		keys[b] = bucketNumber(b.Key)
+	}
+	sort.SliceStable(buckets, func(i, j int) bool {
+		return keys[buckets[i]] < keys[buckets[j]]
+	})
+}
+
+func bucketNumber(key []byte) float64 {
+	_, _, _, typ, err := encoding.DecodeValueTag(key)
+	if err != nil {
+		return 0
+	}
+	switch typ {
+	case encoding.Int:
+		if _, v, err := encoding.DecodeIntValue(key); err == nil {
+			return float64(v)
+		}
+	case encoding.Float:
+		if _, v, err := encoding.DecodeFloatValue(key); err == nil {
+			return v
+		}
+	}
+	return 0
+}
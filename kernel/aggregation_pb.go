@@ -0,0 +1,140 @@
+package kernel
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/tiglabs/baudengine/proto/pspb"
+)
+
+var errEmptyAggregation = errors.New("empty aggregation")
+
+// AggregationsFromPB converts the aggregations of the search request
+func AggregationsFromPB(aggs map[string]pspb.Aggregation) (map[string]Aggregation, error) {
+	if len(aggs) == 0 {
+		return nil, nil
+	}
+	result := make(map[string]Aggregation, len(aggs))
+	for name := range aggs {
+		agg := aggs[name]
+		a, err := aggregationFromPB(&agg)
+		if err != nil {
+			return nil, fmt.Errorf("aggregation %s error: %v", name, err)
+		}
+		result[name] = a
+	}
+	return result, nil
+}
+
+func aggregationFromPB(agg *pspb.Aggregation) (Aggregation, error) {
+	switch a := agg.GetValue().(type) {
+	case *pspb.TermsAggregation:
+		subAggs, err := AggregationsFromPB(a.Aggregations)
+		if err != nil {
+			return nil, err
+		}
+		return &TermsAggregation{FieldId: a.Field, Size: int(a.Size_), ShardSize: int(a.ShardSize), Aggregations: subAggs}, nil
+
+	case *pspb.HistogramAggregation:
+		subAggs, err := AggregationsFromPB(a.Aggregations)
+		if err != nil {
+			return nil, err
+		}
+		return &HistogramAggregation{FieldId: a.Field, Interval: a.Interval, Aggregations: subAggs}, nil
+
+	case *pspb.DateHistogramAggregation:
+		subAggs, err := AggregationsFromPB(a.Aggregations)
+		if err != nil {
+			return nil, err
+		}
+		return &DateHistogramAggregation{FieldId: a.Field, Interval: time.Duration(a.Interval), Aggregations: subAggs}, nil
+
+	case *pspb.RangeAggregation:
+		subAggs, err := AggregationsFromPB(a.Aggregations)
+		if err != nil {
+			return nil, err
+		}
+		rangeAgg := &RangeAggregation{FieldId: a.Field, Aggregations: subAggs}
+		for _, r := range a.Ranges {
+			rangeAgg.Ranges = append(rangeAgg.Ranges, AggregationRange{Key: r.Key, From: r.From, To: r.To})
+		}
+		return rangeAgg, nil
+
+	case *pspb.MinAggregation:
+		return &MinAggregation{FieldId: a.Field}, nil
+
+	case *pspb.MaxAggregation:
+		return &MaxAggregation{FieldId: a.Field}, nil
+
+	case *pspb.AvgAggregation:
+		return &AvgAggregation{FieldId: a.Field}, nil
+
+	case *pspb.SumAggregation:
+		return &SumAggregation{FieldId: a.Field}, nil
+
+	case *pspb.StatsAggregation:
+		return &StatsAggregation{FieldId: a.Field}, nil
+
+	case *pspb.CardinalityAggregation:
+		return &CardinalityAggregation{FieldId: a.Field, Precision: uint8(a.Precision)}, nil
+
+	case nil:
+		return nil, errEmptyAggregation
+
+	default:
+		return nil, fmt.Errorf("unsupported aggregation type %T", a)
+	}
+}
+
+// AggregationResultsToPB converts the partial results of a partition for the search response
+func AggregationResultsToPB(results map[string]*AggregationResult) map[string]pspb.AggregationResult {
+	if len(results) == 0 {
+		return nil
+	}
+	pbResults := make(map[string]pspb.AggregationResult, len(results))
+	for name, result := range results {
+		var pbResult pspb.AggregationResult
+		for _, b := range result.Buckets {
+			pbResult.Buckets = append(pbResult.Buckets, pspb.AggregationBucket{
+				Key:          b.Key,
+				DocCount:     b.DocCount,
+				Aggregations: AggregationResultsToPB(b.Aggregations),
+			})
+		}
+		if result.Stats != nil {
+			pbResult.Stats = &pspb.StatsResult{Count: result.Stats.Count, Sum: result.Stats.Sum, Min: result.Stats.Min, Max: result.Stats.Max}
+		}
+		if result.Cardinality != nil {
+			pbResult.Cardinality = result.Cardinality.Registers
+		}
+		pbResults[name] = pbResult
+	}
+	return pbResults
+}
+
+// AggregationResultsFromPB converts the partial results of the search response for merging by MergeAggregations
+func AggregationResultsFromPB(pbResults map[string]pspb.AggregationResult) map[string]*AggregationResult {
+	if len(pbResults) == 0 {
+		return nil
+	}
+	results := make(map[string]*AggregationResult, len(pbResults))
+	for name, pbResult := range pbResults {
+		result := &AggregationResult{}
+		for _, b := range pbResult.Buckets {
+			result.Buckets = append(result.Buckets, &Bucket{
+				Key:          b.Key,
+				DocCount:     b.DocCount,
+				Aggregations: AggregationResultsFromPB(b.Aggregations),
+			})
+		}
+		if pbResult.Stats != nil {
+			result.Stats = &StatsResult{Count: pbResult.Stats.Count, Sum: pbResult.Stats.Sum, Min: pbResult.Stats.Min, Max: pbResult.Stats.Max}
+		}
+		if len(pbResult.Cardinality) > 0 {
+			result.Cardinality = &HyperLogLog{Registers: pbResult.Cardinality}
+		}
+		results[name] = result
+	}
+	return results
+}
@@ -6,6 +6,18 @@ type Analyzer interface {
 	Analyze([]byte) TokenSet
 }
 
+// Explainer is the analyzer made of the components, it explains the output of every component in order
+type Explainer interface {
+	Explain([]byte) []*Stage
+}
+
+// Stage is the output of a component of an analyzer, the text of a char filter or the tokens of the others
+type Stage struct {
+	Name   string
+	Text   []byte
+	Tokens TokenSet
+}
+
 type DateTimeParser interface {
 	ParseDateTime(string) (time.Time, error)
 }
@@ -0,0 +1,228 @@
+package custom
+
+import (
+	"errors"
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/tiglabs/baudengine/kernel/analysis"
+	"github.com/tiglabs/baudengine/kernel/registry"
+	// the components of the custom analyzers
+	_ "github.com/tiglabs/baudengine/kernel/analysis/filter/asciifolding"
+	_ "github.com/tiglabs/baudengine/kernel/analysis/filter/character"
+	_ "github.com/tiglabs/baudengine/kernel/analysis/filter/lower"
+	_ "github.com/tiglabs/baudengine/kernel/analysis/filter/ngram"
+	_ "github.com/tiglabs/baudengine/kernel/analysis/filter/porter"
+	_ "github.com/tiglabs/baudengine/kernel/analysis/filter/stop"
+	_ "github.com/tiglabs/baudengine/kernel/analysis/filter/synonym"
+	_ "github.com/tiglabs/baudengine/kernel/analysis/tokenizer/character"
+	_ "github.com/tiglabs/baudengine/kernel/analysis/tokenizer/chinese"
+	_ "github.com/tiglabs/baudengine/kernel/analysis/tokenizer/fast"
+	_ "github.com/tiglabs/baudengine/kernel/analysis/tokenizer/keyword"
+	_ "github.com/tiglabs/baudengine/kernel/analysis/tokenizer/ngram"
+)
+
+var (
+	_ analysis.Analyzer  = &Analyzer{}
+	_ analysis.Explainer = &Analyzer{}
+)
+
+// Analyzer is the analyzer declared by the space mapping, the char filters drop the chars of the text,
+// the tokenizer splits the rest into tokens and the token filters change the tokens in order
+type Analyzer struct {
+	CharFilters  []analysis.CharFilter
+	Tokenizer    analysis.Tokenizer
+	TokenFilters []analysis.TokenFilter
+
+	// the names of the components in the config, for the explanation
+	charFilterNames  []string
+	tokenizerName    string
+	tokenFilterNames []string
+}
+
+func New(charFilters []analysis.CharFilter, tokenizer analysis.Tokenizer, tokenFilters []analysis.TokenFilter) *Analyzer {
+	return &Analyzer{CharFilters: charFilters, Tokenizer: tokenizer, TokenFilters: tokenFilters}
+}
+
+// NewFromConfig builds the analyzer from the registered components, the config is like
+// {"char_filters": [...], "tokenizer": ..., "token_filters": [...]}, a component is the registered name
+// or the parameters with the name in "type", like {"type": "stop", "stopwords": ["a", "the"]}
+func NewFromConfig(config map[string]interface{}) (*Analyzer, error) {
+	a := &Analyzer{}
+	for key, val := range config {
+		switch key {
+		case "char_filters":
+			components, err := parseComponents(key, val)
+			if err != nil {
+				return nil, err
+			}
+			for _, c := range components {
+				filter, err := registry.NewCharFilter(c.name, c.config)
+				if err != nil {
+					return nil, err
+				}
+				a.CharFilters = append(a.CharFilters, filter)
+				a.charFilterNames = append(a.charFilterNames, c.name)
+			}
+		case "tokenizer":
+			c, err := parseComponent(key, val)
+			if err != nil {
+				return nil, err
+			}
+			if a.Tokenizer, err = registry.NewTokenizer(c.name, c.config); err != nil {
+				return nil, err
+			}
+			a.tokenizerName = c.name
+		case "token_filters":
+			components, err := parseComponents(key, val)
+			if err != nil {
+				return nil, err
+			}
+			for _, c := range components {
+				filter, err := registry.NewTokenFilter(c.name, c.config)
+				if err != nil {
+					return nil, err
+				}
+				a.TokenFilters = append(a.TokenFilters, filter)
+				a.tokenFilterNames = append(a.tokenFilterNames, c.name)
+			}
+		default:
+			return nil, fmt.Errorf("invalid analyzer parameter %s", key)
+		}
+	}
+	if a.Tokenizer == nil {
+		return nil, errors.New("analyzer has no tokenizer")
+	}
+	return a, nil
+}
+
+type component struct {
+	name   string
+	config registry.Config
+}
+
+func parseComponent(key string, val interface{}) (*component, error) {
+	switch v := val.(type) {
+	case string:
+		return &component{name: v}, nil
+	case map[string]interface{}:
+		name, ok := v["type"].(string)
+		if !ok {
+			return nil, fmt.Errorf("%s has no type", key)
+		}
+		config := make(registry.Config, len(v))
+		for k, param := range v {
+			if k != "type" {
+				config[k] = param
+			}
+		}
+		return &component{name: name, config: config}, nil
+	}
+	return nil, fmt.Errorf("invalid %s", key)
+}
+
+func parseComponents(key string, val interface{}) ([]*component, error) {
+	list, ok := val.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s is not a list", key)
+	}
+	components := make([]*component, 0, len(list))
+	for _, item := range list {
+		c, err := parseComponent(key, item)
+		if err != nil {
+			return nil, err
+		}
+		components = append(components, c)
+	}
+	return components, nil
+}
+
+func (a *Analyzer) Analyze(input []byte) analysis.TokenSet {
+	tokens := a.tokenize(input, a.CharFilters)
+	for _, filter := range a.TokenFilters {
+		tokens = filter.Filter(tokens)
+	}
+	return tokens
+}
+
+// Explain returns the text after every char filter, and the tokens of the tokenizer and every token filter
+func (a *Analyzer) Explain(input []byte) []*analysis.Stage {
+	var stages []*analysis.Stage
+	for i, filter := range a.CharFilters {
+		text, _ := filterChars(input, a.CharFilters[:i+1])
+		stages = append(stages, &analysis.Stage{Name: componentName(a.charFilterNames, i, filter), Text: text})
+	}
+	tokens := a.tokenize(input, a.CharFilters)
+	stages = append(stages, &analysis.Stage{Name: componentName([]string{a.tokenizerName}, 0, a.Tokenizer), Tokens: cloneTokens(tokens)})
+	for i, filter := range a.TokenFilters {
+		// the filters may change the tokens in place
+		tokens = filter.Filter(tokens)
+		stages = append(stages, &analysis.Stage{Name: componentName(a.tokenFilterNames, i, filter), Tokens: cloneTokens(tokens)})
+	}
+	return stages
+}
+
+func componentName(names []string, i int, component interface{}) string {
+	if i < len(names) && names[i] != "" {
+		return names[i]
+	}
+	return fmt.Sprintf("%T", component)
+}
+
+func cloneTokens(tokens analysis.TokenSet) analysis.TokenSet {
+	clone := make(analysis.TokenSet, 0, len(tokens))
+	for _, token := range tokens {
+		t := *token
+		t.Term = append([]byte(nil), token.Term...)
+		clone = append(clone, &t)
+	}
+	return clone
+}
+
+// tokenize tokenizes the text after the char filters, the offsets of the tokens point into the input
+func (a *Analyzer) tokenize(input []byte, charFilters []analysis.CharFilter) analysis.TokenSet {
+	text, offsets := filterChars(input, charFilters)
+	tokens := a.Tokenizer.Tokenize(text)
+	if offsets != nil {
+		for _, token := range tokens {
+			if token.End > token.Start {
+				token.End = offsets[token.End-1] + 1
+			} else {
+				token.End = offsets[token.End]
+			}
+			token.Start = offsets[token.Start]
+		}
+	}
+	return tokens
+}
+
+// filterChars drops the chars filtered out by the char filters,
+// offsets are the offsets of the bytes of the text in the input, nil if there is no char filter
+func filterChars(input []byte, charFilters []analysis.CharFilter) (text []byte, offsets []int) {
+	if len(charFilters) == 0 {
+		return input, nil
+	}
+	text = make([]byte, 0, len(input))
+	offsets = make([]int, 0, len(input)+1)
+	for i := 0; i < len(input); {
+		r, size := utf8.DecodeRune(input[i:])
+		if !filterOut(charFilters, r) {
+			text = append(text, input[i:i+size]...)
+			for j := 0; j < size; j++ {
+				offsets = append(offsets, i+j)
+			}
+		}
+		i += size
+	}
+	offsets = append(offsets, len(input))
+	return text, offsets
+}
+
+func filterOut(charFilters []analysis.CharFilter, r rune) bool {
+	for _, filter := range charFilters {
+		if filter.Filter(r) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,104 @@
+package multilingual
+
+import (
+	"sync"
+
+	"github.com/tiglabs/baudengine/kernel/analysis"
+	"github.com/tiglabs/baudengine/kernel/analysis/filter/lower"
+	"github.com/tiglabs/baudengine/kernel/analysis/filter/porter"
+	"github.com/tiglabs/baudengine/kernel/analysis/segment"
+	"github.com/tiglabs/baudengine/kernel/analysis/tokenizer/chinese"
+	"github.com/tiglabs/baudengine/kernel/analysis/tokenizer/ngram"
+	"github.com/tiglabs/baudengine/kernel/analysis/tokenizer/unicode"
+	"github.com/tiglabs/baudengine/kernel/registry"
+	"github.com/tiglabs/baudengine/util/bytes"
+)
+
+const Name = "multilingual"
+
+var _ analysis.Analyzer = &Analyzer{}
+
+// Analyzer segments the text by the scripts and analyzes every segment by the components of its script:
+// the chinese tokenizer for Han, the unicode tokenizer with the lower and the stemming filters for English,
+// and the bigrams for Korean and Japanese. A segment without tokens, like a number, is one token.
+// The positions and the offsets of the tokens go on across the segments.
+type Analyzer struct {
+	once      sync.Once
+	han       analysis.Tokenizer
+	en        analysis.Tokenizer
+	enFilters []analysis.TokenFilter
+	cjk       analysis.Tokenizer
+}
+
+func New() *Analyzer {
+	return &Analyzer{}
+}
+
+// the registered components are got when the analyzer is used first, they are registered in any order
+func (a *Analyzer) init() {
+	a.once.Do(func() {
+		if a.han == nil {
+			a.han = registry.GetTokenizer(chinese.Name)
+		}
+		if a.en == nil {
+			a.en = registry.GetTokenizer(unicode.Name)
+		}
+		if a.enFilters == nil {
+			a.enFilters = append(a.enFilters, registry.GetTokenFilter(lower.Name))
+			a.enFilters = append(a.enFilters, registry.GetTokenFilter(porter.Name))
+		}
+		if a.cjk == nil {
+			a.cjk = ngram.New(2, 2)
+		}
+	})
+}
+
+func (a *Analyzer) Analyze(input []byte) analysis.TokenSet {
+	a.init()
+	var result analysis.TokenSet
+	// the last position of the segments before
+	base := 0
+	for _, s := range segment.NewSegmenter().TextSegment(input) {
+		var tokens analysis.TokenSet
+		switch s.Type {
+		case segment.Han:
+			tokens = a.han.Tokenize(s.Text)
+		case segment.En:
+			tokens = a.en.Tokenize(s.Text)
+			for _, filter := range a.enFilters {
+				tokens = filter.Filter(tokens)
+			}
+		case segment.Korean, segment.Japanese:
+			tokens = a.cjk.Tokenize(s.Text)
+		}
+		if len(tokens) == 0 {
+			tokenType := analysis.Text
+			if s.Type == segment.Number {
+				tokenType = analysis.Numeric
+			}
+			tokens = analysis.TokenSet{{
+				Start:    0,
+				End:      len(s.Text),
+				Term:     bytes.CloneBytes(s.Text),
+				Position: 1,
+				Type:     tokenType,
+			}}
+		}
+		last := base
+		for _, token := range tokens {
+			token.Start += s.Start
+			token.End += s.Start
+			token.Position += base
+			if token.Position > last {
+				last = token.Position
+			}
+		}
+		base = last
+		result = append(result, tokens...)
+	}
+	return result
+}
+
+func init() {
+	registry.RegisterAnalyzer(Name, New())
+}
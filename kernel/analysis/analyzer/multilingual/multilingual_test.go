@@ -0,0 +1,54 @@
+package multilingual
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/tiglabs/baudengine/kernel/analysis/tokenizer/ngram"
+)
+
+func TestAnalyze(t *testing.T) {
+	// the unigrams of Han instead of the chinese dicts
+	analyzer := &Analyzer{han: ngram.New(1, 1)}
+	input := "你好 World Running 한국어 にする 2018"
+	sets := analyzer.Analyze([]byte(input))
+
+	var terms []string
+	for i, token := range sets {
+		terms = append(terms, string(token.Term))
+		if token.Position != i+1 {
+			t.Fatalf("test position of %s failed, got %d", token.Term, token.Position)
+		}
+	}
+	expected := []string{"你", "好", "world", "run", "한국", "국어", "にす", "する", "2018"}
+	if !reflect.DeepEqual(terms, expected) {
+		t.Fatalf("test failed, got %v", terms)
+	}
+	for _, token := range sets {
+		if token.Start >= token.End || token.End > len(input) {
+			t.Fatalf("test offsets of %s failed, got %d %d", token.Term, token.Start, token.End)
+		}
+	}
+	if input[sets[3].Start:sets[3].End] != "Running" || input[sets[5].Start:sets[5].End] != "국어" {
+		t.Fatalf("test offsets failed, got %v %v", sets[3], sets[5])
+	}
+}
+
+func TestAnalyzeOther(t *testing.T) {
+	analyzer := &Analyzer{han: ngram.New(1, 1)}
+	// the text after the invalid utf8 bytes is still analyzed, the segment of Other is one token
+	input := "World\xffＡＢＣ \xc3Running"
+	sets := analyzer.Analyze([]byte(input))
+
+	var terms []string
+	for _, token := range sets {
+		terms = append(terms, string(token.Term))
+	}
+	expected := []string{"world", "ＡＢＣ", "run"}
+	if !reflect.DeepEqual(terms, expected) {
+		t.Fatalf("test failed, got %v", terms)
+	}
+	if input[sets[1].Start:sets[1].End] != "ＡＢＣ" || input[sets[2].Start:sets[2].End] != "Running" {
+		t.Fatalf("test offsets failed, got %v %v", sets[1], sets[2])
+	}
+}
@@ -0,0 +1,156 @@
+package asciifolding
+
+import (
+	"unicode/utf8"
+
+	"github.com/tiglabs/baudengine/kernel/analysis"
+	"github.com/tiglabs/baudengine/kernel/registry"
+)
+
+const Name = "asciifolding"
+
+var _ analysis.TokenFilter = &ASCIIFoldingFilter{}
+
+// ASCIIFoldingFilter folds the Latin letters and the punctuations out of the Basic Latin block
+// into the ASCII equivalents, like "café" -> "cafe", "straße" -> "strasse"
+type ASCIIFoldingFilter struct {
+	// keep the original token if it is folded
+	preserveOriginal bool
+}
+
+func New(preserveOriginal bool) *ASCIIFoldingFilter {
+	return &ASCIIFoldingFilter{preserveOriginal: preserveOriginal}
+}
+
+func (f *ASCIIFoldingFilter) Filter(input analysis.TokenSet) analysis.TokenSet {
+	output := input
+	if f.preserveOriginal {
+		output = make(analysis.TokenSet, 0, len(input))
+	}
+	for _, token := range input {
+		folded, ok := Fold(token.Term)
+		if !f.preserveOriginal {
+			token.Term = folded
+			continue
+		}
+		output = append(output, token)
+		if ok {
+			output = append(output, &analysis.Token{
+				Start:    token.Start,
+				End:      token.End,
+				Term:     folded,
+				Position: token.Position,
+				Type:     token.Type,
+			})
+		}
+	}
+	return output
+}
+
+// Fold returns the folded term, ok is false if nothing is folded and the term is returned
+func Fold(term []byte) (folded []byte, ok bool) {
+	i := 0
+	for ; i < len(term); i++ {
+		if term[i] >= utf8.RuneSelf {
+			break
+		}
+	}
+	if i == len(term) {
+		return term, false
+	}
+	folded = make([]byte, i, len(term))
+	copy(folded, term[:i])
+	for i < len(term) {
+		r, size := utf8.DecodeRune(term[i:])
+		if s, found := foldings[r]; found {
+			folded = append(folded, s...)
+			ok = true
+		} else {
+			folded = append(folded, term[i:i+size]...)
+		}
+		i += size
+	}
+	if !ok {
+		return term, false
+	}
+	return folded, true
+}
+
+// foldings maps the chars to the ASCII equivalents
+var foldings = make(map[rune]string)
+
+func init() {
+	for _, f := range []struct{ to, from string }{
+		{"A", "ÀÁÂÃÄÅĀĂĄǍǞǠǺȀȂȦȺ"},
+		{"a", "àáâãäåāăąǎǟǡǻȁȃȧ"},
+		{"AE", "ÆǢǼ"},
+		{"ae", "æǣǽ"},
+		{"B", "ƁƂ"},
+		{"b", "ƀƃ"},
+		{"C", "ÇĆĈĊČƇȻ"},
+		{"c", "çćĉċčƈȼ"},
+		{"D", "ÐĎĐƉƊ"},
+		{"d", "ðďđƌȡ"},
+		{"E", "ÈÉÊËĒĔĖĘĚȄȆȨ"},
+		{"e", "èéêëēĕėęěȅȇȩ"},
+		{"F", "Ƒ"},
+		{"f", "ƒ"},
+		{"G", "ĜĞĠĢƓǤǦǴ"},
+		{"g", "ĝğġģǥǧǵ"},
+		{"H", "ĤĦȞ"},
+		{"h", "ĥħȟ"},
+		{"I", "ÌÍÎÏĨĪĬĮİƗǏȈȊ"},
+		{"i", "ìíîïĩīĭįıǐȉȋ"},
+		{"IJ", "Ĳ"},
+		{"ij", "ĳ"},
+		{"J", "Ĵ"},
+		{"j", "ĵǰȷ"},
+		{"K", "ĶƘǨ"},
+		{"k", "ķĸƙǩ"},
+		{"L", "ĹĻĽĿŁȽ"},
+		{"l", "ĺļľŀłƚȴ"},
+		{"N", "ÑŃŅŇŊƝǸȠ"},
+		{"n", "ñńņňŉŋƞǹȵ"},
+		{"O", "ÒÓÔÕÖØŌŎŐƟƠǑǪǬǾȌȎȪȬȮȰ"},
+		{"o", "òóôõöøōŏőơǒǫǭǿȍȏȫȭȯȱ"},
+		{"OE", "Œ"},
+		{"oe", "œ"},
+		{"P", "Ƥ"},
+		{"p", "ƥ"},
+		{"R", "ŔŖŘȐȒ"},
+		{"r", "ŕŗřȑȓ"},
+		{"S", "ŚŜŞŠȘ"},
+		{"s", "śŝşšșſ"},
+		{"ss", "ß"},
+		{"T", "ŢŤŦƬƮȚȾ"},
+		{"t", "ţťŧƫƭțȶ"},
+		{"TH", "Þ"},
+		{"th", "þ"},
+		{"U", "ÙÚÛÜŨŪŬŮŰŲƯǓǕǗǙǛȔȖ"},
+		{"u", "ùúûüũūŭůűųưǔǖǘǚǜȕȗ"},
+		{"V", "Ʋ"},
+		{"W", "Ŵ"},
+		{"w", "ŵ"},
+		{"Y", "ÝŶŸƳȲ"},
+		{"y", "ýÿŷƴȳ"},
+		{"Z", "ŹŻŽƵȤ"},
+		{"z", "źżžƶȥ"},
+		{"'", "‘’‚‛′"},
+		{"\"", "“”„‟″«»"},
+		{"-", "‐‑‒–—"},
+		{"...", "…"},
+	} {
+		for _, r := range f.from {
+			foldings[r] = f.to
+		}
+	}
+
+	registry.RegisterTokenFilter(Name, New(false))
+	registry.RegisterTokenFilterConstructor(Name, func(config registry.Config) (analysis.TokenFilter, error) {
+		preserveOriginal, err := config.Bool("preserve_original")
+		if err != nil {
+			return nil, err
+		}
+		return New(preserveOriginal), nil
+	})
+}
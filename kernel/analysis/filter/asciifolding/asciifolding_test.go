@@ -0,0 +1,38 @@
+package asciifolding
+
+import (
+	"testing"
+
+	"github.com/tiglabs/baudengine/kernel/analysis"
+)
+
+func TestFold(t *testing.T) {
+	words := map[string]string{
+		"café":       "cafe",
+		"Straße":     "Strasse",
+		"Ærøskøbing": "AEroskobing",
+		"naïve":      "naive",
+		"Łódź":       "Lodz",
+		"“quoted”":   "\"quoted\"",
+		"ascii":      "ascii",
+		"你好":         "你好",
+	}
+	for word, expect := range words {
+		if got, _ := Fold([]byte(word)); string(got) != expect {
+			t.Fatalf("fold %s failed, expect %s, got %s", word, expect, got)
+		}
+	}
+
+	set := New(false).Filter(analysis.TokenSet{&analysis.Token{Term: []byte("résumé"), Position: 1}})
+	if len(set) != 1 || string(set[0].Term) != "resume" {
+		t.Fatal("test failed")
+	}
+	set = New(true).Filter(analysis.TokenSet{
+		&analysis.Token{Term: []byte("résumé"), Position: 1},
+		&analysis.Token{Term: []byte("cv"), Position: 2},
+	})
+	if len(set) != 3 || string(set[0].Term) != "résumé" || string(set[1].Term) != "resume" || set[1].Position != 1 ||
+		string(set[2].Term) != "cv" {
+		t.Fatal("test preserve original failed")
+	}
+}
@@ -1,13 +1,31 @@
 package character
 
 import (
+	"fmt"
+	"unicode"
+
 	"github.com/tiglabs/baudengine/kernel/analysis"
+	"github.com/tiglabs/baudengine/kernel/registry"
 )
 
+const Name = "character"
+
 var _ analysis.CharFilter = &CharacterFilter{}
 
 type FilterOutFunc func(r rune) bool
 
+// Functions are the filter functions the custom analyzers name in the space mapping
+var Functions = map[string]FilterOutFunc{
+	"whitespace":  unicode.IsSpace,
+	"letter":      unicode.IsLetter,
+	"digit":       unicode.IsDigit,
+	"number":      unicode.IsNumber,
+	"punctuation": unicode.IsPunct,
+	"symbol":      unicode.IsSymbol,
+	"control":     unicode.IsControl,
+	"mark":        unicode.IsMark,
+}
+
 type CharacterFilter struct {
 	filterOut FilterOutFunc
 }
@@ -20,4 +38,35 @@ func (cf *CharacterFilter) Filter(r rune) bool {
 	return cf.filterOut(r)
 }
 
+// FunctionOf returns the filter function of the parameters,
+// "function" names one of the Functions and "invert" filters out the other chars
+func FunctionOf(config registry.Config) (FilterOutFunc, error) {
+	name, err := config.String("function")
+	if err != nil {
+		return nil, err
+	}
+	f, ok := Functions[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown character function %q", name)
+	}
+	invert, err := config.Bool("invert")
+	if err != nil {
+		return nil, err
+	}
+	if invert {
+		return func(r rune) bool {
+			return !f(r)
+		}, nil
+	}
+	return f, nil
+}
 
+func init() {
+	registry.RegisterCharFilterConstructor(Name, func(config registry.Config) (analysis.CharFilter, error) {
+		f, err := FunctionOf(config)
+		if err != nil {
+			return nil, err
+		}
+		return New(f), nil
+	})
+}
@@ -0,0 +1,83 @@
+package ngram
+
+import (
+	"unicode/utf8"
+
+	"github.com/tiglabs/baudengine/kernel/analysis"
+	"github.com/tiglabs/baudengine/kernel/analysis/tokenizer/ngram"
+	"github.com/tiglabs/baudengine/kernel/registry"
+)
+
+const (
+	Name     = "ngram"
+	EdgeName = "edge_ngram"
+)
+
+var _ analysis.TokenFilter = &NgramFilter{}
+
+// NgramFilter replaces the tokens by the grams of them, the grams keep the position and the offsets
+// of the token. The edge filter emits the grams at the start of the tokens only.
+type NgramFilter struct {
+	minGram, maxGram int
+	edge             bool
+	// keep the original token if it is not one of the grams
+	preserveOriginal bool
+}
+
+func New(minGram, maxGram int, preserveOriginal bool) *NgramFilter {
+	return &NgramFilter{minGram: minGram, maxGram: maxGram, preserveOriginal: preserveOriginal}
+}
+
+func NewEdge(minGram, maxGram int, preserveOriginal bool) *NgramFilter {
+	return &NgramFilter{minGram: minGram, maxGram: maxGram, edge: true, preserveOriginal: preserveOriginal}
+}
+
+func (f *NgramFilter) Filter(input analysis.TokenSet) analysis.TokenSet {
+	output := make(analysis.TokenSet, 0, len(input))
+	for _, token := range input {
+		// the byte offsets of the runes of the term, and the end of the last rune
+		offsets := make([]int, 0, len(token.Term)+1)
+		for i := range string(token.Term) {
+			offsets = append(offsets, i)
+		}
+		offsets = append(offsets, len(token.Term))
+		runes := utf8.RuneCount(token.Term)
+		for start := 0; start < runes; start++ {
+			if f.edge && start > 0 {
+				break
+			}
+			for n := f.minGram; n <= f.maxGram && start+n <= runes; n++ {
+				output = append(output, &analysis.Token{
+					Start:    token.Start,
+					End:      token.End,
+					Term:     append([]byte(nil), token.Term[offsets[start]:offsets[start+n]]...),
+					Position: token.Position,
+					Type:     token.Type,
+				})
+			}
+		}
+		if f.preserveOriginal && (runes < f.minGram || runes > f.maxGram) {
+			output = append(output, token)
+		}
+	}
+	return output
+}
+
+func newConstructor(edge bool) registry.TokenFilterConstructor {
+	return func(config registry.Config) (analysis.TokenFilter, error) {
+		minGram, maxGram, err := ngram.ParseGrams(config)
+		if err != nil {
+			return nil, err
+		}
+		preserveOriginal, err := config.Bool("preserve_original")
+		if err != nil {
+			return nil, err
+		}
+		return &NgramFilter{minGram: minGram, maxGram: maxGram, edge: edge, preserveOriginal: preserveOriginal}, nil
+	}
+}
+
+func init() {
+	registry.RegisterTokenFilterConstructor(Name, newConstructor(false))
+	registry.RegisterTokenFilterConstructor(EdgeName, newConstructor(true))
+}
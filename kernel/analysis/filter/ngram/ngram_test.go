@@ -0,0 +1,38 @@
+package ngram
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/tiglabs/baudengine/kernel/analysis"
+)
+
+func TestFilter(t *testing.T) {
+	input := func() analysis.TokenSet {
+		return analysis.TokenSet{
+			&analysis.Token{Term: []byte("fox"), Start: 4, End: 7, Position: 2},
+			&analysis.Token{Term: []byte("a"), Start: 8, End: 9, Position: 3},
+		}
+	}
+	tests := []struct {
+		filter *NgramFilter
+		terms  []string
+	}{
+		{New(2, 3, false), []string{"fo", "fox", "ox"}},
+		{New(2, 2, true), []string{"fo", "ox", "fox", "a"}},
+		{NewEdge(1, 2, false), []string{"f", "fo", "a"}},
+	}
+	for i, test := range tests {
+		set := test.filter.Filter(input())
+		var terms []string
+		for _, token := range set {
+			terms = append(terms, string(token.Term))
+		}
+		if !reflect.DeepEqual(terms, test.terms) {
+			t.Fatalf("test %d failed, expect %v, got %v", i, test.terms, terms)
+		}
+		if set[0].Position != 2 || set[0].Start != 4 || set[0].End != 7 {
+			t.Fatalf("test %d failed, the grams should keep the position and offsets", i)
+		}
+	}
+}
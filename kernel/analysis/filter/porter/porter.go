@@ -0,0 +1,323 @@
+package porter
+
+import (
+	"github.com/tiglabs/baudengine/kernel/analysis"
+	"github.com/tiglabs/baudengine/kernel/registry"
+)
+
+const Name = "porter_stem"
+
+var _ analysis.TokenFilter = &PorterFilter{}
+
+// PorterFilter stems the English terms by the Porter algorithm, the terms must be lower case,
+// the terms with the chars other than 'a' to 'z' are not changed
+type PorterFilter struct {
+}
+
+func New() *PorterFilter {
+	return &PorterFilter{}
+}
+
+func (f *PorterFilter) Filter(input analysis.TokenSet) analysis.TokenSet {
+	for _, token := range input {
+		token.Term = Stem(token.Term)
+	}
+	return input
+}
+
+// Stem returns the stem of the word, the word is not changed
+func Stem(word []byte) []byte {
+	if len(word) <= 2 {
+		return word
+	}
+	for _, c := range word {
+		if c < 'a' || c > 'z' {
+			return word
+		}
+	}
+	z := &stemmer{b: append([]byte(nil), word...), k: len(word) - 1}
+	z.step1ab()
+	if z.k > 0 {
+		z.step1c()
+		z.step2()
+		z.step3()
+		z.step4()
+		z.step5()
+	}
+	return z.b[:z.k+1]
+}
+
+// stemmer is the state of the algorithm, b[0..k] is the word being stemmed and j is the end of its stem
+type stemmer struct {
+	b    []byte
+	k, j int
+}
+
+// cons is true if b[i] is a consonant
+func (z *stemmer) cons(i int) bool {
+	switch z.b[i] {
+	case 'a', 'e', 'i', 'o', 'u':
+		return false
+	case 'y':
+		if i == 0 {
+			return true
+		}
+		return !z.cons(i - 1)
+	}
+	return true
+}
+
+// m measures the number of the consonant sequences in b[0..j], the word is like [C](VC){m}[V]
+func (z *stemmer) m() int {
+	n, i := 0, 0
+	for ; ; i++ {
+		if i > z.j {
+			return n
+		}
+		if !z.cons(i) {
+			break
+		}
+	}
+	i++
+	for {
+		for ; ; i++ {
+			if i > z.j {
+				return n
+			}
+			if z.cons(i) {
+				break
+			}
+		}
+		i++
+		n++
+		for ; ; i++ {
+			if i > z.j {
+				return n
+			}
+			if !z.cons(i) {
+				break
+			}
+		}
+		i++
+	}
+}
+
+// vowelInStem is true if b[0..j] contains a vowel
+func (z *stemmer) vowelInStem() bool {
+	for i := 0; i <= z.j; i++ {
+		if !z.cons(i) {
+			return true
+		}
+	}
+	return false
+}
+
+// doublec is true if b[j-1..j] is a double consonant
+func (z *stemmer) doublec(j int) bool {
+	if j < 1 || z.b[j] != z.b[j-1] {
+		return false
+	}
+	return z.cons(j)
+}
+
+// cvc is true if b[i-2..i] is consonant, vowel, consonant and the last one is not w, x or y,
+// it restores an e at the end of the short words, like cav(e), lov(e), hop(e)
+func (z *stemmer) cvc(i int) bool {
+	if i < 2 || !z.cons(i) || z.cons(i-1) || !z.cons(i-2) {
+		return false
+	}
+	switch z.b[i] {
+	case 'w', 'x', 'y':
+		return false
+	}
+	return true
+}
+
+// ends is true if b[0..k] ends with s, j is set to the end of the stem before s
+func (z *stemmer) ends(s string) bool {
+	l := len(s)
+	if l > z.k+1 || string(z.b[z.k-l+1:z.k+1]) != s {
+		return false
+	}
+	z.j = z.k - l
+	return true
+}
+
+// setTo replaces b[j+1..k] by s
+func (z *stemmer) setTo(s string) {
+	z.b = append(z.b[:z.j+1], s...)
+	z.k = z.j + len(s)
+}
+
+func (z *stemmer) r(s string) {
+	if z.m() > 0 {
+		z.setTo(s)
+	}
+}
+
+// step1ab removes the plurals and -ed or -ing, like caresses -> caress, ponies -> poni, meetings -> meet
+func (z *stemmer) step1ab() {
+	if z.b[z.k] == 's' {
+		if z.ends("sses") {
+			z.k -= 2
+		} else if z.ends("ies") {
+			z.setTo("i")
+		} else if z.b[z.k-1] != 's' {
+			z.k--
+		}
+	}
+	if z.ends("eed") {
+		if z.m() > 0 {
+			z.k--
+		}
+	} else if (z.ends("ed") || z.ends("ing")) && z.vowelInStem() {
+		z.k = z.j
+		if z.ends("at") {
+			z.setTo("ate")
+		} else if z.ends("bl") {
+			z.setTo("ble")
+		} else if z.ends("iz") {
+			z.setTo("ize")
+		} else if z.doublec(z.k) {
+			z.k--
+			switch z.b[z.k] {
+			case 'l', 's', 'z':
+				z.k++
+			}
+		} else if z.m() == 1 && z.cvc(z.k) {
+			z.setTo("e")
+		}
+	}
+}
+
+// step1c turns the terminal y to i when there is another vowel in the stem
+func (z *stemmer) step1c() {
+	if z.ends("y") && z.vowelInStem() {
+		z.b[z.k] = 'i'
+	}
+}
+
+// step2 maps the double suffices to the single ones, like -ization -> -ize, when m() > 0
+func (z *stemmer) step2() {
+	if z.k < 1 {
+		return
+	}
+	var rules [][2]string
+	switch z.b[z.k-1] {
+	case 'a':
+		rules = [][2]string{{"ational", "ate"}, {"tional", "tion"}}
+	case 'c':
+		rules = [][2]string{{"enci", "ence"}, {"anci", "ance"}}
+	case 'e':
+		rules = [][2]string{{"izer", "ize"}}
+	case 'l':
+		rules = [][2]string{{"bli", "ble"}, {"alli", "al"}, {"entli", "ent"}, {"eli", "e"}, {"ousli", "ous"}}
+	case 'o':
+		rules = [][2]string{{"ization", "ize"}, {"ation", "ate"}, {"ator", "ate"}}
+	case 's':
+		rules = [][2]string{{"alism", "al"}, {"iveness", "ive"}, {"fulness", "ful"}, {"ousness", "ous"}}
+	case 't':
+		rules = [][2]string{{"aliti", "al"}, {"iviti", "ive"}, {"biliti", "ble"}}
+	case 'g':
+		rules = [][2]string{{"logi", "log"}}
+	}
+	z.replace(rules)
+}
+
+// step3 deals with -ic-, -full, -ness etc. like step2
+func (z *stemmer) step3() {
+	var rules [][2]string
+	switch z.b[z.k] {
+	case 'e':
+		rules = [][2]string{{"icate", "ic"}, {"ative", ""}, {"alize", "al"}}
+	case 'i':
+		rules = [][2]string{{"iciti", "ic"}}
+	case 'l':
+		rules = [][2]string{{"ical", "ic"}, {"ful", ""}}
+	case 's':
+		rules = [][2]string{{"ness", ""}}
+	}
+	z.replace(rules)
+}
+
+// replace replaces the first suffix of the rules the word ends with when m() > 0
+func (z *stemmer) replace(rules [][2]string) {
+	for _, rule := range rules {
+		if z.ends(rule[0]) {
+			z.r(rule[1])
+			return
+		}
+	}
+}
+
+// step4 takes off -ant, -ence etc. when m() > 1
+func (z *stemmer) step4() {
+	if z.k < 1 {
+		return
+	}
+	var suffixes []string
+	switch z.b[z.k-1] {
+	case 'a':
+		suffixes = []string{"al"}
+	case 'c':
+		suffixes = []string{"ance", "ence"}
+	case 'e':
+		suffixes = []string{"er"}
+	case 'i':
+		suffixes = []string{"ic"}
+	case 'l':
+		suffixes = []string{"able", "ible"}
+	case 'n':
+		suffixes = []string{"ant", "ement", "ment", "ent"}
+	case 'o':
+		if z.ends("ion") && z.j >= 0 && (z.b[z.j] == 's' || z.b[z.j] == 't') {
+			break
+		}
+		suffixes = []string{"ou"}
+	case 's':
+		suffixes = []string{"ism"}
+	case 't':
+		suffixes = []string{"ate", "iti"}
+	case 'u':
+		suffixes = []string{"ous"}
+	case 'v':
+		suffixes = []string{"ive"}
+	case 'z':
+		suffixes = []string{"ize"}
+	default:
+		return
+	}
+	if suffixes != nil {
+		found := false
+		for _, suffix := range suffixes {
+			if z.ends(suffix) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return
+		}
+	}
+	if z.m() > 1 {
+		z.k = z.j
+	}
+}
+
+// step5 removes the final -e when m() > 1, and changes -ll to -l when m() > 1
+func (z *stemmer) step5() {
+	z.j = z.k
+	if z.b[z.k] == 'e' {
+		a := z.m()
+		if a > 1 || a == 1 && !z.cvc(z.k-1) {
+			z.k--
+		}
+	}
+	if z.b[z.k] == 'l' && z.doublec(z.k) && z.m() > 1 {
+		z.k--
+	}
+}
+
+func init() {
+	registry.RegisterTokenFilter(Name, New())
+}
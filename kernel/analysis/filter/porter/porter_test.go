@@ -0,0 +1,50 @@
+package porter
+
+import (
+	"testing"
+
+	"github.com/tiglabs/baudengine/kernel/analysis"
+)
+
+func TestStem(t *testing.T) {
+	words := map[string]string{
+		"caresses":       "caress",
+		"ponies":         "poni",
+		"ties":           "ti",
+		"caress":         "caress",
+		"cats":           "cat",
+		"feed":           "feed",
+		"agreed":         "agre",
+		"plastered":      "plaster",
+		"motoring":       "motor",
+		"sing":           "sing",
+		"conflated":      "conflat",
+		"troubled":       "troubl",
+		"sized":          "size",
+		"hopping":        "hop",
+		"falling":        "fall",
+		"hissing":        "hiss",
+		"filing":         "file",
+		"happy":          "happi",
+		"relational":     "relat",
+		"conditional":    "condit",
+		"generalization": "gener",
+		"connections":    "connect",
+		"adjustable":     "adjust",
+		"controlling":    "control",
+		"running":        "run",
+		"is":             "is",
+		"café":           "café",
+		"ipad2":          "ipad2",
+	}
+	for word, stem := range words {
+		if got := string(Stem([]byte(word))); got != stem {
+			t.Fatalf("stem %s failed, expect %s, got %s", word, stem, got)
+		}
+	}
+
+	set := New().Filter(analysis.TokenSet{&analysis.Token{Term: []byte("running"), Position: 1}})
+	if len(set) != 1 || string(set[0].Term) != "run" || set[0].Position != 1 {
+		t.Fatal("test failed")
+	}
+}
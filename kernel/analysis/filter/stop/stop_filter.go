@@ -2,9 +2,11 @@ package stop
 
 import (
 	"bufio"
+	"errors"
 	"io"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/heidawei/gotrie/trie"
 	"github.com/tiglabs/baudengine/kernel/analysis"
@@ -20,15 +22,37 @@ type StopFilter struct {
 	dict   *trie.Trie
 }
 
+// New returns the filter loading the dict when it filters first,
+// so that the dict path can be configured after the filter is registered
 func New() *StopFilter {
-	sf := &StopFilter{dict: trie.NewTrie()}
-	sf.loadDict()
 	return &StopFilter{}
 }
 
+// NewWithWords returns the filter of the stop words instead of the dict
+func NewWithWords(words []string) *StopFilter {
+	dict := trie.NewTrie()
+	for _, word := range words {
+		dict.ReplaceOrInsert([]byte(word), nil)
+	}
+	return &StopFilter{dict: dict}
+}
+
+// NewWithDict returns the filter of the dict loaded now, it fails if the dict can not be loaded
+func NewWithDict() (*StopFilter, error) {
+	sf := &StopFilter{}
+	if err := sf.loadDict(); err != nil {
+		return nil, err
+	}
+	return sf, nil
+}
+
 func (sf *StopFilter) loadDict() error {
-	sf.dict = trie.NewTrie()
-	f, err := os.Open(config.GetWordDictPath("stop_word.dict"))
+	path, ok := config.LookupWordDictPath("stop_word.dict")
+	if !ok {
+		return errors.New("stop word dict is not set")
+	}
+	dict := trie.NewTrie()
+	f, err := os.Open(path)
 	if err != nil {
 		return err
 	}
@@ -36,14 +60,17 @@ func (sf *StopFilter) loadDict() error {
 	reader := bufio.NewReader(f)
 	for {
 		line, err := reader.ReadString('\n')
+		if word := strings.TrimSpace(line); word != "" {
+			dict.ReplaceOrInsert([]byte(word), nil)
+		}
 		if err != nil {
 			if err == io.EOF {
-				return nil
+				break
 			}
 			return err
 		}
-		sf.dict.ReplaceOrInsert([]byte(line), nil)
 	}
+	sf.dict = dict
 	return nil
 }
 
@@ -68,4 +95,19 @@ func (sf *StopFilter) Filter(input analysis.TokenSet) analysis.TokenSet {
 
 func init() {
 	registry.RegisterTokenFilter(Name, New())
+	registry.RegisterTokenFilterConstructor(Name, func(config registry.Config) (analysis.TokenFilter, error) {
+		words, err := config.Strings("stopwords")
+		if err != nil {
+			return nil, err
+		}
+		// the dict is loaded by the constructor, so that the mapping of the filter is rejected if it fails
+		if words == nil {
+			sf, err := NewWithDict()
+			if err != nil {
+				return nil, err
+			}
+			return sf, nil
+		}
+		return NewWithWords(words), nil
+	})
 }
@@ -0,0 +1,42 @@
+package stop
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/tiglabs/baudengine/kernel/analysis"
+	"github.com/tiglabs/baudengine/kernel/config"
+	"github.com/tiglabs/baudengine/kernel/registry"
+)
+
+func TestStopFilterDict(t *testing.T) {
+	// the filter of the dict fails to build when the dict is not set
+	if _, err := registry.NewTokenFilter(Name, nil); err == nil {
+		t.Fatal("stop filter without dict should fail")
+	}
+
+	dir, err := ioutil.TempDir("", "stop")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "stop_word.dict")
+	if err := ioutil.WriteFile(path, []byte("a\nthe"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	config.SetWordDictPath("stop_word.dict", path)
+	f, err := registry.NewTokenFilter(Name, nil)
+	if err != nil {
+		t.Fatalf("new stop filter failed, err %v", err)
+	}
+	set := f.Filter(analysis.TokenSet{
+		&analysis.Token{Term: []byte("the")},
+		&analysis.Token{Term: []byte("quick")},
+		&analysis.Token{Term: []byte("a")},
+	})
+	if len(set) != 1 || string(set[0].Term) != "quick" {
+		t.Fatal("test fail")
+	}
+}
@@ -0,0 +1,219 @@
+package synonym
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/tiglabs/baudengine/kernel/analysis"
+	"github.com/tiglabs/baudengine/kernel/registry"
+)
+
+const Name = "synonym"
+
+var _ analysis.TokenFilter = &SynonymFilter{}
+
+// SynonymFilter adds the synonyms of the terms at the positions of them, the rules are like
+//   "ipod, i-pod, i pod"   the words are equivalent, every one is expanded to all of them,
+//                          or replaced by the first one if not expand
+//   "sea biscuit => seabiscuit"   the words on the left are replaced by the words on the right
+// the words of a rule may have several terms, like "new york", they match the consecutive tokens
+type SynonymFilter struct {
+	// the terms of the matched words joined by space -> the synonyms
+	synonyms map[string][][][]byte
+	// the max number of the terms of the matched words
+	maxTerms int
+}
+
+// New returns the filter of the rules
+func New(rules []string, expand bool) (*SynonymFilter, error) {
+	f := &SynonymFilter{synonyms: make(map[string][][][]byte)}
+	for i, rule := range rules {
+		if err := f.addRule(rule, expand); err != nil {
+			return nil, fmt.Errorf("invalid synonym rule %d: %v", i+1, err)
+		}
+	}
+	return f, nil
+}
+
+// NewFromFile returns the filter of the rules in the file, a rule a line,
+// the empty lines and the lines start with '#' are ignored
+func NewFromFile(path string, expand bool) (*SynonymFilter, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	var rules []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		rules = append(rules, scanner.Text())
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return New(rules, expand)
+}
+
+func (f *SynonymFilter) addRule(rule string, expand bool) error {
+	rule = strings.TrimSpace(rule)
+	if rule == "" || strings.HasPrefix(rule, "#") {
+		return nil
+	}
+	var from, to []string
+	if sides := strings.Split(rule, "=>"); len(sides) == 2 {
+		from, to = splitWords(sides[0]), splitWords(sides[1])
+	} else if len(sides) == 1 {
+		from = splitWords(rule)
+		if expand {
+			to = from
+		} else {
+			to = from[:1]
+		}
+	} else {
+		return errors.New("more than one =>")
+	}
+	if len(from) == 0 || len(to) == 0 {
+		return errors.New("no words")
+	}
+	for _, words := range from {
+		terms := strings.Fields(words)
+		if len(terms) > f.maxTerms {
+			f.maxTerms = len(terms)
+		}
+		key := strings.Join(terms, " ")
+		for _, synonym := range to {
+			f.addSynonym(key, strings.Fields(synonym))
+		}
+	}
+	return nil
+}
+
+func (f *SynonymFilter) addSynonym(key string, terms []string) {
+	for _, synonym := range f.synonyms[key] {
+		if joinTerms(synonym) == strings.Join(terms, " ") {
+			return
+		}
+	}
+	synonym := make([][]byte, 0, len(terms))
+	for _, term := range terms {
+		synonym = append(synonym, []byte(term))
+	}
+	f.synonyms[key] = append(f.synonyms[key], synonym)
+}
+
+func splitWords(s string) []string {
+	var words []string
+	for _, word := range strings.Split(s, ",") {
+		if word = strings.TrimSpace(word); word != "" {
+			words = append(words, word)
+		}
+	}
+	return words
+}
+
+func joinTerms(terms [][]byte) string {
+	strs := make([]string, 0, len(terms))
+	for _, term := range terms {
+		strs = append(strs, string(term))
+	}
+	return strings.Join(strs, " ")
+}
+
+// Filter replaces the longest matched words by the synonyms, the original tokens are kept
+// if they are one of the synonyms. The terms of a synonym take the positions of the matched tokens
+// in order, the extra terms take the last position, and they have the offsets of all the matched tokens.
+func (f *SynonymFilter) Filter(input analysis.TokenSet) analysis.TokenSet {
+	output := make(analysis.TokenSet, 0, len(input))
+	for i := 0; i < len(input); {
+		n, synonyms := f.match(input[i:])
+		if n == 0 {
+			output = append(output, input[i])
+			i++
+			continue
+		}
+		matched := input[i : i+n]
+		key := joinTokens(matched)
+		first, last := matched[0], matched[n-1]
+		for _, synonym := range synonyms {
+			if joinTerms(synonym) == key {
+				output = append(output, matched...)
+				continue
+			}
+			for j, term := range synonym {
+				position := first.Position + j
+				if position > last.Position {
+					position = last.Position
+				}
+				output = append(output, &analysis.Token{
+					Start:    first.Start,
+					End:      last.End,
+					Term:     append([]byte(nil), term...),
+					Position: position,
+					Type:     first.Type,
+				})
+			}
+		}
+		i += n
+	}
+	return output
+}
+
+// match returns the number of the tokens of the longest matched words and the synonyms of them
+func (f *SynonymFilter) match(tokens analysis.TokenSet) (int, [][][]byte) {
+	n := f.maxTerms
+	if n > len(tokens) {
+		n = len(tokens)
+	}
+	// the terms of the words are at the consecutive positions
+	for i := 1; i < n; i++ {
+		if tokens[i].Position != tokens[0].Position+i {
+			n = i
+			break
+		}
+	}
+	for ; n > 0; n-- {
+		if synonyms, ok := f.synonyms[joinTokens(tokens[:n])]; ok {
+			return n, synonyms
+		}
+	}
+	return 0, nil
+}
+
+func joinTokens(tokens analysis.TokenSet) string {
+	terms := make([][]byte, 0, len(tokens))
+	for _, token := range tokens {
+		terms = append(terms, token.Term)
+	}
+	return joinTerms(terms)
+}
+
+func init() {
+	// the rules are in "synonyms", or in the file of "synonyms_path", "expand" is true if absent
+	registry.RegisterTokenFilterConstructor(Name, func(config registry.Config) (analysis.TokenFilter, error) {
+		expand := true
+		if _, ok := config["expand"]; ok {
+			var err error
+			if expand, err = config.Bool("expand"); err != nil {
+				return nil, err
+			}
+		}
+		path, err := config.String("synonyms_path")
+		if err != nil {
+			return nil, err
+		}
+		if path != "" {
+			return NewFromFile(path, expand)
+		}
+		rules, err := config.Strings("synonyms")
+		if err != nil {
+			return nil, err
+		}
+		if rules == nil {
+			return nil, errors.New("synonym filter has no synonyms or synonyms_path")
+		}
+		return New(rules, expand)
+	})
+}
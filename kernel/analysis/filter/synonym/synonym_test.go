@@ -0,0 +1,94 @@
+package synonym
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/tiglabs/baudengine/kernel/analysis"
+	"github.com/tiglabs/baudengine/kernel/analysis/tokenizer/character"
+)
+
+func tokenize(text string) analysis.TokenSet {
+	return character.NewCharTokenizer(func(r rune) bool {
+		return r == ' '
+	}).Tokenize([]byte(text))
+}
+
+func terms(set analysis.TokenSet) map[string]int {
+	terms := make(map[string]int)
+	for _, token := range set {
+		terms[string(token.Term)] = token.Position
+	}
+	return terms
+}
+
+func equalTerms(a, b map[string]int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for term, pos := range a {
+		if p, ok := b[term]; !ok || p != pos {
+			return false
+		}
+	}
+	return true
+}
+
+func TestSynonym(t *testing.T) {
+	dir, err := ioutil.TempDir("", "synonym")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "synonym.dict")
+	dict := "# the synonyms\n\nipod, i-pod\nny, new york\nsea biscuit => seabiscuit\n"
+	if err := ioutil.WriteFile(path, []byte(dict), 0644); err != nil {
+		t.Fatal(err)
+	}
+	f, err := NewFromFile(path, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		text  string
+		terms map[string]int
+	}{
+		{"my ipod", map[string]int{"my": 1, "ipod": 2, "i-pod": 2}},
+		{"ny city", map[string]int{"ny": 1, "new": 1, "york": 1, "city": 2}},
+		{"new york city", map[string]int{"new": 1, "york": 2, "ny": 1, "city": 3}},
+		{"new city york", map[string]int{"new": 1, "city": 2, "york": 3}},
+		{"the sea biscuit", map[string]int{"the": 1, "seabiscuit": 2}},
+	}
+	for i, test := range tests {
+		set := f.Filter(tokenize(test.text))
+		if got := terms(set); !equalTerms(got, test.terms) {
+			t.Fatalf("test %d failed, expect %v, got %v", i, test.terms, got)
+		}
+	}
+
+	// the synonyms have the offsets of the matched tokens
+	set := f.Filter(tokenize("in new york"))
+	for _, token := range set {
+		if string(token.Term) == "ny" && (token.Start != 3 || token.End != 11) {
+			t.Fatalf("offsets of synonym failed, got %d %d", token.Start, token.End)
+		}
+	}
+
+	f, err = New([]string{"ipod, i-pod, i pod"}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := terms(f.Filter(tokenize("an i pod"))); !equalTerms(got, map[string]int{"an": 1, "ipod": 2}) {
+		t.Fatalf("contract synonyms failed, got %v", got)
+	}
+
+	if _, err := New([]string{"a => b => c"}, true); err == nil {
+		t.Fatal("invalid rule should fail")
+	}
+	if _, err := NewFromFile(filepath.Join(dir, "none.dict"), true); err == nil {
+		t.Fatal("missing file should fail")
+	}
+}
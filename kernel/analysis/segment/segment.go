@@ -80,10 +80,8 @@ func (s *Segmenter) TextSegment(text []byte) []*Segment {
 	var _type SegmentType = None
 	for i = 0; i < len(text); {
 		r, size := utf8.DecodeRune(text[i:])
-		if r == utf8.RuneError {
-			return sets
-		}
-		if !unicode.IsPrint(r) || unicode.IsSymbol(r) || unicode.IsSpace(r) || symbol.IsSymbol(r) {
+		// the invalid utf8 byte is skipped like a symbol, the text after it is still segmented
+		if r == utf8.RuneError || !unicode.IsPrint(r) || unicode.IsSymbol(r) || unicode.IsSpace(r) || symbol.IsSymbol(r) {
 			_type = None
 		} else if size <= 2 && unicode.IsLetter(r) {
 			// TODO more language
@@ -98,7 +96,6 @@ func (s *Segmenter) TextSegment(text []byte) []*Segment {
 			_type = Japanese
 		} else {
 			// TODO more no language character
-			fmt.Println("other ", r, '(')
 			_type = Other
 		}
 		if segment != nil {
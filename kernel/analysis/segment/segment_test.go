@@ -82,6 +82,27 @@ func TestSegment(t *testing.T) {
 
 }
 
+func TestSegmentOther(t *testing.T) {
+	// the fullwidth letters are Other, the invalid utf8 bytes separate the segments
+	word := "hello\xffＡＢＣ\xe4\xbd 1234\xff"
+	ss := NewSegmenter().TextSegment([]byte(word))
+	if len(ss) != 3 {
+		for _, s := range ss {
+			t.Logf("segment %s", s)
+		}
+		t.Fatalf("test failed %d", len(ss))
+	}
+	if string(ss[0].Text) != "hello" || ss[0].Type != En {
+		t.Fatalf("test failed, %s", ss[0])
+	}
+	if string(ss[1].Text) != "ＡＢＣ" || ss[1].Type != Other || word[ss[1].Start:ss[1].End] != "ＡＢＣ" {
+		t.Fatalf("test failed, %s", ss[1])
+	}
+	if string(ss[2].Text) != "1234" || ss[2].Type != Number {
+		t.Fatalf("test failed, %s", ss[2])
+	}
+}
+
 func TestHan(t *testing.T) {
 	text := []byte("你好")
 	for i := 0; i < len(text); {
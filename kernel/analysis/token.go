@@ -12,6 +12,22 @@ const (
 	KeyWord
 )
 
+func (t TokenType) String() string {
+	switch t {
+	case Numeric:
+		return "numeric"
+	case DateTime:
+		return "datetime"
+	case Boolean:
+		return "boolean"
+	case Text:
+		return "text"
+	case KeyWord:
+		return "keyword"
+	}
+	return fmt.Sprintf("type(%d)", int(t))
+}
+
 type TokenSet  []*Token
 
 type Token struct {
@@ -5,9 +5,12 @@ import (
 
 	"github.com/tiglabs/baudengine/kernel/analysis"
 	"github.com/tiglabs/baudengine/kernel/analysis/filter/character"
+	"github.com/tiglabs/baudengine/kernel/registry"
 	"github.com/tiglabs/baudengine/util/bytes"
 )
 
+const Name = "character"
+
 type Tokenizer struct {
 	filter  analysis.CharFilter
 }
@@ -51,3 +54,13 @@ func (t *Tokenizer) Tokenize(input []byte) analysis.TokenSet {
 	return sets
 }
 
+func init() {
+	// the tokenizer splits the text at the chars of the filter function
+	registry.RegisterTokenizerConstructor(Name, func(config registry.Config) (analysis.Tokenizer, error) {
+		f, err := character.FunctionOf(config)
+		if err != nil {
+			return nil, err
+		}
+		return NewCharTokenizer(f), nil
+	})
+}
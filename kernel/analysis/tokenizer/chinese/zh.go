@@ -1,38 +1,148 @@
 package chinese
 
 import (
+	"fmt"
+	"os"
+	"sync"
+
 	"github.com/tiglabs/baudengine/kernel/analysis"
-	"github.com/yanyiwu/gojieba"
 	"github.com/tiglabs/baudengine/kernel/config"
+	"github.com/tiglabs/baudengine/kernel/registry"
+	"github.com/tiglabs/baudengine/util/log"
+	"github.com/yanyiwu/gojieba"
 )
 
+const Name = "chinese"
+
+// the dicts of NewZh in the config
+var dictNames = []string{"baud_zh.dict", "hmm_model.utf8", "user.dict", "idf.utf8", "stop.dict"}
+
 type ZhTokenizer struct {
-	tokenizer     *gojieba.Jieba
+	// the dict paths, the dicts in the config are used if nil
+	paths []string
+
+	// protects the tokenizer, Reload frees the old one when no tokenizing uses it
+	lock      sync.RWMutex
+	tokenizer *gojieba.Jieba
+	version   uint64
+	lazy      sync.Once
+	// the error of the last loading
+	loadErr error
+}
+
+var (
+	_ analysis.Tokenizer  = &ZhTokenizer{}
+	_ registry.Reloadable = &ZhTokenizer{}
+)
+
+// NewZh returns the tokenizer of the dicts in the config
+func NewZh() (*ZhTokenizer, error) {
+	x := &ZhTokenizer{}
+	if err := x.Reload(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+func NewZhTokenizer(dictpath, hmmpath, userdictpath, idf, stop_words string) (*ZhTokenizer, error) {
+	x := &ZhTokenizer{paths: []string{dictpath, hmmpath, userdictpath, idf, stop_words}}
+	if err := x.Reload(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+func (x *ZhTokenizer) dictPaths() ([]string, error) {
+	if x.paths != nil {
+		return x.paths, nil
+	}
+	paths := make([]string, 0, len(dictNames))
+	for _, name := range dictNames {
+		path, ok := config.LookupWordDictPath(name)
+		if !ok {
+			return nil, fmt.Errorf("word dict %s is not configured", name)
+		}
+		paths = append(paths, path)
+	}
+	return paths, nil
 }
 
-var _ analysis.Tokenizer = &ZhTokenizer{}
+// Reload loads the dicts into a new jieba and swaps it in, the old one is kept if the loading fails
+func (x *ZhTokenizer) Reload() error {
+	paths, err := x.checkDicts()
+	if err != nil {
+		x.lock.Lock()
+		x.loadErr = err
+		x.lock.Unlock()
+		return err
+	}
+	tokenizer := gojieba.NewJieba(paths...)
+
+	x.lock.Lock()
+	old := x.tokenizer
+	x.tokenizer = tokenizer
+	x.version++
+	x.loadErr = nil
+	x.lock.Unlock()
+	if old != nil {
+		old.Free()
+	}
+	return nil
+}
 
-func NewZh() *ZhTokenizer {
-	dictpath := config.GetWordDictPath("baud_zh.dict")
-	hmmpath := config.GetWordDictPath("hmm_model.utf8")
-	userdictpath := config.GetWordDictPath("user.dict")
-	idf := config.GetWordDictPath("idf.utf8")
-	stop_words := config.GetWordDictPath("stop.dict")
-	return NewZhTokenizer(dictpath, hmmpath, userdictpath, idf, stop_words)
+// checkDicts returns the paths of the dicts, jieba aborts on the missing dicts
+func (x *ZhTokenizer) checkDicts() ([]string, error) {
+	paths, err := x.dictPaths()
+	if err != nil {
+		return nil, err
+	}
+	for _, path := range paths {
+		if _, err := os.Stat(path); err != nil {
+			return nil, fmt.Errorf("open dict failed, err %v", err)
+		}
+	}
+	return paths, nil
 }
 
+// LoadError returns the error of the last loading, nil if it succeeded
+func (x *ZhTokenizer) LoadError() error {
+	x.lock.RLock()
+	defer x.lock.RUnlock()
+	return x.loadErr
+}
 
-func NewZhTokenizer(dictpath, hmmpath, userdictpath, idf, stop_words string) *ZhTokenizer {
-	x := gojieba.NewJieba(dictpath, hmmpath, userdictpath, idf, stop_words)
-	return &ZhTokenizer{x}
+// Version returns the version of the loaded dicts, 0 if the dicts are not loaded
+func (x *ZhTokenizer) Version() uint64 {
+	x.lock.RLock()
+	defer x.lock.RUnlock()
+	return x.version
 }
 
 func (x *ZhTokenizer) Free() {
-	x.tokenizer.Free()
+	x.lock.Lock()
+	defer x.lock.Unlock()
+	if x.tokenizer != nil {
+		x.tokenizer.Free()
+		x.tokenizer = nil
+	}
 }
 
+// Tokenize returns no token if the dicts can not be loaded, until they are reloaded successfully
 func (x *ZhTokenizer) Tokenize(input []byte) analysis.TokenSet {
+	// the shared tokenizer loads lazily, so that the dict paths can be configured after it is registered
+	x.lazy.Do(func() {
+		if x.Version() == 0 {
+			if err := x.Reload(); err != nil {
+				log.Error("tokenizer %s load dicts failed, err %v", Name, err)
+			}
+		}
+	})
 	result := make(analysis.TokenSet, 0)
+	x.lock.RLock()
+	defer x.lock.RUnlock()
+	if x.tokenizer == nil {
+		return result
+	}
 	pos := 1
 	words := x.tokenizer.Tokenize(string(input), gojieba.SearchMode, false)
 	for _, word := range words {
@@ -48,3 +158,9 @@ func (x *ZhTokenizer) Tokenize(input []byte) analysis.TokenSet {
 	}
 	return result
 }
+
+func init() {
+	tokenizer := &ZhTokenizer{}
+	registry.RegisterTokenizer(Name, tokenizer)
+	registry.RegisterReloadable(Name, tokenizer)
+}
@@ -1,102 +1,196 @@
 package fast
 
 import (
-	"os"
-	"fmt"
 	"bufio"
+	"fmt"
 	"io"
-	"strings"
+	"os"
 	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
 
-	"github.com/tiglabs/baudengine/kernel/analysis"
 	acdat "github.com/heidawei/AhoCorasickDoubleArrayTrie/ACDAT"
+	"github.com/tiglabs/baudengine/kernel/analysis"
+	"github.com/tiglabs/baudengine/kernel/config"
+	"github.com/tiglabs/baudengine/kernel/registry"
 	"github.com/tiglabs/baudengine/util/bytes"
+	"github.com/tiglabs/baudengine/util/log"
 )
 
 const Name = "fast"
 
+// the dicts of the registered tokenizer in the config, the main dict is required
+const (
+	MainDict = "baud_zh.dict"
+	UserDict = "user.dict"
+)
+
 type FastZhTokenizer struct {
-	// may be more dict
+	// may be more dict, the dicts in the config are used if empty
 	dictPaths []string
-	dict     *acdat.AhoCorasickDoubleArrayTrie
+	// *versionedDict, swapped atomically by Reload so that the tokenizing never waits for the loading
+	dict atomic.Value
+	// serializes the reloads, so that the versions increase in the order of the loadings
+	reloadLock sync.Mutex
+	firstLoad  sync.Once
+	// the error of the last loading
+	errLock sync.Mutex
+	loadErr error
 }
 
-var _ analysis.Tokenizer = &FastZhTokenizer{}
+// versionedDict is the trie of the dicts, the version is the number of the successful loadings
+type versionedDict struct {
+	trie    *acdat.AhoCorasickDoubleArrayTrie
+	version uint64
+}
 
+var (
+	_ analysis.Tokenizer  = &FastZhTokenizer{}
+	_ registry.Reloadable = &FastZhTokenizer{}
+)
 
 func NewFastZhTokenizer(dictpaths []string) *FastZhTokenizer {
 	return &FastZhTokenizer{dictPaths: dictpaths}
 }
 
+// NewFastZh returns the tokenizer of the dicts in the config, the paths are resolved when the dicts are loaded,
+// so that they can be configured after the tokenizer is registered
+func NewFastZh() *FastZhTokenizer {
+	return &FastZhTokenizer{}
+}
+
+func (x *FastZhTokenizer) paths() ([]string, error) {
+	if len(x.dictPaths) > 0 {
+		return x.dictPaths, nil
+	}
+	path, ok := config.LookupWordDictPath(MainDict)
+	if !ok {
+		return nil, fmt.Errorf("word dict %s is not configured", MainDict)
+	}
+	paths := []string{path}
+	if path, ok := config.LookupWordDictPath(UserDict); ok {
+		paths = append(paths, path)
+	}
+	return paths, nil
+}
+
 // dict format: word or word [type freq ......] or word [freq type ......]
 
-func (x *FastZhTokenizer) loadDicts() {
+func (x *FastZhTokenizer) loadDicts() (*acdat.AhoCorasickDoubleArrayTrie, error) {
+	paths, err := x.paths()
+	if err != nil {
+		return nil, err
+	}
 	strMap := acdat.NewStringTreeMap()
-	for _, path := range x.dictPaths {
-		func(_path string) {
-			f, err := os.Open(_path)
-			if err != nil {
-				panic(fmt.Sprintf("open dict failed, err %v", err))
-				return
-			}
-			defer f.Close()
-
-			r := bufio.NewReader(f)
-		LOAD:
-			for {
-				l, err := r.ReadString('\n')
-				if err != nil {
-					if err == io.EOF {
-						break
-					}
-					panic(fmt.Sprintf("read dict failed, err %v", err))
-				}
-				es := strings.Fields(l)
-				if len(es) == 0 {
-					continue
-				}
-				// invalid line
-				if len(es) % 2 ==  0 {
-					continue
-				}
-				w := NewWord([]byte(es[0]))
-				for i := 1; i + 1 < len(es);  {
-					var type_ string
-					var freq int
-					// fomat: word [freq type ......]
-					if byte(es[i][0]) >= '0' && byte(es[i][0]) <= '9' {
-						type_ = es[i+1]
-						freq, err = strconv.Atoi(es[i])
-						if err != nil {
-							continue LOAD
-						}
-					} else {
-						// fomat: word [type freq ......]
-						type_ = es[i]
-						freq, err = strconv.Atoi(es[i+1])
-						if err != nil {
-							continue LOAD
-						}
-					}
-
-					w.addProperty(&WordProperty{type_: type_, freq: freq})
-					i += 2
-				}
-				strMap.Add(es[0], w)
-			}
-		}(path)
+	for _, path := range paths {
+		if err := loadDict(path, strMap); err != nil {
+			return nil, err
+		}
 	}
-	x.dict.Build(strMap)
+	dict := new(acdat.AhoCorasickDoubleArrayTrie)
+	dict.Build(strMap)
+	return dict, nil
 }
 
+func loadDict(path string, strMap *acdat.StringTreeMap) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("open dict failed, err %v", err)
+	}
+	defer f.Close()
+
+	r := bufio.NewReader(f)
+	for {
+		l, err := r.ReadString('\n')
+		if err != nil && err != io.EOF {
+			return fmt.Errorf("read dict %s failed, err %v", path, err)
+		}
+		if w := parseWord(l); w != nil {
+			strMap.Add(string(w.word), w)
+		}
+		if err == io.EOF {
+			return nil
+		}
+	}
+}
+
+// parseWord returns nil if the line is invalid
+func parseWord(l string) *DictWord {
+	es := strings.Fields(l)
+	if len(es) == 0 || len(es)%2 == 0 {
+		return nil
+	}
+	w := NewWord([]byte(es[0]))
+	for i := 1; i+1 < len(es); i += 2 {
+		var type_ string
+		var freq int
+		var err error
+		// fomat: word [freq type ......]
+		if byte(es[i][0]) >= '0' && byte(es[i][0]) <= '9' {
+			type_ = es[i+1]
+			freq, err = strconv.Atoi(es[i])
+		} else {
+			// fomat: word [type freq ......]
+			type_ = es[i]
+			freq, err = strconv.Atoi(es[i+1])
+		}
+		if err != nil {
+			return nil
+		}
+		w.addProperty(&WordProperty{type_: type_, freq: freq})
+	}
+	return w
+}
+
+// Reload loads the dicts into a new trie and swaps it in, the tokenizing in progress goes on with the old one.
+// The old trie is kept if the loading fails.
+func (x *FastZhTokenizer) Reload() error {
+	x.reloadLock.Lock()
+	defer x.reloadLock.Unlock()
+	trie, err := x.loadDicts()
+	x.errLock.Lock()
+	x.loadErr = err
+	x.errLock.Unlock()
+	if err != nil {
+		return err
+	}
+	x.dict.Store(&versionedDict{trie: trie, version: x.Version() + 1})
+	return nil
+}
+
+// LoadError returns the error of the last loading, nil if it succeeded
+func (x *FastZhTokenizer) LoadError() error {
+	x.errLock.Lock()
+	defer x.errLock.Unlock()
+	return x.loadErr
+}
+
+// Version returns the version of the loaded dicts, 0 if the dicts are not loaded
+func (x *FastZhTokenizer) Version() uint64 {
+	if d, ok := x.dict.Load().(*versionedDict); ok {
+		return d.version
+	}
+	return 0
+}
+
+// Tokenize returns no token if the dicts can not be loaded, until they are reloaded successfully
 func (x *FastZhTokenizer) Tokenize(input []byte) analysis.TokenSet {
 	// lazy load
-	if x.dict == nil {
-		x.loadDicts()
-	}
+	x.firstLoad.Do(func() {
+		if x.Version() == 0 {
+			if err := x.Reload(); err != nil {
+				log.Error("tokenizer %s load dicts failed, err %v", Name, err)
+			}
+		}
+	})
 	result := make(analysis.TokenSet, 0)
+	d, ok := x.dict.Load().(*versionedDict)
+	if !ok {
+		return result
+	}
 	pos := 1
-	x.dict.ParseBytesWithIter(input, func(begin, end int, v interface{}) {
+	d.trie.ParseBytesWithIter(input, func(begin, end int, v interface{}) {
 		token := analysis.Token{
 			Term:     bytes.CloneBytes(v.(*DictWord).word),
 			Start:    begin,
@@ -112,13 +206,13 @@ func (x *FastZhTokenizer) Tokenize(input []byte) analysis.TokenSet {
 
 type WordProperty struct {
 	//
-	type_      string
-	freq       int
+	type_ string
+	freq  int
 }
 
 type DictWord struct {
-	word    []byte
-    properties []*WordProperty
+	word       []byte
+	properties []*WordProperty
 }
 
 func NewWord(w []byte) *DictWord {
@@ -126,6 +220,12 @@ func NewWord(w []byte) *DictWord {
 }
 
 func (w *DictWord) addProperty(p *WordProperty) *DictWord {
-	w.properties =  append(w.properties, p)
+	w.properties = append(w.properties, p)
 	return w
 }
+
+func init() {
+	tokenizer := NewFastZh()
+	registry.RegisterTokenizer(Name, tokenizer)
+	registry.RegisterReloadable(Name, tokenizer)
+}
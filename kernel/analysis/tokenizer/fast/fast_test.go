@@ -0,0 +1,71 @@
+package fast
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/tiglabs/baudengine/kernel/analysis"
+)
+
+func termsOf(set analysis.TokenSet) []string {
+	var terms []string
+	for _, token := range set {
+		terms = append(terms, string(token.Term))
+	}
+	return terms
+}
+
+func TestReload(t *testing.T) {
+	dir, err := ioutil.TempDir("", "fast")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "zh.dict")
+	if err := ioutil.WriteFile(path, []byte("中国 n 10\n人民\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tokenizer := NewFastZhTokenizer([]string{path})
+	if terms := termsOf(tokenizer.Tokenize([]byte("中国人民银行"))); !reflect.DeepEqual(terms, []string{"中国", "人民"}) {
+		t.Fatalf("test tokenize failed, got %v", terms)
+	}
+	if tokenizer.Version() != 1 {
+		t.Fatalf("test version failed, got %d", tokenizer.Version())
+	}
+
+	if err := ioutil.WriteFile(path, []byte("中国 n 10\n人民\n银行"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := tokenizer.Reload(); err != nil {
+		t.Fatal(err)
+	}
+	if terms := termsOf(tokenizer.Tokenize([]byte("中国人民银行"))); !reflect.DeepEqual(terms, []string{"中国", "人民", "银行"}) {
+		t.Fatalf("test reload failed, got %v", terms)
+	}
+	if tokenizer.Version() != 2 {
+		t.Fatalf("test reload version failed, got %d", tokenizer.Version())
+	}
+
+	// the failed reloading keeps the dicts loaded before
+	os.Remove(path)
+	if err := tokenizer.Reload(); err == nil {
+		t.Fatal("test reload missing dict failed")
+	}
+	if tokenizer.Version() != 2 || len(tokenizer.Tokenize([]byte("银行"))) != 1 {
+		t.Fatalf("test failed reload failed, got version %d", tokenizer.Version())
+	}
+}
+
+func TestLoadFailure(t *testing.T) {
+	tokenizer := NewFastZhTokenizer([]string{"not_exist.dict"})
+	if sets := tokenizer.Tokenize([]byte("中国")); len(sets) != 0 {
+		t.Fatalf("test tokenize failed, got %v", termsOf(sets))
+	}
+	if tokenizer.Version() != 0 {
+		t.Fatalf("test version failed, got %d", tokenizer.Version())
+	}
+}
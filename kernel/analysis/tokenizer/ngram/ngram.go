@@ -0,0 +1,155 @@
+package ngram
+
+import (
+	"errors"
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/tiglabs/baudengine/kernel/analysis"
+	"github.com/tiglabs/baudengine/kernel/analysis/filter/character"
+	"github.com/tiglabs/baudengine/kernel/registry"
+)
+
+const (
+	Name     = "ngram"
+	EdgeName = "edge_ngram"
+)
+
+// the gram lengths if absent in the parameters
+const (
+	DefaultMinGram = 1
+	DefaultMaxGram = 2
+)
+
+var _ analysis.Tokenizer = &Tokenizer{}
+
+// Tokenizer splits the text into the words of the token chars, and emits the grams of the words,
+// ordered by the start and the length of the grams. The edge tokenizer emits the grams at the start
+// of the words only, for the search as you type.
+type Tokenizer struct {
+	minGram, maxGram int
+	edge             bool
+	// the words are made of the chars of the functions, the text is a word if empty
+	tokenChars []character.FilterOutFunc
+}
+
+func New(minGram, maxGram int, tokenChars ...character.FilterOutFunc) *Tokenizer {
+	return &Tokenizer{minGram: minGram, maxGram: maxGram, tokenChars: tokenChars}
+}
+
+func NewEdge(minGram, maxGram int, tokenChars ...character.FilterOutFunc) *Tokenizer {
+	return &Tokenizer{minGram: minGram, maxGram: maxGram, edge: true, tokenChars: tokenChars}
+}
+
+func (t *Tokenizer) isTokenChar(r rune) bool {
+	if len(t.tokenChars) == 0 {
+		return true
+	}
+	for _, f := range t.tokenChars {
+		if f(r) {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *Tokenizer) Tokenize(input []byte) analysis.TokenSet {
+	var sets analysis.TokenSet
+	pos := 0
+	// the byte offsets of the runes of the word, and the end of the last rune
+	var offsets []int
+	emit := func() {
+		if len(offsets) == 0 {
+			return
+		}
+		runes := len(offsets) - 1
+		for start := 0; start < runes; start++ {
+			if t.edge && start > 0 {
+				break
+			}
+			for n := t.minGram; n <= t.maxGram && start+n <= runes; n++ {
+				pos++
+				sets = append(sets, &analysis.Token{
+					Start:    offsets[start],
+					End:      offsets[start+n],
+					Term:     append([]byte(nil), input[offsets[start]:offsets[start+n]]...),
+					Position: pos,
+					Type:     analysis.Text,
+				})
+			}
+		}
+		offsets = offsets[:0]
+	}
+	for i := 0; i < len(input); {
+		r, size := utf8.DecodeRune(input[i:])
+		if r == utf8.RuneError {
+			break
+		}
+		if t.isTokenChar(r) {
+			if len(offsets) == 0 {
+				offsets = append(offsets, i)
+			}
+			offsets = append(offsets, i+size)
+		} else {
+			emit()
+		}
+		i += size
+	}
+	emit()
+	return sets
+}
+
+// ParseGrams returns the gram lengths of the parameters "min_gram" and "max_gram"
+func ParseGrams(config registry.Config) (int, int, error) {
+	minGram, err := config.Int("min_gram", DefaultMinGram)
+	if err != nil {
+		return 0, 0, err
+	}
+	maxGram, err := config.Int("max_gram", DefaultMaxGram)
+	if err != nil {
+		return 0, 0, err
+	}
+	if minGram < 1 || maxGram < minGram {
+		return 0, 0, fmt.Errorf("invalid grams [%d, %d]", minGram, maxGram)
+	}
+	return minGram, maxGram, nil
+}
+
+// parseTokenChars returns the functions of the chars of the words in the parameter "token_chars"
+func parseTokenChars(config registry.Config) ([]character.FilterOutFunc, error) {
+	names, err := config.Strings("token_chars")
+	if err != nil {
+		return nil, err
+	}
+	var tokenChars []character.FilterOutFunc
+	for _, name := range names {
+		f, ok := character.Functions[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown token chars %q", name)
+		}
+		tokenChars = append(tokenChars, f)
+	}
+	if names != nil && len(tokenChars) == 0 {
+		return nil, errors.New("empty token chars")
+	}
+	return tokenChars, nil
+}
+
+func newConstructor(edge bool) registry.TokenizerConstructor {
+	return func(config registry.Config) (analysis.Tokenizer, error) {
+		minGram, maxGram, err := ParseGrams(config)
+		if err != nil {
+			return nil, err
+		}
+		tokenChars, err := parseTokenChars(config)
+		if err != nil {
+			return nil, err
+		}
+		return &Tokenizer{minGram: minGram, maxGram: maxGram, edge: edge, tokenChars: tokenChars}, nil
+	}
+}
+
+func init() {
+	registry.RegisterTokenizerConstructor(Name, newConstructor(false))
+	registry.RegisterTokenizerConstructor(EdgeName, newConstructor(true))
+}
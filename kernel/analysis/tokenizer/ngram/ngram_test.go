@@ -0,0 +1,37 @@
+package ngram
+
+import (
+	"reflect"
+	"testing"
+	"unicode"
+
+	"github.com/tiglabs/baudengine/kernel/analysis"
+)
+
+func termsOf(set analysis.TokenSet) []string {
+	var terms []string
+	for _, token := range set {
+		terms = append(terms, string(token.Term))
+	}
+	return terms
+}
+
+func TestTokenize(t *testing.T) {
+	sets := New(1, 2).Tokenize([]byte("abc"))
+	if terms := termsOf(sets); !reflect.DeepEqual(terms, []string{"a", "ab", "b", "bc", "c"}) {
+		t.Fatalf("test failed, got %v", terms)
+	}
+	for i, token := range sets {
+		if token.Position != i+1 {
+			t.Fatalf("test position failed, got %d", token.Position)
+		}
+	}
+
+	sets = NewEdge(2, 4, unicode.IsLetter).Tokenize([]byte("quick, 你好世界"))
+	if terms := termsOf(sets); !reflect.DeepEqual(terms, []string{"qu", "qui", "quic", "你好", "你好世", "你好世界"}) {
+		t.Fatalf("test edge failed, got %v", terms)
+	}
+	if sets[4].Start != 7 || sets[4].End != 16 {
+		t.Fatalf("test offsets failed, got %d %d", sets[4].Start, sets[4].End)
+	}
+}
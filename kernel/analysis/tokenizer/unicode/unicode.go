@@ -1,6 +1,8 @@
 package unicode
 
 import (
+	"sync"
+
 	"github.com/tiglabs/baudengine/kernel/analysis"
 	"github.com/tiglabs/baudengine/kernel/registry"
 	"github.com/tiglabs/baudengine/util/bytes"
@@ -11,7 +13,9 @@ import (
 const Name = "unicode"
 
 type UnicodeTokenizer struct {
+	// the registered chinese tokenizer, got when it tokenizes first
 	zhTokneizer analysis.Tokenizer
+	zhOnce      sync.Once
 }
 
 var _ analysis.Tokenizer = &UnicodeTokenizer{}
@@ -21,9 +25,9 @@ func NewUnicodeTokenizer() *UnicodeTokenizer {
 }
 
 func (ut *UnicodeTokenizer) Tokenize(input []byte) analysis.TokenSet {
-	if ut.zhTokneizer == nil {
-		ut.zhTokneizer = chinese.NewZh()
-	}
+	ut.zhOnce.Do(func() {
+		ut.zhTokneizer = registry.GetTokenizer(chinese.Name)
+	})
 	segments := segment.NewSegmenter().TextSegment(input)
 	pos := 1
 	sets := make(analysis.TokenSet, 0, len(segments))
@@ -50,7 +54,7 @@ func (ut *UnicodeTokenizer) Tokenize(input []byte) analysis.TokenSet {
 		}
 	}
 
-	return nil
+	return sets
 }
 
 
@@ -1,7 +1,14 @@
 package config
 
+import (
+	"io/ioutil"
+	"path/filepath"
+	"sync"
+)
+
 type Config struct {
-	dictPath         map[string]string
+	lock     sync.RWMutex
+	dictPath map[string]string
 }
 
 var config *Config
@@ -11,16 +18,41 @@ func New() *Config {
 }
 
 func SetWordDictPath(name, path string) {
+	config.lock.Lock()
+	defer config.lock.Unlock()
 	config.dictPath[name] = path
 }
 
+// SetWordDictDir sets the paths of all the dicts in the dir, the names of the dicts are the file names
+func SetWordDictDir(dir string) error {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	config.lock.Lock()
+	defer config.lock.Unlock()
+	for _, f := range files {
+		if f.Mode().IsRegular() {
+			config.dictPath[f.Name()] = filepath.Join(dir, f.Name())
+		}
+	}
+	return nil
+}
+
 func GetWordDictPath(name string) string {
-	if path, ok := config.dictPath[name]; ok {
+	if path, ok := LookupWordDictPath(name); ok {
 		return path
 	}
 	panic("invalid word dict name")
 }
 
+// LookupWordDictPath returns the path of the dict, ok is false if the dict is not set
+func LookupWordDictPath(name string) (path string, ok bool) {
+	config.lock.RLock()
+	defer config.lock.RUnlock()
+	path, ok = config.dictPath[name]
+	return
+}
 
 func init() {
 	config = New()
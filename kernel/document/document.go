@@ -4,6 +4,17 @@ type Document struct {
 	ID []byte `json:"id"`
 	// _version, _source, _all as special field for document
 	Fields map[string][]Field `json:"fields"`
+	// the objects of the nested fields, indexed as hidden documents
+	Nested []*NestedDocument `json:"nested,omitempty"`
+}
+
+// NestedDocument is an object of a nested field, Offset is the position of the object in the values of the field.
+// The fields of the object and its nested objects are in the document, which has no ID.
+type NestedDocument struct {
+	// Path is the full path name of the nested field
+	Path   string `json:"path"`
+	Offset int    `json:"offset"`
+	*Document
 }
 
 func NewDocument(id []byte) *Document {
@@ -38,3 +49,16 @@ func (d *Document) FindFields(name string) []Field {
 	}
 	return d.Fields[name]
 }
+
+// AddNested adds an object of the nested field and returns it
+func (d *Document) AddNested(path string) *NestedDocument {
+	offset := 0
+	for _, nested := range d.Nested {
+		if nested.Path == path {
+			offset++
+		}
+	}
+	nested := &NestedDocument{Path: path, Offset: offset, Document: &Document{}}
+	d.Nested = append(d.Nested, nested)
+	return nested
+}
@@ -57,14 +57,17 @@ func (c *CompositeField) includeField(field string) bool {
 	return true
 }
 
-//
-func (c *CompositeField) MergeAll(field string, value []byte, freq analysis.TokenFrequencies) {
+// MergeAll merges the value and the terms of the field into the composite field,
+// the value is kept even if the composite field is not stored for the analysis of it
+func (c *CompositeField) MergeAll(field string, fieldId uint32, value []byte, freq analysis.TokenFrequencies) {
 	if c.includeField(field) {
 		if c.property.IsIndexed() {
-			c.compositeFrequencies.MergeAll(field, freq)
+			c.compositeFrequencies.MergeAll(fieldId, freq)
 		}
-		if c.property.IsStored() && len(value) > 0 {
-			c.value = append(c.value, space)
+		if len(value) > 0 {
+			if len(c.value) > 0 {
+				c.value = append(c.value, space)
+			}
 			c.value = append(c.value, value...)
 		}
 	}
@@ -0,0 +1,69 @@
+package document
+
+import (
+	"fmt"
+
+	"github.com/tiglabs/baudengine/kernel/analysis"
+	"github.com/tiglabs/baudengine/util/geo"
+)
+
+var _ Field = &GeoPointField{}
+
+type GeoPointField struct {
+	name              string
+	property          Property
+	lat               float64
+	lon               float64
+	value             []byte
+}
+
+func (g *GeoPointField) Name() string {
+	return g.name
+}
+
+func (g *GeoPointField) Property() Property {
+	return g.property
+}
+
+// Analyze returns the geohash terms of all the precisions of the point
+func (g *GeoPointField) Analyze() (analysis.TokenFrequencies) {
+	hash := geo.EncodeGeohash(g.lat, g.lon, geo.MaxGeohashPrecision)
+	tokens := make([]*analysis.Token, 0, len(hash))
+	for precision := 1; precision <= len(hash); precision++ {
+		tokens = append(tokens, &analysis.Token{
+			Start:    0,
+			End:      len(g.value),
+			Term:     []byte(hash[:precision]),
+			Position: 1,
+			Type:     analysis.KeyWord,
+		})
+	}
+
+	tokenFreqs := analysis.TokenFrequency(tokens, g.property.IncludeTermVectors())
+	return tokenFreqs
+}
+
+func (g *GeoPointField) Value() []byte {
+	return g.value
+}
+
+func (g *GeoPointField) Point() (lat, lon float64) {
+	return g.lat, g.lon
+}
+
+func (g *GeoPointField) String() string {
+	return fmt.Sprintf("&document.GeoPointField{Name:%s, Property: %s, Lat: %v, Lon: %v}", g.name, g.property, g.lat, g.lon)
+}
+
+func NewGeoPointField(name string, lat, lon float64, property Property) (*GeoPointField, error) {
+	if err := geo.ValidPoint(lat, lon); err != nil {
+		return nil, err
+	}
+	return &GeoPointField{
+		name:              name,
+		property:          property,
+		lat:               lat,
+		lon:               lon,
+		value:             geo.EncodePoint(nil, lat, lon),
+	}, nil
+}
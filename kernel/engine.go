@@ -3,6 +3,7 @@ package kernel
 import (
 	"context"
 	"io"
+	"time"
 
 	"github.com/tiglabs/baudengine/proto/metapb"
 	"github.com/tiglabs/baudengine/proto/pspb"
@@ -13,6 +14,13 @@ type Snapshot interface {
 	io.Closer
 	GetApplyID() (uint64, error)
 	NewIterator() Iterator
+	// ScanDocuments returns at most size documents after the doc ID in doc ID order, with the stored fields,
+	// all the stored fields if fields is empty, from the first document if after is nil
+	ScanDocuments(ctx context.Context, after metapb.Key, size int, fields []uint32) ([]*Hit, error)
+	// MatchDocuments returns the documents matched by the query in doc ID order, without scoring
+	MatchDocuments(ctx context.Context, query Query) ([]metapb.Key, error)
+	// DocVersion returns the version of the document in the snapshot
+	DocVersion(docID metapb.Key) (version DocVersion, found bool, err error)
 }
 
 // Iterator is an interface for iterating over key/value pairs in an engine.
@@ -24,11 +32,44 @@ type Iterator interface {
 	Value() metapb.Value
 }
 
+// DocValuesReader is the column-oriented read interface to the doc values of a field,
+// it is shared by sorting, aggregations and scripts.
+type DocValuesReader interface {
+	io.Closer
+	// Get returns the values of the field of the document
+	Get(docID metapb.Key) (pspb.FieldValue, bool, error)
+	// NewIterator returns an iterator over the doc values of the field in doc ID order
+	NewIterator() DocValuesIterator
+}
+
+// DocValuesIterator is an interface for iterating over the doc values of a field.
+type DocValuesIterator interface {
+	io.Closer
+	Next()
+	Valid() bool
+	DocID() metapb.Key
+	Value() pspb.FieldValue
+	// Err returns the error stopped the iteration
+	Err() error
+}
+
 // Reader is the read interface to an engine's data.
 type Reader interface {
 	io.Closer
 	GetApplyID() (uint64, error)
 	GetDocument(ctx context.Context, docID metapb.Key, fields []uint32) (map[uint32]pspb.FieldValue, bool)
+	Search(ctx context.Context, req *Request) (*Result, error)
+	// Statistics returns the statistics of the fields and terms of the query for scoring
+	Statistics(ctx context.Context, query Query) (*Statistics, error)
+	// DocValues returns the doc values reader of the field, the reader must be closed after use
+	DocValues(fieldId uint32) (DocValuesReader, error)
+	// TermVectors returns the indexed terms of the fields of the document with the frequencies and the positions
+	TermVectors(ctx context.Context, req *TermVectorsRequest) (*TermVectorsResult, error)
+	// DocVersion returns the version of the document, found is false if the document is not found
+	DocVersion(docID metapb.Key) (version DocVersion, found bool, err error)
+	// ExpiredDocuments returns the documents expired at the time in unix milliseconds, at most limit
+	// documents in the order of the expiration time
+	ExpiredDocuments(ctx context.Context, now int64, limit int) ([]metapb.Key, error)
 }
 
 // Writer is the write interface to an engine's data.
@@ -37,6 +78,8 @@ type Writer interface {
 	AddDocument(ctx context.Context, doc *pspb.Document) error
 	UpdateDocument(ctx context.Context, doc *pspb.Document, upsert bool) (found bool, err error)
 	DeleteDocument(ctx context.Context, docID metapb.Key) (int, error)
+	// MergeDocument updates some fields of the document, only the changed fields are indexed again
+	MergeDocument(ctx context.Context, req *MergeRequest) (pspb.WriteResult, error)
 }
 
 // ReadWriter is the read/write interface to an engine's data.
@@ -48,6 +91,10 @@ type ReadWriter interface {
 // Batch is the interface for batch operations.
 type Batch interface {
 	Writer
+	// DocVersion returns the version of the document with the writes of the batch
+	DocVersion(docID metapb.Key) (version DocVersion, found bool, err error)
+	// DocExpireAt returns the expiration time of the document in unix milliseconds, 0 if it never expires
+	DocExpireAt(docID metapb.Key) (int64, error)
 	Commit() error
 	Rollback() error
 }
@@ -58,4 +105,12 @@ type Engine interface {
 	NewWriteBatch() Batch
 	NewSnapshot() (Snapshot, error)
 	ApplySnapshot(ctx context.Context, iter Iterator) error
+	// SetMapping sets the JSON mapping schema of the space
+	SetMapping(schema []byte) error
+	// MapDocument maps the JSON source into the fields of the document by the mapping schema
+	MapDocument(docID metapb.Key, source []byte) (*pspb.Document, error)
+	// DefaultTTL returns the default time to live of the documents in the mapping, 0 if they never expire
+	DefaultTTL() time.Duration
+	// Analyze analyzes the text by the analyzer of the name or of the field in the mapping
+	Analyze(req *AnalyzeRequest) (*AnalyzeResult, error)
 }
@@ -0,0 +1,102 @@
+package kernel
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math"
+	"math/bits"
+)
+
+// the precision of HyperLogLog is the number of the bits of the hash selecting the register
+const (
+	MinCardinalityPrecision     = 4
+	MaxCardinalityPrecision     = 16
+	DefaultCardinalityPrecision = 14
+)
+
+// HyperLogLog estimates the number of the distinct values,
+// the sketches of the partitions are merged by keeping the max of every register.
+type HyperLogLog struct {
+	Registers []uint8
+}
+
+// NewHyperLogLog returns an empty sketch with 2^precision registers,
+// DefaultCardinalityPrecision is used when the precision is out of range.
+func NewHyperLogLog(precision uint8) *HyperLogLog {
+	if precision < MinCardinalityPrecision || precision > MaxCardinalityPrecision {
+		precision = DefaultCardinalityPrecision
+	}
+	return &HyperLogLog{Registers: make([]uint8, 1<<precision)}
+}
+
+// Precision returns the number of the bits selecting the register
+func (h *HyperLogLog) Precision() uint8 {
+	return uint8(bits.TrailingZeros(uint(len(h.Registers))))
+}
+
+func (h *HyperLogLog) Add(value []byte) {
+	hash := fnv.New64a()
+	hash.Write(value)
+	x := mix64(hash.Sum64())
+	p := h.Precision()
+	index := x >> (64 - p)
+	// the rank of the first 1 bit after the register bits
+	rank := uint8(bits.LeadingZeros64(x<<p|1<<(p-1))) + 1
+	if rank > h.Registers[index] {
+		h.Registers[index] = rank
+	}
+}
+
+func (h *HyperLogLog) Merge(other *HyperLogLog) error {
+	if len(h.Registers) != len(other.Registers) {
+		return fmt.Errorf("cannot merge HyperLogLog of %d registers into %d registers", len(other.Registers), len(h.Registers))
+	}
+	for i, r := range other.Registers {
+		if r > h.Registers[i] {
+			h.Registers[i] = r
+		}
+	}
+	return nil
+}
+
+// Count returns the estimated number of the distinct values
+func (h *HyperLogLog) Count() uint64 {
+	m := float64(len(h.Registers))
+	var sum float64
+	var zeros int
+	for _, r := range h.Registers {
+		sum += math.Ldexp(1, -int(r))
+		if r == 0 {
+			zeros++
+		}
+	}
+	estimate := hllAlpha(len(h.Registers)) * m * m / sum
+	// linear counting is more accurate for the small cardinalities
+	if estimate <= 2.5*m && zeros > 0 {
+		estimate = m * math.Log(m/float64(zeros))
+	}
+	return uint64(estimate + 0.5)
+}
+
+func hllAlpha(m int) float64 {
+	switch m {
+	case 16:
+		return 0.673
+	case 32:
+		return 0.697
+	case 64:
+		return 0.709
+	default:
+		return 0.7213 / (1 + 1.079/float64(m))
+	}
+}
+
+// mix64 spreads the bits of the FNV hash, which is weak in the high bits for short values
+func mix64(x uint64) uint64 {
+	x ^= x >> 33
+	x *= 0xff51afd7ed558ccd
+	x ^= x >> 33
+	x *= 0xc4ceb9fe1a85ec53
+	x ^= x >> 33
+	return x
+}
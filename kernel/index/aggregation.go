@@ -0,0 +1,306 @@
+package index
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/tiglabs/baudengine/kernel"
+	"github.com/tiglabs/baudengine/proto/metapb"
+	"github.com/tiglabs/baudengine/util/encoding"
+)
+
+// aggregate computes the aggregations over the documents, the documents are in doc ID order
+func (s *searcher) aggregate(docs []metapb.Key, aggs map[string]kernel.Aggregation) (map[string]*kernel.AggregationResult, error) {
+	results := make(map[string]*kernel.AggregationResult, len(aggs))
+	for name, agg := range aggs {
+		result, err := s.aggregation(docs, agg)
+		if err != nil {
+			return nil, fmt.Errorf("aggregation %s error: %v", name, err)
+		}
+		results[name] = result
+	}
+	return results, nil
+}
+
+func (s *searcher) aggregation(docs []metapb.Key, agg kernel.Aggregation) (*kernel.AggregationResult, error) {
+	switch a := agg.(type) {
+	case *kernel.TermsAggregation:
+		return s.bucketAggregation(docs, a.FieldId, a.Aggregations, splitValues)
+
+	case *kernel.HistogramAggregation:
+		if a.Interval <= 0 {
+			return nil, fmt.Errorf("invalid histogram interval %v", a.Interval)
+		}
+		return s.bucketAggregation(docs, a.FieldId, a.Aggregations, func(data []byte) ([][]byte, error) {
+			values, err := numericValues(data)
+			if err != nil {
+				return nil, err
+			}
+			keys := make([][]byte, 0, len(values))
+			for _, v := range values {
+				keys = append(keys, encoding.EncodeFloatValue(nil, 0, math.Floor(v/a.Interval)*a.Interval))
+			}
+			return keys, nil
+		})
+
+	case *kernel.DateHistogramAggregation:
+		interval := int64(a.Interval)
+		if interval <= 0 {
+			return nil, fmt.Errorf("invalid date histogram interval %v", a.Interval)
+		}
+		return s.bucketAggregation(docs, a.FieldId, a.Aggregations, func(data []byte) ([][]byte, error) {
+			values, err := intValues(data)
+			if err != nil {
+				return nil, err
+			}
+			keys := make([][]byte, 0, len(values))
+			for _, v := range values {
+				offset := v % interval
+				if offset < 0 {
+					offset += interval
+				}
+				keys = append(keys, encoding.EncodeIntValue(nil, 0, v-offset))
+			}
+			return keys, nil
+		})
+
+	case *kernel.RangeAggregation:
+		return s.rangeAggregation(docs, a)
+
+	case *kernel.MinAggregation:
+		return s.statsAggregation(docs, a.FieldId)
+	case *kernel.MaxAggregation:
+		return s.statsAggregation(docs, a.FieldId)
+	case *kernel.AvgAggregation:
+		return s.statsAggregation(docs, a.FieldId)
+	case *kernel.SumAggregation:
+		return s.statsAggregation(docs, a.FieldId)
+	case *kernel.StatsAggregation:
+		return s.statsAggregation(docs, a.FieldId)
+
+	case *kernel.CardinalityAggregation:
+		if a.Precision != 0 && (a.Precision < kernel.MinCardinalityPrecision || a.Precision > kernel.MaxCardinalityPrecision) {
+			return nil, fmt.Errorf("invalid cardinality precision %d", a.Precision)
+		}
+		hll := kernel.NewHyperLogLog(a.Precision)
+		err := s.eachDocValue(docs, a.FieldId, func(docID metapb.Key, data []byte) error {
+			values, err := splitValues(data)
+			if err != nil {
+				return err
+			}
+			for _, v := range values {
+				hll.Add(v)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &kernel.AggregationResult{Cardinality: hll}, nil
+
+	case nil:
+		return nil, errors.New("empty aggregation")
+
+	default:
+		return nil, fmt.Errorf("unsupported aggregation type %T", agg)
+	}
+}
+
+// bucketAggregation puts the documents into the buckets of the keys of their field values,
+// a document is counted once in a bucket however many of its values have the key.
+func (s *searcher) bucketAggregation(docs []metapb.Key, fieldId uint32, subAggs map[string]kernel.Aggregation,
+	bucketKeys func(data []byte) ([][]byte, error)) (*kernel.AggregationResult, error) {
+	result := &kernel.AggregationResult{}
+	buckets := make(map[string]*kernel.Bucket)
+	bucketDocs := make(map[*kernel.Bucket][]metapb.Key)
+	err := s.eachDocValue(docs, fieldId, func(docID metapb.Key, data []byte) error {
+		keys, err := bucketKeys(data)
+		if err != nil {
+			return err
+		}
+		for _, key := range keys {
+			b, ok := buckets[string(key)]
+			if !ok {
+				b = &kernel.Bucket{Key: key}
+				buckets[string(key)] = b
+				result.Buckets = append(result.Buckets, b)
+			}
+			if bDocs := bucketDocs[b]; len(bDocs) > 0 && bytes.Equal(bDocs[len(bDocs)-1], docID) {
+				continue
+			}
+			b.DocCount++
+			bucketDocs[b] = append(bucketDocs[b], docID)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(subAggs) > 0 {
+		for _, b := range result.Buckets {
+			if b.Aggregations, err = s.aggregate(bucketDocs[b], subAggs); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return result, nil
+}
+
+// rangeAggregation returns a bucket for every range, including the ranges without documents
+func (s *searcher) rangeAggregation(docs []metapb.Key, a *kernel.RangeAggregation) (*kernel.AggregationResult, error) {
+	type bound struct {
+		key      []byte
+		from, to float64
+	}
+	bounds := make([]bound, 0, len(a.Ranges))
+	for _, r := range a.Ranges {
+		b := bound{key: encoding.EncodeBytesValue(nil, 0, []byte(r.Key)), from: math.Inf(-1), to: math.Inf(1)}
+		if len(r.From) > 0 {
+			values, err := numericValues(r.From)
+			if err != nil || len(values) != 1 {
+				return nil, fmt.Errorf("invalid from of range %s", r.Key)
+			}
+			b.from = values[0]
+		}
+		if len(r.To) > 0 {
+			values, err := numericValues(r.To)
+			if err != nil || len(values) != 1 {
+				return nil, fmt.Errorf("invalid to of range %s", r.Key)
+			}
+			b.to = values[0]
+		}
+		bounds = append(bounds, b)
+	}
+	result, err := s.bucketAggregation(docs, a.FieldId, a.Aggregations, func(data []byte) ([][]byte, error) {
+		values, err := numericValues(data)
+		if err != nil {
+			return nil, err
+		}
+		var keys [][]byte
+		for _, b := range bounds {
+			for _, v := range values {
+				if v >= b.from && v < b.to {
+					keys = append(keys, b.key)
+					break
+				}
+			}
+		}
+		return keys, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	found := make(map[string]struct{}, len(result.Buckets))
+	for _, b := range result.Buckets {
+		found[string(b.Key)] = struct{}{}
+	}
+	for _, b := range bounds {
+		if _, ok := found[string(b.key)]; ok {
+			continue
+		}
+		bucket := &kernel.Bucket{Key: b.key}
+		if len(a.Aggregations) > 0 {
+			if bucket.Aggregations, err = s.aggregate(nil, a.Aggregations); err != nil {
+				return nil, err
+			}
+		}
+		result.Buckets = append(result.Buckets, bucket)
+	}
+	return result, nil
+}
+
+func (s *searcher) statsAggregation(docs []metapb.Key, fieldId uint32) (*kernel.AggregationResult, error) {
+	stats := &kernel.StatsResult{}
+	err := s.eachDocValue(docs, fieldId, func(docID metapb.Key, data []byte) error {
+		values, err := numericValues(data)
+		if err != nil {
+			return err
+		}
+		for _, v := range values {
+			stats.Add(v)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &kernel.AggregationResult{Stats: stats}, nil
+}
+
+// eachDocValue calls fn with the field data of the documents having the field
+func (s *searcher) eachDocValue(docs []metapb.Key, fieldId uint32, fn func(docID metapb.Key, data []byte) error) error {
+	for _, docID := range docs {
+		if err := s.ctx.Err(); err != nil {
+			return err
+		}
+		value, found, err := s.docFieldValue(docID, fieldId)
+		if err != nil {
+			return err
+		}
+		if !found {
+			continue
+		}
+		if err := fn(docID, value.Data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// splitValues returns the values of the field data, every value keeps its value encoding
+func splitValues(data []byte) ([][]byte, error) {
+	var values [][]byte
+	for len(data) > 0 {
+		_, n, err := encoding.PeekValueLength(data)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, data[:n])
+		data = data[n:]
+	}
+	return values, nil
+}
+
+// numericValues returns the int and float values of the field data as float
+func numericValues(data []byte) ([]float64, error) {
+	var values []float64
+	for len(data) > 0 {
+		_, _, _, typ, err := encoding.DecodeValueTag(data)
+		if err != nil {
+			return nil, err
+		}
+		switch typ {
+		case encoding.Int:
+			var v int64
+			if data, v, err = encoding.DecodeIntValue(data); err != nil {
+				return nil, err
+			}
+			values = append(values, float64(v))
+		case encoding.Float:
+			var v float64
+			if data, v, err = encoding.DecodeFloatValue(data); err != nil {
+				return nil, err
+			}
+			values = append(values, v)
+		default:
+			return nil, fmt.Errorf("value type %d is not numeric", typ)
+		}
+	}
+	return values, nil
+}
+
+// intValues returns the int values of the field data, the time values are int unix nanoseconds
+func intValues(data []byte) ([]int64, error) {
+	var values []int64
+	for len(data) > 0 {
+		var v int64
+		var err error
+		if data, v, err = encoding.DecodeIntValue(data); err != nil {
+			return nil, err
+		}
+		values = append(values, v)
+	}
+	return values, nil
+}
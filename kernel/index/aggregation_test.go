@@ -0,0 +1,141 @@
+package index
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/tiglabs/baudengine/kernel"
+	"github.com/tiglabs/baudengine/proto/pspb"
+	"github.com/tiglabs/baudengine/util/encoding"
+)
+
+func newAggDocument(docID string, color string, price int64, created time.Time) *pspb.Document {
+	doc := &pspb.Document{Id: []byte(docID)}
+	for fieldId, data := range map[uint32][]byte{
+		1: encoding.EncodeBytesValue(nil, 0, []byte(color)),
+		2: encoding.EncodeIntValue(nil, 0, price),
+		3: encoding.EncodeIntValue(nil, 0, created.UnixNano()),
+	} {
+		field := pspb.Field{}
+		field.Id = fieldId
+		field.Data = data
+		field.Desc = pspb.FieldDesc{Stored: fieldId == 1, IndexOption: pspb.IndexOption_DOCS, DocValues: true}
+		doc.Fields = append(doc.Fields, field)
+	}
+	return doc
+}
+
+func bucketKey(key []byte) string {
+	if v, ok := decodeSortValue(key).([]byte); ok {
+		return string(v)
+	}
+	return fmt.Sprint(decodeSortValue(key))
+}
+
+func bucketKeys(result *kernel.AggregationResult) map[string]int64 {
+	keys := make(map[string]int64)
+	for _, b := range result.Buckets {
+		keys[bucketKey(b.Key)] = b.DocCount
+	}
+	return keys
+}
+
+func TestSearchAggregations(t *testing.T) {
+	store := open(t)
+	defer cleanup(t, store)
+	driver := NewIndexDriver(store)
+	day := time.Date(2018, 6, 1, 0, 0, 0, 0, time.UTC)
+	docs := []*pspb.Document{
+		newAggDocument("1", "red", 5, day),
+		newAggDocument("2", "red", 15, day.Add(time.Hour)),
+		newAggDocument("3", "blue", 25, day.Add(24*time.Hour)),
+		newAggDocument("4", "red", 35, day.Add(48*time.Hour)),
+		newAggDocument("5", "green", 12, day.Add(49*time.Hour)),
+	}
+	for _, doc := range docs {
+		if err := driver.AddDocument(context.Background(), doc); err != nil {
+			t.Fatalf("add document failed, err %v", err)
+		}
+	}
+
+	aggs := map[string]kernel.Aggregation{
+		"colors": &kernel.TermsAggregation{FieldId: 1, Size: 2, Aggregations: map[string]kernel.Aggregation{
+			"avg_price": &kernel.AvgAggregation{FieldId: 2},
+		}},
+		"prices": &kernel.HistogramAggregation{FieldId: 2, Interval: 10},
+		"days":   &kernel.DateHistogramAggregation{FieldId: 3, Interval: 24 * time.Hour},
+		"ranges": &kernel.RangeAggregation{FieldId: 2, Ranges: []kernel.AggregationRange{
+			{Key: "cheap", To: encoding.EncodeIntValue(nil, 0, 10)},
+			{Key: "medium", From: encoding.EncodeIntValue(nil, 0, 10), To: encoding.EncodeIntValue(nil, 0, 30)},
+			{Key: "expensive", From: encoding.EncodeIntValue(nil, 0, 100)},
+		}},
+		"stats":  &kernel.StatsAggregation{FieldId: 2},
+		"unique": &kernel.CardinalityAggregation{FieldId: 1},
+	}
+	result, err := driver.Search(context.Background(), &kernel.Request{
+		Query:        &kernel.MatchAllQuery{},
+		Aggregations: aggs,
+	})
+	if err != nil {
+		t.Fatalf("search failed, err %v", err)
+	}
+	// the partition keeps the shard size buckets
+	kernel.ReduceAggregations(aggs, result.Aggregations, false)
+
+	colors := result.Aggregations["colors"]
+	if len(colors.Buckets) != 2 || bucketKey(colors.Buckets[0].Key) != "red" || colors.Buckets[0].DocCount != 3 {
+		t.Fatalf("terms aggregation failed, got %v", bucketKeys(colors))
+	}
+	if avg := colors.Buckets[0].Aggregations["avg_price"].Stats.Avg(); avg != 55.0/3 {
+		t.Fatalf("sub aggregation failed, got avg %v", avg)
+	}
+	prices := result.Aggregations["prices"]
+	if len(prices.Buckets) != 4 || decodeSortValue(prices.Buckets[1].Key) != 10.0 || prices.Buckets[1].DocCount != 2 {
+		t.Fatalf("histogram aggregation failed, got %v", bucketKeys(prices))
+	}
+	days := result.Aggregations["days"]
+	if len(days.Buckets) != 3 || decodeSortValue(days.Buckets[2].Key) != day.Add(48*time.Hour).UnixNano() || days.Buckets[2].DocCount != 2 {
+		t.Fatalf("date histogram aggregation failed, got %v", bucketKeys(days))
+	}
+	ranges := bucketKeys(result.Aggregations["ranges"])
+	if len(ranges) != 3 || ranges["cheap"] != 1 || ranges["medium"] != 3 || ranges["expensive"] != 0 {
+		t.Fatalf("range aggregation failed, got %v", ranges)
+	}
+	stats := result.Aggregations["stats"].Stats
+	if stats.Count != 5 || stats.Min != 5 || stats.Max != 35 || stats.Sum != 92 {
+		t.Fatalf("stats aggregation failed, got %v", stats)
+	}
+	if count := result.Aggregations["unique"].Cardinality.Count(); count != 3 {
+		t.Fatalf("cardinality aggregation failed, got %d", count)
+	}
+
+	// the partial results of the partitions are merged
+	merged, err := kernel.MergeAggregations(nil, result.Aggregations)
+	if err != nil {
+		t.Fatalf("merge aggregations failed, err %v", err)
+	}
+	other, err := driver.Search(context.Background(), &kernel.Request{
+		Query:        &kernel.TermQuery{FieldId: 1, Term: []byte("blue")},
+		Aggregations: aggs,
+	})
+	if err != nil {
+		t.Fatalf("search failed, err %v", err)
+	}
+	// the router merges the partial results of the search responses
+	partial := kernel.AggregationResultsFromPB(kernel.AggregationResultsToPB(other.Aggregations))
+	if merged, err = kernel.MergeAggregations(merged, partial); err != nil {
+		t.Fatalf("merge aggregations failed, err %v", err)
+	}
+	kernel.ReduceAggregations(aggs, merged, false)
+	if colors := bucketKeys(merged["colors"]); len(colors) != 2 || colors["red"] != 3 || colors["blue"] != 2 {
+		t.Fatalf("merge terms aggregation failed, got %v", colors)
+	}
+	if stats := merged["stats"].Stats; stats.Count != 6 || stats.Max != 35 {
+		t.Fatalf("merge stats aggregation failed, got %v", stats)
+	}
+	if count := merged["unique"].Cardinality.Count(); count != 3 {
+		t.Fatalf("merge cardinality aggregation failed, got %d", count)
+	}
+}
@@ -0,0 +1,48 @@
+package index
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/tiglabs/baudengine/kernel"
+	"github.com/tiglabs/baudengine/kernel/analysis"
+	"github.com/tiglabs/baudengine/kernel/analysis/tokenizer/keyword"
+	"github.com/tiglabs/baudengine/kernel/mapping"
+)
+
+// Analyze analyzes the text by the analyzer of the name, or by the analyzer of the field in the mapping,
+// the value of a keyword field is one token as it is indexed
+func (id *IndexDriver) Analyze(req *kernel.AnalyzeRequest) (*kernel.AnalyzeResult, error) {
+	name := req.Analyzer
+	if name == "" {
+		if req.Field == "" {
+			return nil, errors.New("analyze request has no analyzer or field")
+		}
+		id.mappingLock.RLock()
+		indexMapping := id.indexMapping
+		id.mappingLock.RUnlock()
+		if indexMapping == nil {
+			return nil, errors.New("space has no mapping for the field")
+		}
+		switch f := indexMapping.FieldMappingNamed(req.Field).(type) {
+		case *mapping.TextFieldMapping:
+			name = f.Analyzer_
+		case *mapping.KeywordFieldMapping:
+			return &kernel.AnalyzeResult{Analyzer: keyword.Name, Tokens: keyword.New().Tokenize(req.Text)}, nil
+		case nil:
+			return nil, fmt.Errorf("unknown field %s", req.Field)
+		default:
+			return nil, fmt.Errorf("field %s is not analyzed", req.Field)
+		}
+	}
+	analyzer := id.analyzerNamed(name)
+	if analyzer == nil {
+		return nil, fmt.Errorf("unknown analyzer %s", name)
+	}
+	result := &kernel.AnalyzeResult{Analyzer: name}
+	if explainer, ok := analyzer.(analysis.Explainer); ok && req.Explain {
+		result.Stages = explainer.Explain(req.Text)
+	}
+	result.Tokens = analyzer.Analyze(req.Text)
+	return result, nil
+}
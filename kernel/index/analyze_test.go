@@ -0,0 +1,195 @@
+package index
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/tiglabs/baudengine/kernel"
+	"github.com/tiglabs/baudengine/kernel/analysis/analyzer/whitspace"
+	"github.com/tiglabs/baudengine/kernel/mapping"
+)
+
+func TestCustomAnalyzer(t *testing.T) {
+	store := open(t)
+	defer cleanup(t, store)
+	driver := NewIndexDriver(store)
+	schema := []byte(`{"settings": {"analysis": {"analyzer": {
+		"title_text": {
+			"char_filters":  [{"type": "character", "function": "punctuation"}],
+			"tokenizer":     {"type": "character", "function": "whitespace"},
+			"token_filters": ["lower", {"type": "stop", "stopwords": ["the", "a"]}]
+		}
+	}}}, "mappings": {"doc": {"_all": {"analyzer": "whitspace"}, "properties": {
+		"title": {"type": "text", "store": true, "analyzer": "title_text"}
+	}}}}`)
+	if err := driver.SetMapping(schema); err != nil {
+		t.Fatalf("set mapping failed, err %v", err)
+	}
+	titleId := uint32(driver.indexMapping.FieldMappingNamed("title").ID())
+	doc, err := driver.MapDocument([]byte("1"), []byte(`{"title": "The e-mail, from A Quick Fox!"}`))
+	if err != nil {
+		t.Fatalf("map document failed, err %v", err)
+	}
+	if err := driver.AddDocument(context.Background(), doc); err != nil {
+		t.Fatalf("add document failed, err %v", err)
+	}
+
+	tests := []struct {
+		term   string
+		docIDs []string
+	}{
+		{"email", []string{"1"}},
+		{"quick", []string{"1"}},
+		{"fox", []string{"1"}},
+		{"the", nil},
+		{"a", nil},
+		{"Quick", nil},
+		{"e-mail", nil},
+	}
+	for i, test := range tests {
+		docIDs := searchDocIDs(t, driver, &kernel.TermQuery{FieldId: titleId, Term: []byte(test.term)})
+		if !equalDocIDs(docIDs, test.docIDs) {
+			t.Fatalf("test %d failed, expect %v, got %v", i, test.docIDs, docIDs)
+		}
+	}
+
+	// the offsets of the analyzed tokens point into the text before the char filters
+	query := &kernel.BooleanQuery{Should: []kernel.Query{
+		&kernel.TermQuery{FieldId: titleId, Term: []byte("email")},
+		&kernel.TermQuery{FieldId: titleId, Term: []byte("fox")},
+	}}
+	result, err := driver.Search(context.Background(), &kernel.Request{Query: query, Size: 10,
+		Highlight: &kernel.Highlight{Fields: []uint32{titleId}, Analyzers: map[uint32]string{titleId: "title_text"}}})
+	if err != nil {
+		t.Fatalf("search failed, err %v", err)
+	}
+	if len(result.Hits) != 1 {
+		t.Fatalf("search failed, got %d hits", len(result.Hits))
+	}
+	highlights := result.Hits[0].Highlights[titleId]
+	if len(highlights) != 1 || highlights[0] != "The <em>e-mail</em>, from A Quick <em>Fox</em>!" {
+		t.Fatalf("highlight failed, got %q", highlights)
+	}
+
+	// the new analyzers are added, the current ones can not be changed
+	merged, err := mapping.MergeSchema(schema, []byte(`{"settings": {"analysis": {"analyzer": {
+		"tag_text": {"tokenizer": "keyword", "token_filters": ["lower"]}
+	}}}, "mappings": {"doc": {"properties": {
+		"tag": {"type": "text", "analyzer": "tag_text"}
+	}}}}`))
+	if err != nil {
+		t.Fatalf("merge mapping failed, err %v", err)
+	}
+	if err := driver.SetMapping(merged); err != nil {
+		t.Fatalf("set merged mapping failed, err %v", err)
+	}
+	doc, err = driver.MapDocument([]byte("2"), []byte(`{"title": "lazy dog", "tag": "Big Dog"}`))
+	if err != nil {
+		t.Fatalf("map document failed, err %v", err)
+	}
+	if err := driver.AddDocument(context.Background(), doc); err != nil {
+		t.Fatalf("add document failed, err %v", err)
+	}
+	tagId := uint32(driver.indexMapping.FieldMappingNamed("tag").ID())
+	if docIDs := searchDocIDs(t, driver, &kernel.TermQuery{FieldId: tagId, Term: []byte("big dog")}); !equalDocIDs(docIDs, []string{"2"}) {
+		t.Fatalf("search by added analyzer failed, got %v", docIDs)
+	}
+	if _, err := mapping.MergeSchema(merged, []byte(`{"settings": {"analysis": {"analyzer": {
+		"tag_text": {"tokenizer": "keyword"}
+	}}}, "mappings": {"doc": {}}}`)); err == nil {
+		t.Fatal("change analyzer should fail")
+	}
+
+	invalid := []string{
+		`{"token_filters": ["lower"]}`,
+		`{"tokenizer": "unknown"}`,
+		`{"tokenizer": "keyword", "token_filters": ["unknown"]}`,
+		`{"tokenizer": {"type": "character", "function": "unknown"}}`,
+		`{"tokenizer": "keyword", "token_filters": [{"type": "stop", "stopwords": "the"}]}`,
+		`{"tokenizer": "keyword", "token_filters": [{"type": "lower", "locale": "en"}]}`,
+	}
+	for i, analyzer := range invalid {
+		schema := fmt.Sprintf(`{"settings": {"analysis": {"analyzer": {"text": %s}}}, "mappings": {"doc": {}}}`, analyzer)
+		if _, err := mapping.NewIndexMapping([]byte(schema)); err == nil {
+			t.Fatalf("invalid analyzer %d should fail", i)
+		}
+	}
+}
+
+func TestAnalyze(t *testing.T) {
+	store := open(t)
+	defer cleanup(t, store)
+	driver := NewIndexDriver(store)
+	schema := []byte(`{"settings": {"analysis": {"analyzer": {
+		"title_text": {
+			"char_filters":  [{"type": "character", "function": "punctuation"}],
+			"tokenizer":     {"type": "character", "function": "whitespace"},
+			"token_filters": ["lower", {"type": "stop", "stopwords": ["the"]}]
+		}
+	}}}, "mappings": {"doc": {"_all": {"analyzer": "whitspace"}, "properties": {
+		"title": {"type": "text", "analyzer": "title_text"},
+		"tag":   {"type": "keyword"},
+		"price": {"type": "long"}
+	}}}}`)
+	if err := driver.SetMapping(schema); err != nil {
+		t.Fatalf("set mapping failed, err %v", err)
+	}
+
+	result, err := driver.Analyze(&kernel.AnalyzeRequest{Field: "title", Text: []byte("The Quick, Fox"), Explain: true})
+	if err != nil {
+		t.Fatalf("analyze failed, err %v", err)
+	}
+	if result.Analyzer != "title_text" || len(result.Tokens) != 2 {
+		t.Fatalf("analyze failed, got %s %v", result.Analyzer, result.Tokens)
+	}
+	if token := result.Tokens[0]; string(token.Term) != "quick" || token.Start != 4 || token.End != 9 || token.Position != 2 {
+		t.Fatalf("analyze token failed, got %v", token)
+	}
+	if token := result.Tokens[1]; string(token.Term) != "fox" || token.Start != 11 || token.End != 14 || token.Position != 3 {
+		t.Fatalf("analyze token failed, got %v", token)
+	}
+	stages := result.Stages
+	if len(stages) != 4 {
+		t.Fatalf("explain failed, got %d stages", len(stages))
+	}
+	if stages[0].Name != "character" || string(stages[0].Text) != "The Quick Fox" {
+		t.Fatalf("explain char filter failed, got %s %q", stages[0].Name, stages[0].Text)
+	}
+	if stages[1].Name != "character" || len(stages[1].Tokens) != 3 || string(stages[1].Tokens[0].Term) != "The" {
+		t.Fatalf("explain tokenizer failed, got %s %v", stages[1].Name, stages[1].Tokens)
+	}
+	if stages[2].Name != "lower" || len(stages[2].Tokens) != 3 || string(stages[2].Tokens[0].Term) != "the" {
+		t.Fatalf("explain lower failed, got %s %v", stages[2].Name, stages[2].Tokens)
+	}
+	if stages[3].Name != "stop" || len(stages[3].Tokens) != 2 {
+		t.Fatalf("explain stop failed, got %s %v", stages[3].Name, stages[3].Tokens)
+	}
+
+	result, err = driver.Analyze(&kernel.AnalyzeRequest{Analyzer: whitspace.Name, Text: []byte("The Quick"), Explain: true})
+	if err != nil {
+		t.Fatalf("analyze by analyzer failed, err %v", err)
+	}
+	if len(result.Tokens) != 2 || string(result.Tokens[0].Term) != "The" || result.Stages != nil {
+		t.Fatalf("analyze by analyzer failed, got %v", result.Tokens)
+	}
+	result, err = driver.Analyze(&kernel.AnalyzeRequest{Field: "tag", Text: []byte("New York")})
+	if err != nil {
+		t.Fatalf("analyze keyword failed, err %v", err)
+	}
+	if len(result.Tokens) != 1 || string(result.Tokens[0].Term) != "New York" {
+		t.Fatalf("analyze keyword failed, got %v", result.Tokens)
+	}
+
+	invalid := []*kernel.AnalyzeRequest{
+		{Text: []byte("text")},
+		{Analyzer: "unknown", Text: []byte("text")},
+		{Field: "unknown", Text: []byte("text")},
+		{Field: "price", Text: []byte("10")},
+	}
+	for i, req := range invalid {
+		if _, err := driver.Analyze(req); err == nil {
+			t.Fatalf("invalid request %d should fail", i)
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package index
+
+import (
+	"errors"
+
+	"github.com/tiglabs/baudengine/kernel"
+	"github.com/tiglabs/baudengine/kernel/store/kvstore"
+	"github.com/tiglabs/baudengine/proto/metapb"
+	"github.com/tiglabs/baudengine/proto/pspb"
+)
+
+var _ kernel.DocValuesReader = &docValuesReader{}
+var _ kernel.DocValuesIterator = &docValuesIterator{}
+
+// DocValues returns the doc values reader of the field on a read transaction
+func (r *IndexDriver) DocValues(fieldId uint32) (kernel.DocValuesReader, error) {
+	tx, err := r.store.NewTransaction(false)
+	if err != nil {
+		return nil, err
+	}
+	return &docValuesReader{tx: tx, fieldId: fieldId, ownTx: tx}, nil
+}
+
+type docValuesReader struct {
+	tx      kvReader
+	fieldId uint32
+	// the transaction rolled back when close, nil if the reader does not own it
+	ownTx kvstore.Transaction
+}
+
+func newDocValuesReader(tx kvReader, fieldId uint32) *docValuesReader {
+	return &docValuesReader{tx: tx, fieldId: fieldId}
+}
+
+func (r *docValuesReader) Get(docID metapb.Key) (pspb.FieldValue, bool, error) {
+	value, err := r.tx.Get(encodeDocValuesKey(r.fieldId, docID))
+	if err != nil {
+		return pspb.FieldValue{}, false, err
+	}
+	if len(value) == 0 {
+		return pspb.FieldValue{}, false, nil
+	}
+	field, err := decodeStoreField(r.fieldId, value)
+	if err != nil {
+		return pspb.FieldValue{}, false, err
+	}
+	return field.FieldValue, true, nil
+}
+
+func (r *docValuesReader) NewIterator() kernel.DocValuesIterator {
+	iter := &docValuesIterator{fieldId: r.fieldId}
+	iter.iter = r.tx.PrefixIterator(encodeDocValuesKey(r.fieldId, nil))
+	if iter.iter == nil {
+		iter.err = errors.New("store driver error")
+		return iter
+	}
+	iter.decode()
+	return iter
+}
+
+func (r *docValuesReader) Close() error {
+	if r.ownTx != nil {
+		return r.ownTx.Rollback()
+	}
+	return nil
+}
+
+type docValuesIterator struct {
+	iter    kvstore.KVIterator
+	fieldId uint32
+	docID   metapb.Key
+	value   pspb.FieldValue
+	err     error
+}
+
+func (i *docValuesIterator) decode() {
+	if i.iter == nil || !i.iter.Valid() {
+		return
+	}
+	_, docID, err := decodeDocValuesKey(i.iter.Key())
+	if err != nil {
+		i.err = err
+		return
+	}
+	field, err := decodeStoreField(i.fieldId, i.iter.Value())
+	if err != nil {
+		i.err = err
+		return
+	}
+	i.docID = docID
+	i.value = field.FieldValue
+}
+
+func (i *docValuesIterator) Next() {
+	if i.err != nil || i.iter == nil {
+		return
+	}
+	i.iter.Next()
+	i.decode()
+}
+
+func (i *docValuesIterator) Valid() bool {
+	return i.err == nil && i.iter != nil && i.iter.Valid()
+}
+
+func (i *docValuesIterator) DocID() metapb.Key {
+	return i.docID
+}
+
+func (i *docValuesIterator) Value() pspb.FieldValue {
+	return i.value
+}
+
+func (i *docValuesIterator) Err() error {
+	return i.err
+}
+
+func (i *docValuesIterator) Close() error {
+	if i.iter != nil {
+		return i.iter.Close()
+	}
+	return nil
+}
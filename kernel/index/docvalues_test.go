@@ -0,0 +1,77 @@
+package index
+
+import (
+	"context"
+	"testing"
+
+	"github.com/tiglabs/baudengine/kernel"
+	"github.com/tiglabs/baudengine/proto/pspb"
+	"github.com/tiglabs/baudengine/util/encoding"
+)
+
+func TestDocValues(t *testing.T) {
+	store := open(t)
+	defer cleanup(t, store)
+	driver := NewIndexDriver(store)
+	ages := map[string]int64{"3": 30, "1": 10, "2": 20}
+	for docID, age := range ages {
+		doc := newTextDocument(docID, map[uint32]string{1: "baud"})
+		// doc values without stored field
+		value := newValueDocument(docID, 2, pspb.ValueType_INT, encoding.EncodeIntValue(nil, 0, age)).Fields[0]
+		value.Desc = pspb.FieldDesc{DocValues: true}
+		doc.Fields = append(doc.Fields, value)
+		if err := driver.AddDocument(context.Background(), doc); err != nil {
+			t.Fatalf("add document failed, err %v", err)
+		}
+	}
+
+	reader, err := driver.DocValues(2)
+	if err != nil {
+		t.Fatalf("doc values failed, err %v", err)
+	}
+	iter := reader.NewIterator()
+	var docIDs []string
+	for ; iter.Valid(); iter.Next() {
+		_, age, err := encoding.DecodeIntValue(iter.Value().Data)
+		if err != nil || age != ages[string(iter.DocID())] {
+			t.Fatalf("doc values of %s failed, got %d err %v", iter.DocID(), age, err)
+		}
+		docIDs = append(docIDs, string(iter.DocID()))
+	}
+	if iter.Err() != nil || !equalDocIDs(docIDs, []string{"1", "2", "3"}) {
+		t.Fatalf("doc values iterator failed, got %v err %v", docIDs, iter.Err())
+	}
+	iter.Close()
+	if _, found, err := reader.Get([]byte("4")); found || err != nil {
+		t.Fatalf("doc values of missing doc failed, found %v err %v", found, err)
+	}
+	reader.Close()
+
+	// sort by doc values
+	result, err := driver.Search(context.Background(), &kernel.Request{
+		Query: &kernel.MatchAllQuery{},
+		Sort:  []kernel.SortField{{FieldId: 2, Reverse: true}},
+	})
+	if err != nil {
+		t.Fatalf("search failed, err %v", err)
+	}
+	docIDs = docIDs[:0]
+	for _, hit := range result.Hits {
+		docIDs = append(docIDs, string(hit.DocID))
+	}
+	if !equalDocIDs(docIDs, []string{"3", "2", "1"}) {
+		t.Fatalf("sort by doc values failed, got %v", docIDs)
+	}
+
+	if _, err := driver.DeleteDocument(context.Background(), []byte("2")); err != nil {
+		t.Fatalf("delete document failed, err %v", err)
+	}
+	reader, err = driver.DocValues(2)
+	if err != nil {
+		t.Fatalf("doc values failed, err %v", err)
+	}
+	defer reader.Close()
+	if _, found, _ := reader.Get([]byte("2")); found {
+		t.Fatal("doc values of deleted doc should be deleted")
+	}
+}
@@ -2,6 +2,7 @@ package index
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/tiglabs/baudengine/util/encoding"
 	"github.com/tiglabs/baudengine/proto/pspb"
@@ -18,12 +19,30 @@ const (
 	KEY_TYPE_R KEY_TYPE = 'R'
 	// term index
 	KEY_TYPE_I KEY_TYPE = 'I'
-	// term vector
+	// term vector, reserved, the term vectors are read from the term entity info, the term index and the positions
 	KEY_TYPE_V KEY_TYPE = 'V'
 	// term position
 	KEY_TYPE_P KEY_TYPE = 'P'
 	// term entity info
 	KEY_TYPE_T KEY_TYPE = 'T'
+	// field statistics
+	KEY_TYPE_C KEY_TYPE = 'C'
+	// term document frequency and total term frequency
+	KEY_TYPE_D KEY_TYPE = 'D'
+	// field length of document
+	KEY_TYPE_L KEY_TYPE = 'L'
+	// doc values
+	KEY_TYPE_E KEY_TYPE = 'E'
+	// doc values fields of document
+	KEY_TYPE_A KEY_TYPE = 'A'
+	// nested document
+	KEY_TYPE_N KEY_TYPE = 'N'
+	// document version
+	KEY_TYPE_O KEY_TYPE = 'O'
+	// document expiration index
+	KEY_TYPE_X KEY_TYPE = 'X'
+	// expiration time of document
+	KEY_TYPE_Y KEY_TYPE = 'Y'
 )
 
 const (
@@ -67,8 +86,9 @@ func fieldTypeOuter(_type FIELD_TYPE) pspb.ValueType {
 		return pspb.ValueType_TIME
 	case FIELD_TYPE_B:
 		return pspb.ValueType_BLOB
+	case FIELD_TYPE_G:
+		return pspb.ValueType_GEO
 	default:
-		// TODO geo
 		return pspb.ValueType_UNKNOWN
 	}
 }
@@ -91,6 +111,8 @@ func fieldTypeInner(_type pspb.ValueType) FIELD_TYPE {
 		return FIELD_TYPE_T
 	case pspb.ValueType_BLOB:
 		return FIELD_TYPE_B
+	case pspb.ValueType_GEO:
+		return FIELD_TYPE_G
 	default:
 		return FIELD_TYPE_U
 	}
@@ -160,12 +182,84 @@ func decodeStoreField(fieldId uint32, row []byte) (*pspb.Field, error) {
 	return field, nil
 }
 
+// encodeValueTerm encodes the first value of the field data to an order-preserving term,
+// int and time (unix nanoseconds) values are encoded as varint, float values as float,
+// and bytes values are kept as they are.
+func encodeValueTerm(data []byte) (term []byte, remaining []byte, err error) {
+	_, _, _, typ, err := encoding.DecodeValueTag(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	switch typ {
+	case encoding.Int:
+		var v int64
+		remaining, v, err = encoding.DecodeIntValue(data)
+		term = encoding.EncodeVarintAscending(nil, v)
+	case encoding.Float:
+		var v float64
+		remaining, v, err = encoding.DecodeFloatValue(data)
+		term = encoding.EncodeFloatAscending(nil, v)
+	case encoding.Bytes:
+		remaining, term, err = encoding.DecodeBytesValue(data)
+	case encoding.True, encoding.False:
+		var v bool
+		remaining, v, err = encoding.DecodeBoolValue(data)
+		if v {
+			term = encoding.EncodeVarintAscending(nil, 1)
+		} else {
+			term = encoding.EncodeVarintAscending(nil, 0)
+		}
+	default:
+		err = fmt.Errorf("unsupported value type %d to index", typ)
+	}
+	return
+}
+
+// prefixEnd returns the smallest key greater than all the keys with the prefix
+func prefixEnd(prefix []byte) []byte {
+	end := append([]byte(nil), prefix...)
+	for i := len(end) - 1; i >= 0; i-- {
+		end[i]++
+		if end[i] != 0 {
+			return end[:i+1]
+		}
+	}
+	// all 0xff
+	return nil
+}
+
 // index key format: [type][field ID][term][doc ID]
 func encodeIndexKey(docID []byte, fieldId uint32, term []byte) (key []byte) {
 	key = append(key, byte(KEY_TYPE_I))
 	key = encoding.EncodeUint32Ascending(key, fieldId)
 	key = encoding.EncodeBytesAscending(key, term)
-	key = encoding.EncodeBytesAscending(key, docID)
+	if len(docID) > 0 {
+		key = encoding.EncodeBytesAscending(key, docID)
+	}
+	return
+}
+
+// the prefix of the index keys of a field
+func encodeIndexFieldKey(fieldId uint32) (key []byte) {
+	key = append(key, byte(KEY_TYPE_I))
+	key = encoding.EncodeUint32Ascending(key, fieldId)
+	return
+}
+
+func decodeIndexKey(key []byte) (docID []byte, fieldId uint32, term []byte, err error) {
+	if len(key) <= 1 || key[0] != byte(KEY_TYPE_I) {
+		err = errors.New("invalid index key")
+		return
+	}
+	key, fieldId, err = encoding.DecodeUint32Ascending(key[1:])
+	if err != nil {
+		return
+	}
+	key, term, err = encoding.DecodeBytesAscending(key, nil)
+	if err != nil {
+		return
+	}
+	_, docID, err = encoding.DecodeBytesAscending(key, nil)
 	return
 }
 
@@ -176,6 +270,13 @@ func encodeIndex(docID []byte, fieldId uint32, term []byte, freq int) (key []byt
 	return
 }
 
+func decodeIndex(row []byte) (freq int, err error) {
+	var v int64
+	_, v, err = encoding.DecodeIntValue(row)
+	freq = int(v)
+	return
+}
+
 // index position key format: [type][field ID][term][doc ID][pos]
 func encodeIndexPositionKey(docID []byte, fieldId uint32, term []byte, pos int) (key []byte) {
 	key = append(key, byte(KEY_TYPE_P))
@@ -188,6 +289,29 @@ func encodeIndexPositionKey(docID []byte, fieldId uint32, term []byte, pos int)
 	return
 }
 
+func decodeIndexPositionKey(key []byte) (docID []byte, fieldId uint32, term []byte, pos int, err error) {
+	if len(key) <= 1 || key[0] != byte(KEY_TYPE_P) {
+		err = errors.New("invalid index position key")
+		return
+	}
+	key, fieldId, err = encoding.DecodeUint32Ascending(key[1:])
+	if err != nil {
+		return
+	}
+	key, term, err = encoding.DecodeBytesAscending(key, nil)
+	if err != nil {
+		return
+	}
+	key, docID, err = encoding.DecodeBytesAscending(key, nil)
+	if err != nil {
+		return
+	}
+	var p uint32
+	_, p, err = encoding.DecodeUint32Ascending(key)
+	pos = int(p)
+	return
+}
+
 func encodeIndexPosition(docID []byte, fieldId uint32, term []byte, pos, start, end int) (key []byte, row []byte, err error) {
 	key = encodeIndexPositionKey(docID, fieldId, term, pos)
 	row = encoding.EncodeIntValue(row, 0, int64(pos))
@@ -199,6 +323,27 @@ func encodeIndexPosition(docID []byte, fieldId uint32, term []byte, pos, start,
 	return
 }
 
+// decodeIndexPosition returns the offsets of the position, end is 0 when the offsets are not indexed
+func decodeIndexPosition(row []byte) (pos, start, end int, err error) {
+	var v int64
+	if row, v, err = encoding.DecodeIntValue(row); err != nil {
+		return
+	}
+	pos = int(v)
+	if len(row) == 0 {
+		return
+	}
+	if row, v, err = encoding.DecodeIntValue(row); err != nil {
+		return
+	}
+	start = int(v)
+	if _, v, err = encoding.DecodeIntValue(row); err != nil {
+		return
+	}
+	end = int(v)
+	return
+}
+
 func encodeFieldTermAbstractKey(docID []byte, fieldId uint32) (key []byte) {
 	key = append(key, byte(KEY_TYPE_T))
 	key = encoding.EncodeBytesAscending(key, docID)
@@ -248,3 +393,271 @@ func decodeFieldTermAbstractValue(value []byte) ([][]byte, error) {
 	}
 	return terms, nil
 }
+
+// field statistics key format: [type][field ID]
+func encodeFieldStatsKey(fieldId uint32) (key []byte) {
+	key = append(key, byte(KEY_TYPE_C))
+	key = encoding.EncodeUint32Ascending(key, fieldId)
+	return
+}
+
+func encodeFieldStats(docCount, sumLength int64) (row []byte) {
+	row = encoding.EncodeIntValue(row, 0, docCount)
+	row = encoding.EncodeIntValue(row, 1, sumLength)
+	return
+}
+
+func decodeFieldStats(row []byte) (docCount, sumLength int64, err error) {
+	if len(row) == 0 {
+		return
+	}
+	row, docCount, err = encoding.DecodeIntValue(row)
+	if err != nil {
+		return
+	}
+	_, sumLength, err = encoding.DecodeIntValue(row)
+	return
+}
+
+// term document frequency key format: [type][field ID][term]
+func encodeTermDocFreqKey(fieldId uint32, term []byte) (key []byte) {
+	key = append(key, byte(KEY_TYPE_D))
+	key = encoding.EncodeUint32Ascending(key, fieldId)
+	key = encoding.EncodeBytesAscending(key, term)
+	return
+}
+
+// the prefix of the document frequency keys of the terms starting with the prefix in a field,
+// it is the encoded prefix term without the terminator
+func encodeTermDocFreqPrefixKey(fieldId uint32, prefix []byte) (key []byte) {
+	key = encodeTermDocFreqKey(fieldId, prefix)
+	return key[:len(key)-2]
+}
+
+func decodeTermDocFreqKey(key []byte) (fieldId uint32, term []byte, err error) {
+	if len(key) <= 1 || key[0] != byte(KEY_TYPE_D) {
+		err = errors.New("invalid term document frequency key")
+		return
+	}
+	key, fieldId, err = encoding.DecodeUint32Ascending(key[1:])
+	if err != nil {
+		return
+	}
+	_, term, err = encoding.DecodeBytesAscending(key, nil)
+	return
+}
+
+// the term statistics are the value of the term document frequency key,
+// the total term frequency is the sum of the frequencies of the term in the documents
+func encodeTermStats(docFreq, totalTermFreq int64) (row []byte) {
+	row = encoding.EncodeIntValue(row, 0, docFreq)
+	row = encoding.EncodeIntValue(row, 1, totalTermFreq)
+	return
+}
+
+func decodeTermStats(row []byte) (docFreq, totalTermFreq int64, err error) {
+	if len(row) == 0 {
+		return
+	}
+	row, docFreq, err = encoding.DecodeIntValue(row)
+	if err != nil || len(row) == 0 {
+		return
+	}
+	_, totalTermFreq, err = encoding.DecodeIntValue(row)
+	return
+}
+
+// field length key format: [type][doc ID][field ID]
+func encodeFieldLengthKey(docID []byte, fieldId uint32) (key []byte) {
+	key = append(key, byte(KEY_TYPE_L))
+	key = encoding.EncodeBytesAscending(key, docID)
+	if fieldId > 0 {
+		key = encoding.EncodeUint32Ascending(key, fieldId)
+	}
+	return
+}
+
+// count encodes the counters of the statistics, document frequency and field length
+func encodeCount(count int64) (row []byte) {
+	return encoding.EncodeIntValue(row, 0, count)
+}
+
+func decodeCount(row []byte) (int64, error) {
+	if len(row) == 0 {
+		return 0, nil
+	}
+	_, count, err := encoding.DecodeIntValue(row)
+	return count, err
+}
+
+// doc values key format: [type][field ID][doc ID]
+func encodeDocValuesKey(fieldId uint32, docID []byte) (key []byte) {
+	key = append(key, byte(KEY_TYPE_E))
+	key = encoding.EncodeUint32Ascending(key, fieldId)
+	if len(docID) > 0 {
+		key = encoding.EncodeBytesAscending(key, docID)
+	}
+	return
+}
+
+func decodeDocValuesKey(key []byte) (fieldId uint32, docID []byte, err error) {
+	if len(key) <= 1 || key[0] != byte(KEY_TYPE_E) {
+		err = errors.New("invalid doc values key")
+		return
+	}
+	key, fieldId, err = encoding.DecodeUint32Ascending(key[1:])
+	if err != nil {
+		return
+	}
+	_, docID, err = encoding.DecodeBytesAscending(key, nil)
+	return
+}
+
+// the row of doc values is the same as the stored field
+func encodeDocValues(docID []byte, field *pspb.Field) (key []byte, row []byte, err error) {
+	key = encodeDocValuesKey(field.Id, docID)
+	row = append(row, byte(fieldTypeInner(field.Type)))
+	if len(field.Data) > 0 {
+		row = append(row, []byte(field.Data)...)
+	}
+	return
+}
+
+// doc values abstract key format: [type][doc ID]
+func encodeDocValuesAbstractKey(docID []byte) (key []byte) {
+	key = append(key, byte(KEY_TYPE_A))
+	key = encoding.EncodeBytesAscending(key, docID)
+	return
+}
+
+func encodeDocValuesAbstract(fieldIds []uint32) (row []byte) {
+	for i, fieldId := range fieldIds {
+		row = encoding.EncodeIntValue(row, uint32(i), int64(fieldId))
+	}
+	return
+}
+
+func decodeDocValuesAbstract(row []byte) ([]uint32, error) {
+	var fieldIds []uint32
+	for len(row) > 0 {
+		var fieldId int64
+		var err error
+		row, fieldId, err = encoding.DecodeIntValue(row)
+		if err != nil {
+			return nil, err
+		}
+		fieldIds = append(fieldIds, uint32(fieldId))
+	}
+	return fieldIds, nil
+}
+
+// nestedDocID returns the ID of the hidden document of a nested object: [parent ID][0x00][field ID][offset],
+// the keys of the nested documents are next to the keys of the parent document.
+func nestedDocID(parent []byte, fieldId, offset uint32) metapb.Key {
+	docID := make([]byte, 0, len(parent)+9)
+	docID = append(docID, parent...)
+	docID = append(docID, 0)
+	docID = encoding.EncodeUint32Ascending(docID, fieldId)
+	docID = encoding.EncodeUint32Ascending(docID, offset)
+	return docID
+}
+
+func decodeNestedDocID(docID []byte) (parent []byte, fieldId, offset uint32, err error) {
+	if len(docID) < 9 || docID[len(docID)-9] != 0 {
+		err = errors.New("invalid nested document ID")
+		return
+	}
+	parent = docID[:len(docID)-9]
+	var key []byte
+	if key, fieldId, err = encoding.DecodeUint32Ascending(docID[len(docID)-8:]); err != nil {
+		return
+	}
+	_, offset, err = encoding.DecodeUint32Ascending(key)
+	return
+}
+
+// nested document key format: [type][nested doc ID], the row is the parent doc ID
+func encodeNestedDocKey(docID []byte) (key []byte) {
+	key = append(key, byte(KEY_TYPE_N))
+	key = encoding.EncodeBytesAscending(key, docID)
+	return
+}
+
+// the prefix of the nested document keys of the parent, the keys of the documents with
+// the parent ID as the prefix of their IDs are also in it
+func encodeNestedDocPrefixKey(parent []byte) (key []byte) {
+	key = encodeNestedDocKey(append(append([]byte(nil), parent...), 0))
+	return key[:len(key)-2]
+}
+
+func decodeNestedDocKey(key []byte) (docID []byte, err error) {
+	if len(key) <= 1 || key[0] != byte(KEY_TYPE_N) {
+		err = errors.New("invalid nested document key")
+		return
+	}
+	_, docID, err = encoding.DecodeBytesAscending(key[1:], nil)
+	return
+}
+
+// document version key format: [type][doc ID], the row is the version and the sequence number
+func encodeDocVersionKey(docID []byte) (key []byte) {
+	key = append(key, byte(KEY_TYPE_O))
+	key = encoding.EncodeBytesAscending(key, docID)
+	return
+}
+
+func decodeDocVersionKey(key []byte) (docID []byte, err error) {
+	if len(key) <= 1 || key[0] != byte(KEY_TYPE_O) {
+		err = errors.New("invalid document version key")
+		return
+	}
+	_, docID, err = encoding.DecodeBytesAscending(key[1:], nil)
+	return
+}
+
+func encodeDocVersion(version, seqNo uint64) (row []byte) {
+	row = encoding.EncodeIntValue(row, 0, int64(version))
+	row = encoding.EncodeIntValue(row, 1, int64(seqNo))
+	return
+}
+
+func decodeDocVersion(row []byte) (version, seqNo uint64, err error) {
+	var v int64
+	if row, v, err = encoding.DecodeIntValue(row); err != nil {
+		return
+	}
+	version = uint64(v)
+	if _, v, err = encoding.DecodeIntValue(row); err != nil {
+		return
+	}
+	seqNo = uint64(v)
+	return
+}
+
+// document expiration index key format: [type][expiration time][doc ID], so the expired documents
+// are the ones before the key of the current time
+func encodeExpireIndexKey(expireAt int64, docID []byte) (key []byte) {
+	key = append(key, byte(KEY_TYPE_X))
+	key = encoding.EncodeVarintAscending(key, expireAt)
+	key = encoding.EncodeBytesAscending(key, docID)
+	return
+}
+
+func decodeExpireIndexKey(key []byte) (expireAt int64, docID []byte, err error) {
+	if len(key) <= 1 || key[0] != byte(KEY_TYPE_X) {
+		err = errors.New("invalid document expiration index key")
+		return
+	}
+	if key, expireAt, err = encoding.DecodeVarintAscending(key[1:]); err != nil {
+		return
+	}
+	_, docID, err = encoding.DecodeBytesAscending(key, nil)
+	return
+}
+
+// expiration time of document key format: [type][doc ID], the row is the expiration time
+func encodeDocExpireKey(docID []byte) (key []byte) {
+	key = append(key, byte(KEY_TYPE_Y))
+	key = encoding.EncodeBytesAscending(key, docID)
+	return
+}
@@ -2,91 +2,59 @@ package index
 
 import (
 	"testing"
-	"github.com/tiglabs/baudengine/kernel/document"
+
+	"github.com/tiglabs/baudengine/proto/pspb"
+	"github.com/tiglabs/baudengine/util/encoding"
 )
 
 func TestDecodeEncodeFileName(t *testing.T) {
-	key := encodeStoreFieldKey([]byte("1"), "name")
-	name, err := decodeStoreFieldKey(key)
+	key := encodeStoreFieldKey([]byte("1"), 3)
+	docID, fieldId, err := decodeStoreFieldKey(key)
 	if err != nil {
 		t.Fatal("encode field failed")
 	}
-	if name != "name" {
+	if string(docID) != "1" || fieldId != 3 {
 		t.Fatal("encode field failed")
 	}
 }
 
 func TestDecodeEncodeFileValue(t *testing.T) {
-	field := document.NewTextField("text", []byte("hello word"), document.StoreField)
-	key, row, err := encodeStoreField([]byte("1"), []document.Field{field})
+	field := &pspb.Field{}
+	field.Id = 3
+	field.Type = pspb.ValueType_STRING
+	field.Data = encoding.EncodeBytesValue(nil, 0, []byte("hello word"))
+	field.Desc = pspb.FieldDesc{Stored: true}
+	key, row, err := encodeStoreField([]byte("1"), field)
 	if err != nil {
 		t.Fatal("encode field failed")
 	}
-	name, err := decodeStoreFieldKey(key)
+	docID, fieldId, err := decodeStoreFieldKey(key)
 	if err != nil {
 		t.Fatal("decode field failed")
 	}
-	if name != "text" {
+	if string(docID) != "1" || fieldId != 3 {
 		t.Fatal("decode field failed")
 	}
-	fs, err := decodeStoreField("text", row)
+	f, err := decodeStoreField(fieldId, row)
 	if err != nil {
 		t.Fatal("decode field failed")
 	}
-	if len(fs) != 1 {
-		t.Fatal("decode field failed")
+	if f.Id != 3 || f.Type != pspb.ValueType_STRING {
+		t.Fatal("invalid field")
 	}
-	f := fs[0]
-	if tf, ok := f.(*document.TextField); !ok {
+	_, text, err := encoding.DecodeBytesValue(f.Data)
+	if err != nil || string(text) != "hello word" {
 		t.Fatal("invalid field")
-	} else {
-		if tf.Name() != "text" {
-			t.Fatal("invalid field")
-		}
-		if string(tf.Value()) != "hello word" {
-			t.Fatal("invalid field")
-		}
 	}
 }
 
-func TestDecodeEncodeFilesValue(t *testing.T) {
-	field1 := document.NewTextField("text", []byte("hello word"), document.StoreField)
-	field2 := document.NewTextField("text", []byte("good, baud"), document.StoreField)
-	key, row, err := encodeStoreField([]byte("1"), []document.Field{field1, field2})
-	if err != nil {
-		t.Fatal("encode field failed")
-	}
-	name, err := decodeStoreFieldKey(key)
+func TestDecodeEncodeTermDocFreqKey(t *testing.T) {
+	key := encodeTermDocFreqKey(3, []byte("baud"))
+	fieldId, term, err := decodeTermDocFreqKey(key)
 	if err != nil {
-		t.Fatal("decode field failed")
+		t.Fatal("decode doc freq key failed")
 	}
-	if name != "text" {
-		t.Fatal("decode field failed")
-	}
-	fs, err := decodeStoreField("text", row)
-	if err != nil {
-		t.Fatal("decode field failed")
-	}
-	if len(fs) != 2 {
-		t.Fatal("decode field failed")
-	}
-	for i, f := range fs {
-		if tf, ok := f.(*document.TextField); !ok {
-			t.Fatal("invalid field")
-		} else {
-			if tf.Name() != "text" {
-				t.Fatal("invalid field")
-			}
-			if i == 0 {
-				if string(tf.Value()) != "hello word" {
-					t.Fatal("invalid field")
-				}
-			} else {
-				if string(tf.Value()) != "good, baud" {
-					t.Fatal("invalid field")
-				}
-			}
-
-		}
+	if fieldId != 3 || string(term) != "baud" {
+		t.Fatal("decode doc freq key failed")
 	}
 }
@@ -0,0 +1,137 @@
+package index
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/tiglabs/baudengine/proto/metapb"
+	"github.com/tiglabs/baudengine/proto/pspb"
+	"github.com/tiglabs/baudengine/util/encoding"
+)
+
+// unixMillis returns the time in unix milliseconds, the unit of the expiration time
+func unixMillis(t time.Time) int64 {
+	return t.UnixNano() / int64(time.Millisecond)
+}
+
+func (r *IndexDriver) DefaultTTL() time.Duration {
+	if indexMapping := r.currentMapping(); indexMapping != nil {
+		return indexMapping.DefaultTTL()
+	}
+	return 0
+}
+
+// ExpiredDocuments returns the documents of the expiration index keys before the time
+func (r *IndexDriver) ExpiredDocuments(ctx context.Context, now int64, limit int) ([]metapb.Key, error) {
+	tx, err := r.store.NewTransaction(false)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+	iter := tx.PrefixIterator([]byte{byte(KEY_TYPE_X)})
+	if iter == nil {
+		return nil, errors.New("store driver error")
+	}
+	defer iter.Close()
+	var docIDs []metapb.Key
+	for ; iter.Valid() && len(docIDs) < limit; iter.Next() {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		expireAt, docID, err := decodeExpireIndexKey(iter.Key())
+		if err != nil {
+			return nil, err
+		}
+		if expireAt > now {
+			break
+		}
+		docIDs = append(docIDs, docID)
+	}
+	return docIDs, nil
+}
+
+func (b *Batch) DocExpireAt(docID metapb.Key) (int64, error) {
+	if err := b.flushDirty(docID); err != nil {
+		return 0, err
+	}
+	return readDocExpire(b.reader(), docID)
+}
+
+func readDocExpire(store kvReader, docID metapb.Key) (int64, error) {
+	value, err := store.Get(encodeDocExpireKey(docID))
+	if err != nil || len(value) == 0 {
+		return 0, err
+	}
+	_, expireAt, err := encoding.DecodeIntValue(value)
+	return expireAt, err
+}
+
+// isDocExpired reports whether the document is expired but not deleted by the sweeper yet
+func isDocExpired(store kvReader, docID metapb.Key, now int64) (bool, error) {
+	expireAt, err := readDocExpire(store, docID)
+	if err != nil {
+		return false, err
+	}
+	return expireAt > 0 && expireAt <= now, nil
+}
+
+// writeExpire sets the expiration time of the document and replaces its key in the expiration index,
+// the document never expires if the time is 0
+func (b *Batch) writeExpire(docID metapb.Key, expireAt int64) error {
+	if err := b.flushDirty(docID); err != nil {
+		return err
+	}
+	current, err := readDocExpire(b.reader(), docID)
+	if err != nil || current == expireAt {
+		return err
+	}
+	if current > 0 {
+		b.batch.Delete(encodeExpireIndexKey(current, docID))
+	}
+	if expireAt > 0 {
+		b.batch.Set(encodeDocExpireKey(docID), encoding.EncodeIntValue(nil, 0, expireAt))
+		b.batch.Set(encodeExpireIndexKey(expireAt, docID), []byte{0})
+	} else {
+		b.batch.Delete(encodeDocExpireKey(docID))
+	}
+	return nil
+}
+
+// mergeExpire sets the new expiration time of the merged document without changed fields,
+// the document is updated if the expiration time is changed
+func (b *Batch) mergeExpire(docID metapb.Key, expireAt int64, forceCommit bool) (pspb.WriteResult, error) {
+	if expireAt == 0 {
+		return pspb.WriteResult_NOOP, nil
+	}
+	current, err := readDocExpire(b.reader(), docID)
+	if err != nil || current == expireAt {
+		return pspb.WriteResult_NOOP, err
+	}
+	if err := b.writeExpire(docID, expireAt); err != nil {
+		return pspb.WriteResult_NOOP, err
+	}
+	if err := b.writeVersion(docID); err != nil {
+		return pspb.WriteResult_NOOP, err
+	}
+	b.dirty[string(docID)] = true
+	if forceCommit {
+		return pspb.WriteResult_UPDATED, b.Commit()
+	}
+	return pspb.WriteResult_UPDATED, nil
+}
+
+// liveMatches removes the expired documents from the matches
+func (s *searcher) liveMatches(matches []*docMatch) ([]*docMatch, error) {
+	live := matches[:0]
+	for _, m := range matches {
+		expired, err := isDocExpired(s.tx, m.docID, s.now)
+		if err != nil {
+			return nil, err
+		}
+		if !expired {
+			live = append(live, m)
+		}
+	}
+	return live, nil
+}
@@ -0,0 +1,75 @@
+package index
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/tiglabs/baudengine/kernel"
+)
+
+func TestDocExpire(t *testing.T) {
+	store := open(t)
+	defer cleanup(t, store)
+	driver := NewIndexDriver(store)
+	driver.now = func() time.Time { return time.Unix(1, 0) }
+	for id, expireAt := range map[string]int64{"1": 2000, "2": 500, "3": 0} {
+		doc := newTextDocument(id, map[uint32]string{1: "quick fox"})
+		doc.ExpireAt = expireAt
+		if err := driver.AddDocument(context.Background(), doc); err != nil {
+			t.Fatalf("add document failed, err %v", err)
+		}
+	}
+	expired := func(now int64, limit int, expect []string) {
+		docIDs, err := driver.ExpiredDocuments(context.Background(), now, limit)
+		if err != nil {
+			t.Fatalf("expired documents failed, err %v", err)
+		}
+		var ids []string
+		for _, docID := range docIDs {
+			ids = append(ids, string(docID))
+		}
+		if !equalDocIDs(ids, expect) {
+			t.Fatalf("expired documents at %d failed, expect %v, got %v", now, expect, ids)
+		}
+	}
+
+	if _, found := driver.GetDocument(context.Background(), []byte("2"), []uint32{1}); found {
+		t.Fatalf("expired document should be hidden")
+	}
+	if _, found := driver.GetDocument(context.Background(), []byte("1"), []uint32{1}); !found {
+		t.Fatalf("document not expired should be found")
+	}
+	if docIDs := searchDocIDs(t, driver, &kernel.MatchAllQuery{}); !equalDocIDs(docIDs, []string{"1", "3"}) {
+		t.Fatalf("search failed, expect [1 3], got %v", docIDs)
+	}
+	expired(1000, 10, []string{"2"})
+	expired(3000, 10, []string{"2", "1"})
+	expired(3000, 1, []string{"2"})
+
+	b := driver.NewWriteBatch()
+	if expireAt, err := b.DocExpireAt([]byte("1")); err != nil || expireAt != 2000 {
+		t.Fatalf("expire time failed, expect 2000, got %d err %v", expireAt, err)
+	}
+	if _, err := b.UpdateDocument(context.Background(), newTextDocument("1", map[uint32]string{1: "lazy dog"}), false); err != nil {
+		t.Fatalf("update document failed, err %v", err)
+	}
+	doc := newTextDocument("3", map[uint32]string{1: "lazy dog"})
+	doc.ExpireAt = 800
+	if err := b.AddDocument(context.Background(), doc); err != nil {
+		t.Fatalf("add document failed, err %v", err)
+	}
+	if expireAt, err := b.DocExpireAt([]byte("3")); err != nil || expireAt != 800 {
+		t.Fatalf("expire time failed, expect 800, got %d err %v", expireAt, err)
+	}
+	if _, err := b.DeleteDocument(context.Background(), []byte("2")); err != nil {
+		t.Fatalf("delete document failed, err %v", err)
+	}
+	if err := b.Commit(); err != nil {
+		t.Fatalf("commit failed, err %v", err)
+	}
+	expired(3000, 10, []string{"3"})
+	if docIDs := searchDocIDs(t, driver, &kernel.MatchAllQuery{}); !equalDocIDs(docIDs, []string{"1"}) {
+		t.Fatalf("search failed, expect [1], got %v", docIDs)
+	}
+}
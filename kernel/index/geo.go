@@ -0,0 +1,166 @@
+package index
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/tiglabs/baudengine/kernel"
+	"github.com/tiglabs/baudengine/kernel/analysis"
+	"github.com/tiglabs/baudengine/util/geo"
+)
+
+// the max number of the geohash cells a geo query looks up for a box
+const maxGeoCells = 32
+
+// geoPointTokens returns the geohash terms of all the precisions for every point of the field,
+// so that a geo query looks up the cells of any precision
+func geoPointTokens(data []byte) (analysis.TokenSet, error) {
+	points, err := decodeGeoPoints(data)
+	if err != nil {
+		return nil, err
+	}
+	var tokens analysis.TokenSet
+	for i, p := range points {
+		if err := geo.ValidPoint(p.Lat, p.Lon); err != nil {
+			return nil, err
+		}
+		hash := geo.EncodeGeohash(p.Lat, p.Lon, geo.MaxGeohashPrecision)
+		for precision := 1; precision <= len(hash); precision++ {
+			tokens = append(tokens, &analysis.Token{
+				Term:     []byte(hash[:precision]),
+				Position: i + 1,
+				Type:     analysis.KeyWord,
+			})
+		}
+	}
+	return tokens, nil
+}
+
+// decodeGeoPoints returns the points of the geo point field data,
+// a point is encoded as the float latitude followed by the float longitude
+func decodeGeoPoints(data []byte) ([]kernel.GeoPoint, error) {
+	values, err := numericValues(data)
+	if err != nil {
+		return nil, err
+	}
+	if len(values)%2 != 0 {
+		return nil, errors.New("invalid geo point data")
+	}
+	points := make([]kernel.GeoPoint, 0, len(values)/2)
+	for i := 0; i < len(values); i += 2 {
+		points = append(points, kernel.GeoPoint{Lat: values[i], Lon: values[i+1]})
+	}
+	return points, nil
+}
+
+func (s *searcher) geoBoundingBoxMatches(q *kernel.GeoBoundingBoxQuery) ([]*docMatch, error) {
+	if err := geo.ValidPoint(q.TopLeft.Lat, q.TopLeft.Lon); err != nil {
+		return nil, err
+	}
+	if err := geo.ValidPoint(q.BottomRight.Lat, q.BottomRight.Lon); err != nil {
+		return nil, err
+	}
+	if q.TopLeft.Lat < q.BottomRight.Lat {
+		return nil, errors.New("top of geo bounding box is below bottom")
+	}
+	rects := geo.BoxRects(q.TopLeft.Lat, q.TopLeft.Lon, q.BottomRight.Lat, q.BottomRight.Lon)
+	return s.geoMatches(q.FieldId, rects, func(p kernel.GeoPoint) bool {
+		for _, r := range rects {
+			if r.Contains(p.Lat, p.Lon) {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+func (s *searcher) geoDistanceMatches(q *kernel.GeoDistanceQuery) ([]*docMatch, error) {
+	if err := geo.ValidPoint(q.Origin.Lat, q.Origin.Lon); err != nil {
+		return nil, err
+	}
+	if q.Distance <= 0 || math.IsNaN(q.Distance) {
+		return nil, fmt.Errorf("invalid geo distance %v", q.Distance)
+	}
+	rects := geo.DistanceRects(q.Origin.Lat, q.Origin.Lon, q.Distance)
+	return s.geoMatches(q.FieldId, rects, func(p kernel.GeoPoint) bool {
+		return geo.Distance(q.Origin.Lat, q.Origin.Lon, p.Lat, p.Lon) <= q.Distance
+	})
+}
+
+func (s *searcher) geoPolygonMatches(q *kernel.GeoPolygonQuery) ([]*docMatch, error) {
+	if len(q.Points) < 3 {
+		return nil, errors.New("geo polygon needs at least 3 points")
+	}
+	lats := make([]float64, 0, len(q.Points))
+	lons := make([]float64, 0, len(q.Points))
+	r := geo.Rect{MinLat: 90, MinLon: 180, MaxLat: -90, MaxLon: -180}
+	for _, p := range q.Points {
+		if err := geo.ValidPoint(p.Lat, p.Lon); err != nil {
+			return nil, err
+		}
+		lats = append(lats, p.Lat)
+		lons = append(lons, p.Lon)
+		r.MinLat, r.MaxLat = math.Min(r.MinLat, p.Lat), math.Max(r.MaxLat, p.Lat)
+		r.MinLon, r.MaxLon = math.Min(r.MinLon, p.Lon), math.Max(r.MaxLon, p.Lon)
+	}
+	return s.geoMatches(q.FieldId, []geo.Rect{r}, func(p kernel.GeoPoint) bool {
+		return geo.PolygonContains(lats, lons, p.Lat, p.Lon)
+	})
+}
+
+// geoMatches returns the documents having a point accepted by contains,
+// the candidates are the documents in the geohash cells covering the boxes,
+// and their points are read from the doc values or the stored field to check
+func (s *searcher) geoMatches(fieldId uint32, rects []geo.Rect, contains func(p kernel.GeoPoint) bool) ([]*docMatch, error) {
+	var lists [][]*docMatch
+	for _, r := range rects {
+		for _, cell := range geo.GeohashCells(r, maxGeoCells) {
+			matches, err := s.termDocs(fieldId, []byte(cell))
+			if err != nil {
+				return nil, err
+			}
+			lists = append(lists, matches)
+		}
+	}
+	var matches []*docMatch
+	for _, m := range disjunction(lists, 1) {
+		if err := s.ctx.Err(); err != nil {
+			return nil, err
+		}
+		value, found, err := s.docFieldValue(m.docID, fieldId)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			return nil, fmt.Errorf("field[%d] has neither doc values nor stored value for geo query", fieldId)
+		}
+		points, err := decodeGeoPoints(value.Data)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range points {
+			if contains(p) {
+				matches = append(matches, &docMatch{docID: m.docID, score: 1})
+				break
+			}
+		}
+	}
+	return matches, nil
+}
+
+// minGeoDistance returns the distance in meters of the nearest point of the field data to the origin
+func minGeoDistance(data []byte, origin *kernel.GeoPoint) (float64, error) {
+	points, err := decodeGeoPoints(data)
+	if err != nil {
+		return 0, err
+	}
+	if len(points) == 0 {
+		return 0, errors.New("no geo point")
+	}
+	min := math.Inf(1)
+	for _, p := range points {
+		min = math.Min(min, geo.Distance(origin.Lat, origin.Lon, p.Lat, p.Lon))
+	}
+	return min, nil
+}
@@ -0,0 +1,89 @@
+package index
+
+import (
+	"context"
+	"testing"
+
+	"github.com/tiglabs/baudengine/kernel"
+	"github.com/tiglabs/baudengine/proto/pspb"
+	"github.com/tiglabs/baudengine/util/geo"
+)
+
+func TestSearchGeo(t *testing.T) {
+	store := open(t)
+	defer cleanup(t, store)
+	driver := NewIndexDriver(store)
+	points := map[string][]kernel.GeoPoint{
+		"1": {{Lat: 48.8566, Lon: 2.3522}},                                 // Paris
+		"2": {{Lat: 51.5074, Lon: -0.1278}},                                // London
+		"3": {{Lat: 52.52, Lon: 13.405}},                                   // Berlin
+		"4": {{Lat: -17.7134, Lon: 178.065}},                               // Fiji
+		"5": {{Lat: -13.759, Lon: -172.105}},                               // Samoa
+		"6": {{Lat: 40.7128, Lon: -74.006}, {Lat: 35.6762, Lon: 139.6503}}, // New York and Tokyo
+	}
+	for docID, ps := range points {
+		doc := newTextDocument(docID, map[uint32]string{1: "city"})
+		var data []byte
+		for _, p := range ps {
+			data = geo.EncodePoint(data, p.Lat, p.Lon)
+		}
+		field := pspb.Field{}
+		field.Id = 2
+		field.Type = pspb.ValueType_GEO
+		field.Data = data
+		field.Desc = pspb.FieldDesc{IndexOption: pspb.IndexOption_DOCS, DocValues: true}
+		doc.Fields = append(doc.Fields, field)
+		if err := driver.AddDocument(context.Background(), doc); err != nil {
+			t.Fatalf("add document failed, err %v", err)
+		}
+	}
+
+	cases := []struct {
+		query kernel.Query
+		docs  []string
+	}{
+		{&kernel.GeoBoundingBoxQuery{FieldId: 2, TopLeft: kernel.GeoPoint{Lat: 55, Lon: -5}, BottomRight: kernel.GeoPoint{Lat: 45, Lon: 15}}, []string{"1", "2", "3"}},
+		{&kernel.GeoBoundingBoxQuery{FieldId: 2, TopLeft: kernel.GeoPoint{Lat: 55, Lon: 0}, BottomRight: kernel.GeoPoint{Lat: 45, Lon: 10}}, []string{"1"}},
+		// crossing the dateline
+		{&kernel.GeoBoundingBoxQuery{FieldId: 2, TopLeft: kernel.GeoPoint{Lat: -10, Lon: 170}, BottomRight: kernel.GeoPoint{Lat: -20, Lon: -170}}, []string{"4", "5"}},
+		{&kernel.GeoDistanceQuery{FieldId: 2, Origin: kernel.GeoPoint{Lat: 48.8566, Lon: 2.3522}, Distance: 400000}, []string{"1", "2"}},
+		{&kernel.GeoDistanceQuery{FieldId: 2, Origin: kernel.GeoPoint{Lat: 48.8566, Lon: 2.3522}, Distance: 1000000}, []string{"1", "2", "3"}},
+		// any point of the document
+		{&kernel.GeoDistanceQuery{FieldId: 2, Origin: kernel.GeoPoint{Lat: 35.6895, Lon: 139.6917}, Distance: 10000}, []string{"6"}},
+		{&kernel.GeoDistanceQuery{FieldId: 2, Origin: kernel.GeoPoint{Lat: -16, Lon: 179.9}, Distance: 1500000}, []string{"4", "5"}},
+		{&kernel.GeoPolygonQuery{FieldId: 2, Points: []kernel.GeoPoint{{Lat: 52, Lon: -2}, {Lat: 52, Lon: 4}, {Lat: 47, Lon: 3}}}, []string{"1", "2"}},
+	}
+	for i, c := range cases {
+		if got := searchDocIDs(t, driver, c.query); !equalDocIDs(got, c.docs) {
+			t.Fatalf("case %d failed, got %v, expect %v", i, got, c.docs)
+		}
+	}
+
+	invalid := []kernel.Query{
+		&kernel.GeoBoundingBoxQuery{FieldId: 2, TopLeft: kernel.GeoPoint{Lat: 45, Lon: -5}, BottomRight: kernel.GeoPoint{Lat: 55, Lon: 15}},
+		&kernel.GeoDistanceQuery{FieldId: 2, Origin: kernel.GeoPoint{Lat: 91, Lon: 0}, Distance: 1000},
+		&kernel.GeoPolygonQuery{FieldId: 2, Points: []kernel.GeoPoint{{Lat: 52, Lon: -2}, {Lat: 52, Lon: 4}}},
+	}
+	for i, query := range invalid {
+		if _, err := driver.Search(context.Background(), &kernel.Request{Query: query}); err == nil {
+			t.Fatalf("invalid query %d should fail", i)
+		}
+	}
+
+	// sort by the distance from Berlin
+	result, err := driver.Search(context.Background(), &kernel.Request{
+		Query: &kernel.MatchAllQuery{},
+		Sort:  []kernel.SortField{{FieldId: 2, GeoDistance: &kernel.GeoPoint{Lat: 52.52, Lon: 13.405}}},
+		Size:  4,
+	})
+	if err != nil {
+		t.Fatalf("search failed, err %v", err)
+	}
+	var docIDs []string
+	for _, hit := range result.Hits {
+		docIDs = append(docIDs, string(hit.DocID))
+	}
+	if expect := []string{"3", "1", "2", "6"}; !equalDocIDs(docIDs, expect) {
+		t.Fatalf("geo distance sort failed, expect %v, got %v", expect, docIDs)
+	}
+}
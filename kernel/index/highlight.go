@@ -0,0 +1,234 @@
+package index
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+	"unicode/utf8"
+
+	"github.com/tiglabs/baudengine/kernel"
+	"github.com/tiglabs/baudengine/proto/metapb"
+	"github.com/tiglabs/baudengine/util/encoding"
+)
+
+// span is the offsets of a term in the text of the field
+type span struct {
+	start, end int
+}
+
+// fragment is a piece of the text of the field, containing the spans of the terms
+type fragment struct {
+	start, end int
+	spans      []span
+}
+
+// highlight returns the fragments of the stored fields of the document containing the terms of the query
+func (s *searcher) highlight(docID metapb.Key, query kernel.Query, h *kernel.Highlight) (map[uint32][]string, error) {
+	terms := queryTerms(query, nil)
+	fields := h.Fields
+	if len(fields) == 0 {
+		for fieldId := range terms {
+			fields = append(fields, fieldId)
+		}
+	}
+	highlights := make(map[uint32][]string)
+	for _, fieldId := range fields {
+		if len(terms[fieldId]) == 0 {
+			continue
+		}
+		row, err := s.tx.Get(encodeStoreFieldKey(docID, fieldId))
+		if err != nil {
+			return nil, err
+		}
+		// not stored field
+		if len(row) == 0 {
+			continue
+		}
+		field, err := decodeStoreField(fieldId, row)
+		if err != nil {
+			return nil, err
+		}
+		text, err := fieldText(field.Data)
+		if err != nil {
+			return nil, err
+		}
+		spans, found, err := s.termOffsets(docID, fieldId, terms[fieldId])
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			if spans, err = s.analyzeOffsets(h.Analyzers[fieldId], field.Data, terms[fieldId]); err != nil {
+				return nil, err
+			}
+		}
+		if len(spans) == 0 {
+			continue
+		}
+		highlights[fieldId] = fragments(text, spans, h)
+	}
+	return highlights, nil
+}
+
+// termOffsets returns the indexed offsets of the terms in the field of the document,
+// found is false when the field is indexed without offsets
+func (s *searcher) termOffsets(docID metapb.Key, fieldId uint32, terms [][]byte) (spans []span, found bool, err error) {
+	for _, term := range terms {
+		iter := s.tx.PrefixIterator(encodeIndexPositionKey(docID, fieldId, term, 0))
+		if iter == nil {
+			return nil, false, errors.New("store driver error")
+		}
+		for ; iter.Valid(); iter.Next() {
+			_, start, end, err := decodeIndexPosition(iter.Value())
+			if err != nil {
+				iter.Close()
+				return nil, false, err
+			}
+			if end == 0 {
+				iter.Close()
+				return nil, false, nil
+			}
+			spans = append(spans, span{start: start, end: end})
+		}
+		iter.Close()
+	}
+	return spans, len(spans) > 0, nil
+}
+
+// analyzeOffsets analyzes the field data again for the offsets of the terms
+func (s *searcher) analyzeOffsets(analyzerName string, data []byte, terms [][]byte) ([]span, error) {
+	if analyzerName == "" {
+		return nil, nil
+	}
+	analyzer := s.analyzerNamed(analyzerName)
+	if analyzer == nil {
+		return nil, fmt.Errorf("unknown analyzer %s", analyzerName)
+	}
+	tokens, err := analyzeFieldValues(analyzer, data)
+	if err != nil {
+		return nil, err
+	}
+	var spans []span
+	for _, token := range tokens {
+		for _, term := range terms {
+			if bytes.Equal(token.Term, term) {
+				spans = append(spans, span{start: token.Start, end: token.End})
+				break
+			}
+		}
+	}
+	return spans, nil
+}
+
+// fieldText joins the values of the field as they are analyzed, so that the offsets point into the text
+func fieldText(data []byte) ([]byte, error) {
+	var text []byte
+	for i := 0; len(data) > 0; i++ {
+		var (
+			value []byte
+			err   error
+		)
+		data, value, err = encoding.DecodeBytesValue(data)
+		if err != nil {
+			return nil, err
+		}
+		if i > 0 {
+			text = append(text, bytes.Repeat([]byte{' '}, offsetGap)...)
+		}
+		text = append(text, value...)
+	}
+	return text, nil
+}
+
+// fragments cuts the text into the fragments around the spans, the fragments with more spans come first
+func fragments(text []byte, spans []span, h *kernel.Highlight) []string {
+	size := h.FragmentSize
+	if size <= 0 {
+		size = kernel.DefaultFragmentSize
+	}
+	num := h.NumFragments
+	if num <= 0 {
+		num = kernel.DefaultNumFragments
+	}
+	preTag, postTag := h.PreTag, h.PostTag
+	if preTag == "" && postTag == "" {
+		preTag, postTag = kernel.DefaultHighlightPreTag, kernel.DefaultHighlightPostTag
+	}
+
+	sort.Slice(spans, func(i, j int) bool {
+		return spans[i].start < spans[j].start
+	})
+	// the overlapped spans are merged
+	merged := spans[:0]
+	for _, sp := range spans {
+		if sp.end > len(text) {
+			sp.end = len(text)
+		}
+		if sp.start >= sp.end {
+			continue
+		}
+		if n := len(merged); n > 0 && sp.start <= merged[n-1].end {
+			if sp.end > merged[n-1].end {
+				merged[n-1].end = sp.end
+			}
+			continue
+		}
+		merged = append(merged, sp)
+	}
+
+	var frags []fragment
+	for i := 0; i < len(merged); {
+		j := i + 1
+		for j < len(merged) && merged[j].end-merged[i].start <= size {
+			j++
+		}
+		f := fragment{start: merged[i].start, end: merged[j-1].end, spans: merged[i:j]}
+		// pad the fragment with the text around the spans
+		if pad := size - (f.end - f.start); pad > 0 {
+			f.start -= pad / 2
+			if f.start < 0 {
+				f.start = 0
+			}
+			f.end = f.start + size
+			if f.end > len(text) {
+				f.end = len(text)
+				if f.start = f.end - size; f.start < 0 {
+					f.start = 0
+				}
+			}
+			if f.end < f.spans[len(f.spans)-1].end {
+				f.end = f.spans[len(f.spans)-1].end
+			}
+		}
+		for f.start > 0 && !utf8.RuneStart(text[f.start]) {
+			f.start--
+		}
+		for f.end < len(text) && !utf8.RuneStart(text[f.end]) {
+			f.end++
+		}
+		frags = append(frags, f)
+		i = j
+	}
+	sort.SliceStable(frags, func(i, j int) bool {
+		return len(frags[i].spans) > len(frags[j].spans)
+	})
+	if len(frags) > num {
+		frags = frags[:num]
+	}
+
+	result := make([]string, 0, len(frags))
+	for _, f := range frags {
+		var buf bytes.Buffer
+		last := f.start
+		for _, sp := range f.spans {
+			buf.Write(text[last:sp.start])
+			buf.WriteString(preTag)
+			buf.Write(text[sp.start:sp.end])
+			buf.WriteString(postTag)
+			last = sp.end
+		}
+		buf.Write(text[last:f.end])
+		result = append(result, string(bytes.TrimSpace(buf.Bytes())))
+	}
+	return result
+}
@@ -0,0 +1,64 @@
+package index
+
+import (
+	"context"
+	"testing"
+
+	"github.com/tiglabs/baudengine/kernel"
+	"github.com/tiglabs/baudengine/kernel/analysis/analyzer/whitspace"
+	"github.com/tiglabs/baudengine/proto/pspb"
+)
+
+func TestSearchHighlight(t *testing.T) {
+	store := open(t)
+	defer cleanup(t, store)
+	driver := NewIndexDriver(store)
+	doc := newTextDocument("1", map[uint32]string{1: "the quick brown fox jumps over the lazy dog"})
+	// positions without offsets
+	noOffsets := newTextDocument("2", map[uint32]string{2: "a lazy fox"})
+	noOffsets.Fields[0].Desc.IndexOption = pspb.IndexOption_DOCS_FREQ_POSITION
+	doc.Fields = append(doc.Fields, noOffsets.Fields...)
+	if err := driver.AddDocument(context.Background(), doc); err != nil {
+		t.Fatalf("add document failed, err %v", err)
+	}
+
+	query := &kernel.BooleanQuery{Should: []kernel.Query{
+		&kernel.TermQuery{FieldId: 1, Term: []byte("fox")},
+		&kernel.TermQuery{FieldId: 1, Term: []byte("lazy")},
+		&kernel.TermQuery{FieldId: 2, Term: []byte("fox")},
+	}}
+	search := func(h *kernel.Highlight) map[uint32][]string {
+		result, err := driver.Search(context.Background(), &kernel.Request{Query: query, Highlight: h})
+		if err != nil {
+			t.Fatalf("search failed, err %v", err)
+		}
+		if len(result.Hits) != 1 {
+			t.Fatalf("search failed, got %d hits", len(result.Hits))
+		}
+		return result.Hits[0].Highlights
+	}
+
+	highlights := search(&kernel.Highlight{})
+	if len(highlights[1]) != 1 || highlights[1][0] != "the quick brown <em>fox</em> jumps over the <em>lazy</em> dog" {
+		t.Fatalf("highlight failed, got %q", highlights[1])
+	}
+	// no analyzer to read the offsets of field 2
+	if _, ok := highlights[2]; ok {
+		t.Fatalf("highlight without offsets failed, got %q", highlights[2])
+	}
+
+	highlights = search(&kernel.Highlight{
+		Fields:       []uint32{1, 2},
+		PreTag:       "[",
+		PostTag:      "]",
+		FragmentSize: 10,
+		NumFragments: 1,
+		Analyzers:    map[uint32]string{2: whitspace.Name},
+	})
+	if len(highlights[1]) != 1 || highlights[1][0] != "wn [fox] jum" {
+		t.Fatalf("highlight fragment failed, got %q", highlights[1])
+	}
+	if len(highlights[2]) != 1 || highlights[2][0] != "a lazy [fox]" {
+		t.Fatalf("highlight analyzed failed, got %q", highlights[2])
+	}
+}
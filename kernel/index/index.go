@@ -2,9 +2,13 @@ package index
 
 import (
 	"context"
+	"sync"
+	"time"
 
 	"github.com/tiglabs/baudengine/kernel"
+	"github.com/tiglabs/baudengine/kernel/analysis"
 	"github.com/tiglabs/baudengine/kernel/mapping"
+	"github.com/tiglabs/baudengine/kernel/registry"
 	"github.com/tiglabs/baudengine/kernel/store/kvstore"
 )
 
@@ -14,12 +18,16 @@ var _ kernel.Engine = &IndexDriver{}
 
 type IndexDriver struct {
 	store        kvstore.KVStore
+	mappingLock  sync.RWMutex
 	indexMapping mapping.IndexMapping
+	// now returns the current time, the expired documents are hidden from the reads
+	now func() time.Time
 }
 
 func NewIndexDriver(store kvstore.KVStore) *IndexDriver {
 	return &IndexDriver{
 		store: store,
+		now:   time.Now,
 	}
 }
 
@@ -28,7 +36,7 @@ func (id *IndexDriver) NewSnapshot() (kernel.Snapshot, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &Snapshot{snap: snap}, nil
+	return &Snapshot{snap: snap, now: unixMillis(id.now()), indexMapping: id.currentMapping()}, nil
 }
 
 // TODO clear store kv paris before apply snapshot
@@ -63,6 +71,31 @@ func (id *IndexDriver) ApplySnapshot(ctx context.Context, iter kernel.Iterator)
 
 
 func (id *IndexDriver) NewWriteBatch() kernel.Batch {
-	return NewBatch(id.store)
+	return id.newBatch()
+}
+
+// newBatch returns the batch analyzing the fields by the analyzers of the space
+func (id *IndexDriver) newBatch() *Batch {
+	b := NewBatch(id.store)
+	b.analyzerNamed = id.analyzerNamed
+	b.currentMapping = id.currentMapping
+	return b
+}
+
+func (id *IndexDriver) currentMapping() mapping.IndexMapping {
+	id.mappingLock.RLock()
+	defer id.mappingLock.RUnlock()
+	return id.indexMapping
+}
+
+// analyzerNamed returns the custom analyzer of the space mapping, or the registered analyzer of the name
+func (id *IndexDriver) analyzerNamed(name string) analysis.Analyzer {
+	id.mappingLock.RLock()
+	indexMapping := id.indexMapping
+	id.mappingLock.RUnlock()
+	if indexMapping == nil {
+		return registry.GetAnalyzer(name)
+	}
+	return indexMapping.AnalyzerNamed(name)
 }
 
@@ -1,16 +1,19 @@
 package index
 
 import (
-	"testing"
+	"context"
 	"os"
+	"sort"
+	"testing"
 
+	"github.com/tiglabs/baudengine/kernel"
+	"github.com/tiglabs/baudengine/kernel/analysis/analyzer/whitspace"
 	"github.com/tiglabs/baudengine/kernel/store/kvstore"
 	"github.com/tiglabs/baudengine/kernel/store/kvstore/boltdb"
-	"github.com/tiglabs/baudengine/kernel/document"
-	"golang.org/x/net/context"
+	"github.com/tiglabs/baudengine/proto/pspb"
+	"github.com/tiglabs/baudengine/util/encoding"
 )
 
-
 func open(t *testing.T) kvstore.KVStore {
 	rv, err := boltdb.New(&boltdb.StoreConfig{Path: "test"})
 	if err != nil {
@@ -30,23 +33,89 @@ func cleanup(t *testing.T, s kvstore.KVStore) {
 	}
 }
 
+// getText returns the stored text of the field of the document
+func getText(t *testing.T, driver *IndexDriver, docID string, fieldId uint32) (string, bool) {
+	fvs, find := driver.GetDocument(context.Background(), []byte(docID), []uint32{fieldId})
+	if !find {
+		return "", false
+	}
+	fv, ok := fvs[fieldId]
+	if !ok {
+		t.Fatalf("get document failed, field %d not found", fieldId)
+	}
+	_, text, err := encoding.DecodeBytesValue(fv.Data)
+	if err != nil {
+		t.Fatalf("get document failed, err %v", err)
+	}
+	return string(text), true
+}
+
+func newTextDocument(docID string, texts map[uint32]string) *pspb.Document {
+	doc := &pspb.Document{Id: []byte(docID)}
+	for fieldId, text := range texts {
+		field := pspb.Field{}
+		field.Id = fieldId
+		field.Type = pspb.ValueType_STRING
+		field.Data = encoding.EncodeBytesValue(nil, 0, []byte(text))
+		field.Desc = pspb.FieldDesc{Stored: true, Tokenized: true, IndexOption: pspb.IndexOption_DOCS_FREQ_POSITION_OFFSET, Analyzer: whitspace.Name}
+		doc.Fields = append(doc.Fields, field)
+	}
+	return doc
+}
+
+func searchDocIDs(t *testing.T, driver *IndexDriver, query kernel.Query) []string {
+	result, err := driver.Search(context.Background(), &kernel.Request{Query: query, Size: 100})
+	if err != nil {
+		t.Fatalf("search failed, err %v", err)
+	}
+	if result.Total != len(result.Hits) {
+		t.Fatalf("search failed, total %d hits %d", result.Total, len(result.Hits))
+	}
+	var docIDs []string
+	for _, hit := range result.Hits {
+		docIDs = append(docIDs, string(hit.DocID))
+	}
+	// the hits are ordered by score
+	sort.Strings(docIDs)
+	return docIDs
+}
+
+func equalDocIDs(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func newValueDocument(docID string, fieldId uint32, valueType pspb.ValueType, data []byte) *pspb.Document {
+	field := pspb.Field{}
+	field.Id = fieldId
+	field.Type = valueType
+	field.Data = data
+	field.Desc = pspb.FieldDesc{Stored: true, IndexOption: pspb.IndexOption_DOCS}
+	return &pspb.Document{Id: []byte(docID), Fields: []pspb.Field{field}}
+}
+
 func TestAddDocument(t *testing.T) {
 	store := open(t)
 	defer cleanup(t, store)
 	driver := NewIndexDriver(store)
-	doc := document.NewDocument([]byte("1"))
-	tf := document.NewTextField("text", []byte("hello, baud"), document.StoreField)
-	doc.AddField(tf)
+	doc := newTextDocument("1", map[uint32]string{1: "hello, baud"})
 
-	err := driver.AddDocument(context.Background(), doc, 1)
+	err := driver.AddDocument(context.Background(), doc)
 	if err != nil {
 		t.Fatalf("add document failed, err %v", err)
 	}
-	fvs, find := driver.GetDocument(context.Background(), []byte("1"), []string{"text"})
+	text, find := getText(t, driver, "1", 1)
 	if !find {
 		t.Fatal("get docment failed")
 	}
-	if fvs["text"].(string) != "hello, baud" {
+	if text != "hello, baud" {
 		t.Fatal("get document failed")
 	}
 }
@@ -55,28 +124,24 @@ func TestGetDocument(t *testing.T) {
 	store := open(t)
 	defer cleanup(t, store)
 	driver := NewIndexDriver(store)
-	doc := document.NewDocument([]byte("1"))
-	tf := document.NewTextField("text", []byte("hello, baud"), document.StoreField)
-	bf := document.NewBooleanField("bool", true, document.StoreField)
-	doc.AddField(tf)
-	doc.AddField(bf)
+	doc := newTextDocument("1", map[uint32]string{1: "hello, baud", 2: "true"})
 
-	err := driver.AddDocument(context.Background(), doc, 1)
+	err := driver.AddDocument(context.Background(), doc)
 	if err != nil {
 		t.Fatalf("add document failed, err %v", err)
 	}
-	fvs, find := driver.GetDocument(context.Background(), []byte("1"), []string{"text"})
+	text, find := getText(t, driver, "1", 1)
 	if !find {
 		t.Fatal("get docment failed")
 	}
-	if fvs["text"].(string) != "hello, baud" {
+	if text != "hello, baud" {
 		t.Fatal("get document failed")
 	}
-	fvs, find = driver.GetDocument(context.Background(), []byte("1"), []string{"bool"})
+	text, find = getText(t, driver, "1", 2)
 	if !find {
 		t.Fatal("get docment failed")
 	}
-	if fvs["bool"].(bool) != true {
+	if text != "true" {
 		t.Fatal("get document failed")
 	}
 }
@@ -85,29 +150,27 @@ func TestDelDocument(t *testing.T) {
 	store := open(t)
 	defer cleanup(t, store)
 	driver := NewIndexDriver(store)
-	doc := document.NewDocument([]byte("1"))
-	tf := document.NewTextField("text", []byte("hello, baud"), document.StoreField)
-	doc.AddField(tf)
+	doc := newTextDocument("1", map[uint32]string{1: "hello, baud"})
 
-	err := driver.AddDocument(context.Background(), doc, 1)
+	err := driver.AddDocument(context.Background(), doc)
 	if err != nil {
 		t.Fatalf("add document failed, err %v", err)
 	}
-	fvs, find := driver.GetDocument(context.Background(), []byte("1"), []string{"text"})
+	text, find := getText(t, driver, "1", 1)
 	if !find {
 		t.Fatal("get docment failed")
 	}
-	if fvs["text"].(string) != "hello, baud" {
+	if text != "hello, baud" {
 		t.Fatal("get document failed")
 	}
-	n, err := driver.DeleteDocument(context.Background(), []byte("1"), 1)
+	n, err := driver.DeleteDocument(context.Background(), []byte("1"))
 	if err != nil {
 		t.Fatalf("del document failed, err %v", err)
 	}
 	if n != 1 {
 		t.Fatal("del document failed")
 	}
-	_, find = driver.GetDocument(context.Background(), []byte("1"), []string{"text"})
+	_, find = getText(t, driver, "1", 1)
 	if find {
 		t.Fatal("get docment failed")
 	}
@@ -117,30 +180,21 @@ func TestUpdateDocument(t *testing.T) {
 	store := open(t)
 	defer cleanup(t, store)
 	driver := NewIndexDriver(store)
-	doc := document.NewDocument([]byte("1"))
-	tf := document.NewTextField("text", []byte("hello, baud"), document.StoreField)
-	vf := document.NewTextField("_version", []byte("1"), document.StoreField)
-	doc.AddField(tf)
-	doc.AddField(vf)
+	doc := newTextDocument("1", map[uint32]string{1: "hello, baud"})
 
-	err := driver.AddDocument(context.Background(), doc, 1)
+	err := driver.AddDocument(context.Background(), doc)
 	if err != nil {
 		t.Fatalf("add document failed, err %v", err)
 	}
-	fvs, find := driver.GetDocument(context.Background(), []byte("1"), []string{"text"})
+	text, find := getText(t, driver, "1", 1)
 	if !find {
 		t.Fatal("get docment failed")
 	}
-	if fvs["text"].(string) != "hello, baud" {
+	if text != "hello, baud" {
 		t.Fatal("get document failed")
 	}
-	tf = document.NewTextField("text", []byte("hello, now"), document.StoreField)
-	doc.DeleteField("text")
-	doc.DeleteField("_version")
-	vf = document.NewTextField("_version", []byte("1"), document.StoreField)
-	doc.AddField(tf)
-	doc.AddField(vf)
-	found, err := driver.UpdateDocument(context.Background(), doc, false, 1)
+	doc = newTextDocument("1", map[uint32]string{1: "hello, now"})
+	found, err := driver.UpdateDocument(context.Background(), doc, false)
 	if err != nil {
 		t.Fatalf("update document failed, err %v", err)
 	}
@@ -148,11 +202,72 @@ func TestUpdateDocument(t *testing.T) {
 		t.Fatal("update document failed")
 	}
 
-	fvs, find = driver.GetDocument(context.Background(), []byte("1"), []string{"text"})
+	text, find = getText(t, driver, "1", 1)
 	if !find {
 		t.Fatal("get docment failed")
 	}
-	if fvs["text"].(string) != "hello, now" {
+	if text != "hello, now" {
 		t.Fatal("get document failed")
 	}
 }
+
+func TestNotStoredDocument(t *testing.T) {
+	store := open(t)
+	defer cleanup(t, store)
+	driver := NewIndexDriver(store)
+	notStored := func(doc *pspb.Document) *pspb.Document {
+		for i := range doc.Fields {
+			doc.Fields[i].Desc.Stored = false
+		}
+		return doc
+	}
+	if err := driver.AddDocument(context.Background(), notStored(newTextDocument("1", map[uint32]string{1: "hello baud"}))); err != nil {
+		t.Fatalf("add document failed, err %v", err)
+	}
+	if docIDs := searchDocIDs(t, driver, &kernel.MatchAllQuery{}); !equalDocIDs(docIDs, []string{"1"}) {
+		t.Fatalf("match all failed, got %v", docIDs)
+	}
+	if docIDs := searchDocIDs(t, driver, &kernel.BooleanQuery{MustNot: []kernel.Query{&kernel.TermQuery{FieldId: 1, Term: []byte("world")}}}); !equalDocIDs(docIDs, []string{"1"}) {
+		t.Fatalf("must not failed, got %v", docIDs)
+	}
+
+	// the old terms and statistics are deleted by the update
+	found, err := driver.UpdateDocument(context.Background(), notStored(newTextDocument("1", map[uint32]string{1: "hello world"})), false)
+	if err != nil || !found {
+		t.Fatalf("update document failed, found %v err %v", found, err)
+	}
+	if docIDs := searchDocIDs(t, driver, &kernel.TermQuery{FieldId: 1, Term: []byte("baud")}); len(docIDs) != 0 {
+		t.Fatalf("old term should not match, got %v", docIDs)
+	}
+	if docIDs := searchDocIDs(t, driver, &kernel.TermQuery{FieldId: 1, Term: []byte("world")}); !equalDocIDs(docIDs, []string{"1"}) {
+		t.Fatalf("new term should match, got %v", docIDs)
+	}
+	query := &kernel.TermsQuery{FieldId: 1, Terms: [][]byte{[]byte("hello"), []byte("baud")}}
+	stats, err := driver.Statistics(context.Background(), query)
+	if err != nil {
+		t.Fatalf("statistics failed, err %v", err)
+	}
+	if fs := stats.Fields[1]; fs.DocCount != 1 || fs.SumLength != 2 || stats.DocFreqs[1]["baud"] != 0 {
+		t.Fatalf("statistics of the update failed, got %v %v", fs, stats.DocFreqs[1])
+	}
+
+	n, err := driver.DeleteDocument(context.Background(), []byte("1"))
+	if err != nil || n != 1 {
+		t.Fatalf("delete document failed, n %d err %v", n, err)
+	}
+	if docIDs := searchDocIDs(t, driver, &kernel.TermQuery{FieldId: 1, Term: []byte("hello")}); len(docIDs) != 0 {
+		t.Fatalf("deleted document should not match, got %v", docIDs)
+	}
+	if docIDs := searchDocIDs(t, driver, &kernel.MatchAllQuery{}); len(docIDs) != 0 {
+		t.Fatalf("deleted document should not match all, got %v", docIDs)
+	}
+	if stats, err = driver.Statistics(context.Background(), query); err != nil {
+		t.Fatalf("statistics failed, err %v", err)
+	}
+	if fs := stats.Fields[1]; fs != nil && (fs.DocCount != 0 || fs.SumLength != 0) {
+		t.Fatalf("statistics of the delete failed, got %v", fs)
+	}
+	if n, err = driver.DeleteDocument(context.Background(), []byte("1")); err != nil || n != 0 {
+		t.Fatalf("delete deleted document failed, n %d err %v", n, err)
+	}
+}
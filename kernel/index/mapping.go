@@ -0,0 +1,188 @@
+package index
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/tiglabs/baudengine/kernel/document"
+	"github.com/tiglabs/baudengine/kernel/mapping"
+	"github.com/tiglabs/baudengine/proto/metapb"
+	"github.com/tiglabs/baudengine/proto/pspb"
+	"github.com/tiglabs/baudengine/util/encoding"
+)
+
+// SetMapping parses the mapping schema of the space, the source documents are mapped by it
+func (id *IndexDriver) SetMapping(schema []byte) error {
+	indexMapping, err := mapping.NewIndexMapping(schema)
+	if err != nil {
+		return err
+	}
+	id.mappingLock.Lock()
+	id.indexMapping = indexMapping
+	id.mappingLock.Unlock()
+	return nil
+}
+
+// MapDocument maps the JSON source into the fields of the document,
+// the field IDs and descriptions come from the mapping of the space
+func (id *IndexDriver) MapDocument(docID metapb.Key, source []byte) (*pspb.Document, error) {
+	id.mappingLock.RLock()
+	indexMapping := id.indexMapping
+	id.mappingLock.RUnlock()
+	if indexMapping == nil {
+		return nil, errors.New("space has no mapping for the source document")
+	}
+	doc := document.NewDocument(docID)
+	if err := indexMapping.MapDocument(doc, source); err != nil {
+		return nil, err
+	}
+	return toPSDocument(indexMapping, doc)
+}
+
+// toPSDocument converts the mapped document, the values of a field are encoded into one multi-valued field
+func toPSDocument(indexMapping mapping.IndexMapping, doc *document.Document) (*pspb.Document, error) {
+	fields, err := toPSFields(indexMapping, doc.Fields)
+	if err != nil {
+		return nil, err
+	}
+	psDoc := &pspb.Document{Id: doc.ID, Fields: fields}
+	if err := appendNestedDocuments(psDoc, indexMapping, doc.ID, doc.Nested); err != nil {
+		return nil, err
+	}
+	return psDoc, nil
+}
+
+func toPSFields(indexMapping mapping.IndexMapping, docFields map[string][]document.Field) ([]pspb.Field, error) {
+	names := make([]string, 0, len(docFields))
+	for name := range docFields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	fields := make([]pspb.Field, 0, len(names))
+	for _, name := range names {
+		fieldMapping := indexMapping.FieldMappingNamed(name)
+		if fieldMapping == nil {
+			return nil, fmt.Errorf("field %s has no mapping", name)
+		}
+		field := pspb.Field{}
+		field.Id = uint32(fieldMapping.ID())
+		for _, f := range docFields[name] {
+			var err error
+			if field.Data, err = appendFieldValue(field.Data, &field, fieldMapping, f); err != nil {
+				return nil, fmt.Errorf("field %s error: %v", name, err)
+			}
+			field.Desc = fieldDesc(fieldMapping, f.Property())
+		}
+		if len(field.Data) == 0 {
+			continue
+		}
+		fields = append(fields, field)
+	}
+	return fields, nil
+}
+
+// appendNestedDocuments converts the nested objects of the parent and their nested objects into the nested documents
+func appendNestedDocuments(psDoc *pspb.Document, indexMapping mapping.IndexMapping, parent metapb.Key, nested []*document.NestedDocument) error {
+	for _, n := range nested {
+		fieldMapping := indexMapping.FieldMappingNamed(n.Path)
+		if fieldMapping == nil {
+			return fmt.Errorf("nested field %s has no mapping", n.Path)
+		}
+		fields, err := toPSFields(indexMapping, n.Fields)
+		if err != nil {
+			return err
+		}
+		nestedDoc := pspb.NestedDocument{Parent: parent, Field: uint32(fieldMapping.ID()), Offset: uint32(n.Offset), Fields: fields}
+		psDoc.Nested = append(psDoc.Nested, nestedDoc)
+		docID := nestedDocID(parent, nestedDoc.Field, nestedDoc.Offset)
+		if err := appendNestedDocuments(psDoc, indexMapping, docID, n.Nested); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// appendFieldValue appends the value encoding of the document field and sets the type of the field
+func appendFieldValue(data []byte, field *pspb.Field, fieldMapping mapping.FieldMapping, f document.Field) ([]byte, error) {
+	switch f := f.(type) {
+	case *document.TextField:
+		field.Type = pspb.ValueType_STRING
+		return encoding.EncodeBytesValue(data, 0, f.Value()), nil
+
+	case *document.CompositeField:
+		field.Type = pspb.ValueType_STRING
+		if len(f.Value()) == 0 {
+			return data, nil
+		}
+		return encoding.EncodeBytesValue(data, 0, f.Value()), nil
+
+	case *document.NumericField:
+		number, err := f.Number()
+		if err != nil {
+			return nil, err
+		}
+		switch fieldMapping.Type() {
+		case "long", "integer", "short", "byte":
+			field.Type = pspb.ValueType_INT
+			return encoding.EncodeIntValue(data, 0, int64(number)), nil
+		default:
+			field.Type = pspb.ValueType_FLOAT
+			return encoding.EncodeFloatValue(data, 0, number), nil
+		}
+
+	case *document.DateTimeField:
+		t, err := f.DateTime()
+		if err != nil {
+			return nil, err
+		}
+		field.Type = pspb.ValueType_TIME
+		return encoding.EncodeIntValue(data, 0, t.UnixNano()), nil
+
+	case *document.BooleanField:
+		b, err := f.Boolean()
+		if err != nil {
+			return nil, err
+		}
+		field.Type = pspb.ValueType_BOOL
+		return encoding.EncodeBoolValue(data, 0, b), nil
+
+	case *document.GeoPointField:
+		field.Type = pspb.ValueType_GEO
+		return append(data, f.Value()...), nil
+
+	default:
+		return nil, fmt.Errorf("unsupported document field %T", f)
+	}
+}
+
+func fieldDesc(fieldMapping mapping.FieldMapping, property document.Property) pspb.FieldDesc {
+	desc := pspb.FieldDesc{
+		Stored:    property.IsStored(),
+		DocValues: property.IncludeDocValues(),
+	}
+	if !property.IsIndexed() {
+		return desc
+	}
+	desc.IndexOption = indexOption(fieldMapping)
+	if text, ok := fieldMapping.(*mapping.TextFieldMapping); ok {
+		desc.Tokenized = true
+		desc.Analyzer = text.Analyzer_
+	}
+	return desc
+}
+
+// indexOption returns the index option of the indexed field, only the text fields have positions
+func indexOption(fieldMapping mapping.FieldMapping) pspb.IndexOption {
+	if text, ok := fieldMapping.(*mapping.TextFieldMapping); ok {
+		switch text.IndexOptions {
+		case "freqs":
+			return pspb.IndexOption_DOCS_FREQ
+		case "positions":
+			return pspb.IndexOption_DOCS_FREQ_POSITION
+		case "offsets":
+			return pspb.IndexOption_DOCS_FREQ_POSITION_OFFSET
+		}
+	}
+	return pspb.IndexOption_DOCS
+}
@@ -0,0 +1,233 @@
+package index
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/tiglabs/baudengine/kernel"
+	"github.com/tiglabs/baudengine/kernel/mapping"
+	"github.com/tiglabs/baudengine/util/encoding"
+)
+
+func TestMapDocument(t *testing.T) {
+	store := open(t)
+	defer cleanup(t, store)
+	driver := NewIndexDriver(store)
+	schema := `{"mappings": {"doc": {"_all": {"analyzer": "whitspace"}, "properties": {
+		"title":    {"type": "text", "store": true, "analyzer": "whitspace"},
+		"tag":      {"type": "keyword"},
+		"price":    {"type": "long"},
+		"weight":   {"type": "double"},
+		"created":  {"type": "date"},
+		"sold":     {"type": "boolean"},
+		"location": {"type": "geo_point"},
+		"user":     {"properties": {"name": {"type": "keyword"}}}
+	}}}}`
+	if _, err := driver.MapDocument([]byte("1"), []byte(`{"title": "quick fox"}`)); err == nil {
+		t.Fatal("map document without mapping should fail")
+	}
+	if err := driver.SetMapping([]byte(schema)); err != nil {
+		t.Fatalf("set mapping failed, err %v", err)
+	}
+	fieldId := func(name string) uint32 {
+		return uint32(driver.indexMapping.FieldMappingNamed(name).ID())
+	}
+
+	sources := map[string]string{
+		"1": `{"title": "the quick fox", "tag": ["animal", "fast"], "price": 30, "weight": 2.5, "created": "2018-06-01",
+			"sold": true, "location": {"lat": 48.8566, "lon": 2.3522}, "user": {"name": "alice"}}`,
+		"2": `{"title": "lazy dog", "tag": "animal", "price": "15", "created": 1527811200000, "location": "51.5074,-0.1278"}`,
+	}
+	for docID, source := range sources {
+		doc, err := driver.MapDocument([]byte(docID), []byte(source))
+		if err != nil {
+			t.Fatalf("map document %s failed, err %v", docID, err)
+		}
+		if err := driver.AddDocument(context.Background(), doc); err != nil {
+			t.Fatalf("add document failed, err %v", err)
+		}
+	}
+
+	tests := []struct {
+		query  kernel.Query
+		docIDs []string
+	}{
+		{&kernel.TermQuery{FieldId: fieldId("title"), Term: []byte("quick")}, []string{"1"}},
+		{&kernel.TermQuery{FieldId: fieldId("_all"), Term: []byte("dog")}, []string{"2"}},
+		{&kernel.TermQuery{FieldId: fieldId("tag"), Term: []byte("animal")}, []string{"1", "2"}},
+		{&kernel.TermQuery{FieldId: fieldId("user.name"), Term: []byte("alice")}, []string{"1"}},
+		{&kernel.RangeQuery{FieldId: fieldId("price"), Lt: encoding.EncodeIntValue(nil, 0, 20)}, []string{"2"}},
+		{&kernel.RangeQuery{FieldId: fieldId("weight"), Gt: encoding.EncodeFloatValue(nil, 0, 2)}, []string{"1"}},
+		{&kernel.RangeQuery{FieldId: fieldId("created"), Gte: encoding.EncodeIntValue(nil, 0, time.Date(2018, 6, 1, 0, 0, 0, 0, time.UTC).UnixNano())}, []string{"1", "2"}},
+		{&kernel.GeoDistanceQuery{FieldId: fieldId("location"), Origin: kernel.GeoPoint{Lat: 51.5, Lon: -0.1}, Distance: 10000}, []string{"2"}},
+	}
+	for i, test := range tests {
+		docIDs := searchDocIDs(t, driver, test.query)
+		if !equalDocIDs(docIDs, test.docIDs) {
+			t.Fatalf("test %d failed, expect %v, got %v", i, test.docIDs, docIDs)
+		}
+	}
+
+	invalid := []string{
+		`{"unknown": 1}`,
+		`{"price": "cheap"}`,
+		`{"created": "yesterday"}`,
+		`["not", "object"]`,
+	}
+	for i, source := range invalid {
+		if _, err := driver.MapDocument([]byte("3"), []byte(source)); err == nil {
+			t.Fatalf("invalid source %d should fail", i)
+		}
+	}
+}
+
+func TestUpdateMapping(t *testing.T) {
+	store := open(t)
+	defer cleanup(t, store)
+	driver := NewIndexDriver(store)
+	schema := []byte(`{"mappings": {"doc": {"_all": {"analyzer": "whitspace"}, "properties": {
+		"title": {"type": "text", "analyzer": "whitspace"},
+		"price": {"type": "long"}
+	}}}}`)
+	if err := driver.SetMapping(schema); err != nil {
+		t.Fatalf("set mapping failed, err %v", err)
+	}
+	doc, err := driver.MapDocument([]byte("1"), []byte(`{"title": "quick fox", "price": 30}`))
+	if err != nil {
+		t.Fatalf("map document failed, err %v", err)
+	}
+	if err := driver.AddDocument(context.Background(), doc); err != nil {
+		t.Fatalf("add document failed, err %v", err)
+	}
+
+	// the documents indexed by the current mapping are still searched by the merged one
+	merged, err := mapping.MergeSchema(schema, []byte(`{"mappings": {"doc": {"properties": {
+		"author": {"type": "keyword"},
+		"price":  {"type": "long"},
+		"brand":  {"properties": {"name": {"type": "keyword"}}}
+	}}}}`))
+	if err != nil {
+		t.Fatalf("merge mapping failed, err %v", err)
+	}
+	if err := driver.SetMapping(merged); err != nil {
+		t.Fatalf("set merged mapping failed, err %v", err)
+	}
+	doc, err = driver.MapDocument([]byte("2"), []byte(`{"title": "lazy dog", "author": "bob", "brand": {"name": "acme"}}`))
+	if err != nil {
+		t.Fatalf("map document failed, err %v", err)
+	}
+	if err := driver.AddDocument(context.Background(), doc); err != nil {
+		t.Fatalf("add document failed, err %v", err)
+	}
+	fieldId := func(name string) uint32 {
+		return uint32(driver.indexMapping.FieldMappingNamed(name).ID())
+	}
+	tests := []struct {
+		query  kernel.Query
+		docIDs []string
+	}{
+		{&kernel.TermQuery{FieldId: fieldId("title"), Term: []byte("quick")}, []string{"1"}},
+		{&kernel.TermQuery{FieldId: fieldId("_all"), Term: []byte("dog")}, []string{"2"}},
+		{&kernel.TermQuery{FieldId: fieldId("author"), Term: []byte("bob")}, []string{"2"}},
+		{&kernel.TermQuery{FieldId: fieldId("brand.name"), Term: []byte("acme")}, []string{"2"}},
+	}
+	for i, test := range tests {
+		docIDs := searchDocIDs(t, driver, test.query)
+		if !equalDocIDs(docIDs, test.docIDs) {
+			t.Fatalf("test %d failed, expect %v, got %v", i, test.docIDs, docIDs)
+		}
+	}
+}
+
+func TestDynamicMapping(t *testing.T) {
+	store := open(t)
+	defer cleanup(t, store)
+	driver := NewIndexDriver(store)
+	schema := []byte(`{"mappings": {"doc": {"_all": {"analyzer": "whitspace"}, "properties": {
+		"title":  {"type": "text", "analyzer": "whitspace"},
+		"meta":   {"dynamic": false, "properties": {"source": {"type": "keyword"}}},
+		"labels": {"dynamic": "strict", "properties": {"color": {"type": "keyword"}}}
+	}}}}`)
+	if err := driver.SetMapping(schema); err != nil {
+		t.Fatalf("set mapping failed, err %v", err)
+	}
+	source := []byte(`{"title": "quick fox", "price": 30, "weight": 2.5, "sold": true, "created": "2018-06-01T10:00:00Z",
+		"brand": "acme corp", "empty": null, "user": {"name": "alice", "tags": [{"age": 3}, {"city": "paris"}]},
+		"meta": {"source": "web", "ignored": 1}}`)
+	_, err := driver.MapDocument([]byte("1"), source)
+	dynamicErr, ok := err.(*mapping.DynamicMappingError)
+	if !ok {
+		t.Fatalf("map document with new fields should return the mapping update, err %v", err)
+	}
+	merged, err := mapping.MergeSchema(schema, dynamicErr.Update)
+	if err != nil {
+		t.Fatalf("merge mapping failed, err %v", err)
+	}
+	if err := driver.SetMapping(merged); err != nil {
+		t.Fatalf("set merged mapping failed, err %v", err)
+	}
+	types := map[string]string{
+		"price":          "long",
+		"weight":         "double",
+		"sold":           "boolean",
+		"created":        "date",
+		"brand":          "text",
+		"brand.keyword":  "keyword",
+		"user.name":      "text",
+		"user.tags.age":  "long",
+		"user.tags.city": "text",
+	}
+	for name, typ := range types {
+		field := driver.indexMapping.FieldMappingNamed(name)
+		if field == nil || field.Type() != typ {
+			t.Fatalf("field %s should be mapped as %s, got %v", name, typ, field)
+		}
+	}
+	for _, name := range []string{"empty", "meta.ignored"} {
+		if driver.indexMapping.FieldMappingNamed(name) != nil {
+			t.Fatalf("field %s should not be mapped", name)
+		}
+	}
+
+	// the dynamic text fields are analyzed by the standard analyzer, so the document has no new string
+	doc, err := driver.MapDocument([]byte("1"), []byte(`{"title": "quick fox", "price": 30, "sold": true,
+		"user": {"tags": [{"age": 3}]}, "meta": {"source": "web", "ignored": 1}}`))
+	if err != nil {
+		t.Fatalf("map document failed, err %v", err)
+	}
+	if err := driver.AddDocument(context.Background(), doc); err != nil {
+		t.Fatalf("add document failed, err %v", err)
+	}
+	fieldId := func(name string) uint32 {
+		return uint32(driver.indexMapping.FieldMappingNamed(name).ID())
+	}
+	tests := []struct {
+		query  kernel.Query
+		docIDs []string
+	}{
+		{&kernel.TermQuery{FieldId: fieldId("title"), Term: []byte("fox")}, []string{"1"}},
+		{&kernel.RangeQuery{FieldId: fieldId("price"), Gt: encoding.EncodeIntValue(nil, 0, 20)}, []string{"1"}},
+		{&kernel.RangeQuery{FieldId: fieldId("user.tags.age"), Lt: encoding.EncodeIntValue(nil, 0, 5)}, []string{"1"}},
+	}
+	for i, test := range tests {
+		docIDs := searchDocIDs(t, driver, test.query)
+		if !equalDocIDs(docIDs, test.docIDs) {
+			t.Fatalf("test %d failed, expect %v, got %v", i, test.docIDs, docIDs)
+		}
+	}
+
+	if _, err := driver.MapDocument([]byte("2"), []byte(`{"labels": {"size": "xl"}}`)); err == nil {
+		t.Fatal("new field of strict object should fail")
+	} else if _, ok := err.(*mapping.DynamicMappingError); ok {
+		t.Fatal("new field of strict object should not update the mapping")
+	}
+	// the type of a dynamic field can not be changed by the next documents
+	_, err = driver.MapDocument([]byte("3"), []byte(`{"color": "red"}`))
+	if dynamicErr, ok = err.(*mapping.DynamicMappingError); !ok {
+		t.Fatalf("map document with new fields should return the mapping update, err %v", err)
+	}
+	if _, err := mapping.MergeSchema(merged, []byte(`{"mappings": {"doc": {"properties": {"price": {"type": "text"}}}}}`)); err == nil {
+		t.Fatal("dynamic field type should not be changed")
+	}
+}
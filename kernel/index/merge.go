@@ -0,0 +1,534 @@
+package index
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/tiglabs/baudengine/kernel"
+	"github.com/tiglabs/baudengine/kernel/document"
+	"github.com/tiglabs/baudengine/kernel/mapping"
+	"github.com/tiglabs/baudengine/kernel/script"
+	"github.com/tiglabs/baudengine/proto/metapb"
+	"github.com/tiglabs/baudengine/proto/pspb"
+	"github.com/tiglabs/baudengine/util/encoding"
+)
+
+const allFieldName = "_all"
+
+func (w *IndexDriver) MergeDocument(ctx context.Context, req *kernel.MergeRequest) (pspb.WriteResult, error) {
+	return w.newBatch().mergeDocument(ctx, req, true)
+}
+
+func (b *Batch) MergeDocument(ctx context.Context, req *kernel.MergeRequest) (pspb.WriteResult, error) {
+	return b.mergeDocument(ctx, req, false)
+}
+
+// mergeDocument merges the partial document, or the fields set by the script, into the document rebuilt
+// from the stored text fields, which are needed for _all. Only the fields in the partial document,
+// and _all if a text field is changed, are indexed again, the other fields are not read or written.
+func (b *Batch) mergeDocument(ctx context.Context, req *kernel.MergeRequest, forceCommit bool) (pspb.WriteResult, error) {
+	var indexMapping mapping.IndexMapping
+	if b.currentMapping != nil {
+		indexMapping = b.currentMapping()
+	}
+	if indexMapping == nil {
+		return pspb.WriteResult_NOOP, errors.New("space has no mapping for the partial document")
+	}
+	if len(req.Partial) > 0 && req.Script != "" {
+		return pspb.WriteResult_NOOP, errors.New("update has both the partial document and the script")
+	}
+	if err := b.flushDirty(req.DocID); err != nil {
+		return pspb.WriteResult_NOOP, err
+	}
+	found := isDocExist(b.reader(), req.DocID)
+	if !found && !req.Upsert {
+		return pspb.WriteResult_NOT_FOUND, nil
+	}
+	var rows map[uint32][]byte
+	if found {
+		var err error
+		if rows, err = b.fieldRows(req.DocID); err != nil {
+			return pspb.WriteResult_NOOP, err
+		}
+	}
+	partial := req.Partial
+	if req.Script != "" {
+		var err error
+		if partial, err = runScript(indexMapping, req, rows); err != nil {
+			return pspb.WriteResult_NOOP, err
+		}
+	}
+	if len(partial) == 0 {
+		if found {
+			return b.mergeExpire(req.DocID, req.ExpireAt, forceCommit)
+		}
+		return pspb.WriteResult_NOOP, nil
+	}
+	source, removed, err := splitRemovedFields(partial)
+	if err != nil {
+		return pspb.WriteResult_NOOP, err
+	}
+
+	if !found {
+		doc := document.NewDocument(req.DocID)
+		if err := indexMapping.MapDocument(doc, source); err != nil {
+			return pspb.WriteResult_NOOP, err
+		}
+		psDoc, err := toPSDocument(indexMapping, doc)
+		if err != nil {
+			return pspb.WriteResult_NOOP, err
+		}
+		psDoc.ExpireAt = req.ExpireAt
+		return pspb.WriteResult_CREATED, b.addDocument(ctx, psDoc, forceCommit)
+	}
+
+	doc, err := storedDocument(indexMapping, req.DocID, rows)
+	if err != nil {
+		return pspb.WriteResult_NOOP, err
+	}
+	restored := make(map[string]document.Field, len(doc.Fields))
+	for name, fields := range doc.Fields {
+		restored[name] = fields[0]
+	}
+	for _, name := range removed {
+		doc.DeleteField(name)
+	}
+	if err := indexMapping.MergeDocument(doc, source); err != nil {
+		return pspb.WriteResult_NOOP, err
+	}
+	psDoc, err := toPSDocument(indexMapping, doc)
+	if err != nil {
+		return pspb.WriteResult_NOOP, err
+	}
+
+	// the fields replaced by the partial document are the changed ones, the others are the restored fields
+	changed := make(map[uint32]bool)
+	var textChanged bool
+	for name, fields := range doc.Fields {
+		if name == allFieldName || len(fields) == 0 || restored[name] == fields[0] {
+			continue
+		}
+		if fieldMapping := indexMapping.FieldMappingNamed(name); fieldMapping != nil {
+			changed[uint32(fieldMapping.ID())] = true
+		}
+		if _, ok := fields[0].(*document.TextField); ok {
+			textChanged = true
+		}
+	}
+	nestedFields := make(map[uint32]bool)
+	for _, name := range removed {
+		switch fieldMapping := indexMapping.FieldMappingNamed(name).(type) {
+		case nil:
+		case *mapping.NestedFieldMapping:
+			nestedFields[uint32(fieldMapping.ID())] = true
+		case *mapping.TextFieldMapping:
+			changed[uint32(fieldMapping.ID())] = true
+			textChanged = true
+		default:
+			changed[uint32(fieldMapping.ID())] = true
+		}
+	}
+	for _, nested := range psDoc.Nested {
+		if bytes.Equal(nested.Parent, req.DocID) {
+			nestedFields[nested.Field] = true
+		}
+	}
+	var allId uint32
+	if allMapping := indexMapping.FieldMappingNamed(allFieldName); allMapping != nil {
+		allId = uint32(allMapping.ID())
+	}
+	if textChanged && allId > 0 {
+		changed[allId] = true
+	}
+	var fields []pspb.Field
+	for _, field := range psDoc.Fields {
+		if changed[field.Id] {
+			fields = append(fields, field)
+		}
+	}
+	if len(nestedFields) == 0 {
+		same, err := b.sameFields(req.DocID, rows, changed, allId, fields)
+		if err != nil {
+			return pspb.WriteResult_NOOP, err
+		}
+		if same {
+			return b.mergeExpire(req.DocID, req.ExpireAt, forceCommit)
+		}
+	}
+
+	// the keys are written in the order of the fields, the same on all the replicas
+	for _, fieldId := range sortedFieldIds(changed) {
+		if err := b.deleteField(req.DocID, fieldId); err != nil {
+			return pspb.WriteResult_NOOP, err
+		}
+	}
+	for _, fieldId := range sortedFieldIds(nestedFields) {
+		if err := b.deleteNestedDocuments(req.DocID, fieldId); err != nil {
+			return pspb.WriteResult_NOOP, err
+		}
+	}
+	docValuesFields, err := b.indexFields(req.DocID, fields)
+	if err != nil {
+		return pspb.WriteResult_NOOP, err
+	}
+	if err := b.mergeDocValuesAbstract(req.DocID, changed, docValuesFields); err != nil {
+		return pspb.WriteResult_NOOP, err
+	}
+	if err := b.addNestedDocuments(psDoc.Nested); err != nil {
+		return pspb.WriteResult_NOOP, err
+	}
+	if req.ExpireAt > 0 {
+		if err := b.writeExpire(req.DocID, req.ExpireAt); err != nil {
+			return pspb.WriteResult_NOOP, err
+		}
+	}
+	if err := b.writeVersion(req.DocID); err != nil {
+		return pspb.WriteResult_NOOP, err
+	}
+	b.dirty[string(req.DocID)] = true
+	if forceCommit {
+		return pspb.WriteResult_UPDATED, b.Commit()
+	}
+	return pspb.WriteResult_UPDATED, nil
+}
+
+// fieldRows returns the rows of the stored fields and of the doc values of the document by the field IDs,
+// a row is the field type and the field data
+func (b *Batch) fieldRows(docID metapb.Key) (map[uint32][]byte, error) {
+	rows := make(map[uint32][]byte)
+	iter := b.reader().PrefixIterator(encodeStoreFieldKey(docID, 0))
+	if iter == nil {
+		return nil, errors.New("store driver error")
+	}
+	for ; iter.Valid(); iter.Next() {
+		_, fieldId, err := decodeStoreFieldKey(iter.Key())
+		if err != nil {
+			iter.Close()
+			return nil, err
+		}
+		rows[fieldId] = append([]byte(nil), iter.Value()...)
+	}
+	iter.Close()
+	value, err := b.reader().Get(encodeDocValuesAbstractKey(docID))
+	if err != nil || len(value) == 0 {
+		return rows, err
+	}
+	fieldIds, err := decodeDocValuesAbstract(value)
+	if err != nil {
+		return nil, err
+	}
+	for _, fieldId := range fieldIds {
+		if _, ok := rows[fieldId]; ok {
+			continue
+		}
+		row, err := b.reader().Get(encodeDocValuesKey(fieldId, docID))
+		if err != nil {
+			return nil, err
+		}
+		if len(row) > 0 {
+			rows[fieldId] = row
+		}
+	}
+	return rows, nil
+}
+
+// sameFields reports whether the changed fields have the same values as before, the values of the fields
+// neither stored nor with doc values are unknown, so they are always changed
+func (b *Batch) sameFields(docID metapb.Key, rows map[uint32][]byte, changed map[uint32]bool, allId uint32, fields []pspb.Field) (bool, error) {
+	newRows := make(map[uint32][]byte, len(fields))
+	for i := range fields {
+		field := &fields[i]
+		_, row, err := encodeStoreField(docID, field)
+		if err != nil {
+			return false, err
+		}
+		if row == nil && field.Desc.DocValues {
+			if _, row, err = encodeDocValues(docID, field); err != nil {
+				return false, err
+			}
+		}
+		if row == nil {
+			return false, nil
+		}
+		newRows[field.Id] = row
+	}
+	for fieldId := range changed {
+		// _all is changed only by the text fields
+		if fieldId == allId {
+			continue
+		}
+		if newRows[fieldId] != nil {
+			if !bytes.Equal(newRows[fieldId], rows[fieldId]) {
+				return false, nil
+			}
+			continue
+		}
+		// the removed field
+		if rows[fieldId] != nil {
+			return false, nil
+		}
+		value, err := b.reader().Get(encodeFieldTermAbstractKey(docID, fieldId))
+		if err != nil {
+			return false, err
+		}
+		if len(value) > 0 {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// deleteField deletes the stored value, the doc values and the terms of the field of the document
+func (b *Batch) deleteField(docID metapb.Key, fieldId uint32) error {
+	b.batch.Delete(encodeStoreFieldKey(docID, fieldId))
+	b.batch.Delete(encodeDocValuesKey(fieldId, docID))
+	value, err := b.reader().Get(encodeFieldTermAbstractKey(docID, fieldId))
+	if err != nil {
+		return err
+	}
+	if len(value) == 0 {
+		return nil
+	}
+	terms, err := decodeFieldTermAbstractValue(value)
+	if err != nil {
+		return err
+	}
+	return b.deleteFieldTerms(docID, fieldId, terms)
+}
+
+// mergeDocValuesAbstract replaces the changed fields in the doc values abstract of the document by the new ones
+func (b *Batch) mergeDocValuesAbstract(docID metapb.Key, changed map[uint32]bool, docValuesFields []uint32) error {
+	key := encodeDocValuesAbstractKey(docID)
+	value, err := b.reader().Get(key)
+	if err != nil {
+		return err
+	}
+	var fieldIds []uint32
+	if len(value) > 0 {
+		current, err := decodeDocValuesAbstract(value)
+		if err != nil {
+			return err
+		}
+		for _, fieldId := range current {
+			if !changed[fieldId] {
+				fieldIds = append(fieldIds, fieldId)
+			}
+		}
+	}
+	fieldIds = append(fieldIds, docValuesFields...)
+	if len(fieldIds) == 0 {
+		b.batch.Delete(key)
+		return nil
+	}
+	sort.Slice(fieldIds, func(i, j int) bool { return fieldIds[i] < fieldIds[j] })
+	b.batch.Set(key, encodeDocValuesAbstract(fieldIds))
+	return nil
+}
+
+func sortedFieldIds(set map[uint32]bool) []uint32 {
+	fieldIds := make([]uint32, 0, len(set))
+	for fieldId := range set {
+		fieldIds = append(fieldIds, fieldId)
+	}
+	sort.Slice(fieldIds, func(i, j int) bool { return fieldIds[i] < fieldIds[j] })
+	return fieldIds
+}
+
+// storedDocument rebuilds the document of the stored text fields for rebuilding _all
+func storedDocument(indexMapping mapping.IndexMapping, docID metapb.Key, rows map[uint32][]byte) (*document.Document, error) {
+	doc := document.NewDocument(docID)
+	for _, fieldId := range sortedRowIds(rows) {
+		name, fieldMapping := indexMapping.FieldMappingByID(uint64(fieldId))
+		text, ok := fieldMapping.(*mapping.TextFieldMapping)
+		if !ok || name == allFieldName {
+			continue
+		}
+		field, err := decodeStoreField(fieldId, rows[fieldId])
+		if err != nil {
+			return nil, err
+		}
+		if field.Type != pspb.ValueType_STRING {
+			continue
+		}
+		analyzer := indexMapping.AnalyzerNamed(text.Analyzer_)
+		for data := field.Data; len(data) > 0; {
+			var value []byte
+			if data, value, err = encoding.DecodeBytesValue(data); err != nil {
+				return nil, err
+			}
+			doc.AddField(document.NewTextFieldCustom(name, value, text.Property(), analyzer))
+		}
+	}
+	return doc, nil
+}
+
+func sortedRowIds(rows map[uint32][]byte) []uint32 {
+	fieldIds := make([]uint32, 0, len(rows))
+	for fieldId := range rows {
+		fieldIds = append(fieldIds, fieldId)
+	}
+	sort.Slice(fieldIds, func(i, j int) bool { return fieldIds[i] < fieldIds[j] })
+	return fieldIds
+}
+
+// runScript runs the script on the values of the stored fields and the doc values,
+// and returns the partial document of the fields set by the script, nil if no field is set
+func runScript(indexMapping mapping.IndexMapping, req *kernel.MergeRequest, rows map[uint32][]byte) ([]byte, error) {
+	s, err := script.Compile(req.Script)
+	if err != nil {
+		return nil, fmt.Errorf("compile script failed, err %v", err)
+	}
+	params, err := script.ParseParams(req.Params)
+	if err != nil {
+		return nil, fmt.Errorf("invalid script params, err %v", err)
+	}
+	values := make(map[string]interface{}, len(rows))
+	for fieldId, row := range rows {
+		name, _ := indexMapping.FieldMappingByID(uint64(fieldId))
+		if name == "" || name == allFieldName {
+			continue
+		}
+		field, err := decodeStoreField(fieldId, row)
+		if err != nil {
+			return nil, err
+		}
+		value, ok, err := scriptValue(field)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			values[name] = value
+		}
+	}
+	set, err := s.Run(values, params)
+	if err != nil {
+		return nil, fmt.Errorf("run script failed, err %v", err)
+	}
+	if len(set) == 0 {
+		return nil, nil
+	}
+	names := make([]string, 0, len(set))
+	for name := range set {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	// the full path names are the paths of the objects in the partial document
+	partial := make(map[string]interface{})
+	for _, name := range names {
+		path := strings.Split(name, ".")
+		object := partial
+		for _, key := range path[:len(path)-1] {
+			child, ok := object[key].(map[string]interface{})
+			if !ok {
+				if _, exists := object[key]; exists {
+					return nil, fmt.Errorf("script sets both %s and its object", name)
+				}
+				child = make(map[string]interface{})
+				object[key] = child
+			}
+			object = child
+		}
+		if _, exists := object[path[len(path)-1]]; exists {
+			return nil, fmt.Errorf("script sets both %s and its fields", name)
+		}
+		object[path[len(path)-1]] = set[name]
+	}
+	return json.Marshal(partial)
+}
+
+// scriptValue returns the value of the field for the script, a list if the field has more than one value,
+// the time is the unix milliseconds, the geo points are not supported
+func scriptValue(field *pspb.Field) (interface{}, bool, error) {
+	if field.Type == pspb.ValueType_GEO {
+		return nil, false, nil
+	}
+	var values []interface{}
+	for data := field.Data; len(data) > 0; {
+		_, dataOffset, _, typ, err := encoding.DecodeValueTag(data)
+		if err != nil {
+			return nil, false, err
+		}
+		var value interface{}
+		switch typ {
+		case encoding.Null:
+			data = data[dataOffset:]
+		case encoding.Int:
+			var v int64
+			if data, v, err = encoding.DecodeIntValue(data); err != nil {
+				return nil, false, err
+			}
+			if field.Type == pspb.ValueType_TIME {
+				v /= 1e6
+			}
+			value = v
+		case encoding.Float:
+			var v float64
+			if data, v, err = encoding.DecodeFloatValue(data); err != nil {
+				return nil, false, err
+			}
+			value = v
+		case encoding.Bytes:
+			var v []byte
+			if data, v, err = encoding.DecodeBytesValue(data); err != nil {
+				return nil, false, err
+			}
+			value = string(v)
+		case encoding.True, encoding.False:
+			var v bool
+			if data, v, err = encoding.DecodeBoolValue(data); err != nil {
+				return nil, false, err
+			}
+			value = v
+		default:
+			return nil, false, fmt.Errorf("unsupported value type %d", typ)
+		}
+		values = append(values, value)
+	}
+	switch len(values) {
+	case 0:
+		return nil, true, nil
+	case 1:
+		return values[0], true, nil
+	}
+	return values, true, nil
+}
+
+// splitRemovedFields returns the partial document without the null fields, and the full path names of the null fields,
+// which are removed from the document
+func splitRemovedFields(partial []byte) ([]byte, []string, error) {
+	decoder := json.NewDecoder(bytes.NewReader(partial))
+	// the numbers are kept as they are in the source
+	decoder.UseNumber()
+	var object map[string]interface{}
+	if err := decoder.Decode(&object); err != nil {
+		return nil, nil, fmt.Errorf("partial document is not a JSON object, err %v", err)
+	}
+	var removed []string
+	var split func(prefix string, object map[string]interface{})
+	split = func(prefix string, object map[string]interface{}) {
+		for key, value := range object {
+			switch value := value.(type) {
+			case nil:
+				removed = append(removed, prefix+key)
+				delete(object, key)
+			case map[string]interface{}:
+				split(prefix+key+".", value)
+			}
+		}
+	}
+	split("", object)
+	if len(removed) == 0 {
+		return partial, nil, nil
+	}
+	sort.Strings(removed)
+	source, err := json.Marshal(object)
+	if err != nil {
+		return nil, nil, err
+	}
+	return source, removed, nil
+}
@@ -0,0 +1,139 @@
+package index
+
+import (
+	"context"
+	"testing"
+
+	"github.com/tiglabs/baudengine/kernel"
+	"github.com/tiglabs/baudengine/proto/pspb"
+	"github.com/tiglabs/baudengine/util/encoding"
+)
+
+func TestMergeDocument(t *testing.T) {
+	store := open(t)
+	defer cleanup(t, store)
+	driver := NewIndexDriver(store)
+	schema := `{"mappings": {"doc": {"_all": {"analyzer": "whitspace"}, "properties": {
+		"title": {"type": "text", "store": true, "analyzer": "whitspace"},
+		"tag":   {"type": "keyword"},
+		"views": {"type": "long"}
+	}}}}`
+	if err := driver.SetMapping([]byte(schema)); err != nil {
+		t.Fatalf("set mapping failed, err %v", err)
+	}
+	fieldId := func(name string) uint32 {
+		return uint32(driver.indexMapping.FieldMappingNamed(name).ID())
+	}
+	doc, err := driver.MapDocument([]byte("1"), []byte(`{"title": "quick fox", "tag": "animal", "views": 10}`))
+	if err != nil {
+		t.Fatalf("map document failed, err %v", err)
+	}
+	if err := driver.AddDocument(context.Background(), doc); err != nil {
+		t.Fatalf("add document failed, err %v", err)
+	}
+	views := func(docID string) int64 {
+		reader, err := driver.DocValues(fieldId("views"))
+		if err != nil {
+			t.Fatalf("doc values failed, err %v", err)
+		}
+		defer reader.Close()
+		value, found, err := reader.Get([]byte(docID))
+		if err != nil || !found {
+			t.Fatalf("doc values of %s failed, found %v err %v", docID, found, err)
+		}
+		_, v, err := encoding.DecodeIntValue(value.Data)
+		if err != nil {
+			t.Fatalf("decode views failed, err %v", err)
+		}
+		return v
+	}
+
+	merges := []struct {
+		req    *kernel.MergeRequest
+		result pspb.WriteResult
+	}{
+		{&kernel.MergeRequest{DocID: []byte("1"), Partial: []byte(`{"title": "lazy dog"}`)}, pspb.WriteResult_UPDATED},
+		{&kernel.MergeRequest{DocID: []byte("1"), Script: `views += params.n; tag.append("pet"); if (views > 10) title = title + " runs"`,
+			Params: []byte(`{"n": 2}`)}, pspb.WriteResult_UPDATED},
+		{&kernel.MergeRequest{DocID: []byte("1"), Partial: []byte(`{"views": 12}`)}, pspb.WriteResult_NOOP},
+		{&kernel.MergeRequest{DocID: []byte("2"), Partial: []byte(`{"views": 1}`)}, pspb.WriteResult_NOT_FOUND},
+		{&kernel.MergeRequest{DocID: []byte("2"), Script: `views += 1; title = "new doc"`, Upsert: true}, pspb.WriteResult_CREATED},
+	}
+	for i, merge := range merges {
+		result, err := driver.MergeDocument(context.Background(), merge.req)
+		if err != nil || result != merge.result {
+			t.Fatalf("merge %d failed, expect %v, got %v err %v", i, merge.result, result, err)
+		}
+	}
+	tests := []struct {
+		query  kernel.Query
+		docIDs []string
+	}{
+		{&kernel.TermQuery{FieldId: fieldId("title"), Term: []byte("quick")}, nil},
+		{&kernel.TermQuery{FieldId: fieldId("title"), Term: []byte("runs")}, []string{"1"}},
+		{&kernel.TermQuery{FieldId: fieldId("_all"), Term: []byte("dog")}, []string{"1"}},
+		{&kernel.TermQuery{FieldId: fieldId("_all"), Term: []byte("fox")}, nil},
+		{&kernel.TermQuery{FieldId: fieldId("_all"), Term: []byte("doc")}, []string{"2"}},
+		{&kernel.TermQuery{FieldId: fieldId("tag"), Term: []byte("pet")}, []string{"1"}},
+		{&kernel.TermQuery{FieldId: fieldId("tag"), Term: []byte("animal")}, []string{"1"}},
+		{&kernel.RangeQuery{FieldId: fieldId("views"), Gte: encoding.EncodeIntValue(nil, 0, 12)}, []string{"1"}},
+	}
+	for i, test := range tests {
+		docIDs := searchDocIDs(t, driver, test.query)
+		if !equalDocIDs(docIDs, test.docIDs) {
+			t.Fatalf("test %d failed, expect %v, got %v", i, test.docIDs, docIDs)
+		}
+	}
+	if v := views("2"); v != 1 {
+		t.Fatalf("upsert views failed, got %d", v)
+	}
+
+	// the second update in the batch reads the fields written by the first one
+	batch := driver.NewWriteBatch()
+	for i := 0; i < 2; i++ {
+		result, err := batch.MergeDocument(context.Background(), &kernel.MergeRequest{DocID: []byte("1"), Script: `views += 1`})
+		if err != nil || result != pspb.WriteResult_UPDATED {
+			t.Fatalf("batch merge %d failed, got %v err %v", i, result, err)
+		}
+	}
+	if err := batch.Commit(); err != nil {
+		t.Fatalf("commit failed, err %v", err)
+	}
+	if v := views("1"); v != 14 {
+		t.Fatalf("batch merge views failed, got %d", v)
+	}
+
+	// the null removes the field with its terms
+	if result, err := driver.MergeDocument(context.Background(), &kernel.MergeRequest{DocID: []byte("1"), Partial: []byte(`{"tag": null}`)}); err != nil || result != pspb.WriteResult_UPDATED {
+		t.Fatalf("remove field failed, got %v err %v", result, err)
+	}
+	if docIDs := searchDocIDs(t, driver, &kernel.TermQuery{FieldId: fieldId("tag"), Term: []byte("pet")}); len(docIDs) != 0 {
+		t.Fatalf("removed field is found, got %v", docIDs)
+	}
+	vectors, err := driver.TermVectors(context.Background(), &kernel.TermVectorsRequest{DocID: []byte("1"), Fields: []uint32{fieldId("title")}, TermStatistics: true})
+	if err != nil {
+		t.Fatalf("term vectors failed, err %v", err)
+	}
+	var terms []string
+	for _, vector := range vectors.Fields[fieldId("title")] {
+		if vector.DocFreq != 1 {
+			t.Fatalf("doc freq of %s failed, got %d", vector.Term, vector.DocFreq)
+		}
+		terms = append(terms, string(vector.Term))
+	}
+	if !equalDocIDs(terms, []string{"dog", "lazy", "runs"}) {
+		t.Fatalf("merged terms failed, got %v", terms)
+	}
+
+	invalid := []*kernel.MergeRequest{
+		{DocID: []byte("1"), Script: `views = views / 0`},
+		{DocID: []byte("1"), Script: `views +`},
+		{DocID: []byte("1"), Partial: []byte(`{"views": "many"}`)},
+		{DocID: []byte("1"), Partial: []byte(`{"views": 1}`), Script: `views = 2`},
+	}
+	for i, req := range invalid {
+		if _, err := driver.MergeDocument(context.Background(), req); err == nil {
+			t.Fatalf("invalid merge %d should fail", i)
+		}
+	}
+}
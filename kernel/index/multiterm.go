@@ -0,0 +1,244 @@
+package index
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/tiglabs/baudengine/kernel"
+)
+
+func (s *searcher) prefixMatches(q *kernel.PrefixQuery) ([]*docMatch, error) {
+	var terms [][]byte
+	err := s.walkTerms(q.FieldId, q.Prefix, func(term []byte) bool {
+		terms = append(terms, term)
+		return len(terms) < maxExpansions(q.MaxExpansions)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.multiTermMatches(q.FieldId, terms, nil)
+}
+
+func (s *searcher) wildcardMatches(q *kernel.WildcardQuery) ([]*docMatch, error) {
+	var expr strings.Builder
+	for _, r := range q.Pattern {
+		switch r {
+		case '*':
+			expr.WriteString(".*")
+		case '?':
+			expr.WriteString(".")
+		default:
+			expr.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	return s.patternMatches(q.FieldId, expr.String(), q.MaxExpansions)
+}
+
+func (s *searcher) regexpMatches(q *kernel.RegexpQuery) ([]*docMatch, error) {
+	return s.patternMatches(q.FieldId, q.Pattern, q.MaxExpansions)
+}
+
+// patternMatches returns the documents containing the terms matching the whole regular expression,
+// only the terms starting with the literal prefix of the expression are walked
+func (s *searcher) patternMatches(fieldId uint32, expr string, limit int) ([]*docMatch, error) {
+	re, err := regexp.Compile("^(?:" + expr + ")$")
+	if err != nil {
+		return nil, fmt.Errorf("invalid pattern %s: %v", expr, err)
+	}
+	prefix, _ := re.LiteralPrefix()
+	var terms [][]byte
+	err = s.walkTerms(fieldId, []byte(prefix), func(term []byte) bool {
+		if re.Match(term) {
+			terms = append(terms, term)
+		}
+		return len(terms) < maxExpansions(limit)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.multiTermMatches(fieldId, terms, nil)
+}
+
+func (s *searcher) fuzzyMatches(q *kernel.FuzzyQuery) ([]*docMatch, error) {
+	if !utf8.Valid(q.Term) {
+		return nil, errors.New("fuzzy term is not valid utf8")
+	}
+	target := []rune(string(q.Term))
+	maxEdits := q.MaxEdits
+	if maxEdits <= 0 {
+		switch {
+		case len(target) < 3:
+			maxEdits = 0
+		case len(target) < 6:
+			maxEdits = 1
+		default:
+			maxEdits = 2
+		}
+	}
+	prefixLength := q.PrefixLength
+	if prefixLength > len(target) {
+		prefixLength = len(target)
+	}
+	if prefixLength < 0 {
+		prefixLength = 0
+	}
+
+	type candidate struct {
+		term  []byte
+		edits int
+	}
+	var candidates []candidate
+	err := s.walkTerms(q.FieldId, []byte(string(target[:prefixLength])), func(term []byte) bool {
+		if edits, ok := levenshtein(target, []rune(string(term)), maxEdits); ok {
+			candidates = append(candidates, candidate{term: term, edits: edits})
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	// the terms with fewer edits are preferred, then the dictionary order
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].edits < candidates[j].edits
+	})
+	if limit := maxExpansions(q.MaxExpansions); len(candidates) > limit {
+		candidates = candidates[:limit]
+	}
+	terms := make([][]byte, 0, len(candidates))
+	boosts := make([]float64, 0, len(candidates))
+	for _, c := range candidates {
+		terms = append(terms, c.term)
+		length := utf8.RuneCount(c.term)
+		if len(target) < length {
+			length = len(target)
+		}
+		boost := 1.0
+		if length > 0 {
+			boost = 1 - float64(c.edits)/float64(length)
+		}
+		if boost < 0 {
+			boost = 0
+		}
+		boosts = append(boosts, boost)
+	}
+	return s.multiTermMatches(q.FieldId, terms, boosts)
+}
+
+// multiTermMatches unions the documents of the terms, the documents have the same score without boosts,
+// otherwise the terms are scored with the max document frequency of them, so that a rare term does not
+// outscore a common one, and the scores are multiplied by their boosts
+func (s *searcher) multiTermMatches(fieldId uint32, terms [][]byte, boosts []float64) ([]*docMatch, error) {
+	var docFreq int64
+	if boosts != nil {
+		for _, term := range terms {
+			df, err := s.docFreq(fieldId, term)
+			if err != nil {
+				return nil, err
+			}
+			if df > docFreq {
+				docFreq = df
+			}
+		}
+	}
+	lists := make([][]*docMatch, 0, len(terms))
+	for i, term := range terms {
+		matches, err := s.termMatchesWithDocFreq(fieldId, term, docFreq)
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range matches {
+			if boosts == nil {
+				m.score = 1
+			} else {
+				m.score *= boosts[i]
+			}
+		}
+		lists = append(lists, matches)
+	}
+	matches := disjunction(lists, 1)
+	if boosts == nil {
+		for _, m := range matches {
+			m.score = 1
+		}
+	}
+	return matches, nil
+}
+
+// walkTerms calls fn with the terms of the field starting with the prefix in order, until fn returns false.
+// The terms are read from their document frequency keys, one key a term, instead of the postings of the terms.
+func (s *searcher) walkTerms(fieldId uint32, prefix []byte, fn func(term []byte) bool) error {
+	iter := s.tx.PrefixIterator(encodeTermDocFreqPrefixKey(fieldId, prefix))
+	if iter == nil {
+		return errors.New("store driver error")
+	}
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if err := s.ctx.Err(); err != nil {
+			return err
+		}
+		_, term, err := decodeTermDocFreqKey(iter.Key())
+		if err != nil {
+			return err
+		}
+		if !fn(term) {
+			return nil
+		}
+	}
+	return nil
+}
+
+func maxExpansions(limit int) int {
+	if limit <= 0 {
+		return kernel.DefaultMaxExpansions
+	}
+	return limit
+}
+
+// levenshtein returns the edit distance of a and b, ok is false when it is greater than max
+func levenshtein(a, b []rune, max int) (distance int, ok bool) {
+	if diff := len(a) - len(b); diff > max || -diff > max {
+		return 0, false
+	}
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		rowMin := curr[0]
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = minInt(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+			if curr[j] < rowMin {
+				rowMin = curr[j]
+			}
+		}
+		// the distance never gets smaller than the minimum of a row
+		if rowMin > max {
+			return 0, false
+		}
+		prev, curr = curr, prev
+	}
+	if prev[len(b)] > max {
+		return 0, false
+	}
+	return prev[len(b)], true
+}
+
+func minInt(values ...int) int {
+	min := values[0]
+	for _, v := range values[1:] {
+		if v < min {
+			min = v
+		}
+	}
+	return min
+}
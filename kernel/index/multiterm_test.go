@@ -0,0 +1,65 @@
+package index
+
+import (
+	"context"
+	"testing"
+
+	"github.com/tiglabs/baudengine/kernel"
+	"github.com/tiglabs/baudengine/proto/pspb"
+)
+
+func TestSearchMultiTerm(t *testing.T) {
+	store := open(t)
+	defer cleanup(t, store)
+	driver := NewIndexDriver(store)
+	docs := []*pspb.Document{
+		newTextDocument("1", map[uint32]string{1: "iphone case"}),
+		newTextDocument("2", map[uint32]string{1: "iphone charger"}),
+		newTextDocument("3", map[uint32]string{1: "ipad cover"}),
+		newTextDocument("4", map[uint32]string{1: "android phone", 2: "iphone"}),
+	}
+	for _, doc := range docs {
+		if err := driver.AddDocument(context.Background(), doc); err != nil {
+			t.Fatalf("add document failed, err %v", err)
+		}
+	}
+
+	cases := []struct {
+		query kernel.Query
+		docs  []string
+	}{
+		{&kernel.PrefixQuery{FieldId: 1, Prefix: []byte("ip")}, []string{"1", "2", "3"}},
+		{&kernel.PrefixQuery{FieldId: 1, Prefix: []byte("iphone")}, []string{"1", "2"}},
+		{&kernel.PrefixQuery{FieldId: 1, Prefix: []byte("ip"), MaxExpansions: 1}, []string{"3"}},
+		{&kernel.PrefixQuery{FieldId: 1, Prefix: []byte("x")}, nil},
+		{&kernel.WildcardQuery{FieldId: 1, Pattern: "c*r"}, []string{"2", "3"}},
+		{&kernel.WildcardQuery{FieldId: 1, Pattern: "?hone"}, []string{"4"}},
+		{&kernel.WildcardQuery{FieldId: 1, Pattern: "ip*.e"}, nil},
+		{&kernel.RegexpQuery{FieldId: 1, Pattern: "ip(ad|hone)"}, []string{"1", "2", "3"}},
+		{&kernel.RegexpQuery{FieldId: 1, Pattern: "c.se"}, []string{"1"}},
+		{&kernel.FuzzyQuery{FieldId: 1, Term: []byte("iphoen")}, []string{"1", "2"}},
+		{&kernel.FuzzyQuery{FieldId: 1, Term: []byte("chargr"), MaxEdits: 1}, []string{"2"}},
+		{&kernel.FuzzyQuery{FieldId: 1, Term: []byte("kase"), MaxEdits: 1, PrefixLength: 1}, nil},
+		{&kernel.FuzzyQuery{FieldId: 1, Term: []byte("pone"), MaxEdits: 1}, []string{"4"}},
+	}
+	for i, c := range cases {
+		if got := searchDocIDs(t, driver, c.query); !equalDocIDs(got, c.docs) {
+			t.Fatalf("case %d failed, got %v, expect %v", i, got, c.docs)
+		}
+	}
+
+	if _, err := driver.Search(context.Background(), &kernel.Request{Query: &kernel.RegexpQuery{FieldId: 1, Pattern: "("}}); err == nil {
+		t.Fatal("invalid regexp should fail")
+	}
+
+	// the term with fewer edits scores higher
+	result, err := driver.Search(context.Background(), &kernel.Request{
+		Query: &kernel.FuzzyQuery{FieldId: 1, Term: []byte("iphones"), MaxEdits: 2},
+	})
+	if err != nil {
+		t.Fatalf("search failed, err %v", err)
+	}
+	if len(result.Hits) != 3 || string(result.Hits[2].DocID) != "4" {
+		t.Fatalf("fuzzy score failed, got %d hits", len(result.Hits))
+	}
+}
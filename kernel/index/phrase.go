@@ -0,0 +1,159 @@
+package index
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/tiglabs/baudengine/kernel"
+	"github.com/tiglabs/baudengine/proto/metapb"
+	"github.com/tiglabs/baudengine/proto/pspb"
+)
+
+// phraseMatches returns the documents containing all the terms of the phrase within the slop
+func (s *searcher) phraseMatches(q *kernel.PhraseQuery) ([]*docMatch, error) {
+	if len(q.Terms) == 0 {
+		return nil, errors.New("phrase query has no terms")
+	}
+	if q.Slop < 0 {
+		return nil, fmt.Errorf("invalid phrase slop %d", q.Slop)
+	}
+	if err := s.checkPositions(q.FieldId); err != nil {
+		return nil, err
+	}
+	var candidates []*docMatch
+	for i, term := range q.Terms {
+		termMatches, err := s.termMatches(q.FieldId, term)
+		if err != nil {
+			return nil, err
+		}
+		if i == 0 {
+			candidates = termMatches
+		} else {
+			candidates = conjunction(candidates, termMatches)
+		}
+	}
+
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+	similarity, err := s.similarity(q.FieldId)
+	if err != nil {
+		return nil, err
+	}
+	fieldStats, err := s.fieldStatistics(q.FieldId)
+	if err != nil {
+		return nil, err
+	}
+	docFreqs := make([]int64, len(q.Terms))
+	for i, term := range q.Terms {
+		if docFreqs[i], err = s.docFreq(q.FieldId, term); err != nil {
+			return nil, err
+		}
+	}
+
+	var matches []*docMatch
+	positions := make([][]int, len(q.Terms))
+	for _, m := range candidates {
+		if err := s.ctx.Err(); err != nil {
+			return nil, err
+		}
+		for i, term := range q.Terms {
+			termPositions, err := s.termPositions(m.docID, q.FieldId, term)
+			if err != nil {
+				return nil, err
+			}
+			// the term is indexed, so the field has no positions
+			if len(termPositions) == 0 {
+				return nil, fmt.Errorf("field[%d] is indexed without positions, phrase query is not supported", q.FieldId)
+			}
+			positions[i] = termPositions
+		}
+		if !phraseMatched(positions, q.Slop) {
+			continue
+		}
+		fieldLength, err := s.fieldLength(m.docID, q.FieldId)
+		if err != nil {
+			return nil, err
+		}
+		// the phrase is scored as its terms appear once
+		var score float64
+		for _, docFreq := range docFreqs {
+			score += similarity.Score(1, fieldLength, docFreq, fieldStats)
+		}
+		matches = append(matches, &docMatch{docID: m.docID, score: score})
+	}
+	return matches, nil
+}
+
+// checkPositions fails if the field is indexed without positions in the mapping, the fields not in the mapping
+// are checked by their indexed terms
+func (s *searcher) checkPositions(fieldId uint32) error {
+	if s.indexMapping == nil {
+		return nil
+	}
+	_, fieldMapping := s.indexMapping.FieldMappingByID(uint64(fieldId))
+	if fieldMapping == nil {
+		return nil
+	}
+	if !fieldMapping.Index() || indexOption(fieldMapping) < pspb.IndexOption_DOCS_FREQ_POSITION {
+		return fmt.Errorf("field[%d] is indexed without positions, phrase query is not supported", fieldId)
+	}
+	return nil
+}
+
+// termPositions returns the positions of the term in the field of the document in ascending order
+func (s *searcher) termPositions(docID metapb.Key, fieldId uint32, term []byte) ([]int, error) {
+	iter := s.tx.PrefixIterator(encodeIndexPositionKey(docID, fieldId, term, 0))
+	if iter == nil {
+		return nil, errors.New("store driver error")
+	}
+	defer iter.Close()
+	var positions []int
+	for ; iter.Valid(); iter.Next() {
+		_, _, _, pos, err := decodeIndexPositionKey(iter.Key())
+		if err != nil {
+			return nil, err
+		}
+		positions = append(positions, pos)
+	}
+	return positions, nil
+}
+
+// phraseMatched reports whether one position of every term can be picked,
+// so that the distance of the picked positions to the phrase order is within the slop.
+// The positions of the i-th term are shifted by i, then the smallest range covering
+// all the terms is searched, the phrase matches when the range is not greater than the slop.
+// The same position is never picked for two terms.
+func phraseMatched(positions [][]int, slop int) bool {
+	cursors := make([]int, len(positions))
+	for {
+		minTerm, min, max := 0, 0, 0
+		for i, c := range cursors {
+			shifted := positions[i][c] - i
+			if i == 0 || shifted < min {
+				minTerm, min = i, shifted
+			}
+			if i == 0 || shifted > max {
+				max = shifted
+			}
+		}
+		if max-min <= slop && distinctPositions(positions, cursors) {
+			return true
+		}
+		cursors[minTerm]++
+		if cursors[minTerm] >= len(positions[minTerm]) {
+			return false
+		}
+	}
+}
+
+func distinctPositions(positions [][]int, cursors []int) bool {
+	picked := make(map[int]struct{}, len(cursors))
+	for i, c := range cursors {
+		if _, ok := picked[positions[i][c]]; ok {
+			return false
+		}
+		picked[positions[i][c]] = struct{}{}
+	}
+	return true
+}
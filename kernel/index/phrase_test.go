@@ -0,0 +1,83 @@
+package index
+
+import (
+	"context"
+	"testing"
+
+	"github.com/tiglabs/baudengine/kernel"
+	"github.com/tiglabs/baudengine/proto/pspb"
+)
+
+func TestSearchPhrase(t *testing.T) {
+	store := open(t)
+	defer cleanup(t, store)
+	driver := NewIndexDriver(store)
+	docs := []*pspb.Document{
+		newTextDocument("1", map[uint32]string{1: "the quick brown fox"}),
+		newTextDocument("2", map[uint32]string{1: "the brown quick fox"}),
+		newTextDocument("3", map[uint32]string{1: "quick red and brown fox"}),
+	}
+	for _, doc := range docs {
+		if err := driver.AddDocument(context.Background(), doc); err != nil {
+			t.Fatalf("add document failed, err %v", err)
+		}
+	}
+	terms := [][]byte{[]byte("quick"), []byte("brown"), []byte("fox")}
+
+	tests := []struct {
+		query  kernel.Query
+		docIDs []string
+	}{
+		{&kernel.PhraseQuery{FieldId: 1, Terms: terms}, []string{"1"}},
+		{&kernel.PhraseQuery{FieldId: 1, Terms: terms, Slop: 1}, []string{"1"}},
+		{&kernel.PhraseQuery{FieldId: 1, Terms: terms, Slop: 2}, []string{"1", "2", "3"}},
+		{&kernel.PhraseQuery{FieldId: 1, Terms: [][]byte{[]byte("quick"), []byte("fox")}, Slop: 1}, []string{"1", "2"}},
+		{&kernel.PhraseQuery{FieldId: 1, Terms: [][]byte{[]byte("fox"), []byte("quick")}}, nil},
+	}
+	for i, test := range tests {
+		docIDs := searchDocIDs(t, driver, test.query)
+		if !equalDocIDs(docIDs, test.docIDs) {
+			t.Fatalf("test %d failed, expect %v, got %v", i, test.docIDs, docIDs)
+		}
+	}
+
+	// no positions for the field indexed with DOCS_FREQ
+	doc := newTextDocument("4", map[uint32]string{2: "quick brown fox"})
+	doc.Fields[0].Desc.IndexOption = pspb.IndexOption_DOCS_FREQ
+	if err := driver.AddDocument(context.Background(), doc); err != nil {
+		t.Fatalf("add document failed, err %v", err)
+	}
+	_, err := driver.Search(context.Background(), &kernel.Request{Query: &kernel.PhraseQuery{FieldId: 2, Terms: terms}})
+	if err == nil {
+		t.Fatal("phrase query on the field without positions should fail")
+	}
+}
+
+func TestSearchPhraseIndexOptions(t *testing.T) {
+	store := open(t)
+	defer cleanup(t, store)
+	driver := NewIndexDriver(store)
+	schema := `{"mappings": {"doc": {"properties": {
+		"title": {"type": "text", "analyzer": "whitspace"},
+		"body":  {"type": "text", "analyzer": "whitspace", "index_options": "freqs"},
+		"tag":   {"type": "keyword"}
+	}}}}`
+	if err := driver.SetMapping([]byte(schema)); err != nil {
+		t.Fatalf("set mapping failed, err %v", err)
+	}
+	fieldId := func(name string) uint32 {
+		return uint32(driver.indexMapping.FieldMappingNamed(name).ID())
+	}
+	terms := [][]byte{[]byte("quick"), []byte("fox")}
+	// the fields without positions fail before any document is matched
+	for _, name := range []string{"body", "tag"} {
+		_, err := driver.Search(context.Background(), &kernel.Request{Query: &kernel.PhraseQuery{FieldId: fieldId(name), Terms: terms}})
+		if err == nil {
+			t.Fatalf("phrase query on the field %s without positions should fail", name)
+		}
+	}
+	result, err := driver.Search(context.Background(), &kernel.Request{Query: &kernel.PhraseQuery{FieldId: fieldId("title"), Terms: terms}})
+	if err != nil || result.Total != 0 {
+		t.Fatalf("phrase query on the field with positions failed, result %v err %v", result, err)
+	}
+}
@@ -0,0 +1,82 @@
+package index
+
+import (
+	"bytes"
+	"errors"
+	"sort"
+
+	"github.com/tiglabs/baudengine/kernel"
+)
+
+// rangeMatches scans the index keys of the field between the bounds of the query
+func (s *searcher) rangeMatches(q *kernel.RangeQuery) ([]*docMatch, error) {
+	start, end, err := rangeKeys(q)
+	if err != nil {
+		return nil, err
+	}
+	if end != nil && bytes.Compare(start, end) >= 0 {
+		return nil, nil
+	}
+	iter := s.tx.RangeIterator(start, end)
+	if iter == nil {
+		return nil, errors.New("store driver error")
+	}
+	defer iter.Close()
+	// a multi-valued field may have several terms in the range
+	docs := make(map[string]*docMatch)
+	for count := 0; iter.Valid(); iter.Next() {
+		count++
+		if count%1000 == 0 {
+			if err := s.ctx.Err(); err != nil {
+				return nil, err
+			}
+		}
+		docID, _, _, err := decodeIndexKey(iter.Key())
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := docs[string(docID)]; !ok {
+			docs[string(docID)] = &docMatch{docID: docID, score: 1}
+		}
+	}
+	matches := make([]*docMatch, 0, len(docs))
+	for _, m := range docs {
+		matches = append(matches, m)
+	}
+	sort.Slice(matches, func(i, j int) bool {
+		return bytes.Compare(matches[i].docID, matches[j].docID) < 0
+	})
+	return matches, nil
+}
+
+// rangeKeys returns the start and end index keys of the range, the end key is exclusive
+func rangeKeys(q *kernel.RangeQuery) (start, end []byte, err error) {
+	fieldKey := encodeIndexFieldKey(q.FieldId)
+	start, end = fieldKey, prefixEnd(fieldKey)
+	var term []byte
+	switch {
+	case len(q.Gt) > 0:
+		if term, _, err = encodeValueTerm(q.Gt); err != nil {
+			return
+		}
+		start = prefixEnd(encodeIndexKey(nil, q.FieldId, term))
+	case len(q.Gte) > 0:
+		if term, _, err = encodeValueTerm(q.Gte); err != nil {
+			return
+		}
+		start = encodeIndexKey(nil, q.FieldId, term)
+	}
+	switch {
+	case len(q.Lt) > 0:
+		if term, _, err = encodeValueTerm(q.Lt); err != nil {
+			return
+		}
+		end = encodeIndexKey(nil, q.FieldId, term)
+	case len(q.Lte) > 0:
+		if term, _, err = encodeValueTerm(q.Lte); err != nil {
+			return
+		}
+		end = prefixEnd(encodeIndexKey(nil, q.FieldId, term))
+	}
+	return
+}
@@ -0,0 +1,57 @@
+package index
+
+import (
+	"context"
+	"testing"
+
+	"github.com/tiglabs/baudengine/kernel"
+	"github.com/tiglabs/baudengine/proto/pspb"
+	"github.com/tiglabs/baudengine/util/encoding"
+)
+
+func TestSearchRange(t *testing.T) {
+	store := open(t)
+	defer cleanup(t, store)
+	driver := NewIndexDriver(store)
+	docs := []*pspb.Document{
+		newValueDocument("1", 1, pspb.ValueType_INT, encoding.EncodeIntValue(nil, 0, -100)),
+		newValueDocument("2", 1, pspb.ValueType_INT, encoding.EncodeIntValue(nil, 0, 5)),
+		newValueDocument("3", 1, pspb.ValueType_INT, encoding.EncodeIntValue(nil, 0, 300)),
+		// multi-valued
+		newValueDocument("4", 1, pspb.ValueType_INT, encoding.EncodeIntValue(encoding.EncodeIntValue(nil, 0, 1), 0, 1000)),
+		newValueDocument("5", 2, pspb.ValueType_FLOAT, encoding.EncodeFloatValue(nil, 0, -1.5)),
+		newValueDocument("6", 2, pspb.ValueType_FLOAT, encoding.EncodeFloatValue(nil, 0, 2.5)),
+		newValueDocument("7", 3, pspb.ValueType_STRING, encoding.EncodeBytesValue(nil, 0, []byte("apple"))),
+		newValueDocument("8", 3, pspb.ValueType_STRING, encoding.EncodeBytesValue(nil, 0, []byte("banana"))),
+	}
+	for _, doc := range docs {
+		if err := driver.AddDocument(context.Background(), doc); err != nil {
+			t.Fatalf("add document failed, err %v", err)
+		}
+	}
+	intValue := func(v int64) []byte { return encoding.EncodeIntValue(nil, 0, v) }
+	floatValue := func(v float64) []byte { return encoding.EncodeFloatValue(nil, 0, v) }
+
+	tests := []struct {
+		query  kernel.Query
+		docIDs []string
+	}{
+		{&kernel.RangeQuery{FieldId: 1}, []string{"1", "2", "3", "4"}},
+		{&kernel.RangeQuery{FieldId: 1, Gte: intValue(5)}, []string{"2", "3", "4"}},
+		{&kernel.RangeQuery{FieldId: 1, Gt: intValue(5)}, []string{"3", "4"}},
+		{&kernel.RangeQuery{FieldId: 1, Lt: intValue(5)}, []string{"1", "4"}},
+		{&kernel.RangeQuery{FieldId: 1, Lte: intValue(5)}, []string{"1", "2", "4"}},
+		{&kernel.RangeQuery{FieldId: 1, Gt: intValue(-100), Lt: intValue(300)}, []string{"2", "4"}},
+		{&kernel.RangeQuery{FieldId: 1, Gt: intValue(300), Lt: intValue(5)}, nil},
+		{&kernel.RangeQuery{FieldId: 2, Gte: floatValue(-2), Lt: floatValue(0)}, []string{"5"}},
+		{&kernel.RangeQuery{FieldId: 2, Gt: floatValue(-1.5)}, []string{"6"}},
+		{&kernel.RangeQuery{FieldId: 3, Gte: encoding.EncodeBytesValue(nil, 0, []byte("b"))}, []string{"8"}},
+		{&kernel.TermQuery{FieldId: 3, Term: []byte("apple")}, []string{"7"}},
+	}
+	for i, test := range tests {
+		docIDs := searchDocIDs(t, driver, test.query)
+		if !equalDocIDs(docIDs, test.docIDs) {
+			t.Fatalf("test %d failed, expect %v, got %v", i, test.docIDs, docIDs)
+		}
+	}
+}
@@ -33,6 +33,10 @@ func (r *IndexDriver) GetDocument(ctx context.Context, docID metapb.Key, fields
 		return nil, false
 	}
 	defer tx.Rollback()
+	// the expired document is hidden until it is deleted by the sweeper
+	if expired, err := isDocExpired(tx, docID, unixMillis(r.now())); err != nil || expired {
+		return nil, false
+	}
 	fieldValues := make(map[uint32]pspb.FieldValue)
 	for _, fieldId := range fields {
 		value, err := tx.Get(encodeStoreFieldKey(docID, fieldId))
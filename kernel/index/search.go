@@ -0,0 +1,679 @@
+package index
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/tiglabs/baudengine/kernel"
+	"github.com/tiglabs/baudengine/kernel/analysis"
+	"github.com/tiglabs/baudengine/kernel/mapping"
+	"github.com/tiglabs/baudengine/kernel/registry"
+	"github.com/tiglabs/baudengine/kernel/store/kvstore"
+	"github.com/tiglabs/baudengine/proto/metapb"
+	"github.com/tiglabs/baudengine/proto/pspb"
+)
+
+// docMatch is a document matched by a query, the matches of a query are ordered by doc ID
+type docMatch struct {
+	docID metapb.Key
+	score float64
+}
+
+// searchReader is the store read by the searcher, a read transaction or a snapshot
+type searchReader interface {
+	kvReader
+	RangeIterator(start, end []byte) kvstore.KVIterator
+}
+
+type searcher struct {
+	ctx context.Context
+	tx  searchReader
+	// similarity names of the fields
+	similarityNames map[uint32]string
+	similarities    map[uint32]Similarity
+	// statistics of all the partitions, the local statistics are used if nil
+	stats      *kernel.Statistics
+	fieldStats map[uint32]*kernel.FieldStatistics
+	// the nested documents matched by the nested queries with inner hits,
+	// by the parent doc ID and the field ID of the nested field
+	innerHits map[string]map[uint32][]*docMatch
+	// analyzerNamed resolves the analyzer names of the highlighted fields
+	analyzerNamed func(name string) analysis.Analyzer
+	// the time of the search in unix milliseconds, the documents expired at it are not matched
+	now int64
+	// the mapping of the space for the index options of the fields, nil if not set
+	indexMapping mapping.IndexMapping
+}
+
+func newSearcher(ctx context.Context, tx searchReader, req *kernel.Request) *searcher {
+	return &searcher{
+		ctx:             ctx,
+		tx:              tx,
+		similarityNames: req.Similarities,
+		similarities:    make(map[uint32]Similarity),
+		stats:           req.Statistics,
+		fieldStats:      make(map[uint32]*kernel.FieldStatistics),
+		innerHits:       make(map[string]map[uint32][]*docMatch),
+		analyzerNamed:   registry.GetAnalyzer,
+	}
+}
+
+func (r *IndexDriver) Search(ctx context.Context, req *kernel.Request) (*kernel.Result, error) {
+	if req == nil || req.Query == nil {
+		return nil, errors.New("empty search request")
+	}
+	tx, err := r.store.NewTransaction(false)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	s := newSearcher(ctx, tx, req)
+	s.analyzerNamed = r.analyzerNamed
+	s.now = unixMillis(r.now())
+	s.indexMapping = r.currentMapping()
+	matches, err := s.search(req.Query)
+	if err != nil {
+		return nil, err
+	}
+	// the nested documents are only matched by the nested queries
+	if matches, err = s.rootMatches(matches); err != nil {
+		return nil, err
+	}
+	// the expired documents not deleted yet
+	if matches, err = s.liveMatches(matches); err != nil {
+		return nil, err
+	}
+	var aggResults map[string]*kernel.AggregationResult
+	if len(req.Aggregations) > 0 {
+		docs := make([]metapb.Key, 0, len(matches))
+		for _, m := range matches {
+			docs = append(docs, m.docID)
+		}
+		if aggResults, err = s.aggregate(docs, req.Aggregations); err != nil {
+			return nil, err
+		}
+		kernel.ReduceAggregations(req.Aggregations, aggResults, true)
+	}
+	if len(req.Sort) > 0 {
+		if err = s.sortMatches(matches, req.Sort); err != nil {
+			return nil, err
+		}
+	} else {
+		// the documents with the same score are kept in doc ID order
+		sort.SliceStable(matches, func(i, j int) bool {
+			return matches[i].score > matches[j].score
+		})
+	}
+	result := &kernel.Result{Total: len(matches), Aggregations: aggResults}
+	size := req.Size
+	if size <= 0 {
+		size = kernel.DefaultSearchSize
+	}
+	from := req.From
+	if from < 0 {
+		from = 0
+	}
+	if from >= len(matches) {
+		return result, nil
+	}
+	matches = matches[from:]
+	if len(matches) > size {
+		matches = matches[:size]
+	}
+	result.Hits = make([]*kernel.Hit, 0, len(matches))
+	for _, m := range matches {
+		fields, err := s.loadFields(m.docID, req.Fields)
+		if err != nil {
+			return nil, err
+		}
+		hit := &kernel.Hit{DocID: m.docID, Score: m.score, Fields: fields}
+		if req.Highlight != nil {
+			if hit.Highlights, err = s.highlight(m.docID, req.Query, req.Highlight); err != nil {
+				return nil, err
+			}
+		}
+		if innerHits := s.innerHits[string(m.docID)]; len(innerHits) > 0 {
+			if hit.InnerHits, err = s.loadInnerHits(innerHits); err != nil {
+				return nil, err
+			}
+		}
+		result.Hits = append(result.Hits, hit)
+	}
+	return result, nil
+}
+
+func (s *searcher) search(query kernel.Query) ([]*docMatch, error) {
+	if err := s.ctx.Err(); err != nil {
+		return nil, err
+	}
+	switch q := query.(type) {
+	case *kernel.TermQuery:
+		return s.termMatches(q.FieldId, q.Term)
+	case *kernel.TermsQuery:
+		var lists [][]*docMatch
+		for _, term := range q.Terms {
+			matches, err := s.termMatches(q.FieldId, term)
+			if err != nil {
+				return nil, err
+			}
+			lists = append(lists, matches)
+		}
+		return disjunction(lists, 1), nil
+	case *kernel.PhraseQuery:
+		return s.phraseMatches(q)
+	case *kernel.RangeQuery:
+		return s.rangeMatches(q)
+	case *kernel.PrefixQuery:
+		return s.prefixMatches(q)
+	case *kernel.WildcardQuery:
+		return s.wildcardMatches(q)
+	case *kernel.RegexpQuery:
+		return s.regexpMatches(q)
+	case *kernel.FuzzyQuery:
+		return s.fuzzyMatches(q)
+	case *kernel.GeoBoundingBoxQuery:
+		return s.geoBoundingBoxMatches(q)
+	case *kernel.GeoDistanceQuery:
+		return s.geoDistanceMatches(q)
+	case *kernel.GeoPolygonQuery:
+		return s.geoPolygonMatches(q)
+	case *kernel.MatchAllQuery:
+		return s.allMatches()
+	case *kernel.BooleanQuery:
+		return s.booleanMatches(q)
+	case *kernel.NestedQuery:
+		return s.nestedMatches(q)
+	default:
+		return nil, fmt.Errorf("unsupported query type %T", query)
+	}
+}
+
+func (s *searcher) termMatches(fieldId uint32, term []byte) ([]*docMatch, error) {
+	docFreq, err := s.docFreq(fieldId, term)
+	if err != nil {
+		return nil, err
+	}
+	return s.termMatchesWithDocFreq(fieldId, term, docFreq)
+}
+
+// termMatchesWithDocFreq scores the documents of the term with the document frequency
+func (s *searcher) termMatchesWithDocFreq(fieldId uint32, term []byte, docFreq int64) ([]*docMatch, error) {
+	iter := s.tx.PrefixIterator(encodeIndexKey(nil, fieldId, term))
+	if iter == nil {
+		return nil, errors.New("store driver error")
+	}
+	defer iter.Close()
+	var matches []*docMatch
+	for ; iter.Valid(); iter.Next() {
+		docID, _, _, err := decodeIndexKey(iter.Key())
+		if err != nil {
+			return nil, err
+		}
+		freq, err := decodeIndex(iter.Value())
+		if err != nil {
+			return nil, err
+		}
+		matches = append(matches, &docMatch{docID: docID, score: float64(freq)})
+	}
+	if len(matches) == 0 {
+		return nil, nil
+	}
+	// the score of the matches is the term frequency until scored
+	similarity, err := s.similarity(fieldId)
+	if err != nil {
+		return nil, err
+	}
+	fieldStats, err := s.fieldStatistics(fieldId)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range matches {
+		fieldLength, err := s.fieldLength(m.docID, fieldId)
+		if err != nil {
+			return nil, err
+		}
+		m.score = similarity.Score(int(m.score), fieldLength, docFreq, fieldStats)
+	}
+	return matches, nil
+}
+
+// termDocs returns the documents of the term with the same score
+func (s *searcher) termDocs(fieldId uint32, term []byte) ([]*docMatch, error) {
+	iter := s.tx.PrefixIterator(encodeIndexKey(nil, fieldId, term))
+	if iter == nil {
+		return nil, errors.New("store driver error")
+	}
+	defer iter.Close()
+	var matches []*docMatch
+	for ; iter.Valid(); iter.Next() {
+		docID, _, _, err := decodeIndexKey(iter.Key())
+		if err != nil {
+			return nil, err
+		}
+		matches = append(matches, &docMatch{docID: docID, score: 1})
+	}
+	return matches, nil
+}
+
+// allMatches returns all the documents, including the nested documents
+func (s *searcher) allMatches() ([]*docMatch, error) {
+	// every document has a version, even if none of its fields is stored
+	iter := s.tx.PrefixIterator([]byte{byte(KEY_TYPE_O)})
+	if iter == nil {
+		return nil, errors.New("store driver error")
+	}
+	defer iter.Close()
+	var matches []*docMatch
+	for ; iter.Valid(); iter.Next() {
+		docID, err := decodeDocVersionKey(iter.Key())
+		if err != nil {
+			return nil, err
+		}
+		matches = append(matches, &docMatch{docID: docID, score: 1})
+	}
+	// the nested documents have no version
+	nestedIter := s.tx.PrefixIterator([]byte{byte(KEY_TYPE_N)})
+	if nestedIter == nil {
+		return nil, errors.New("store driver error")
+	}
+	defer nestedIter.Close()
+	var nested []*docMatch
+	for ; nestedIter.Valid(); nestedIter.Next() {
+		docID, err := decodeNestedDocKey(nestedIter.Key())
+		if err != nil {
+			return nil, err
+		}
+		nested = append(nested, &docMatch{docID: docID, score: 1})
+	}
+	return union(matches, nested), nil
+}
+
+// nestedDoc returns the parent and the field of the nested document, nested is false for the other documents
+func (s *searcher) nestedDoc(docID metapb.Key) (parent metapb.Key, fieldId, offset uint32, nested bool, err error) {
+	// the IDs of the nested documents have a 0x00 before the field ID
+	if bytes.IndexByte(docID, 0) < 0 {
+		return
+	}
+	row, err := s.tx.Get(encodeNestedDocKey(docID))
+	if err != nil || len(row) == 0 {
+		return
+	}
+	parent, fieldId, offset, err = decodeNestedDocID(docID)
+	nested = err == nil
+	return
+}
+
+// rootMatches removes the nested documents from the matches
+func (s *searcher) rootMatches(matches []*docMatch) ([]*docMatch, error) {
+	roots := matches[:0]
+	for _, m := range matches {
+		_, _, _, nested, err := s.nestedDoc(m.docID)
+		if err != nil {
+			return nil, err
+		}
+		if !nested {
+			roots = append(roots, m)
+		}
+	}
+	return roots, nil
+}
+
+// nestedMatches joins the nested documents of the field matched by the query to their parents
+func (s *searcher) nestedMatches(q *kernel.NestedQuery) ([]*docMatch, error) {
+	subMatches, err := s.search(q.Query)
+	if err != nil {
+		return nil, err
+	}
+	children := make(map[string][]*docMatch)
+	for _, m := range subMatches {
+		parent, fieldId, _, nested, err := s.nestedDoc(m.docID)
+		if err != nil {
+			return nil, err
+		}
+		if !nested || fieldId != q.FieldId {
+			continue
+		}
+		children[string(parent)] = append(children[string(parent)], m)
+	}
+	matches := make([]*docMatch, 0, len(children))
+	for parent, nested := range children {
+		score, err := nestedScore(q.ScoreMode, nested)
+		if err != nil {
+			return nil, err
+		}
+		matches = append(matches, &docMatch{docID: metapb.Key(parent), score: score})
+		if !q.InnerHits {
+			continue
+		}
+		size := q.InnerHitsSize
+		if size <= 0 {
+			size = kernel.DefaultInnerHitsSize
+		}
+		// the nested documents with the same score are kept in doc ID order
+		sort.SliceStable(nested, func(i, j int) bool {
+			return nested[i].score > nested[j].score
+		})
+		if len(nested) > size {
+			nested = nested[:size]
+		}
+		if s.innerHits[parent] == nil {
+			s.innerHits[parent] = make(map[uint32][]*docMatch)
+		}
+		s.innerHits[parent][q.FieldId] = nested
+	}
+	sort.Slice(matches, func(i, j int) bool {
+		return bytes.Compare(matches[i].docID, matches[j].docID) < 0
+	})
+	return matches, nil
+}
+
+// nestedScore computes the score of a parent from the scores of its matched nested documents
+func nestedScore(scoreMode string, nested []*docMatch) (float64, error) {
+	var score float64
+	switch scoreMode {
+	case "", "avg", "sum":
+		for _, m := range nested {
+			score += m.score
+		}
+		if scoreMode != "sum" {
+			score /= float64(len(nested))
+		}
+	case "max":
+		score = nested[0].score
+		for _, m := range nested[1:] {
+			score = math.Max(score, m.score)
+		}
+	case "min":
+		score = nested[0].score
+		for _, m := range nested[1:] {
+			score = math.Min(score, m.score)
+		}
+	case "none":
+	default:
+		return 0, fmt.Errorf("unknown score mode %s of nested query", scoreMode)
+	}
+	return score, nil
+}
+
+// loadInnerHits returns the offsets, scores and stored fields of the nested documents by field
+func (s *searcher) loadInnerHits(innerHits map[uint32][]*docMatch) (map[uint32][]*kernel.InnerHit, error) {
+	hits := make(map[uint32][]*kernel.InnerHit, len(innerHits))
+	for fieldId, nested := range innerHits {
+		for _, m := range nested {
+			_, _, offset, err := decodeNestedDocID(m.docID)
+			if err != nil {
+				return nil, err
+			}
+			fields, err := s.loadFields(m.docID, nil)
+			if err != nil {
+				return nil, err
+			}
+			hits[fieldId] = append(hits[fieldId], &kernel.InnerHit{Offset: int(offset), Score: m.score, Fields: fields})
+		}
+	}
+	return hits, nil
+}
+
+func (s *searcher) booleanMatches(q *kernel.BooleanQuery) ([]*docMatch, error) {
+	var matches []*docMatch
+	for i, sub := range q.Must {
+		subMatches, err := s.search(sub)
+		if err != nil {
+			return nil, err
+		}
+		if i == 0 {
+			matches = subMatches
+		} else {
+			matches = conjunction(matches, subMatches)
+		}
+	}
+	if len(q.Should) > 0 {
+		lists := make([][]*docMatch, 0, len(q.Should))
+		for _, sub := range q.Should {
+			subMatches, err := s.search(sub)
+			if err != nil {
+				return nil, err
+			}
+			lists = append(lists, subMatches)
+		}
+		minShould := q.MinShould
+		if minShould <= 0 && len(q.Must) == 0 {
+			minShould = 1
+		}
+		switch {
+		case len(q.Must) == 0:
+			matches = disjunction(lists, minShould)
+		case minShould > 0:
+			matches = conjunction(matches, disjunction(lists, minShould))
+		default:
+			matches = optional(matches, disjunction(lists, 1))
+		}
+	} else if len(q.Must) == 0 {
+		all, err := s.allMatches()
+		if err != nil {
+			return nil, err
+		}
+		matches = all
+	}
+	if len(q.MustNot) > 0 {
+		lists := make([][]*docMatch, 0, len(q.MustNot))
+		for _, sub := range q.MustNot {
+			subMatches, err := s.search(sub)
+			if err != nil {
+				return nil, err
+			}
+			lists = append(lists, subMatches)
+		}
+		matches = exclusion(matches, disjunction(lists, 1))
+	}
+	return matches, nil
+}
+
+func (s *searcher) similarity(fieldId uint32) (Similarity, error) {
+	if similarity, ok := s.similarities[fieldId]; ok {
+		return similarity, nil
+	}
+	similarity, err := newSimilarity(s.similarityNames[fieldId])
+	if err != nil {
+		return nil, err
+	}
+	s.similarities[fieldId] = similarity
+	return similarity, nil
+}
+
+// fieldStatistics returns the statistics of the field in the request, or the local statistics
+// if the field is not in them
+func (s *searcher) fieldStatistics(fieldId uint32) (*kernel.FieldStatistics, error) {
+	if s.stats != nil {
+		if fieldStats, ok := s.stats.Fields[fieldId]; ok {
+			return fieldStats, nil
+		}
+	}
+	if fieldStats, ok := s.fieldStats[fieldId]; ok {
+		return fieldStats, nil
+	}
+	value, err := s.tx.Get(encodeFieldStatsKey(fieldId))
+	if err != nil {
+		return nil, err
+	}
+	docCount, sumLength, err := decodeFieldStats(value)
+	if err != nil {
+		return nil, err
+	}
+	fieldStats := &kernel.FieldStatistics{DocCount: docCount, SumLength: sumLength}
+	s.fieldStats[fieldId] = fieldStats
+	return fieldStats, nil
+}
+
+// docFreq returns the document frequency of the term in the request, or the local one if the term is not
+// in them, like the terms expanded by the multi term queries which are not known before the search
+func (s *searcher) docFreq(fieldId uint32, term []byte) (int64, error) {
+	if s.stats != nil {
+		if docFreq, ok := s.stats.DocFreqs[fieldId][string(term)]; ok {
+			return docFreq, nil
+		}
+	}
+	value, err := s.tx.Get(encodeTermDocFreqKey(fieldId, term))
+	if err != nil {
+		return 0, err
+	}
+	docFreq, _, err := decodeTermStats(value)
+	return docFreq, err
+}
+
+func (s *searcher) fieldLength(docID metapb.Key, fieldId uint32) (int64, error) {
+	value, err := s.tx.Get(encodeFieldLengthKey(docID, fieldId))
+	if err != nil {
+		return 0, err
+	}
+	return decodeCount(value)
+}
+
+// loadFields returns the stored fields of the document, all the stored fields if fields is empty
+func (s *searcher) loadFields(docID metapb.Key, fields []uint32) (map[uint32]pspb.FieldValue, error) {
+	fieldValues := make(map[uint32]pspb.FieldValue)
+	if len(fields) > 0 {
+		for _, fieldId := range fields {
+			value, err := s.tx.Get(encodeStoreFieldKey(docID, fieldId))
+			if err != nil {
+				return nil, err
+			}
+			if len(value) == 0 {
+				continue
+			}
+			field, err := decodeStoreField(fieldId, value)
+			if err != nil {
+				return nil, err
+			}
+			fieldValues[fieldId] = field.FieldValue
+		}
+		return fieldValues, nil
+	}
+	iter := s.tx.PrefixIterator(encodeStoreFieldKey(docID, 0))
+	if iter == nil {
+		return nil, errors.New("store driver error")
+	}
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		_, fieldId, err := decodeStoreFieldKey(iter.Key())
+		if err != nil {
+			return nil, err
+		}
+		field, err := decodeStoreField(fieldId, iter.Value())
+		if err != nil {
+			return nil, err
+		}
+		fieldValues[fieldId] = field.FieldValue
+	}
+	return fieldValues, nil
+}
+
+// conjunction returns the documents in both a and b
+func conjunction(a, b []*docMatch) []*docMatch {
+	var matches []*docMatch
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		switch c := bytes.Compare(a[i].docID, b[j].docID); {
+		case c < 0:
+			i++
+		case c > 0:
+			j++
+		default:
+			matches = append(matches, &docMatch{docID: a[i].docID, score: a[i].score + b[j].score})
+			i++
+			j++
+		}
+	}
+	return matches
+}
+
+// optional returns the documents in a, adding the score of the ones also in b
+func optional(a, b []*docMatch) []*docMatch {
+	matches := make([]*docMatch, 0, len(a))
+	j := 0
+	for _, m := range a {
+		for j < len(b) && bytes.Compare(b[j].docID, m.docID) < 0 {
+			j++
+		}
+		score := m.score
+		if j < len(b) && bytes.Equal(b[j].docID, m.docID) {
+			score += b[j].score
+		}
+		matches = append(matches, &docMatch{docID: m.docID, score: score})
+	}
+	return matches
+}
+
+// union returns the documents in a or b, the documents in both have the score in a
+func union(a, b []*docMatch) []*docMatch {
+	if len(b) == 0 {
+		return a
+	}
+	matches := make([]*docMatch, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch c := bytes.Compare(a[i].docID, b[j].docID); {
+		case c < 0:
+			matches = append(matches, a[i])
+			i++
+		case c > 0:
+			matches = append(matches, b[j])
+			j++
+		default:
+			matches = append(matches, a[i])
+			i++
+			j++
+		}
+	}
+	matches = append(matches, a[i:]...)
+	return append(matches, b[j:]...)
+}
+
+// exclusion returns the documents in a but not in b
+func exclusion(a, b []*docMatch) []*docMatch {
+	var matches []*docMatch
+	j := 0
+	for _, m := range a {
+		for j < len(b) && bytes.Compare(b[j].docID, m.docID) < 0 {
+			j++
+		}
+		if j < len(b) && bytes.Equal(b[j].docID, m.docID) {
+			continue
+		}
+		matches = append(matches, m)
+	}
+	return matches
+}
+
+// disjunction returns the documents in at least min of the lists
+func disjunction(lists [][]*docMatch, min int) []*docMatch {
+	type counter struct {
+		match *docMatch
+		count int
+	}
+	counters := make(map[string]*counter)
+	for _, list := range lists {
+		for _, m := range list {
+			if c, ok := counters[string(m.docID)]; ok {
+				c.match.score += m.score
+				c.count++
+			} else {
+				counters[string(m.docID)] = &counter{match: &docMatch{docID: m.docID, score: m.score}, count: 1}
+			}
+		}
+	}
+	matches := make([]*docMatch, 0, len(counters))
+	for _, c := range counters {
+		if c.count >= min {
+			matches = append(matches, c.match)
+		}
+	}
+	sort.Slice(matches, func(i, j int) bool {
+		return bytes.Compare(matches[i].docID, matches[j].docID) < 0
+	})
+	return matches
+}
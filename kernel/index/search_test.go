@@ -0,0 +1,239 @@
+package index
+
+import (
+	"context"
+	"testing"
+
+	"github.com/tiglabs/baudengine/kernel"
+	"github.com/tiglabs/baudengine/kernel/mapping"
+	"github.com/tiglabs/baudengine/proto/pspb"
+	"github.com/tiglabs/baudengine/util/encoding"
+)
+
+func TestSearch(t *testing.T) {
+	store := open(t)
+	defer cleanup(t, store)
+	driver := NewIndexDriver(store)
+	docs := []*pspb.Document{
+		newTextDocument("1", map[uint32]string{1: "hello baud", 2: "red"}),
+		newTextDocument("2", map[uint32]string{1: "hello world", 2: "blue"}),
+		newTextDocument("3", map[uint32]string{1: "baud engine", 2: "red"}),
+	}
+	for _, doc := range docs {
+		if err := driver.AddDocument(context.Background(), doc); err != nil {
+			t.Fatalf("add document failed, err %v", err)
+		}
+	}
+
+	tests := []struct {
+		query  kernel.Query
+		docIDs []string
+	}{
+		{&kernel.TermQuery{FieldId: 1, Term: []byte("hello")}, []string{"1", "2"}},
+		{&kernel.TermQuery{FieldId: 2, Term: []byte("hello")}, nil},
+		{&kernel.TermsQuery{FieldId: 1, Terms: [][]byte{[]byte("world"), []byte("engine")}}, []string{"2", "3"}},
+		{&kernel.MatchAllQuery{}, []string{"1", "2", "3"}},
+		{&kernel.BooleanQuery{
+			Must: []kernel.Query{&kernel.TermQuery{FieldId: 1, Term: []byte("baud")}},
+		}, []string{"1", "3"}},
+		{&kernel.BooleanQuery{
+			Must:    []kernel.Query{&kernel.TermQuery{FieldId: 1, Term: []byte("baud")}},
+			MustNot: []kernel.Query{&kernel.TermQuery{FieldId: 1, Term: []byte("hello")}},
+		}, []string{"3"}},
+		{&kernel.BooleanQuery{
+			Should: []kernel.Query{
+				&kernel.TermQuery{FieldId: 1, Term: []byte("world")},
+				&kernel.TermQuery{FieldId: 2, Term: []byte("red")},
+			},
+		}, []string{"1", "2", "3"}},
+		{&kernel.BooleanQuery{
+			Should: []kernel.Query{
+				&kernel.TermQuery{FieldId: 1, Term: []byte("baud")},
+				&kernel.TermQuery{FieldId: 2, Term: []byte("red")},
+				&kernel.TermQuery{FieldId: 1, Term: []byte("engine")},
+			},
+			MinShould: 2,
+		}, []string{"1", "3"}},
+		{&kernel.BooleanQuery{
+			MustNot: []kernel.Query{&kernel.TermQuery{FieldId: 2, Term: []byte("red")}},
+		}, []string{"2"}},
+	}
+	for i, test := range tests {
+		docIDs := searchDocIDs(t, driver, test.query)
+		if !equalDocIDs(docIDs, test.docIDs) {
+			t.Fatalf("test %d failed, expect %v, got %v", i, test.docIDs, docIDs)
+		}
+	}
+
+	// deleted documents are not matched any more
+	if _, err := driver.DeleteDocument(context.Background(), []byte("1")); err != nil {
+		t.Fatalf("delete document failed, err %v", err)
+	}
+	docIDs := searchDocIDs(t, driver, &kernel.TermQuery{FieldId: 1, Term: []byte("baud")})
+	if !equalDocIDs(docIDs, []string{"3"}) {
+		t.Fatalf("search after delete failed, got %v", docIDs)
+	}
+}
+
+func TestSearchPaging(t *testing.T) {
+	store := open(t)
+	defer cleanup(t, store)
+	driver := NewIndexDriver(store)
+	for _, docID := range []string{"1", "2", "3", "4"} {
+		if err := driver.AddDocument(context.Background(), newTextDocument(docID, map[uint32]string{1: "baud"})); err != nil {
+			t.Fatalf("add document failed, err %v", err)
+		}
+	}
+	result, err := driver.Search(context.Background(), &kernel.Request{
+		Query:  &kernel.TermQuery{FieldId: 1, Term: []byte("baud")},
+		From:   1,
+		Size:   2,
+		Fields: []uint32{1},
+	})
+	if err != nil {
+		t.Fatalf("search failed, err %v", err)
+	}
+	if result.Total != 4 || len(result.Hits) != 2 {
+		t.Fatalf("search failed, total %d hits %d", result.Total, len(result.Hits))
+	}
+	if string(result.Hits[0].DocID) != "2" || string(result.Hits[1].DocID) != "3" {
+		t.Fatalf("search failed, hits %s %s", result.Hits[0].DocID, result.Hits[1].DocID)
+	}
+	_, data, err := encoding.DecodeBytesValue(result.Hits[0].Fields[1].Data)
+	if err != nil || string(data) != "baud" {
+		t.Fatalf("search failed, field %v err %v", result.Hits[0].Fields[1], err)
+	}
+}
+
+func TestSearchNested(t *testing.T) {
+	store := open(t)
+	defer cleanup(t, store)
+	driver := NewIndexDriver(store)
+	schema := []byte(`{"mappings": {"doc": {"_all": {"analyzer": "whitspace"}, "properties": {
+		"title":    {"type": "text", "analyzer": "whitspace", "store": true},
+		"comments": {"type": "nested", "properties": {
+			"author": {"type": "keyword", "store": true},
+			"stars":  {"type": "long"}
+		}}
+	}}}}`)
+	if err := driver.SetMapping(schema); err != nil {
+		t.Fatalf("set mapping failed, err %v", err)
+	}
+	sources := map[string]string{
+		"1": `{"title": "first", "comments": [{"author": "alice", "stars": 5}, {"author": "bob", "stars": 1}]}`,
+		"2": `{"title": "second", "comments": [{"author": "alice", "stars": 1}, {"author": "bob", "stars": 5}]}`,
+	}
+	for _, docID := range []string{"1", "2"} {
+		doc, err := driver.MapDocument([]byte(docID), []byte(sources[docID]))
+		if err != nil {
+			t.Fatalf("map document failed, err %v", err)
+		}
+		if len(doc.Nested) != 2 {
+			t.Fatalf("document %s should have 2 nested objects, got %d", docID, len(doc.Nested))
+		}
+		if err := driver.AddDocument(context.Background(), doc); err != nil {
+			t.Fatalf("add document failed, err %v", err)
+		}
+	}
+	fieldId := func(name string) uint32 {
+		return uint32(driver.indexMapping.FieldMappingNamed(name).ID())
+	}
+	comments := fieldId("comments")
+	author := func(name string) kernel.Query {
+		return &kernel.TermQuery{FieldId: fieldId("comments.author"), Term: []byte(name)}
+	}
+	goodReview := &kernel.RangeQuery{FieldId: fieldId("comments.stars"), Gte: encoding.EncodeIntValue(nil, 0, 4)}
+	tests := []struct {
+		query  kernel.Query
+		docIDs []string
+	}{
+		// the fields of the same object are matched together
+		{&kernel.NestedQuery{FieldId: comments, Query: &kernel.BooleanQuery{Must: []kernel.Query{author("alice"), goodReview}}}, []string{"1"}},
+		{&kernel.NestedQuery{FieldId: comments, Query: &kernel.BooleanQuery{Must: []kernel.Query{author("bob"), goodReview}}}, []string{"2"}},
+		{&kernel.NestedQuery{FieldId: comments, Query: &kernel.MatchAllQuery{}}, []string{"1", "2"}},
+		{&kernel.NestedQuery{FieldId: comments, Query: &kernel.BooleanQuery{MustNot: []kernel.Query{goodReview}}}, []string{"1", "2"}},
+		// the nested documents are hidden from the other queries
+		{author("alice"), nil},
+		{&kernel.MatchAllQuery{}, []string{"1", "2"}},
+		{&kernel.BooleanQuery{Must: []kernel.Query{
+			&kernel.TermQuery{FieldId: fieldId("title"), Term: []byte("second")},
+			&kernel.NestedQuery{FieldId: comments, Query: author("alice"), ScoreMode: "max"},
+		}}, []string{"2"}},
+	}
+	for i, test := range tests {
+		docIDs := searchDocIDs(t, driver, test.query)
+		if !equalDocIDs(docIDs, test.docIDs) {
+			t.Fatalf("test %d failed, expect %v, got %v", i, test.docIDs, docIDs)
+		}
+	}
+
+	result, err := driver.Search(context.Background(), &kernel.Request{
+		Query: &kernel.NestedQuery{FieldId: comments, Query: goodReview, InnerHits: true},
+	})
+	if err != nil {
+		t.Fatalf("search failed, err %v", err)
+	}
+	if len(result.Hits) != 2 {
+		t.Fatalf("search should return 2 hits, got %d", len(result.Hits))
+	}
+	offsets := map[string]int{"1": 0, "2": 1}
+	for _, hit := range result.Hits {
+		innerHits := hit.InnerHits[comments]
+		if len(innerHits) != 1 || innerHits[0].Offset != offsets[string(hit.DocID)] {
+			t.Fatalf("document %s has wrong inner hits %v", hit.DocID, innerHits)
+		}
+		if _, ok := innerHits[0].Fields[fieldId("comments.author")]; !ok {
+			t.Fatalf("inner hit of document %s should have the stored author", hit.DocID)
+		}
+		if _, ok := hit.Fields[fieldId("comments.author")]; ok {
+			t.Fatalf("document %s should not have the fields of the nested objects", hit.DocID)
+		}
+	}
+
+	// the nested objects are replaced and deleted with the document
+	doc, err := driver.MapDocument([]byte("2"), []byte(`{"title": "second", "comments": {"author": "carol", "stars": 3}}`))
+	if err != nil {
+		t.Fatalf("map document failed, err %v", err)
+	}
+	if _, err := driver.UpdateDocument(context.Background(), doc, false); err != nil {
+		t.Fatalf("update document failed, err %v", err)
+	}
+	if _, err := driver.DeleteDocument(context.Background(), []byte("1")); err != nil {
+		t.Fatalf("delete document failed, err %v", err)
+	}
+	tests = []struct {
+		query  kernel.Query
+		docIDs []string
+	}{
+		{&kernel.NestedQuery{FieldId: comments, Query: author("alice")}, nil},
+		{&kernel.NestedQuery{FieldId: comments, Query: author("bob")}, nil},
+		{&kernel.NestedQuery{FieldId: comments, Query: author("carol")}, []string{"2"}},
+		{&kernel.NestedQuery{FieldId: comments, Query: &kernel.MatchAllQuery{}}, []string{"2"}},
+	}
+	for i, test := range tests {
+		docIDs := searchDocIDs(t, driver, test.query)
+		if !equalDocIDs(docIDs, test.docIDs) {
+			t.Fatalf("test %d after update failed, expect %v, got %v", i, test.docIDs, docIDs)
+		}
+	}
+
+	// the new fields of the nested objects are added to the nested field
+	_, err = driver.MapDocument([]byte("3"), []byte(`{"comments": [{"author": "dave", "mood": "happy"}]}`))
+	dynamicErr, ok := err.(*mapping.DynamicMappingError)
+	if !ok {
+		t.Fatalf("map document with new fields should return the mapping update, err %v", err)
+	}
+	merged, err := mapping.MergeSchema(schema, dynamicErr.Update)
+	if err != nil {
+		t.Fatalf("merge mapping failed, err %v", err)
+	}
+	if err := driver.SetMapping(merged); err != nil {
+		t.Fatalf("set merged mapping failed, err %v", err)
+	}
+	if field := driver.indexMapping.FieldMappingNamed("comments"); field == nil || field.Type() != "nested" || uint32(field.ID()) != comments {
+		t.Fatalf("nested field should keep its type and id, got %v", field)
+	}
+	if field := driver.indexMapping.FieldMappingNamed("comments.mood"); field == nil || field.Type() != "text" {
+		t.Fatalf("new field of nested object should be mapped, got %v", field)
+	}
+}
@@ -0,0 +1,82 @@
+package index
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/tiglabs/baudengine/kernel"
+)
+
+// the similarity names of the field mapping
+const (
+	SimilarityBM25    = "BM25"
+	SimilarityClassic = "classic"
+	SimilarityBoolean = "boolean"
+)
+
+// Similarity scores a term of the field in a document
+type Similarity interface {
+	// Score returns the score of the term, freq is the frequency of the term in the field
+	// and fieldLength is the number of the tokens of the field in the document
+	Score(freq int, fieldLength int64, docFreq int64, field *kernel.FieldStatistics) float64
+}
+
+// BM25Similarity is the Okapi BM25 similarity
+type BM25Similarity struct {
+	K1 float64
+	B  float64
+}
+
+func NewBM25Similarity() *BM25Similarity {
+	return &BM25Similarity{K1: 1.2, B: 0.75}
+}
+
+func (s *BM25Similarity) Score(freq int, fieldLength int64, docFreq int64, field *kernel.FieldStatistics) float64 {
+	var docCount, avgLength float64 = 0, 1
+	if field != nil && field.DocCount > 0 {
+		docCount = float64(field.DocCount)
+		avgLength = float64(field.SumLength) / docCount
+	}
+	idf := math.Log(1 + (docCount-float64(docFreq)+0.5)/(float64(docFreq)+0.5))
+	tf := float64(freq)
+	norm := s.K1 * (1 - s.B + s.B*float64(fieldLength)/avgLength)
+	return idf * tf * (s.K1 + 1) / (tf + norm)
+}
+
+// ClassicSimilarity is the TF/IDF similarity
+type ClassicSimilarity struct {
+}
+
+func (s *ClassicSimilarity) Score(freq int, fieldLength int64, docFreq int64, field *kernel.FieldStatistics) float64 {
+	var docCount float64
+	if field != nil {
+		docCount = float64(field.DocCount)
+	}
+	idf := 1 + math.Log((docCount+1)/(float64(docFreq)+1))
+	norm := 1.0
+	if fieldLength > 0 {
+		norm = 1 / math.Sqrt(float64(fieldLength))
+	}
+	return math.Sqrt(float64(freq)) * idf * idf * norm
+}
+
+// BooleanSimilarity only tells whether the term matches
+type BooleanSimilarity struct {
+}
+
+func (s *BooleanSimilarity) Score(freq int, fieldLength int64, docFreq int64, field *kernel.FieldStatistics) float64 {
+	return 1
+}
+
+func newSimilarity(name string) (Similarity, error) {
+	switch name {
+	case "", SimilarityBM25:
+		return NewBM25Similarity(), nil
+	case SimilarityClassic:
+		return &ClassicSimilarity{}, nil
+	case SimilarityBoolean:
+		return &BooleanSimilarity{}, nil
+	default:
+		return nil, fmt.Errorf("unknown similarity %s", name)
+	}
+}
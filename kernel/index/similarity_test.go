@@ -0,0 +1,70 @@
+package index
+
+import (
+	"context"
+	"testing"
+
+	"github.com/tiglabs/baudengine/kernel"
+	"github.com/tiglabs/baudengine/proto/pspb"
+)
+
+func TestSearchScore(t *testing.T) {
+	store := open(t)
+	defer cleanup(t, store)
+	driver := NewIndexDriver(store)
+	docs := []*pspb.Document{
+		newTextDocument("1", map[uint32]string{1: "baud is a search engine written in go"}),
+		newTextDocument("2", map[uint32]string{1: "baud engine"}),
+		newTextDocument("3", map[uint32]string{1: "baud baud engine"}),
+		newTextDocument("4", map[uint32]string{1: "search"}),
+	}
+	for _, doc := range docs {
+		if err := driver.AddDocument(context.Background(), doc); err != nil {
+			t.Fatalf("add document failed, err %v", err)
+		}
+	}
+	result, err := driver.Search(context.Background(), &kernel.Request{
+		Query: &kernel.TermQuery{FieldId: 1, Term: []byte("baud")},
+	})
+	if err != nil {
+		t.Fatalf("search failed, err %v", err)
+	}
+	var docIDs []string
+	for i, hit := range result.Hits {
+		docIDs = append(docIDs, string(hit.DocID))
+		if i > 0 && hit.Score > result.Hits[i-1].Score {
+			t.Fatalf("hits are not ordered by score, %v", result.Hits)
+		}
+	}
+	if !equalDocIDs(docIDs, []string{"3", "2", "1"}) {
+		t.Fatalf("search failed, got %v", docIDs)
+	}
+
+	// rare terms score higher
+	result, err = driver.Search(context.Background(), &kernel.Request{
+		Query: &kernel.BooleanQuery{Should: []kernel.Query{
+			&kernel.TermQuery{FieldId: 1, Term: []byte("engine")},
+			&kernel.TermQuery{FieldId: 1, Term: []byte("search")},
+		}},
+	})
+	if err != nil {
+		t.Fatalf("search failed, err %v", err)
+	}
+	if string(result.Hits[0].DocID) != "4" {
+		t.Fatalf("search failed, top hit %s", result.Hits[0].DocID)
+	}
+
+	// boolean similarity scores all the matches the same
+	result, err = driver.Search(context.Background(), &kernel.Request{
+		Query:        &kernel.TermQuery{FieldId: 1, Term: []byte("baud")},
+		Similarities: map[uint32]string{1: SimilarityBoolean},
+	})
+	if err != nil {
+		t.Fatalf("search failed, err %v", err)
+	}
+	for _, hit := range result.Hits {
+		if hit.Score != 1 {
+			t.Fatalf("search failed, score %f", hit.Score)
+		}
+	}
+}
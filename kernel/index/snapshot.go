@@ -1,17 +1,26 @@
 package index
 
 import (
+	"bytes"
+	"context"
 	"errors"
 	"encoding/binary"
 
 	"github.com/tiglabs/baudengine/kernel/store/kvstore"
 	"github.com/tiglabs/baudengine/kernel"
+	"github.com/tiglabs/baudengine/kernel/mapping"
+	"github.com/tiglabs/baudengine/proto/metapb"
+	"github.com/tiglabs/baudengine/proto/pspb"
 )
 
 var _ kernel.Snapshot = &Snapshot{}
 
 type Snapshot struct {
 	snap     kvstore.Snapshot
+	// the time of the snapshot in unix milliseconds, the documents expired at it are not scanned
+	now int64
+	// the mapping of the space when the snapshot is opened
+	indexMapping mapping.IndexMapping
 }
 
 func (ds *Snapshot)GetApplyID() (uint64, error) {
@@ -31,6 +40,109 @@ func (ds *Snapshot)NewIterator() kernel.Iterator {
 	return &Iterator{iter: iter, filters: []Filter{&RaftFilter{}}}
 }
 
+// ScanDocuments pages through the stored field keys, the nested and the expired documents are skipped
+func (ds *Snapshot) ScanDocuments(ctx context.Context, after metapb.Key, size int, fields []uint32) ([]*kernel.Hit, error) {
+	start := []byte{byte(KEY_TYPE_F)}
+	if after != nil {
+		start = encodeStoreFieldKey(after, 0)
+	}
+	iter := ds.snap.RangeIterator(start, []byte{byte(KEY_TYPE_F) + 1})
+	if iter == nil {
+		return nil, errors.New("store driver error")
+	}
+	defer iter.Close()
+
+	var hits []*kernel.Hit
+	var current *kernel.Hit
+	skip := false
+	for ; iter.Valid(); iter.Next() {
+		docID, fieldId, err := decodeStoreFieldKey(iter.Key())
+		if err != nil {
+			return nil, err
+		}
+		if current == nil || !bytes.Equal(current.DocID, docID) {
+			if len(hits) == size {
+				break
+			}
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+			current = &kernel.Hit{DocID: append(metapb.Key(nil), docID...), Fields: make(map[uint32]pspb.FieldValue)}
+			if skip, err = ds.skipDocument(after, current.DocID); err != nil {
+				return nil, err
+			}
+			if !skip {
+				hits = append(hits, current)
+			}
+		}
+		if skip || (len(fields) > 0 && !containsField(fields, fieldId)) {
+			continue
+		}
+		field, err := decodeStoreField(fieldId, append([]byte(nil), iter.Value()...))
+		if err != nil {
+			return nil, err
+		}
+		current.Fields[fieldId] = field.FieldValue
+	}
+	return hits, nil
+}
+
+// skipDocument reports whether the document is the last one of the previous page, a nested document or expired
+func (ds *Snapshot) skipDocument(after, docID metapb.Key) (bool, error) {
+	if after != nil && bytes.Equal(after, docID) {
+		return true, nil
+	}
+	// the IDs of the nested documents have a 0x00 before the field ID
+	if bytes.IndexByte(docID, 0) >= 0 {
+		row, err := ds.snap.Get(encodeNestedDocKey(docID))
+		if err != nil {
+			return false, err
+		}
+		if len(row) > 0 {
+			return true, nil
+		}
+	}
+	return isDocExpired(ds.snap, docID, ds.now)
+}
+
+// MatchDocuments runs the query on the snapshot, the nested and the expired documents are not matched
+func (ds *Snapshot) MatchDocuments(ctx context.Context, query kernel.Query) ([]metapb.Key, error) {
+	if query == nil {
+		return nil, errors.New("empty query")
+	}
+	s := newSearcher(ctx, ds.snap, &kernel.Request{})
+	s.now = ds.now
+	s.indexMapping = ds.indexMapping
+	matches, err := s.search(query)
+	if err != nil {
+		return nil, err
+	}
+	if matches, err = s.rootMatches(matches); err != nil {
+		return nil, err
+	}
+	if matches, err = s.liveMatches(matches); err != nil {
+		return nil, err
+	}
+	docIDs := make([]metapb.Key, len(matches))
+	for i, m := range matches {
+		docIDs[i] = m.docID
+	}
+	return docIDs, nil
+}
+
+func (ds *Snapshot) DocVersion(docID metapb.Key) (kernel.DocVersion, bool, error) {
+	return readDocVersion(ds.snap, docID)
+}
+
+func containsField(fields []uint32, fieldId uint32) bool {
+	for _, f := range fields {
+		if f == fieldId {
+			return true
+		}
+	}
+	return false
+}
+
 func (ds *Snapshot)Close() error {
 	return ds.snap.Close()
 }
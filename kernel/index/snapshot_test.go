@@ -0,0 +1,119 @@
+package index
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/tiglabs/baudengine/kernel"
+	"github.com/tiglabs/baudengine/util/encoding"
+)
+
+func TestScanDocuments(t *testing.T) {
+	store := open(t)
+	defer cleanup(t, store)
+	driver := NewIndexDriver(store)
+	driver.now = func() time.Time { return time.Unix(1, 0) }
+	for _, id := range []string{"d", "a", "c", "b"} {
+		doc := newTextDocument(id, map[uint32]string{1: "title " + id, 2: "body " + id})
+		if id == "c" {
+			doc.ExpireAt = 500
+		}
+		if err := driver.AddDocument(context.Background(), doc); err != nil {
+			t.Fatalf("add document failed, err %v", err)
+		}
+	}
+	snap, err := driver.NewSnapshot()
+	if err != nil {
+		t.Fatalf("new snapshot failed, err %v", err)
+	}
+	defer snap.Close()
+	// the documents written after the snapshot are not scanned
+	if err := driver.AddDocument(context.Background(), newTextDocument("e", map[uint32]string{1: "title e"})); err != nil {
+		t.Fatalf("add document failed, err %v", err)
+	}
+
+	var after []byte
+	var pages [][]string
+	for {
+		hits, err := snap.ScanDocuments(context.Background(), after, 2, []uint32{2})
+		if err != nil {
+			t.Fatalf("scan documents failed, err %v", err)
+		}
+		if len(hits) == 0 {
+			break
+		}
+		var page []string
+		for _, hit := range hits {
+			if len(hit.Fields) != 1 {
+				t.Fatalf("document %s has fields %v, expect field 2", hit.DocID, hit.Fields)
+			}
+			_, body, err := encoding.DecodeBytesValue(hit.Fields[2].Data)
+			if err != nil || string(body) != "body "+string(hit.DocID) {
+				t.Fatalf("document %s has body %s, err %v", hit.DocID, body, err)
+			}
+			page = append(page, string(hit.DocID))
+		}
+		pages = append(pages, page)
+		after = hits[len(hits)-1].DocID
+	}
+	if fmt.Sprint(pages) != "[[a b] [d]]" {
+		t.Fatalf("scan documents failed, expect [[a b] [d]], got %v", pages)
+	}
+}
+
+func TestMatchDocuments(t *testing.T) {
+	store := open(t)
+	defer cleanup(t, store)
+	driver := NewIndexDriver(store)
+	driver.now = func() time.Time { return time.Unix(1, 0) }
+	for _, id := range []string{"d", "a", "c", "b"} {
+		doc := newTextDocument(id, map[uint32]string{1: "title " + id, 2: "body " + id})
+		if id == "c" {
+			doc.ExpireAt = 500
+		}
+		if err := driver.AddDocument(context.Background(), doc); err != nil {
+			t.Fatalf("add document failed, err %v", err)
+		}
+	}
+	snap, err := driver.NewSnapshot()
+	if err != nil {
+		t.Fatalf("new snapshot failed, err %v", err)
+	}
+	defer snap.Close()
+	// the documents written after the snapshot are not matched
+	if err := driver.AddDocument(context.Background(), newTextDocument("e", map[uint32]string{1: "title e"})); err != nil {
+		t.Fatalf("add document failed, err %v", err)
+	}
+	if _, err := driver.DeleteDocument(context.Background(), []byte("a")); err != nil {
+		t.Fatalf("delete document failed, err %v", err)
+	}
+
+	tests := []struct {
+		query  kernel.Query
+		expect string
+	}{
+		{&kernel.MatchAllQuery{}, "[a b d]"},
+		{&kernel.TermQuery{FieldId: 1, Term: []byte("title")}, "[a b d]"},
+		{&kernel.TermQuery{FieldId: 2, Term: []byte("b")}, "[b]"},
+		{&kernel.TermQuery{FieldId: 2, Term: []byte("e")}, "[]"},
+	}
+	for _, test := range tests {
+		docIDs, err := snap.MatchDocuments(context.Background(), test.query)
+		if err != nil {
+			t.Fatalf("match documents failed, err %v", err)
+		}
+		var ids []string
+		for _, docID := range docIDs {
+			ids = append(ids, string(docID))
+		}
+		if fmt.Sprint(ids) != test.expect {
+			t.Fatalf("query %v matched %v, expect %s", test.query, ids, test.expect)
+		}
+	}
+	// the version of the document deleted after the snapshot
+	if _, found, err := snap.DocVersion([]byte("a")); err != nil || !found {
+		t.Fatalf("document a version not found in snapshot, err %v", err)
+	}
+}
@@ -0,0 +1,158 @@
+package index
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/tiglabs/baudengine/kernel"
+	"github.com/tiglabs/baudengine/proto/metapb"
+	"github.com/tiglabs/baudengine/proto/pspb"
+	"github.com/tiglabs/baudengine/util/encoding"
+)
+
+// sortMatches orders the matches by the sort fields, the documents missing a sort value are put last
+func (s *searcher) sortMatches(matches []*docMatch, sortFields []kernel.SortField) error {
+	values := make(map[*docMatch][]interface{}, len(matches))
+	for _, m := range matches {
+		docValues := make([]interface{}, len(sortFields))
+		for i, sf := range sortFields {
+			if sf.FieldId == 0 {
+				docValues[i] = m.score
+				continue
+			}
+			value, found, err := s.docFieldValue(m.docID, sf.FieldId)
+			if err != nil {
+				return err
+			}
+			if !found {
+				continue
+			}
+			if sf.GeoDistance != nil {
+				distance, err := minGeoDistance(value.Data, sf.GeoDistance)
+				if err != nil {
+					return err
+				}
+				docValues[i] = distance
+				continue
+			}
+			docValues[i] = decodeSortValue(value.Data)
+		}
+		values[m] = docValues
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		vi, vj := values[matches[i]], values[matches[j]]
+		for k, sf := range sortFields {
+			switch {
+			case vi[k] == nil && vj[k] == nil:
+				continue
+			case vi[k] == nil:
+				return false
+			case vj[k] == nil:
+				return true
+			}
+			c := compareSortValue(vi[k], vj[k])
+			if c == 0 {
+				continue
+			}
+			if sf.Reverse {
+				return c > 0
+			}
+			return c < 0
+		}
+		return false
+	})
+	return nil
+}
+
+// docFieldValue reads the value of the field from its doc values, or from the stored field if no doc values
+func (s *searcher) docFieldValue(docID metapb.Key, fieldId uint32) (pspb.FieldValue, bool, error) {
+	value, found, err := newDocValuesReader(s.tx, fieldId).Get(docID)
+	if err != nil || found {
+		return value, found, err
+	}
+	row, err := s.tx.Get(encodeStoreFieldKey(docID, fieldId))
+	if err != nil || len(row) == 0 {
+		return pspb.FieldValue{}, false, err
+	}
+	field, err := decodeStoreField(fieldId, row)
+	if err != nil {
+		return pspb.FieldValue{}, false, err
+	}
+	return field.FieldValue, true, nil
+}
+
+// decodeSortValue returns the first value of the field data, or the raw data when it is not value encoded
+func decodeSortValue(data []byte) interface{} {
+	if len(data) == 0 {
+		return nil
+	}
+	_, _, _, typ, err := encoding.DecodeValueTag(data)
+	if err != nil {
+		return []byte(data)
+	}
+	switch typ {
+	case encoding.Int:
+		if _, v, err := encoding.DecodeIntValue(data); err == nil {
+			return v
+		}
+	case encoding.Float:
+		if _, v, err := encoding.DecodeFloatValue(data); err == nil {
+			return v
+		}
+	case encoding.Bytes:
+		if _, v, err := encoding.DecodeBytesValue(data); err == nil {
+			return v
+		}
+	case encoding.True, encoding.False:
+		if _, v, err := encoding.DecodeBoolValue(data); err == nil {
+			return v
+		}
+	}
+	return []byte(data)
+}
+
+func compareSortValue(a, b interface{}) int {
+	switch va := a.(type) {
+	case int64:
+		switch vb := b.(type) {
+		case int64:
+			return compareNumber(float64(va), float64(vb))
+		case float64:
+			return compareNumber(float64(va), vb)
+		}
+	case float64:
+		switch vb := b.(type) {
+		case int64:
+			return compareNumber(va, float64(vb))
+		case float64:
+			return compareNumber(va, vb)
+		}
+	case bool:
+		if vb, ok := b.(bool); ok {
+			switch {
+			case va == vb:
+				return 0
+			case !va:
+				return -1
+			default:
+				return 1
+			}
+		}
+	case []byte:
+		if vb, ok := b.([]byte); ok {
+			return bytes.Compare(va, vb)
+		}
+	}
+	return 0
+}
+
+func compareNumber(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,56 @@
+package index
+
+import (
+	"context"
+	"testing"
+
+	"github.com/tiglabs/baudengine/kernel"
+	"github.com/tiglabs/baudengine/proto/pspb"
+	"github.com/tiglabs/baudengine/util/encoding"
+)
+
+func TestSearchSort(t *testing.T) {
+	store := open(t)
+	defer cleanup(t, store)
+	driver := NewIndexDriver(store)
+	ages := map[string]int64{"1": 30, "2": 10, "3": 20}
+	for docID, age := range ages {
+		doc := newTextDocument(docID, map[uint32]string{1: "baud"})
+		field := pspb.Field{}
+		field.Id = 2
+		field.Type = pspb.ValueType_INT
+		field.Data = encoding.EncodeIntValue(nil, 0, age)
+		field.Desc = pspb.FieldDesc{Stored: true}
+		doc.Fields = append(doc.Fields, field)
+		if err := driver.AddDocument(context.Background(), doc); err != nil {
+			t.Fatalf("add document failed, err %v", err)
+		}
+	}
+	if err := driver.AddDocument(context.Background(), newTextDocument("4", map[uint32]string{1: "baud"})); err != nil {
+		t.Fatalf("add document failed, err %v", err)
+	}
+
+	tests := []struct {
+		sort   []kernel.SortField
+		docIDs []string
+	}{
+		{[]kernel.SortField{{FieldId: 2}}, []string{"2", "3", "1", "4"}},
+		{[]kernel.SortField{{FieldId: 2, Reverse: true}}, []string{"1", "3", "2", "4"}},
+	}
+	for i, test := range tests {
+		result, err := driver.Search(context.Background(), &kernel.Request{
+			Query: &kernel.TermQuery{FieldId: 1, Term: []byte("baud")},
+			Sort:  test.sort,
+		})
+		if err != nil {
+			t.Fatalf("search failed, err %v", err)
+		}
+		var docIDs []string
+		for _, hit := range result.Hits {
+			docIDs = append(docIDs, string(hit.DocID))
+		}
+		if !equalDocIDs(docIDs, test.docIDs) {
+			t.Fatalf("test %d failed, expect %v, got %v", i, test.docIDs, docIDs)
+		}
+	}
+}
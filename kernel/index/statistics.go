@@ -0,0 +1,83 @@
+package index
+
+import (
+	"context"
+
+	"github.com/tiglabs/baudengine/kernel"
+)
+
+// Statistics returns the local statistics of the fields and terms of the query,
+// the statistics of all the partitions can be merged and passed to Search for consistent scores.
+func (r *IndexDriver) Statistics(ctx context.Context, query kernel.Query) (*kernel.Statistics, error) {
+	tx, err := r.store.NewTransaction(false)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	s := newSearcher(ctx, tx, &kernel.Request{})
+	stats := kernel.NewStatistics()
+	for fieldId, terms := range queryTerms(query, nil) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		fieldStats, err := s.fieldStatistics(fieldId)
+		if err != nil {
+			return nil, err
+		}
+		stats.AddField(fieldId, fieldStats.DocCount, fieldStats.SumLength)
+		for _, term := range terms {
+			docFreq, err := s.docFreq(fieldId, term)
+			if err != nil {
+				return nil, err
+			}
+			stats.AddDocFreq(fieldId, term, docFreq)
+		}
+	}
+	return stats, nil
+}
+
+// queryTerms returns the terms of the query by field, the fields of the queries without known terms
+// have no term, their terms are expanded in the search
+func queryTerms(query kernel.Query, terms map[uint32][][]byte) map[uint32][][]byte {
+	if terms == nil {
+		terms = make(map[uint32][][]byte)
+	}
+	switch q := query.(type) {
+	case *kernel.TermQuery:
+		terms[q.FieldId] = append(terms[q.FieldId], q.Term)
+	case *kernel.TermsQuery:
+		terms[q.FieldId] = append(terms[q.FieldId], q.Terms...)
+	case *kernel.PhraseQuery:
+		terms[q.FieldId] = append(terms[q.FieldId], q.Terms...)
+	case *kernel.PrefixQuery:
+		terms[q.FieldId] = terms[q.FieldId]
+	case *kernel.WildcardQuery:
+		terms[q.FieldId] = terms[q.FieldId]
+	case *kernel.RegexpQuery:
+		terms[q.FieldId] = terms[q.FieldId]
+	case *kernel.FuzzyQuery:
+		terms[q.FieldId] = terms[q.FieldId]
+	case *kernel.RangeQuery:
+		terms[q.FieldId] = terms[q.FieldId]
+	case *kernel.GeoBoundingBoxQuery:
+		terms[q.FieldId] = terms[q.FieldId]
+	case *kernel.GeoDistanceQuery:
+		terms[q.FieldId] = terms[q.FieldId]
+	case *kernel.GeoPolygonQuery:
+		terms[q.FieldId] = terms[q.FieldId]
+	case *kernel.BooleanQuery:
+		for _, sub := range q.Must {
+			queryTerms(sub, terms)
+		}
+		for _, sub := range q.Should {
+			queryTerms(sub, terms)
+		}
+		for _, sub := range q.MustNot {
+			queryTerms(sub, terms)
+		}
+	case *kernel.NestedQuery:
+		queryTerms(q.Query, terms)
+	}
+	return terms
+}
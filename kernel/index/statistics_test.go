@@ -0,0 +1,94 @@
+package index
+
+import (
+	"context"
+	"testing"
+
+	"github.com/tiglabs/baudengine/kernel"
+	"github.com/tiglabs/baudengine/proto/pspb"
+)
+
+func TestStatistics(t *testing.T) {
+	store := open(t)
+	defer cleanup(t, store)
+	driver := NewIndexDriver(store)
+	docs := []*pspb.Document{
+		newTextDocument("1", map[uint32]string{1: "hello baud"}),
+		newTextDocument("2", map[uint32]string{1: "hello world engine"}),
+	}
+	for _, doc := range docs {
+		if err := driver.AddDocument(context.Background(), doc); err != nil {
+			t.Fatalf("add document failed, err %v", err)
+		}
+	}
+	query := &kernel.TermsQuery{FieldId: 1, Terms: [][]byte{[]byte("hello"), []byte("baud")}}
+	checkStatistics := func(docCount, sumLength, hello, baud int64) {
+		stats, err := driver.Statistics(context.Background(), query)
+		if err != nil {
+			t.Fatalf("statistics failed, err %v", err)
+		}
+		fs := stats.Fields[1]
+		if fs.DocCount != docCount || fs.SumLength != sumLength {
+			t.Fatalf("field statistics failed, expect %d %d, got %d %d", docCount, sumLength, fs.DocCount, fs.SumLength)
+		}
+		if stats.DocFreqs[1]["hello"] != hello || stats.DocFreqs[1]["baud"] != baud {
+			t.Fatalf("doc frequency failed, got %v", stats.DocFreqs[1])
+		}
+	}
+	checkStatistics(2, 5, 2, 1)
+
+	if _, err := driver.UpdateDocument(context.Background(), newTextDocument("2", map[uint32]string{1: "baud"}), false); err != nil {
+		t.Fatalf("update document failed, err %v", err)
+	}
+	checkStatistics(2, 3, 1, 2)
+
+	if _, err := driver.DeleteDocument(context.Background(), []byte("1")); err != nil {
+		t.Fatalf("delete document failed, err %v", err)
+	}
+	checkStatistics(1, 1, 0, 1)
+
+	// the global statistics make the scores consistent across partitions
+	global := kernel.NewStatistics()
+	global.AddField(1, 100, 200)
+	global.AddDocFreq(1, []byte("baud"), 50)
+	local, err := driver.Statistics(context.Background(), query)
+	if err != nil {
+		t.Fatalf("statistics failed, err %v", err)
+	}
+	global.Merge(local)
+	result, err := driver.Search(context.Background(), &kernel.Request{
+		Query:      &kernel.TermQuery{FieldId: 1, Term: []byte("baud")},
+		Statistics: global,
+	})
+	if err != nil {
+		t.Fatalf("search failed, err %v", err)
+	}
+	expect := NewBM25Similarity().Score(1, 1, 51, &kernel.FieldStatistics{DocCount: 101, SumLength: 201})
+	if len(result.Hits) != 1 || result.Hits[0].Score != expect {
+		t.Fatalf("search with statistics failed, expect %f, got %v", expect, result.Hits)
+	}
+
+	// the terms expanded by the fuzzy query are not known by the statistics, they are scored by the local
+	// document frequencies, and the fields missing in the statistics by the local field statistics
+	fuzzy := &kernel.FuzzyQuery{FieldId: 1, Term: []byte("bauds")}
+	fuzzyStats, err := driver.Statistics(context.Background(), fuzzy)
+	if err != nil {
+		t.Fatalf("statistics failed, err %v", err)
+	}
+	if fs := fuzzyStats.Fields[1]; fs == nil || fs.DocCount != 1 {
+		t.Fatalf("field statistics of fuzzy query failed, got %v", fs)
+	}
+	localResult, err := driver.Search(context.Background(), &kernel.Request{Query: fuzzy})
+	if err != nil {
+		t.Fatalf("search failed, err %v", err)
+	}
+	for _, stats := range []*kernel.Statistics{fuzzyStats, kernel.NewStatistics()} {
+		result, err := driver.Search(context.Background(), &kernel.Request{Query: fuzzy, Statistics: stats})
+		if err != nil {
+			t.Fatalf("search failed, err %v", err)
+		}
+		if len(result.Hits) != 1 || len(localResult.Hits) != 1 || result.Hits[0].Score <= 0 || result.Hits[0].Score != localResult.Hits[0].Score {
+			t.Fatalf("search expanded terms with statistics failed, expect %v, got %v", localResult.Hits, result.Hits)
+		}
+	}
+}
@@ -0,0 +1,154 @@
+package index
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/tiglabs/baudengine/kernel"
+	"github.com/tiglabs/baudengine/proto/metapb"
+)
+
+// TermVectors returns the indexed terms of the fields of the document, they are read from the term list of the field,
+// the frequencies in the term index and the positions, so nothing more is written for the term vectors
+func (r *IndexDriver) TermVectors(ctx context.Context, req *kernel.TermVectorsRequest) (*kernel.TermVectorsResult, error) {
+	tx, err := r.store.NewTransaction(false)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	s := newSearcher(ctx, tx, &kernel.Request{})
+	found, err := s.docExists(req.DocID)
+	if err != nil {
+		return nil, err
+	}
+	result := &kernel.TermVectorsResult{Found: found, Fields: make(map[uint32][]*kernel.TermVector)}
+	if !found {
+		return result, nil
+	}
+	fields := req.Fields
+	if len(fields) == 0 {
+		if fields, err = s.indexedFields(req.DocID); err != nil {
+			return nil, err
+		}
+	}
+	for _, fieldId := range fields {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		vectors, err := s.termVectors(req.DocID, fieldId, req.TermStatistics)
+		if err != nil {
+			return nil, err
+		}
+		if len(vectors) > 0 {
+			result.Fields[fieldId] = vectors
+		}
+	}
+	return result, nil
+}
+
+// docExists reports whether the document has stored fields or indexed terms
+func (s *searcher) docExists(docID metapb.Key) (bool, error) {
+	for _, prefix := range [][]byte{encodeStoreFieldKey(docID, 0), encodeFieldTermAbstractKey(docID, 0)} {
+		iter := s.tx.PrefixIterator(prefix)
+		if iter == nil {
+			return false, errors.New("store driver error")
+		}
+		valid := iter.Valid()
+		iter.Close()
+		if valid {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// indexedFields returns the fields of the document with the indexed terms
+func (s *searcher) indexedFields(docID metapb.Key) ([]uint32, error) {
+	iter := s.tx.PrefixIterator(encodeFieldTermAbstractKey(docID, 0))
+	if iter == nil {
+		return nil, errors.New("store driver error")
+	}
+	defer iter.Close()
+	var fields []uint32
+	for ; iter.Valid(); iter.Next() {
+		_, fieldId, err := decodeFieldTermAbstractKey(iter.Key())
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, fieldId)
+	}
+	return fields, nil
+}
+
+// termVectors returns the terms of the field of the document in term order
+func (s *searcher) termVectors(docID metapb.Key, fieldId uint32, termStatistics bool) ([]*kernel.TermVector, error) {
+	value, err := s.tx.Get(encodeFieldTermAbstractKey(docID, fieldId))
+	if err != nil {
+		return nil, err
+	}
+	if len(value) == 0 {
+		return nil, nil
+	}
+	terms, err := decodeFieldTermAbstractValue(value)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(terms, func(i, j int) bool { return bytes.Compare(terms[i], terms[j]) < 0 })
+	vectors := make([]*kernel.TermVector, 0, len(terms))
+	for _, term := range terms {
+		row, err := s.tx.Get(encodeIndexKey(docID, fieldId, term))
+		if err != nil {
+			return nil, err
+		}
+		freq, err := decodeIndex(row)
+		if err != nil {
+			return nil, err
+		}
+		positions, err := s.termPositionOffsets(docID, fieldId, term)
+		if err != nil {
+			return nil, err
+		}
+		vector := &kernel.TermVector{Term: term, Freq: freq, Positions: positions}
+		if termStatistics {
+			if vector.DocFreq, err = s.docFreq(fieldId, term); err != nil {
+				return nil, err
+			}
+			if vector.TotalTermFreq, err = s.totalTermFreq(fieldId, term); err != nil {
+				return nil, err
+			}
+		}
+		vectors = append(vectors, vector)
+	}
+	return vectors, nil
+}
+
+// termPositionOffsets returns the positions of the term in the field of the document with the offsets
+func (s *searcher) termPositionOffsets(docID metapb.Key, fieldId uint32, term []byte) ([]kernel.TermPosition, error) {
+	iter := s.tx.PrefixIterator(encodeIndexPositionKey(docID, fieldId, term, 0))
+	if iter == nil {
+		return nil, errors.New("store driver error")
+	}
+	defer iter.Close()
+	var positions []kernel.TermPosition
+	for ; iter.Valid(); iter.Next() {
+		pos, start, end, err := decodeIndexPosition(iter.Value())
+		if err != nil {
+			return nil, err
+		}
+		positions = append(positions, kernel.TermPosition{Position: pos, Start: start, End: end})
+	}
+	return positions, nil
+}
+
+// totalTermFreq returns the sum of the frequencies of the term in the documents, kept with the document frequency
+func (s *searcher) totalTermFreq(fieldId uint32, term []byte) (int64, error) {
+	value, err := s.tx.Get(encodeTermDocFreqKey(fieldId, term))
+	if err != nil {
+		return 0, err
+	}
+	_, totalTermFreq, err := decodeTermStats(value)
+	return totalTermFreq, err
+}
@@ -0,0 +1,78 @@
+package index
+
+import (
+	"context"
+	"testing"
+
+	"github.com/tiglabs/baudengine/kernel"
+	"github.com/tiglabs/baudengine/proto/pspb"
+)
+
+func TestTermVectors(t *testing.T) {
+	store := open(t)
+	defer cleanup(t, store)
+	driver := NewIndexDriver(store)
+	doc := newTextDocument("1", map[uint32]string{1: "the quick the fox"})
+	// positions without offsets
+	noOffsets := newTextDocument("1", map[uint32]string{2: "a lazy fox"})
+	noOffsets.Fields[0].Desc.IndexOption = pspb.IndexOption_DOCS_FREQ_POSITION
+	doc.Fields = append(doc.Fields, noOffsets.Fields...)
+	for _, doc := range []*pspb.Document{doc, newTextDocument("2", map[uint32]string{1: "the dog"})} {
+		if err := driver.AddDocument(context.Background(), doc); err != nil {
+			t.Fatalf("add document failed, err %v", err)
+		}
+	}
+
+	result, err := driver.TermVectors(context.Background(), &kernel.TermVectorsRequest{DocID: []byte("1"), TermStatistics: true})
+	if err != nil {
+		t.Fatalf("term vectors failed, err %v", err)
+	}
+	if !result.Found || len(result.Fields) != 2 {
+		t.Fatalf("term vectors failed, got %v", result)
+	}
+	var terms []string
+	for _, vector := range result.Fields[1] {
+		terms = append(terms, string(vector.Term))
+	}
+	if !equalDocIDs(terms, []string{"fox", "quick", "the"}) {
+		t.Fatalf("term vectors terms failed, got %v", terms)
+	}
+	the := result.Fields[1][2]
+	if the.Freq != 2 || the.DocFreq != 2 || the.TotalTermFreq != 3 {
+		t.Fatalf("term vectors freq failed, got %d %d %d", the.Freq, the.DocFreq, the.TotalTermFreq)
+	}
+	if len(the.Positions) != 2 || the.Positions[0] != (kernel.TermPosition{Position: 1, Start: 0, End: 3}) ||
+		the.Positions[1] != (kernel.TermPosition{Position: 3, Start: 10, End: 13}) {
+		t.Fatalf("term vectors positions failed, got %v", the.Positions)
+	}
+	fox := result.Fields[2][1]
+	if string(fox.Term) != "fox" || len(fox.Positions) != 1 || fox.Positions[0] != (kernel.TermPosition{Position: 3}) {
+		t.Fatalf("term vectors without offsets failed, got %v", fox)
+	}
+
+	result, err = driver.TermVectors(context.Background(), &kernel.TermVectorsRequest{DocID: []byte("1"), Fields: []uint32{2}})
+	if err != nil {
+		t.Fatalf("term vectors failed, err %v", err)
+	}
+	if len(result.Fields) != 1 || len(result.Fields[2]) != 3 || result.Fields[2][0].DocFreq != 0 {
+		t.Fatalf("term vectors of fields failed, got %v", result.Fields)
+	}
+
+	result, err = driver.TermVectors(context.Background(), &kernel.TermVectorsRequest{DocID: []byte("3")})
+	if err != nil || result.Found {
+		t.Fatalf("term vectors of missing document failed, got %v %v", result, err)
+	}
+
+	// the term statistics are kept when the documents are deleted
+	if _, err := driver.DeleteDocument(context.Background(), []byte("2")); err != nil {
+		t.Fatalf("delete document failed, err %v", err)
+	}
+	result, err = driver.TermVectors(context.Background(), &kernel.TermVectorsRequest{DocID: []byte("1"), Fields: []uint32{1}, TermStatistics: true})
+	if err != nil {
+		t.Fatalf("term vectors failed, err %v", err)
+	}
+	the = result.Fields[1][2]
+	if the.DocFreq != 1 || the.TotalTermFreq != 2 {
+		t.Fatalf("term vectors freq after delete failed, got %d %d", the.DocFreq, the.TotalTermFreq)
+	}
+}
@@ -0,0 +1,76 @@
+package index
+
+import (
+	"github.com/tiglabs/baudengine/kernel"
+	"github.com/tiglabs/baudengine/proto/metapb"
+)
+
+// batchVersion is the version of the document written by the batch, the version of the
+// deleted document is kept, so that the document written again in the batch continues it
+type batchVersion struct {
+	version kernel.DocVersion
+	deleted bool
+}
+
+func (r *IndexDriver) DocVersion(docID metapb.Key) (kernel.DocVersion, bool, error) {
+	return readDocVersion(r.store, docID)
+}
+
+func (b *Batch) DocVersion(docID metapb.Key) (kernel.DocVersion, bool, error) {
+	if v, ok := b.versions[string(docID)]; ok {
+		return v.version, !v.deleted, nil
+	}
+	return readDocVersion(b.reader(), docID)
+}
+
+func readDocVersion(store kvReader, docID metapb.Key) (kernel.DocVersion, bool, error) {
+	var v kernel.DocVersion
+	value, err := store.Get(encodeDocVersionKey(docID))
+	if err != nil || len(value) == 0 {
+		return v, false, err
+	}
+	v.Version, v.SeqNo, err = decodeDocVersion(value)
+	if err != nil {
+		return v, false, err
+	}
+	return v, true, nil
+}
+
+// writeVersion increases the version of the document written by the batch, the version is
+// at least the apply ID, so that the document deleted and written again has a greater version
+// unless it is written more times than the raft indexes between the writes
+func (b *Batch) writeVersion(docID metapb.Key) error {
+	v, ok := b.versions[string(docID)]
+	if !ok {
+		current, _, err := readDocVersion(b.reader(), docID)
+		if err != nil {
+			return err
+		}
+		v = &batchVersion{version: current}
+		b.versions[string(docID)] = v
+	}
+	v.version.Version++
+	if v.version.Version < b.applyID {
+		v.version.Version = b.applyID
+	}
+	v.version.SeqNo = b.applyID
+	v.deleted = false
+	b.batch.Set(encodeDocVersionKey(docID), encodeDocVersion(v.version.Version, v.version.SeqNo))
+	return nil
+}
+
+// deleteVersion deletes the version of the deleted document
+func (b *Batch) deleteVersion(docID metapb.Key) error {
+	v, ok := b.versions[string(docID)]
+	if !ok {
+		current, _, err := readDocVersion(b.reader(), docID)
+		if err != nil {
+			return err
+		}
+		v = &batchVersion{version: current}
+		b.versions[string(docID)] = v
+	}
+	v.deleted = true
+	b.batch.Delete(encodeDocVersionKey(docID))
+	return nil
+}
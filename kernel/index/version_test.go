@@ -0,0 +1,74 @@
+package index
+
+import (
+	"context"
+	"testing"
+
+	"github.com/tiglabs/baudengine/kernel"
+	"github.com/tiglabs/baudengine/proto/pspb"
+)
+
+func TestDocVersion(t *testing.T) {
+	store := open(t)
+	defer cleanup(t, store)
+	driver := NewIndexDriver(store)
+	doc := func(id string) *pspb.Document {
+		return newTextDocument(id, map[uint32]string{1: "quick fox"})
+	}
+	expect := func(b kernel.Batch, id string, version, seqNo uint64, found bool) {
+		var v kernel.DocVersion
+		var ok bool
+		var err error
+		if b == nil {
+			v, ok, err = driver.DocVersion([]byte(id))
+		} else {
+			v, ok, err = b.DocVersion([]byte(id))
+		}
+		if err != nil || ok != found || v.Version != version || v.SeqNo != seqNo {
+			t.Fatalf("version of %s failed, expect %d %d %v, got %v %v err %v", id, version, seqNo, found, v, ok, err)
+		}
+	}
+
+	b := driver.NewWriteBatch()
+	b.SetApplyID(5)
+	if err := b.AddDocument(context.Background(), doc("1")); err != nil {
+		t.Fatalf("add document failed, err %v", err)
+	}
+	expect(b, "1", 5, 5, true)
+	if _, err := b.UpdateDocument(context.Background(), doc("1"), false); err != nil {
+		t.Fatalf("update document failed, err %v", err)
+	}
+	expect(b, "1", 6, 5, true)
+	if err := b.AddDocument(context.Background(), doc("2")); err != nil {
+		t.Fatalf("add document failed, err %v", err)
+	}
+	expect(nil, "1", 0, 0, false)
+	if err := b.Commit(); err != nil {
+		t.Fatalf("commit failed, err %v", err)
+	}
+	expect(nil, "1", 6, 5, true)
+	expect(nil, "2", 5, 5, true)
+
+	b = driver.NewWriteBatch()
+	b.SetApplyID(9)
+	if _, err := b.MergeDocument(context.Background(), &kernel.MergeRequest{DocID: []byte("1"), Partial: []byte(`{"title": "lazy dog"}`)}); err == nil {
+		t.Fatalf("merge without mapping should fail")
+	}
+	if _, err := b.DeleteDocument(context.Background(), []byte("2")); err != nil {
+		t.Fatalf("delete document failed, err %v", err)
+	}
+	expect(b, "2", 5, 5, false)
+	if err := b.AddDocument(context.Background(), doc("2")); err != nil {
+		t.Fatalf("add document failed, err %v", err)
+	}
+	expect(b, "2", 9, 9, true)
+	if _, err := b.DeleteDocument(context.Background(), []byte("1")); err != nil {
+		t.Fatalf("delete document failed, err %v", err)
+	}
+	if err := b.Commit(); err != nil {
+		t.Fatalf("commit failed, err %v", err)
+	}
+	expect(nil, "1", 0, 0, false)
+	expect(nil, "2", 9, 9, true)
+	expect(nil, "3", 0, 0, false)
+}
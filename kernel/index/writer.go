@@ -1,6 +1,7 @@
 package index
 
 import (
+	"bytes"
 	"encoding/binary"
 	"context"
 	"fmt"
@@ -13,6 +14,7 @@ import (
 	"github.com/tiglabs/baudengine/kernel/registry"
 	"github.com/tiglabs/baudengine/util/encoding"
 	"github.com/tiglabs/baudengine/kernel/analysis"
+	"github.com/tiglabs/baudengine/kernel/mapping"
 )
 
 func (w *IndexDriver) SetApplyID(applyID uint64) error {
@@ -25,27 +27,76 @@ func (w *IndexDriver) SetApplyID(applyID uint64) error {
 }
 
 func (w *IndexDriver) AddDocument(ctx context.Context, doc *pspb.Document) error {
-	return NewBatch(w.store).addDocument(ctx, doc, true)
+	return w.newBatch().addDocument(ctx, doc, true)
 }
 
 func (w *IndexDriver) UpdateDocument(ctx context.Context, doc *pspb.Document, upsert bool) (found bool, err error) {
-	return NewBatch(w.store).updateDocument(ctx, doc, upsert, true)
+	return w.newBatch().updateDocument(ctx, doc, upsert, true)
 }
 
 func (w *IndexDriver) DeleteDocument(ctx context.Context, docID metapb.Key) (int, error) {
-	return NewBatch(w.store).deleteDocument(ctx, docID, true)
+	return w.newBatch().deleteDocument(ctx, docID, true)
+}
+
+// the gaps between the values of a multi-valued field
+const (
+	positionGap = 100
+	offsetGap   = 1
+)
+
+type fieldStatsDelta struct {
+	docCount  int64
+	sumLength int64
+}
+
+type termStatsDelta struct {
+	docFreq       int64
+	totalTermFreq int64
 }
 
 type Batch struct {
 	batch   kvstore.KVBatch
 	tx kvstore.Transaction
 	store   kvstore.KVStore
+	// the statistics changed by the batch, applied when commit
+	fieldStats map[uint32]*fieldStatsDelta
+	termStats  map[string]*termStatsDelta
+	// analyzerNamed resolves the analyzer names of the fields
+	analyzerNamed func(name string) analysis.Analyzer
+	// currentMapping returns the mapping of the space for the partial updates
+	currentMapping func() mapping.IndexMapping
+	// the documents written by the operations not flushed into the transaction
+	dirty map[string]bool
+	// the raft index of the batch and the versions of the documents written by the batch
+	applyID  uint64
+	versions map[string]*batchVersion
+}
+
+// kvReader reads the store, or the write transaction after the batch is flushed into it
+type kvReader interface {
+	Get(key []byte) ([]byte, error)
+	PrefixIterator(prefix []byte) kvstore.KVIterator
 }
 
 var _ kernel.Batch = &Batch{}
 
 func NewBatch(store kvstore.KVStore) *Batch {
-	return &Batch{store: store, batch: store.NewKVBatch()}
+	return &Batch{
+		store:      store,
+		batch:      store.NewKVBatch(),
+		fieldStats: make(map[uint32]*fieldStatsDelta),
+		termStats:  make(map[string]*termStatsDelta),
+		analyzerNamed: registry.GetAnalyzer,
+		dirty:      make(map[string]bool),
+		versions:   make(map[string]*batchVersion),
+	}
+}
+
+func (b *Batch) reader() kvReader {
+	if b.tx != nil {
+		return b.tx
+	}
+	return b.store
 }
 
 func (b *Batch) SetApplyID(applyID uint64) error {
@@ -53,6 +104,7 @@ func (b *Batch) SetApplyID(applyID uint64) error {
 		var buff [8]byte
 		binary.BigEndian.PutUint64(buff[:], applyID)
 		b.batch.Set(RAFT_APPLY_ID, buff[:])
+		b.applyID = applyID
 	}
 	return nil
 }
@@ -63,67 +115,204 @@ func (b *Batch) AddDocument(ctx context.Context, doc *pspb.Document) error {
 
 func (b *Batch) addDocument(ctx context.Context, doc *pspb.Document, forceCommit bool) error {
 	// todo check doc ???
+	if err := b.addFields(doc.Id, doc.Fields); err != nil {
+		return err
+	}
+	if err := b.addNestedDocuments(doc.Nested); err != nil {
+		return err
+	}
+	if err := b.writeExpire(doc.Id, doc.ExpireAt); err != nil {
+		return err
+	}
+	if err := b.writeVersion(doc.Id); err != nil {
+		return err
+	}
+	b.dirty[string(doc.Id)] = true
+	if forceCommit {
+		return b.Commit()
+	}
+	return nil
+}
+
+// the nested objects are hidden documents, only found by the nested queries
+func (b *Batch) addNestedDocuments(nested []pspb.NestedDocument) error {
+	for i := range nested {
+		n := &nested[i]
+		docID := nestedDocID(n.Parent, n.Field, n.Offset)
+		if err := b.addFields(docID, n.Fields); err != nil {
+			return err
+		}
+		b.batch.Set(encodeNestedDocKey(docID), n.Parent)
+	}
+	return nil
+}
+
+// addFields indexes the fields of the document
+func (b *Batch) addFields(docID metapb.Key, fields []pspb.Field) error {
+	docValuesFields, err := b.indexFields(docID, fields)
+	if err != nil {
+		return err
+	}
+	if len(docValuesFields) > 0 {
+		b.batch.Set(encodeDocValuesAbstractKey(docID), encodeDocValuesAbstract(docValuesFields))
+	}
+	return nil
+}
+
+// indexFields indexes the fields and returns the fields with the doc values
+func (b *Batch) indexFields(docID metapb.Key, fields []pspb.Field) ([]uint32, error) {
 	// encode field
-	for _, field := range doc.Fields {
-		fk, fv, err := encodeStoreField(doc.Id, &field)
+	var docValuesFields []uint32
+	for _, field := range fields {
+		fk, fv, err := encodeStoreField(docID, &field)
 		if err != nil {
-			return err
+			return nil, err
+		}
+		// not stored field
+		if fk != nil {
+			b.batch.Set(fk, fv)
+		}
+		if field.Desc.DocValues {
+			dk, dv, err := encodeDocValues(docID, &field)
+			if err != nil {
+				return nil, err
+			}
+			b.batch.Set(dk, dv)
+			docValuesFields = append(docValuesFields, field.Id)
 		}
-        b.batch.Set(fk, fv)
 		// analysis field value
+		var tokens analysis.TokenSet
 		if field.Desc.Tokenized {
-			analyzer := registry.GetAnalyzer(field.Desc.Analyzer)
+			analyzer := b.analyzerNamed(field.Desc.Analyzer)
 			if analyzer == nil {
-				return fmt.Errorf("unknown analyzer %s", field.Desc.Analyzer)
+				return nil, fmt.Errorf("unknown analyzer %s", field.Desc.Analyzer)
+			}
+			tokens, err = analyzeFieldValues(analyzer, field.Data)
+		} else if field.Desc.IndexOption != pspb.IndexOption_NONE {
+			if field.Type == pspb.ValueType_GEO {
+				tokens, err = geoPointTokens(field.Data)
+			} else {
+				tokens, err = fieldValueTokens(field.Data)
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(tokens) > 0 {
+			var includeTermVectors bool
+			switch field.Desc.IndexOption {
+			case pspb.IndexOption_DOCS, pspb.IndexOption_DOCS_FREQ:
+				includeTermVectors = false
+			case pspb.IndexOption_DOCS_FREQ_POSITION, pspb.IndexOption_DOCS_FREQ_POSITION_OFFSET:
+				includeTermVectors = true
+			}
+			tokenFreq := analysis.TokenFrequency(tokens, includeTermVectors)
+			var terms [][]byte
+			terms = make([][]byte, 0, len(tokenFreq))
+			for _, tokenF := range tokenFreq {
+				indexKey, indexRow, err := encodeIndex(docID, field.Id, tokenF.Term, tokenF.Frequency())
+				if err != nil {
+					return nil, err
+				}
+				b.batch.Set(indexKey, indexRow)
+				if includeTermVectors {
+					for _, pos := range tokenF.Locations {
+						start, end := pos.Start, pos.End
+						// the offsets are only indexed for highlighting when required
+						if field.Desc.IndexOption != pspb.IndexOption_DOCS_FREQ_POSITION_OFFSET {
+							start, end = 0, 0
+						}
+						indexPosKey, indexPosRow, err := encodeIndexPosition(docID, field.Id, tokenF.Term, pos.Position, start, end)
+						if err != nil {
+							return nil, err
+						}
+						b.batch.Set(indexPosKey, indexPosRow)
+					}
+				}
+				terms = append(terms, tokenF.Term)
+				b.addTermStats(field.Id, tokenF.Term, 1, int64(tokenF.Frequency()))
+			}
+			fieldTermKey, fieldTermValue, err := encodeFieldTermAbstract(docID, field.Id, terms)
+			if err != nil {
+				return nil, err
 			}
-            data := []byte(field.Data)
-            for len(data) > 0 {
-            	var fData []byte
-            	data, fData, err = encoding.DecodeBytesValue(data)
-            	if err != nil {
-            		return err
-	            }
-	            tokens := analyzer.Analyze(fData)
-	            // TODO position gap
-	            var includeTermVectors bool
-	            switch field.Desc.IndexOption {
-	            case pspb.IndexOption_DOCS, pspb.IndexOption_DOCS_FREQ:
-		            includeTermVectors = false
-	            case pspb.IndexOption_DOCS_FREQ_POSITION, pspb.IndexOption_DOCS_FREQ_POSITION_OFFSET:
-		            includeTermVectors = true
-	            }
-	            tokenFreq := analysis.TokenFrequency(tokens, includeTermVectors)
-	            var terms [][]byte
-	            terms = make([][]byte, 0, len(tokenFreq))
-	            for _, tokenF := range tokenFreq {
-	            	indexKey, indexRow, err := encodeIndex(doc.Id, field.Id, tokenF.Term, tokenF.Frequency())
-	            	if err != nil {
-	            		return err
-		            }
-		            b.batch.Set(indexKey, indexRow)
-	            	if includeTermVectors {
-	            		for _, pos := range tokenF.Locations {
-				            indexPosKey, indexPosRow, err := encodeIndexPosition(doc.Id, field.Id, tokenF.Term, pos.Position, pos.Start, pos.End)
-				            if err != nil {
-					            return err
-				            }
-				            b.batch.Set(indexPosKey, indexPosRow)
-			            }
-		            }
-		            terms = append(terms, tokenF.Term)
-	            }
-	            fieldTermKey, fieldTermValue, err := encodeFieldTermAbstract(doc.Id, field.Id, terms)
-	            if err != nil {
-	            	return err
-	            }
-	            b.batch.Set(fieldTermKey, fieldTermValue)
-            }
+			b.batch.Set(fieldTermKey, fieldTermValue)
+			b.batch.Set(encodeFieldLengthKey(docID, field.Id), encodeCount(int64(len(tokens))))
+			b.addFieldStats(field.Id, 1, int64(len(tokens)))
 		}
 	}
-	if forceCommit {
-		return b.Commit()
+	return docValuesFields, nil
+}
+
+// fieldValueTokens returns a token for every value of the not tokenized field,
+// the terms of numeric and time values keep the order of the values for range queries
+func fieldValueTokens(data []byte) (analysis.TokenSet, error) {
+	var tokens analysis.TokenSet
+	for len(data) > 0 {
+		_, dataOffset, _, typ, err := encoding.DecodeValueTag(data)
+		if err != nil {
+			return nil, err
+		}
+		// null value has no term
+		if typ == encoding.Null {
+			data = data[dataOffset:]
+			continue
+		}
+		term, remaining, err := encodeValueTerm(data)
+		if err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, &analysis.Token{
+			Term:     term,
+			Position: len(tokens) + 1,
+			Type:     valueTokenType(typ),
+		})
+		data = remaining
 	}
-	return nil
+	return tokens, nil
+}
+
+func valueTokenType(typ encoding.Type) analysis.TokenType {
+	switch typ {
+	case encoding.Int, encoding.Float:
+		return analysis.Numeric
+	case encoding.True, encoding.False:
+		return analysis.Boolean
+	default:
+		return analysis.KeyWord
+	}
+}
+
+// analyzeFieldValues analyzes all the values of the field,
+// the positions and offsets of the values are continuous with a gap between two values
+func analyzeFieldValues(analyzer analysis.Analyzer, data []byte) (analysis.TokenSet, error) {
+	var (
+		tokens     analysis.TokenSet
+		lastPos    int
+		lastOffset int
+	)
+	for len(data) > 0 {
+		var (
+			value []byte
+			err   error
+		)
+		data, value, err = encoding.DecodeBytesValue(data)
+		if err != nil {
+			return nil, err
+		}
+		valueTokens := analyzer.Analyze(value)
+		for _, token := range valueTokens {
+			token.Position += lastPos
+			token.Start += lastOffset
+			token.End += lastOffset
+		}
+		if len(valueTokens) > 0 {
+			tokens = append(tokens, valueTokens...)
+			lastPos = valueTokens[len(valueTokens)-1].Position + positionGap
+		}
+		lastOffset += len(value) + offsetGap
+	}
+	return tokens, nil
 }
 
 func (b *Batch) UpdateDocument(ctx context.Context, doc *pspb.Document, upsert bool) (found bool, err error) {
@@ -131,8 +320,11 @@ func (b *Batch) UpdateDocument(ctx context.Context, doc *pspb.Document, upsert b
 }
 
 func (b *Batch) updateDocument(ctx context.Context, doc *pspb.Document, upsert bool, forceCommit bool) (found bool, err error) {
+	if err = b.flushDirty(doc.Id); err != nil {
+		return
+	}
 	// step 1. find doc
-	if isDocExist(b.store, []byte(doc.Id)) {
+	if isDocExist(b.reader(), []byte(doc.Id)) {
 		found = true
 	}
 	if !found && !upsert {
@@ -156,11 +348,47 @@ func (b *Batch) DeleteDocument(ctx context.Context, docID metapb.Key) (int, erro
 }
 
 func (b *Batch) deleteDocument(ctx context.Context, docID metapb.Key, forceCommit bool) (int, error) {
+	if err := b.flushDirty(docID); err != nil {
+		return 0, err
+	}
+	// the document may have no stored field, while its terms and doc values are still deleted
+	if !isDocExist(b.reader(), docID) {
+		return 0, nil
+	}
+	count := 1
+	if _, err := b.deleteStoredFields(docID); err != nil {
+		return 0, err
+	}
+	if err := b.deleteDocumentTerms(docID); err != nil {
+		return 0, err
+	}
+	if err := b.deleteDocValues(docID); err != nil {
+		return 0, err
+	}
+	if err := b.deleteNestedDocuments(docID, 0); err != nil {
+		return 0, err
+	}
+	if err := b.writeExpire(docID, 0); err != nil {
+		return 0, err
+	}
+	if err := b.deleteVersion(docID); err != nil {
+		return 0, err
+	}
+	b.dirty[string(docID)] = true
+	if forceCommit {
+		return count, b.Commit()
+	}
+	return count, nil
+}
+
+// deleteStoredFields returns the number of the stored fields deleted
+func (b *Batch) deleteStoredFields(docID metapb.Key) (int, error) {
 	prefixDocKey := encodeStoreFieldKey(docID, 0)
-	fieldIter := b.store.PrefixIterator(prefixDocKey)
+	fieldIter := b.reader().PrefixIterator(prefixDocKey)
 	if fieldIter == nil {
 		return 0, errors.New("store driver error")
 	}
+	defer fieldIter.Close()
 	count := 0
 	for fieldIter.Valid() {
 		// delete field
@@ -168,50 +396,161 @@ func (b *Batch) deleteDocument(ctx context.Context, docID metapb.Key, forceCommi
 		fieldIter.Next()
 		count++
 	}
-	defer fieldIter.Close()
-	// no document for the docID
-	if count == 0 {
-		return 0, nil
+	return count, nil
+}
+
+// deleteNestedDocuments deletes the hidden documents of the nested objects of the parent and their nested objects,
+// only the objects of the nested field if the field ID is not 0
+func (b *Batch) deleteNestedDocuments(parent metapb.Key, fieldId uint32) error {
+	iter := b.reader().PrefixIterator(encodeNestedDocPrefixKey(parent))
+	if iter == nil {
+		return errors.New("store driver error")
+	}
+	var docIDs []metapb.Key
+	for ; iter.Valid(); iter.Next() {
+		// the nested documents of the other documents with the parent ID as their prefix
+		if !bytes.Equal(iter.Value(), parent) {
+			continue
+		}
+		docID, err := decodeNestedDocKey(iter.Key())
+		if err != nil {
+			iter.Close()
+			return err
+		}
+		if fieldId > 0 {
+			_, nestedField, _, err := decodeNestedDocID(docID)
+			if err != nil {
+				iter.Close()
+				return err
+			}
+			if nestedField != fieldId {
+				continue
+			}
+		}
+		b.batch.Delete(iter.Key())
+		docIDs = append(docIDs, docID)
+	}
+	iter.Close()
+	for _, docID := range docIDs {
+		if _, err := b.deleteStoredFields(docID); err != nil {
+			return err
+		}
+		if err := b.deleteDocumentTerms(docID); err != nil {
+			return err
+		}
+		if err := b.deleteDocValues(docID); err != nil {
+			return err
+		}
+		if err := b.deleteNestedDocuments(docID, 0); err != nil {
+			return err
+		}
 	}
-	count = 1
+	return nil
+}
+
+// the iterators must be closed before commit, or the write transaction may wait for them
+func (b *Batch) deleteDocumentTerms(docID metapb.Key) error {
 	prefixFieldTermKey := encodeFieldTermAbstractKey([]byte(docID), 0)
-	fieldTermIter := b.store.PrefixIterator(prefixFieldTermKey)
+	fieldTermIter := b.reader().PrefixIterator(prefixFieldTermKey)
 	if fieldTermIter == nil {
-		return 0, errors.New("store driver error")
+		return errors.New("store driver error")
 	}
 	defer fieldTermIter.Close()
 	for fieldTermIter.Valid() {
 		_, fieldId, err := decodeFieldTermAbstractKey(fieldTermIter.Key())
 		if err != nil {
-			return 0, err
+			return err
 		}
 		terms, err := decodeFieldTermAbstractValue(fieldTermIter.Value())
 		if err != nil {
-			return 0, err
-		}
-		// delete field terms
-		b.batch.Delete(fieldTermIter.Key())
-		for _, term := range terms {
-			// delete term index
-			b.batch.Delete(encodeIndexKey(docID, fieldId, term))
-			prefixTermPosKey := encodeIndexPositionKey(docID, fieldId, term, 0)
-			termPosIter := b.store.PrefixIterator(prefixTermPosKey)
-			if termPosIter == nil {
-				return 0, errors.New("store driver error")
-			}
-			for termPosIter.Valid() {
-				// delete term position
-				b.batch.Delete(termPosIter.Key())
-				termPosIter.Next()
-			}
-			termPosIter.Close()
+			return err
+		}
+		if err := b.deleteFieldTerms(docID, fieldId, terms); err != nil {
+			return err
 		}
 		fieldTermIter.Next()
 	}
-	return count, b.Commit()
+	return nil
+}
+
+// deleteFieldTerms deletes the terms of the field of the document and their statistics
+func (b *Batch) deleteFieldTerms(docID metapb.Key, fieldId uint32, terms [][]byte) error {
+	// delete field terms
+	b.batch.Delete(encodeFieldTermAbstractKey(docID, fieldId))
+	fieldLengthKey := encodeFieldLengthKey(docID, fieldId)
+	value, err := b.reader().Get(fieldLengthKey)
+	if err != nil {
+		return err
+	}
+	fieldLength, err := decodeCount(value)
+	if err != nil {
+		return err
+	}
+	b.batch.Delete(fieldLengthKey)
+	b.addFieldStats(fieldId, -1, -fieldLength)
+	for _, term := range terms {
+		// delete term index
+		indexKey := encodeIndexKey(docID, fieldId, term)
+		value, err := b.reader().Get(indexKey)
+		if err != nil {
+			return err
+		}
+		freq, err := decodeIndex(value)
+		if err != nil {
+			return err
+		}
+		b.batch.Delete(indexKey)
+		b.addTermStats(fieldId, term, -1, -int64(freq))
+		prefixTermPosKey := encodeIndexPositionKey(docID, fieldId, term, 0)
+		termPosIter := b.reader().PrefixIterator(prefixTermPosKey)
+		if termPosIter == nil {
+			return errors.New("store driver error")
+		}
+		for termPosIter.Valid() {
+			// delete term position
+			b.batch.Delete(termPosIter.Key())
+			termPosIter.Next()
+		}
+		termPosIter.Close()
+	}
+	return nil
+}
+
+func (b *Batch) deleteDocValues(docID metapb.Key) error {
+	docValuesKey := encodeDocValuesAbstractKey(docID)
+	value, err := b.reader().Get(docValuesKey)
+	if err != nil {
+		return err
+	}
+	if len(value) == 0 {
+		return nil
+	}
+	fieldIds, err := decodeDocValuesAbstract(value)
+	if err != nil {
+		return err
+	}
+	for _, fieldId := range fieldIds {
+		b.batch.Delete(encodeDocValuesKey(fieldId, docID))
+	}
+	b.batch.Delete(docValuesKey)
+	return nil
 }
 
 func (b *Batch) Commit() error {
+	if err := b.flush(); err != nil {
+		b.Rollback()
+		return err
+	}
+	if err := b.commitStats(); err != nil {
+		b.tx.Rollback()
+		return err
+	}
+	return b.tx.Commit()
+}
+
+// flush writes the operations of the batch into the write transaction, so that the documents written
+// by the batch are read back from the transaction
+func (b *Batch) flush() error {
 	if b.tx == nil {
 		tx, err := b.store.NewTransaction(true)
 		if err != nil {
@@ -222,28 +561,132 @@ func (b *Batch) Commit() error {
 	// TODO remove batch, replace with tx when add/update/delete document
 	for _, op := range b.batch.Operations() {
 		// TODO in badger driver, the tx may full
+		var err error
 		if op.Value() == nil {
-			b.tx.Delete(op.Key())
+			err = b.tx.Delete(op.Key())
 		} else {
-			b.tx.Put(op.Key(), op.Value())
+			err = b.tx.Put(op.Key(), op.Value())
+		}
+		if err != nil {
+			return err
 		}
 	}
-	return b.tx.Commit()
+	b.batch.Reset()
+	b.dirty = make(map[string]bool)
+	return nil
+}
+
+// flushDirty flushes the batch if the document is written by the operations not flushed,
+// so that the document written by the batch is read back from the transaction
+func (b *Batch) flushDirty(docID metapb.Key) error {
+	if b.dirty[string(docID)] {
+		return b.flush()
+	}
+	return nil
 }
 
 func (b *Batch) Rollback() error {
+	b.resetStats()
+	b.versions = make(map[string]*batchVersion)
 	if b.tx != nil {
 		return b.tx.Rollback()
 	}
 	return nil
 }
 
-func isDocExist(store kvstore.KVStore, docID []byte) (bool) {
-	key := encodeStoreFieldKey(docID, 0)
-	iter := store.PrefixIterator(key)
-	if iter == nil {
-		return false
+func (b *Batch) addFieldStats(fieldId uint32, docCount, sumLength int64) {
+	if delta, ok := b.fieldStats[fieldId]; ok {
+		delta.docCount += docCount
+		delta.sumLength += sumLength
+		return
+	}
+	b.fieldStats[fieldId] = &fieldStatsDelta{docCount: docCount, sumLength: sumLength}
+}
+
+func (b *Batch) addTermStats(fieldId uint32, term []byte, docFreq, totalTermFreq int64) {
+	key := string(encodeTermDocFreqKey(fieldId, term))
+	if delta, ok := b.termStats[key]; ok {
+		delta.docFreq += docFreq
+		delta.totalTermFreq += totalTermFreq
+		return
+	}
+	b.termStats[key] = &termStatsDelta{docFreq: docFreq, totalTermFreq: totalTermFreq}
+}
+
+// commitStats adds the statistics changed by the batch to the ones in the transaction
+func (b *Batch) commitStats() error {
+	defer b.resetStats()
+	for fieldId, delta := range b.fieldStats {
+		if delta.docCount == 0 && delta.sumLength == 0 {
+			continue
+		}
+		key := encodeFieldStatsKey(fieldId)
+		value, err := b.tx.Get(key)
+		if err != nil {
+			return err
+		}
+		docCount, sumLength, err := decodeFieldStats(value)
+		if err != nil {
+			return err
+		}
+		docCount += delta.docCount
+		sumLength += delta.sumLength
+		if docCount <= 0 {
+			err = b.tx.Delete(key)
+		} else {
+			err = b.tx.Put(key, encodeFieldStats(docCount, sumLength))
+		}
+		if err != nil {
+			return err
+		}
+	}
+	for key, delta := range b.termStats {
+		if delta.docFreq == 0 && delta.totalTermFreq == 0 {
+			continue
+		}
+		value, err := b.tx.Get([]byte(key))
+		if err != nil {
+			return err
+		}
+		docFreq, totalTermFreq, err := decodeTermStats(value)
+		if err != nil {
+			return err
+		}
+		docFreq += delta.docFreq
+		totalTermFreq += delta.totalTermFreq
+		if docFreq <= 0 {
+			err = b.tx.Delete([]byte(key))
+		} else {
+			err = b.tx.Put([]byte(key), encodeTermStats(docFreq, totalTermFreq))
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (b *Batch) resetStats() {
+	b.fieldStats = make(map[uint32]*fieldStatsDelta)
+	b.termStats = make(map[string]*termStatsDelta)
+}
+
+// isDocExist reports whether the document has a version, stored fields, indexed terms or doc values
+func isDocExist(store kvReader, docID []byte) (bool) {
+	if value, err := store.Get(encodeDocVersionKey(docID)); err == nil && len(value) > 0 {
+		return true
+	}
+	for _, key := range [][]byte{encodeStoreFieldKey(docID, 0), encodeFieldTermAbstractKey(docID, 0)} {
+		iter := store.PrefixIterator(key)
+		if iter == nil {
+			return false
+		}
+		valid := iter.Valid()
+		iter.Close()
+		if valid {
+			return true
+		}
 	}
-	defer iter.Close()
-	return iter.Valid()
+	value, err := store.Get(encodeDocValuesAbstractKey(docID))
+	return err == nil && len(value) > 0
 }
@@ -0,0 +1,102 @@
+package mapping
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/tiglabs/baudengine/kernel/analysis"
+	"github.com/tiglabs/baudengine/kernel/analysis/analyzer/custom"
+)
+
+// parseAnalyzers builds the custom analyzers of the space declared in the settings of the schema, like
+// {"settings": {"analysis": {"analyzer": {"name": {"char_filters": [...], "tokenizer": ..., "token_filters": [...]}}}}}
+func parseAnalyzers(data []byte) (map[string]analysis.Analyzer, error) {
+	schema := make(map[string]interface{})
+	if err := json.Unmarshal(data, &schema); err != nil {
+		return nil, err
+	}
+	configs, err := analyzerSchema(schema)
+	if err != nil {
+		return nil, err
+	}
+	analyzers := make(map[string]analysis.Analyzer, len(configs))
+	for name, config := range configs {
+		config, ok := config.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("invalid analyzer %s", name)
+		}
+		analyzer, err := custom.NewFromConfig(config)
+		if err != nil {
+			return nil, fmt.Errorf("invalid analyzer %s: %v", name, err)
+		}
+		analyzers[name] = analyzer
+	}
+	return analyzers, nil
+}
+
+// analyzerSchema returns the custom analyzers of the schema by the names, nil if there is none
+func analyzerSchema(schema map[string]interface{}) (map[string]interface{}, error) {
+	val, ok := schema["settings"]
+	if !ok {
+		return nil, nil
+	}
+	settings, ok := val.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("invalid settings")
+	}
+	val, ok = settings["analysis"]
+	if !ok {
+		return nil, nil
+	}
+	analysisSettings, ok := val.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("invalid analysis settings")
+	}
+	val, ok = analysisSettings["analyzer"]
+	if !ok {
+		return nil, nil
+	}
+	analyzers, ok := val.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("invalid analyzer settings")
+	}
+	return analyzers, nil
+}
+
+// mergeAnalyzerSchema adds the new custom analyzers of the update, an analyzer can not be changed
+// because the documents are indexed by it
+func mergeAnalyzerSchema(current, update map[string]interface{}) error {
+	updateAnalyzers, err := analyzerSchema(update)
+	if err != nil || len(updateAnalyzers) == 0 {
+		return err
+	}
+	currentAnalyzers, err := analyzerSchema(current)
+	if err != nil {
+		return err
+	}
+	if currentAnalyzers == nil {
+		settings, ok := current["settings"].(map[string]interface{})
+		if !ok {
+			settings = make(map[string]interface{})
+			current["settings"] = settings
+		}
+		analysisSettings, ok := settings["analysis"].(map[string]interface{})
+		if !ok {
+			analysisSettings = make(map[string]interface{})
+			settings["analysis"] = analysisSettings
+		}
+		currentAnalyzers = make(map[string]interface{})
+		analysisSettings["analyzer"] = currentAnalyzers
+	}
+	for name, analyzer := range updateAnalyzers {
+		if currentAnalyzer, ok := currentAnalyzers[name]; ok {
+			if !reflect.DeepEqual(currentAnalyzer, analyzer) {
+				return fmt.Errorf("analyzer %s can not be changed", name)
+			}
+			continue
+		}
+		currentAnalyzers[name] = analyzer
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package mapping
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/tiglabs/baudengine/kernel/analysis"
+)
+
+// the built-in date formats of the date field mapping
+var dateTimeParsers = map[string]analysis.DateTimeParser{
+	"strict_date_optional_time": layoutParser{"2006-01-02T15:04:05.999999999Z07:00", "2006-01-02T15:04:05.999999999",
+		"2006-01-02T15:04Z07:00", "2006-01-02T15:04", "2006-01-02", "2006-01", "2006"},
+	"date_optional_time": layoutParser{"2006-01-02T15:04:05.999999999Z07:00", "2006-01-02T15:04:05.999999999",
+		"2006-01-02T15:04Z07:00", "2006-01-02T15:04", "2006-01-02", "2006-01", "2006"},
+	"basic_date":   layoutParser{"20060102"},
+	"epoch_millis": epochParser(time.Millisecond),
+	"epoch_second": epochParser(time.Second),
+}
+
+// layoutParser parses the time by the first layout matching it, the time without zone is UTC
+type layoutParser []string
+
+func (p layoutParser) ParseDateTime(input string) (time.Time, error) {
+	var err error
+	for _, layout := range p {
+		var t time.Time
+		if t, err = time.Parse(layout, input); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, err
+}
+
+// epochParser parses the number of the units since the epoch
+type epochParser time.Duration
+
+func (p epochParser) ParseDateTime(input string) (time.Time, error) {
+	v, err := strconv.ParseFloat(input, 64)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(0, int64(v*float64(p))).UTC(), nil
+}
@@ -16,18 +16,18 @@ import (
 type DocumentMapping struct {
 	Name string                            `json:"name,omitempty"`
 	Enabled_ bool                          `json:"enabled,omitempty"`
-	Dynamic bool                           `json:"dynamic,omitempty"`
+	Dynamic string                         `json:"dynamic,omitempty"`
 	Mapping map[string]FieldMapping        `json:"mappings"`
 	StructTagKey string                    `json:"-"`
 }
 
 func NewDocumentMapping(name string, mapping map[string]FieldMapping) *DocumentMapping {
-	doc := &DocumentMapping{Name:name, StructTagKey: "json", Mapping: mapping, Enabled_: true, Dynamic: false}
+	doc := &DocumentMapping{Name:name, StructTagKey: "json", Mapping: mapping, Enabled_: true, Dynamic: DynamicTrue}
 	return doc
 }
 
 func (dm *DocumentMapping) parseDocument(doc interface{}, path []string, context *parseContext) error {
-	if dm.Mapping != nil && dm.Enabled_ && !dm.Dynamic {
+	if dm.Mapping != nil && dm.Enabled_ {
 		val := reflect.ValueOf(doc)
 		typ := val.Type()
 		switch typ.Kind() {
@@ -36,7 +36,13 @@ func (dm *DocumentMapping) parseDocument(doc interface{}, path []string, context
 				for _, key := range val.MapKeys() {
 					field, ok := dm.Mapping[key.String()]
 					if !ok {
-						return fmt.Errorf("Fields %s that can not be identified", key.String())
+						var err error
+						if field, err = unmappedField(key.String(), dm.Dynamic); err != nil {
+							return err
+						}
+						if field == nil {
+							continue
+						}
 					}
 
 					fieldName := key.String()
@@ -60,11 +66,6 @@ func (dm *DocumentMapping) parseDocument(doc interface{}, path []string, context
 		default:
 			return errors.New("Fields that can not be identified")
 		}
-		allFieldMapping := dm.Mapping["_all"]
-		if allFieldMapping != nil && allFieldMapping.Enabled() {
-			field := document.NewCompositeFieldWithProperty("_all", context.excludedFromAll, allFieldMapping.(*TextFieldMapping).Property())
-			context.doc.AddField(field)
-		}
 	}
 	return nil
 }
@@ -106,6 +107,23 @@ func parseBool(val interface{}) (bool, error) {
 	}
 }
 
+// parseDynamic parses the dynamic setting of an object, a boolean or one of "true", "false" and "strict"
+func parseDynamic(val interface{}) (string, error) {
+	switch v := val.(type) {
+	case bool:
+		if v {
+			return DynamicTrue, nil
+		}
+		return DynamicFalse, nil
+	case string:
+		switch v {
+		case DynamicTrue, DynamicFalse, DynamicStrict:
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid dynamic %v", val)
+}
+
 func parseString(val interface{}) (string, error) {
 	_val := reflect.ValueOf(val)
 	typ := _val.Type()
@@ -245,7 +263,6 @@ func validIndexOptions(opt string) bool {
 	default:
 		return false
 	}
-	return false
 }
 
 func validFieldDataFrequencyFilter(f *FieldDataFrequencyFilter) bool {
@@ -268,7 +285,6 @@ func validSimilarity(s string) bool {
 	default:
 		return false
 	}
-	return false
 }
 
 func validTermVector(s string) bool {
@@ -763,6 +779,53 @@ func parseBooleanFieldMapping(name string, obj interface{}, index *uint64, enabl
 	return nil, errors.New("invalid boolean field")
 }
 
+func parseGeoPointFieldMapping(name string, obj interface{}, index *uint64, enable, includeInAll bool) (*GeoPointFieldMapping, error) {
+	val := reflect.ValueOf(obj)
+	typ := val.Type()
+	if typ.Kind() == reflect.Map {
+		if typ.Key().Kind() == reflect.String {
+			filedMapping := NewGeoPointFieldMapping(name, atomic.AddUint64(index, 1))
+			filedMapping.Enabled_ = enable
+			for _, key := range val.MapKeys() {
+				switch key.String() {
+				case "enabled":
+					b, err := parseBool(val.MapIndex(key).Interface())
+					if err != nil {
+						return nil, err
+					}
+					filedMapping.Enabled_ = b
+				case "doc_values":
+					b, err := parseBool(val.MapIndex(key).Interface())
+					if err != nil {
+						return nil, err
+					}
+					filedMapping.DocValues = b
+				case "ignore_malformed":
+					b, err := parseBool(val.MapIndex(key).Interface())
+					if err != nil {
+						return nil, err
+					}
+					filedMapping.IgnoreMalformed = b
+				case "index":
+					b, err := parseBool(val.MapIndex(key).Interface())
+					if err != nil {
+						return nil, err
+					}
+					filedMapping.Index_ = b
+				case "store":
+					b, err := parseBool(val.MapIndex(key).Interface())
+					if err != nil {
+						return nil, err
+					}
+					filedMapping.Store_ = b
+				}
+			}
+			return filedMapping, nil
+		}
+	}
+	return nil, errors.New("invalid geo point field")
+}
+
 func parseSourceFieldMapping(obj interface{}, index *uint64) (*SourceFieldMapping, error) {
 	val := reflect.ValueOf(obj)
 	typ := val.Type()
@@ -798,7 +861,7 @@ func parseSourceFieldMapping(obj interface{}, index *uint64) (*SourceFieldMappin
 	return nil, errors.New("invalid boolean field")
 }
 
-func parseObjectFieldMapping(name string, schema interface{}, index *uint64, enable, includeInAll, root bool) (*ObjectFieldMapping, error) {
+func parseObjectFieldMapping(name string, schema interface{}, index *uint64, enable, includeInAll bool, dynamic string, root bool) (*ObjectFieldMapping, error) {
 	val := reflect.ValueOf(schema)
 	typ := val.Type()
 	switch typ.Kind() {
@@ -830,6 +893,12 @@ func parseObjectFieldMapping(name string, schema interface{}, index *uint64, ena
 					} else {
 						// TODO error???
 					}
+				case "dynamic":
+					d, err := parseDynamic(val.MapIndex(key).Interface())
+					if err != nil {
+						return nil, err
+					}
+					dynamic = d
 				case "enabled":
 					b, err := parseBool(val.MapIndex(key).Interface())
 					if err != nil {
@@ -847,7 +916,7 @@ func parseObjectFieldMapping(name string, schema interface{}, index *uint64, ena
 					fVal := val.MapIndex(key).Interface()
 					_fVal := reflect.ValueOf(fVal)
 					if _fVal.Type().Kind() == reflect.Map {
-						_fields, err := parseFieldMapping(fVal, index, enable, includeInAll)
+						_fields, err := parseFieldMapping(fVal, index, enable, includeInAll, dynamic)
 						if err != nil {
 							return nil, err
 						}
@@ -858,6 +927,7 @@ func parseObjectFieldMapping(name string, schema interface{}, index *uint64, ena
 				}
 			}
 			fieldMapping.Enabled_ = enable
+			fieldMapping.Dynamic = dynamic
 			fieldMapping.IncludeInAll = includeInAll
 			return fieldMapping, nil
 		}
@@ -865,7 +935,31 @@ func parseObjectFieldMapping(name string, schema interface{}, index *uint64, ena
 	return nil, errors.New("invalid field")
 }
 
-func parseFieldMapping(schema interface{}, index *uint64, enable, includeInAll bool) ([]FieldMapping, error) {
+func isNestedSchema(schema interface{}) bool {
+	val := reflect.ValueOf(schema)
+	if val.Kind() != reflect.Map || val.Type().Key().Kind() != reflect.String {
+		return false
+	}
+	typ := val.MapIndex(reflect.ValueOf("type"))
+	return typ.IsValid() && typ.CanInterface() && typ.Interface() == "nested"
+}
+
+// parseNestedFieldMapping parses the nested field as an object field, its objects are indexed as nested documents
+func parseNestedFieldMapping(name string, schema interface{}, index *uint64, enable, includeInAll bool, dynamic string) (*NestedFieldMapping, error) {
+	object, err := parseObjectFieldMapping(name, schema, index, enable, includeInAll, dynamic, false)
+	if err != nil {
+		return nil, err
+	}
+	fieldMapping := NewNestedFieldMapping(name, object.Id)
+	fieldMapping.Enabled_ = object.Enabled_
+	fieldMapping.Dynamic = object.Dynamic
+	fieldMapping.IncludeInAll = object.IncludeInAll
+	fieldMapping.Properties = object.Properties
+	return fieldMapping, nil
+}
+
+// the objects inherit the dynamic setting of the parent object when they have no setting of their own
+func parseFieldMapping(schema interface{}, index *uint64, enable, includeInAll bool, dynamic string) ([]FieldMapping, error) {
 	val := reflect.ValueOf(schema)
 	typ := val.Type()
 	switch typ.Kind() {
@@ -884,8 +978,9 @@ func parseFieldMapping(schema interface{}, index *uint64, enable, includeInAll b
 						elementVal := fVal.MapIndex(elementName).Interface()
 						_elementVal := reflect.ValueOf(elementVal)
 						if _elementVal.Type().Kind() == reflect.Map {
-							if elementName.String() == "properties" {
-								field, err := parseObjectFieldMapping(fieldName.String(), fieldVal, index, enable, includeInAll, false)
+							// the properties of a nested field are parsed with its type
+							if elementName.String() == "properties" && !isNestedSchema(fieldVal) {
+								field, err := parseObjectFieldMapping(fieldName.String(), fieldVal, index, enable, includeInAll, dynamic, false)
 								if err != nil {
 									return nil, err
 								}
@@ -924,9 +1019,19 @@ func parseFieldMapping(schema interface{}, index *uint64, enable, includeInAll b
 										return nil, err
 									}
 									fields = append(fields, field)
+								case "geo_point":
+									field, err := parseGeoPointFieldMapping(fieldName.String(), fieldVal, index, enable, includeInAll)
+									if err != nil {
+										return nil, err
+									}
+									fields = append(fields, field)
 								case "object":
 								case "nested":
-								// TODO nested
+									field, err := parseNestedFieldMapping(fieldName.String(), fieldVal, index, enable, includeInAll, dynamic)
+									if err != nil {
+										return nil, err
+									}
+									fields = append(fields, field)
 								default:
 									return nil, fmt.Errorf("invalid filed type %s", _elementVal.String())
 								}
@@ -959,16 +1064,18 @@ func parseSchema(data []byte) ([]*DocumentMapping, error) {
 					docMapping := docVal.MapIndex(docName).Interface()
 					dMapping := reflect.ValueOf(docMapping)
 					if dMapping.Type().Kind() == reflect.Map && dMapping.Type().Key().Kind() == reflect.String {
-						objectFieldMapping, err := parseObjectFieldMapping(docName.String(), docMapping, &index, true, true, true)
+						objectFieldMapping, err := parseObjectFieldMapping(docName.String(), docMapping, &index, true, true, DynamicTrue, true)
 						if err != nil {
 							return nil, err
 						}
 						// _all check
 						if _, ok := objectFieldMapping.Properties["_all"]; !ok {
-							NewTextFieldMapping("_all", atomic.AddUint64(&index, 1))
+							objectFieldMapping.AddFileMapping(NewTextFieldMapping("_all", atomic.AddUint64(&index, 1)))
 						}
 						// _source check
-						docMappings = append(docMappings, NewDocumentMapping(docName.String(), objectFieldMapping.Properties))
+						documentMapping := NewDocumentMapping(docName.String(), objectFieldMapping.Properties)
+						documentMapping.Dynamic = objectFieldMapping.Dynamic
+						docMappings = append(docMappings, documentMapping)
 					}
 				}
 				return docMappings, nil
@@ -997,4 +1104,28 @@ type parseContext struct {
 	im              IndexMapping
 	dm              *DocumentMapping
 	excludedFromAll []string
+	// the schema properties of the new fields of the dynamic objects
+	dynamicFields map[string]interface{}
+}
+
+// addDynamicField adds the schema of a new field to the properties of its parent objects
+func (c *parseContext) addDynamicField(path []string, schema map[string]interface{}) {
+	if c.dynamicFields == nil {
+		c.dynamicFields = make(map[string]interface{})
+	}
+	properties := c.dynamicFields
+	for _, name := range path[:len(path)-1] {
+		parent, ok := properties[name].(map[string]interface{})
+		if !ok {
+			parent = map[string]interface{}{"properties": make(map[string]interface{})}
+			properties[name] = parent
+		}
+		properties = parent["properties"].(map[string]interface{})
+	}
+	name := path[len(path)-1]
+	if current, ok := properties[name].(map[string]interface{}); ok {
+		mergeDynamicSchema(current, schema)
+	} else {
+		properties[name] = schema
+	}
 }
\ No newline at end of file
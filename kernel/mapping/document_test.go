@@ -192,7 +192,7 @@ func TestParseObjectFieldMapping(t *testing.T) {
 	properties["boolField"] = boolField
 	objField["properties"] = properties
 	var index uint64
-	field, err := parseObjectFieldMapping("objField", objField, &index, true, true)
+	field, err := parseObjectFieldMapping("objField", objField, &index, true, true, DynamicTrue, false)
 	if err != nil {
 		t.Fatalf("parse object fielld failed %v", err)
 		return
@@ -288,12 +288,17 @@ func TestParseSchema(t *testing.T) {
 }
 `
 	//level0FieldNames := []string{"region", "is_published", "title", "date", "content", "city", "manager"}
-	dm, err := parseSchema([]byte(schema))
+	dms, err := parseSchema([]byte(schema))
 	if err != nil {
 		t.Fatal("parseSchema failed ", err)
 		return
 	}
-	if len(dm.Mapping) != 7 {
+	if len(dms) != 1 {
+		t.Fatalf("parse failed %v", dms)
+	}
+	dm := dms[0]
+	// the _all field is added by the parser
+	if len(dm.Mapping) != 8 {
 		t.Fatalf("parse failed %v", dm.Mapping)
 	}
 	for fn, field := range dm.Mapping {
@@ -426,7 +431,7 @@ func TestParseSchema(t *testing.T) {
 					}
 				}
 			}
-
+		case "_all":
 		default:
 			t.Fatalf("invalid field name %s", fn)
 			return
@@ -6,6 +6,7 @@ import (
 	"strings"
 	"strconv"
 	"fmt"
+	"math"
 	"time"
 
 	"github.com/tiglabs/baudengine/kernel/document"
@@ -32,25 +33,33 @@ func(e *EmptyFieldMapping) ParseField(data interface{}, path []string, context *
 	return nil
 }
 
+// the dynamic settings of an object, for the fields not in the mapping of the object:
+// true adds the fields to the mapping, false ignores the fields and strict rejects the document
+const (
+	DynamicTrue   = "true"
+	DynamicFalse  = "false"
+	DynamicStrict = "strict"
+)
+
+// DynamicFieldMapping maps the field not in the mapping of a dynamic object,
+// the type of the field is inferred from the value and the field is added to the mapping update of the document
 type DynamicFieldMapping struct {
 	Name_ string                   `json:"name,omitempty"`
 	// field ID
 	Id  uint64                     `json:"id,omitempty"`
 	Type_ string                   `json:"type,omitempty"`
 	Enabled_ bool                  `json:"enabled,omitempty"`
-	Dynamic bool                   `json:"dynamic,omitempty"`
+	Dynamic string                 `json:"dynamic,omitempty"`
 	IncludeInAll bool              `json:"include_in_all,omitempty"`
 	Properties map[string]FieldMapping `json:"properties,omitempty"`
 }
 
-// type only can be object or nested
-func NewDynamicFieldMapping(name, type_ string, id uint64) *DynamicFieldMapping {
+func NewDynamicFieldMapping(name string) *DynamicFieldMapping {
 	return &DynamicFieldMapping{
 		Name_: name,
-		Id: id,
-		Type_: type_,
 		Enabled_: true,
-		Dynamic: true,
+		Dynamic: DynamicTrue,
+		IncludeInAll: true,
 	}
 }
 
@@ -58,13 +67,130 @@ func(f *DynamicFieldMapping) Name() string {return f.Name_}
 func(f *DynamicFieldMapping) Type() string {return f.Type_}
 func(f *DynamicFieldMapping) ID()   uint64 {return f.Id}
 func(f *DynamicFieldMapping) Store() bool {return false}
-func(f *DynamicFieldMapping) Index() bool {return false}
-func(f *DynamicFieldMapping) Enabled() bool {return false}
+func(f *DynamicFieldMapping) Index() bool {return true}
+func(f *DynamicFieldMapping) Enabled() bool {return f.Enabled_}
 func(f *DynamicFieldMapping) ParseField(data interface{}, path []string, context *parseContext) error {
-	// todo
+	if !f.Enabled() {
+		return nil
+	}
+	schema, err := inferFieldSchema(data, context)
+	if err != nil {
+		return fmt.Errorf("field %s error: %v", encodePath(path), err)
+	}
+	// null and empty array have no type, the field is added by the next value
+	if schema == nil {
+		return nil
+	}
+	f.Type_ = fieldSchemaType(schema)
+	context.addDynamicField(path, schema)
 	return nil
 }
 
+// inferFieldSchema infers the schema of the field from the JSON value
+func inferFieldSchema(data interface{}, context *parseContext) (map[string]interface{}, error) {
+	switch val := data.(type) {
+	case nil:
+		return nil, nil
+	case bool:
+		return map[string]interface{}{"type": "boolean"}, nil
+	case float64:
+		if val == math.Trunc(val) && math.Abs(val) < math.MaxInt64 {
+			return map[string]interface{}{"type": "long"}, nil
+		}
+		return map[string]interface{}{"type": "double"}, nil
+	case int, int64:
+		return map[string]interface{}{"type": "long"}, nil
+	case string:
+		if isDynamicDate(val, context) {
+			return map[string]interface{}{"type": "date"}, nil
+		}
+		// the text is searched by the keyword sub field as a whole
+		return map[string]interface{}{"type": "text", "fields": map[string]interface{}{
+			"keyword": map[string]interface{}{"type": "keyword"}}}, nil
+	case map[string]interface{}:
+		properties := make(map[string]interface{})
+		for name, v := range val {
+			schema, err := inferFieldSchema(v, context)
+			if err != nil {
+				return nil, err
+			}
+			if schema != nil {
+				properties[name] = schema
+			}
+		}
+		return map[string]interface{}{"properties": properties}, nil
+	case []interface{}:
+		// the type of the array is the type of the first value, the objects in the array merge their fields
+		var schema map[string]interface{}
+		for _, v := range val {
+			s, err := inferFieldSchema(v, context)
+			if err != nil {
+				return nil, err
+			}
+			if schema == nil {
+				schema = s
+			} else if s != nil {
+				mergeDynamicSchema(schema, s)
+			}
+		}
+		return schema, nil
+	default:
+		return nil, fmt.Errorf("invalid value type %T", data)
+	}
+}
+
+// mergeDynamicSchema adds the fields of the update object which are not in the current object,
+// the type of a field is the type of its first value
+func mergeDynamicSchema(current, update map[string]interface{}) {
+	currentFields, ok := current["properties"].(map[string]interface{})
+	if !ok {
+		return
+	}
+	updateFields, ok := update["properties"].(map[string]interface{})
+	if !ok {
+		return
+	}
+	for name, updateField := range updateFields {
+		currentField, ok := currentFields[name].(map[string]interface{})
+		if !ok {
+			currentFields[name] = updateField
+			continue
+		}
+		mergeDynamicSchema(currentField, updateField.(map[string]interface{}))
+	}
+}
+
+// the date formats detecting the date strings of the dynamic fields
+var dynamicDateFormats = []string{"strict_date_optional_time"}
+
+func isDynamicDate(val string, context *parseContext) bool {
+	// the year or the year and month only, like "2018", is not detected
+	if len(val) < len("2006-01-02") {
+		return false
+	}
+	for _, format := range dynamicDateFormats {
+		if parser := context.im.DateTimeParserNamed(format); parser != nil {
+			if _, err := parser.ParseDateTime(val); err == nil {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// unmappedField returns the mapping of the field not in the mapping of the object by the dynamic setting,
+// the field is ignored when both the mapping and the error are nil
+func unmappedField(name, dynamic string) (FieldMapping, error) {
+	switch dynamic {
+	case DynamicTrue:
+		return NewDynamicFieldMapping(name), nil
+	case DynamicFalse:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("Fields %s that can not be identified", name)
+	}
+}
+
 type SourceFieldMapping struct {
 	Name_ string                   `json:"name,omitempty"`
 	// field ID
@@ -96,7 +222,7 @@ type ObjectFieldMapping struct {
 	Id  uint64                     `json:"id,omitempty"`
 	Type_ string                   `json:"type,omitempty"`
 	Enabled_ bool                  `json:"enabled,omitempty"`
-	Dynamic bool                   `json:"dynamic,omitempty"`
+	Dynamic string                 `json:"dynamic,omitempty"`
 	IncludeInAll bool              `json:"include_in_all,omitempty"`
 	Properties map[string]FieldMapping `json:"properties,omitempty"`
 }
@@ -107,7 +233,7 @@ func NewObjectFieldMapping(name string, id uint64) *ObjectFieldMapping {
 		Id: id,
 		Type_: "object",
 		Enabled_: true,
-		Dynamic: false,
+		Dynamic: DynamicTrue,
 		IncludeInAll: true,
 	}
 }
@@ -129,11 +255,6 @@ func (f *ObjectFieldMapping) ParseField(data interface{}, path []string, context
 	if !f.Enabled() {
 		return nil
 	}
-	// not support dynamic now
-	if f.Dynamic {
-		// todo
-		return nil
-	}
 	var err error
 	val := reflect.ValueOf(data)
 	typ := val.Type()
@@ -142,17 +263,19 @@ func (f *ObjectFieldMapping) ParseField(data interface{}, path []string, context
 		if typ.Key().Kind() == reflect.String {
 			for _, key := range val.MapKeys() {
 				// get field
-				if f.Properties == nil {
-					return errors.New("Fields that can not be identified")
-				}
 				field, ok := f.Properties[key.String()]
 				if !ok {
-					return fmt.Errorf("Fields %s that can not be identified", key.String())
+					if field, err = unmappedField(key.String(), f.Dynamic); err != nil {
+						return err
+					}
+					if field == nil {
+						continue
+					}
 				}
-				
+
 				fieldName := key.String()
 				fieldVal := val.MapIndex(key).Interface()
-				err = field.ParseField(fieldVal, append(path, f.Name(), fieldName), context)
+				err = field.ParseField(fieldVal, append(path, fieldName), context)
 				if err != nil {
 					return err
 				}
@@ -187,7 +310,7 @@ type NestedFieldMapping struct {
 	Id  uint64                     `json:"id,omitempty"`
 	Type_ string                   `json:"type,omitempty"`
 	Enabled_ bool                  `json:"enabled,omitempty"`
-	Dynamic bool                   `json:"dynamic,omitempty"`
+	Dynamic string                 `json:"dynamic,omitempty"`
 	IncludeInAll bool              `json:"include_in_all,omitempty"`
 	Properties map[string]FieldMapping `json:"properties,omitempty"`
 }
@@ -198,14 +321,14 @@ func NewNestedFieldMapping(name string, id uint64) *NestedFieldMapping {
 		Id: id,
 		Type_: "nested",
 		Enabled_: true,
-		Dynamic: false,
+		Dynamic: DynamicTrue,
 		IncludeInAll: true,
 	}
 }
 
 func(f *NestedFieldMapping) Name() string {return f.Name_}
 func(f *NestedFieldMapping) Type() string {return "nested"}
-func(f *NestedFieldMapping) ID()   uint64 {return 0}
+func(f *NestedFieldMapping) ID()   uint64 {return f.Id}
 func(f *NestedFieldMapping) Store() bool {return false}
 func(f *NestedFieldMapping) Index() bool {return true}
 func(f *NestedFieldMapping) Enabled() bool {return true}
@@ -216,11 +339,52 @@ func(f *NestedFieldMapping) AddFileMapping(field FieldMapping) {
 	f.Properties[field.Name()] = field
 }
 
+// ParseField parses every object of the nested field into a nested document of the current document,
+// so the fields of an object are matched together by the nested queries
 func(f *NestedFieldMapping) ParseField(data interface{}, path []string, context *parseContext) error {
 	if !f.Enabled() {
 		return nil
 	}
-	// todo
+	val := reflect.ValueOf(data)
+	typ := val.Type()
+	switch typ.Kind() {
+	case reflect.Map:
+		if typ.Key().Kind() != reflect.String {
+			return errors.New("Fields that can not be identified")
+		}
+		parent := context.doc
+		nested := parent.AddNested(encodePath(path))
+		context.doc = nested.Document
+		defer func() { context.doc = parent }()
+		for _, key := range val.MapKeys() {
+			field, ok := f.Properties[key.String()]
+			if !ok {
+				var err error
+				if field, err = unmappedField(key.String(), f.Dynamic); err != nil {
+					return err
+				}
+				if field == nil {
+					continue
+				}
+			}
+			fieldName := key.String()
+			fieldVal := val.MapIndex(key).Interface()
+			if err := field.ParseField(fieldVal, append(path, fieldName), context); err != nil {
+				return err
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < val.Len(); i++ {
+			if val.Index(i).CanInterface() {
+				fieldVal := val.Index(i).Interface()
+				if err := f.ParseField(fieldVal, path, context); err != nil {
+					return err
+				}
+			}
+		}
+	default:
+		return errors.New("Fields that can not be identified")
+	}
 	return nil
 }
 
@@ -346,7 +510,7 @@ func (f *TextFieldMapping) ParseField(data interface{}, path []string, context *
 			context.excludedFromAll = append(context.excludedFromAll, fieldName)
 		}
 		for name, fieldMapping := range f.Fields {
-			err = fieldMapping.ParseField(propertyValueString, append(path, fieldName, name), context)
+			err = fieldMapping.ParseField(propertyValueString, append(path, name), context)
 			if err != nil {
 				return err
 			}
@@ -568,6 +732,7 @@ func(f *NumericFieldMapping) ParseField(data interface{}, path []string, context
 				}
 			}
 		}
+		return nil
 	default:
 		return fmt.Errorf("invalid field type %s", val.Kind().String())
 	}
@@ -647,10 +812,20 @@ func(f *DateFieldMapping) ParseField(data interface{}, path []string, context *p
 	var err error
 	typ := val.Type()
 	switch typ.Kind() {
-	case reflect.String:
-		propertyValueString := val.String()
+	case reflect.String, reflect.Float64, reflect.Float32, reflect.Int, reflect.Int64:
+		var propertyValueString string
+		switch typ.Kind() {
+		case reflect.Float64, reflect.Float32:
+			// epoch_millis and epoch_second are numbers in JSON
+			propertyValueString = strconv.FormatFloat(val.Float(), 'f', -1, 64)
+		case reflect.Int, reflect.Int64:
+			propertyValueString = strconv.FormatInt(val.Int(), 10)
+		default:
+			propertyValueString = val.String()
+		}
 		formats := strings.Split(f.Format, "||")
 		var parsedDateTime time.Time
+		err = fmt.Errorf("no date format of %s parses %s", f.Format, propertyValueString)
 		for _, format := range formats {
 			dateTimeParser := context.im.DateTimeParserNamed(format)
 			if dateTimeParser != nil {
@@ -712,6 +887,7 @@ func NewBooleanFieldMapping(name string, id uint64) *BooleanFieldMapping {
 		Enabled_: true,
 		Boost: 1.0,
 		DocValues: true,
+		Index_: true,
 		Store_: false,
 	}
 }
@@ -768,6 +944,138 @@ func(f *BooleanFieldMapping) ParseField(data interface{}, path []string, context
 	return nil
 }
 
+type GeoPointFieldMapping struct {
+	Name_ string                   `json:"name,omitempty"`
+	// field ID
+	Id  uint64                     `json:"id,omitempty"`
+	Type_ string                   `json:"type,omitempty"`
+	Enabled_ bool                  `json:"enabled,omitempty"`
+	DocValues bool                 `json:"doc_values,omitempty"`
+	IgnoreMalformed bool           `json:"ignore_malformed,omitempty"`
+	Index_ bool                    `json:"index,omitempty"`
+	Store_ bool                    `json:"store,omitempty"`
+}
+
+func NewGeoPointFieldMapping(name string, id uint64) *GeoPointFieldMapping {
+	return &GeoPointFieldMapping{
+		Name_: name,
+		Id: id,
+		Type_: "geo_point",
+		Enabled_: true,
+		DocValues: true,
+		IgnoreMalformed: false,
+		Index_: true,
+		Store_: false,
+	}
+}
+
+func(f *GeoPointFieldMapping) Name() string {return f.Name_}
+func(f *GeoPointFieldMapping) Type() string {return f.Type_}
+func(f *GeoPointFieldMapping) ID()   uint64 {return f.Id}
+func(f *GeoPointFieldMapping) Store() bool {return f.Store_}
+func(f *GeoPointFieldMapping) Index() bool {return f.Index_}
+func(f *GeoPointFieldMapping) Enabled() bool {return f.Enabled_}
+func(f *GeoPointFieldMapping) Property() document.Property {
+	var p document.Property
+	if f.Store() {
+		p |= document.StoreField
+	}
+	if f.Index() {
+		p |= document.IndexField
+	}
+	if f.DocValues {
+		p |= document.DocValues
+	}
+	return p
+}
+
+// ParseField accepts a point as an object with lat and lon, a string "lat,lon",
+// or an array [lon, lat], and an array of the points.
+func(f *GeoPointFieldMapping) ParseField(data interface{}, path []string, context *parseContext) error {
+	if !f.Enabled() {
+		return nil
+	}
+	val := reflect.ValueOf(data)
+	if !val.IsValid() {
+		// cannot do anything with the zero value
+		return errors.New("field value invalid")
+	}
+	var lat, lon float64
+	var err error
+	typ := val.Type()
+	switch typ.Kind() {
+	case reflect.Map:
+		if typ.Key().Kind() != reflect.String {
+			return errors.New("invalid geo point")
+		}
+		var foundLat, foundLon bool
+		for _, key := range val.MapKeys() {
+			switch key.String() {
+			case "lat":
+				lat, err = parseFloat(val.MapIndex(key).Interface())
+				foundLat = true
+			case "lon":
+				lon, err = parseFloat(val.MapIndex(key).Interface())
+				foundLon = true
+			}
+			if err != nil {
+				return f.malformed(err)
+			}
+		}
+		if !foundLat || !foundLon {
+			return f.malformed(errors.New("geo point needs lat and lon"))
+		}
+	case reflect.String:
+		parts := strings.Split(val.String(), ",")
+		if len(parts) != 2 {
+			return f.malformed(fmt.Errorf("invalid geo point %s", val.String()))
+		}
+		if lat, err = strconv.ParseFloat(strings.TrimSpace(parts[0]), 64); err != nil {
+			return f.malformed(err)
+		}
+		if lon, err = strconv.ParseFloat(strings.TrimSpace(parts[1]), 64); err != nil {
+			return f.malformed(err)
+		}
+	case reflect.Slice, reflect.Array:
+		// [lon, lat] as GeoJSON
+		if val.Len() == 2 {
+			_lon, errLon := parseFloat(val.Index(0).Interface())
+			_lat, errLat := parseFloat(val.Index(1).Interface())
+			if errLon == nil && errLat == nil {
+				lat, lon = _lat, _lon
+				break
+			}
+		}
+		for i := 0; i < val.Len(); i++ {
+			if val.Index(i).CanInterface() {
+				fieldVal := val.Index(i).Interface()
+				err = f.ParseField(fieldVal, path, context)
+				if err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	default:
+		return errors.New("invalid field type")
+	}
+	fieldName := getFieldName(path, f)
+	field, err := document.NewGeoPointField(fieldName, lat, lon, f.Property())
+	if err != nil {
+		return f.malformed(err)
+	}
+	context.doc.AddField(field)
+	return nil
+}
+
+// malformed ignores the malformed point when ignore_malformed is set
+func(f *GeoPointFieldMapping) malformed(err error) error {
+	if f.IgnoreMalformed {
+		return nil
+	}
+	return err
+}
+
 func getFieldName(path []string, fieldMapping FieldMapping) string {
 	parentName := ""
 	if len(path) > 1 {
@@ -12,7 +12,7 @@ import (
 
 type mockAnalyzer struct {}
 
-func(a *mockAnalyzer)Analyze(val []byte) []*analysis.Token {
+func(a *mockAnalyzer)Analyze(val []byte) analysis.TokenSet {
 	return nil
 }
 
@@ -36,10 +36,13 @@ func(m *mockIndexMapping) DateTimeParserNamed(name string) analysis.DateTimePars
 
 func(m *mockIndexMapping) RebuildAllField(doc *document.Document) error {return nil}
 func(m *mockIndexMapping) MergeDocument(doc *document.Document, source []byte) error {return nil}
+func(m *mockIndexMapping) FieldMappingNamed(name string) FieldMapping {return nil}
+func(m *mockIndexMapping) FieldMappingByID(id uint64) (string, FieldMapping) {return "", nil}
+func(m *mockIndexMapping) DefaultTTL() time.Duration {return 0}
 
 func TestTextFieldMapping(t *testing.T) {
 	context := &parseContext{
-		doc: document.NewDocument("1"),
+		doc: document.NewDocument([]byte("1")),
 		im: &mockIndexMapping{},
 		dm: nil,
 	}
@@ -67,13 +70,14 @@ func TestTextFieldMapping(t *testing.T) {
 
 func TestTextFieldWithFieldsMapping(t *testing.T) {
 	context := &parseContext{
-		doc: document.NewDocument("1"),
+		doc: document.NewDocument([]byte("1")),
 		im: &mockIndexMapping{},
 		dm: nil,
 	}
 	fieldMapping := NewTextFieldMapping("text", 1)
 	fieldMapping.AddField(NewTextFieldMapping("sub", 2))
-	var path []string
+	// the path of the field has its name, like the one of the document parser
+	path := []string{"text"}
 	err := fieldMapping.ParseField("text string", path, context)
 	if err != nil {
 		t.Fatalf("ParseField failed, %v", err)
@@ -107,7 +111,7 @@ func TestTextFieldWithFieldsMapping(t *testing.T) {
 
 func TestTextFieldsMapping(t *testing.T) {
 	context := &parseContext{
-		doc: document.NewDocument("1"),
+		doc: document.NewDocument([]byte("1")),
 		im: &mockIndexMapping{},
 		dm: nil,
 	}
@@ -143,13 +147,14 @@ func TestTextFieldsMapping(t *testing.T) {
 
 func TestTextFieldsWithFieldsMapping(t *testing.T) {
 	context := &parseContext{
-		doc: document.NewDocument("1"),
+		doc: document.NewDocument([]byte("1")),
 		im: &mockIndexMapping{},
 		dm: nil,
 	}
 	fieldMapping := NewTextFieldMapping("text", 1)
 	fieldMapping.AddField(NewTextFieldMapping("sub", 2))
-	var path []string
+	// the path of the field has its name, like the one of the document parser
+	path := []string{"text"}
 	checkStrings := []string{"str1", "str2"}
 	err := fieldMapping.ParseField(checkStrings, path, context)
 	if err != nil {
@@ -182,7 +187,7 @@ func TestTextFieldsWithFieldsMapping(t *testing.T) {
 
 func TestKeywordFieldMapping(t *testing.T) {
 	context := &parseContext{
-		doc: document.NewDocument("1"),
+		doc: document.NewDocument([]byte("1")),
 		im: &mockIndexMapping{},
 		dm: nil,
 	}
@@ -210,7 +215,7 @@ func TestKeywordFieldMapping(t *testing.T) {
 
 func TestKeywordFieldsMapping(t *testing.T) {
 	context := &parseContext{
-		doc: document.NewDocument("1"),
+		doc: document.NewDocument([]byte("1")),
 		im: &mockIndexMapping{},
 		dm: nil,
 	}
@@ -246,7 +251,7 @@ func TestKeywordFieldsMapping(t *testing.T) {
 
 func TestDateFieldMapping(t *testing.T) {
 	context := &parseContext{
-		doc: document.NewDocument("1"),
+		doc: document.NewDocument([]byte("1")),
 		im: &mockIndexMapping{},
 		dm: nil,
 	}
@@ -280,7 +285,7 @@ func TestDateFieldMapping(t *testing.T) {
 
 func TestDateFieldsMapping(t *testing.T) {
 	context := &parseContext{
-		doc: document.NewDocument("1"),
+		doc: document.NewDocument([]byte("1")),
 		im: &mockIndexMapping{},
 		dm: nil,
 	}
@@ -319,7 +324,7 @@ func TestDateFieldsMapping(t *testing.T) {
 
 func TestNumericStringFieldMapping(t *testing.T) {
 	context := &parseContext{
-		doc: document.NewDocument("1"),
+		doc: document.NewDocument([]byte("1")),
 		im: &mockIndexMapping{},
 		dm: nil,
 	}
@@ -352,7 +357,7 @@ func TestNumericStringFieldMapping(t *testing.T) {
 
 func TestNumericStringFieldsMapping(t *testing.T) {
 	context := &parseContext{
-		doc: document.NewDocument("1"),
+		doc: document.NewDocument([]byte("1")),
 		im: &mockIndexMapping{},
 		dm: nil,
 	}
@@ -388,7 +393,7 @@ func TestNumericStringFieldsMapping(t *testing.T) {
 
 func TestNumericIntFieldMapping(t *testing.T) {
 	context := &parseContext{
-		doc: document.NewDocument("1"),
+		doc: document.NewDocument([]byte("1")),
 		im: &mockIndexMapping{},
 		dm: nil,
 	}
@@ -421,7 +426,7 @@ func TestNumericIntFieldMapping(t *testing.T) {
 
 func TestBooleanFieldsMapping(t *testing.T) {
 	context := &parseContext{
-		doc: document.NewDocument("1"),
+		doc: document.NewDocument([]byte("1")),
 		im: &mockIndexMapping{},
 		dm: nil,
 	}
@@ -458,7 +463,7 @@ func TestBooleanFieldsMapping(t *testing.T) {
 
 func TestBooleanFieldMapping(t *testing.T) {
 	context := &parseContext{
-		doc: document.NewDocument("1"),
+		doc: document.NewDocument([]byte("1")),
 		im: &mockIndexMapping{},
 		dm: nil,
 	}
@@ -491,7 +496,7 @@ func TestBooleanFieldMapping(t *testing.T) {
 
 func TestObjectFieldMapping(t *testing.T) {
 	context := &parseContext{
-		doc: document.NewDocument("1"),
+		doc: document.NewDocument([]byte("1")),
 		im: &mockIndexMapping{},
 		dm: nil,
 	}
@@ -518,7 +523,8 @@ func TestObjectFieldMapping(t *testing.T) {
 	data, _ := json.Marshal(objM)
 	doc := make(map[string]interface{})
 	json.Unmarshal(data, &doc)
-	var path []string
+	// the path of the field has its name, like the one of the document parser
+	path := []string{"object"}
 	err := objFieldMapping.ParseField(doc["object"], path, context)
 	if err != nil {
 		t.Fatalf("parse field failed, err %v", err)
@@ -546,7 +552,7 @@ func TestObjectFieldMapping(t *testing.T) {
 
 func TestObjectFieldsMapping(t *testing.T) {
 	context := &parseContext{
-		doc: document.NewDocument("1"),
+		doc: document.NewDocument([]byte("1")),
 		im: &mockIndexMapping{},
 		dm: nil,
 	}
@@ -584,7 +590,8 @@ func TestObjectFieldsMapping(t *testing.T) {
 	data, _ := json.Marshal(objM)
 	doc := make(map[string]interface{})
 	json.Unmarshal(data, &doc)
-	var path []string
+	// the path of the field has its name, like the one of the document parser
+	path := []string{"object"}
 	err := objFieldMapping.ParseField(doc["object"], path, context)
 	if err != nil {
 		t.Fatalf("parse field failed, err %v", err)
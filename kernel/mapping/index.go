@@ -0,0 +1,235 @@
+package mapping
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/tiglabs/baudengine/kernel/analysis"
+	"github.com/tiglabs/baudengine/kernel/document"
+	"github.com/tiglabs/baudengine/kernel/registry"
+)
+
+const allFieldName = "_all"
+
+var _ IndexMapping = &IndexMappingImpl{}
+
+// IndexMappingImpl is the mapping of a space, every field has the ID allocated by the schema
+type IndexMappingImpl struct {
+	DocMapping *DocumentMapping
+	// the full path name of the field -> the field mapping
+	fields map[string]FieldMapping
+	// the custom analyzers of the space by the names
+	analyzers map[string]analysis.Analyzer
+	// the default time to live of the documents, 0 if the documents never expire
+	ttl time.Duration
+}
+
+// NewIndexMapping parses the JSON schema of a space, the schema has one document mapping
+func NewIndexMapping(schema []byte) (*IndexMappingImpl, error) {
+	docMappings, err := parseSchema(schema)
+	if err != nil {
+		return nil, err
+	}
+	if len(docMappings) != 1 {
+		return nil, fmt.Errorf("schema has %d document mappings, a space has one", len(docMappings))
+	}
+	analyzers, err := parseAnalyzers(schema)
+	if err != nil {
+		return nil, err
+	}
+	ttl, err := parseTTL(schema)
+	if err != nil {
+		return nil, err
+	}
+	im := &IndexMappingImpl{
+		DocMapping: docMappings[0],
+		fields:     make(map[string]FieldMapping),
+		analyzers:  analyzers,
+		ttl:        ttl,
+	}
+	im.addFieldMappings("", im.DocMapping.Mapping)
+	// the merged schema has explicit IDs, so the fields keep their IDs among the versions
+	if err := im.applyFieldIDs(schema); err != nil {
+		return nil, err
+	}
+	return im, nil
+}
+
+func (im *IndexMappingImpl) addFieldMappings(prefix string, fields map[string]FieldMapping) {
+	for name, field := range fields {
+		fullName := prefix + name
+		switch f := field.(type) {
+		case *ObjectFieldMapping:
+			im.addFieldMappings(fullName+pathSeparator, f.Properties)
+		case *NestedFieldMapping:
+			im.fields[fullName] = f
+			im.addFieldMappings(fullName+pathSeparator, f.Properties)
+		case *TextFieldMapping:
+			im.fields[fullName] = f
+			im.addFieldMappings(fullName+pathSeparator, f.Fields)
+		case *KeywordFieldMapping:
+			im.fields[fullName] = f
+			im.addFieldMappings(fullName+pathSeparator, f.Fields)
+		default:
+			im.fields[fullName] = f
+		}
+	}
+}
+
+// FieldMappingNamed returns the mapping of the field by the full path name, like "parent.field"
+func (im *IndexMappingImpl) FieldMappingNamed(name string) FieldMapping {
+	return im.fields[name]
+}
+
+// FieldMappingByID returns the full path name and the mapping of the field of the ID, the name is empty if not found
+func (im *IndexMappingImpl) FieldMappingByID(id uint64) (string, FieldMapping) {
+	for name, f := range im.fields {
+		if f.ID() == id {
+			return name, f
+		}
+	}
+	return "", nil
+}
+
+func (im *IndexMappingImpl) MapDocument(doc *document.Document, data []byte) error {
+	var source interface{}
+	if err := json.Unmarshal(data, &source); err != nil {
+		return err
+	}
+	if _, ok := source.(map[string]interface{}); !ok {
+		return errors.New("source document is not a JSON object")
+	}
+	context := &parseContext{doc: doc, im: im, dm: im.DocMapping}
+	if err := im.DocMapping.parseDocument(source, nil, context); err != nil {
+		return err
+	}
+	if len(context.dynamicFields) > 0 {
+		update, err := json.Marshal(map[string]interface{}{
+			"mappings": map[string]interface{}{
+				im.DocMapping.Name: map[string]interface{}{"properties": context.dynamicFields},
+			},
+		})
+		if err != nil {
+			return err
+		}
+		return &DynamicMappingError{Update: update}
+	}
+	return im.RebuildAllField(doc)
+}
+
+// DynamicMappingError is returned by MapDocument when the document has new fields of the dynamic objects,
+// the document is mapped again after the update is merged into the schema of the space
+type DynamicMappingError struct {
+	// the schema of the new fields with the inferred types
+	Update []byte
+}
+
+func (e *DynamicMappingError) Error() string {
+	return fmt.Sprintf("document has new fields of the dynamic mapping: %s", e.Update)
+}
+
+// MergeDocument replaces the fields of the document by the fields in the source
+func (im *IndexMappingImpl) MergeDocument(doc *document.Document, source []byte) error {
+	merged := document.NewDocument(doc.ID)
+	if err := im.MapDocument(merged, source); err != nil {
+		return err
+	}
+	for name, fields := range merged.Fields {
+		if name == allFieldName {
+			continue
+		}
+		doc.DeleteField(name)
+		for _, field := range fields {
+			doc.AddField(field)
+		}
+	}
+	// the objects of the nested fields in the source replace the current ones
+	paths := make(map[string]bool)
+	for _, nested := range merged.Nested {
+		paths[nested.Path] = true
+	}
+	current := doc.Nested
+	doc.Nested = nil
+	for _, nested := range current {
+		if !paths[nested.Path] {
+			doc.Nested = append(doc.Nested, nested)
+		}
+	}
+	doc.Nested = append(doc.Nested, merged.Nested...)
+	return im.RebuildAllField(doc)
+}
+
+// RebuildAllField rebuilds the _all field from the text fields included in all
+func (im *IndexMappingImpl) RebuildAllField(doc *document.Document) error {
+	doc.DeleteField(allFieldName)
+	allMapping, ok := im.DocMapping.Mapping[allFieldName].(*TextFieldMapping)
+	if !ok || !allMapping.Enabled() {
+		return nil
+	}
+	// the values are merged in the order of the names
+	names := make([]string, 0, len(doc.Fields))
+	var excluded []string
+	for name := range doc.Fields {
+		names = append(names, name)
+		if !includeInAll(im.fields[name]) {
+			excluded = append(excluded, name)
+		}
+	}
+	sort.Strings(names)
+	all := document.NewCompositeFieldWithProperty(allFieldName, excluded, allMapping.Property())
+	for _, name := range names {
+		var fieldId uint32
+		if fieldMapping := im.fields[name]; fieldMapping != nil {
+			fieldId = uint32(fieldMapping.ID())
+		}
+		for _, field := range doc.Fields[name] {
+			text, ok := field.(*document.TextField)
+			if !ok {
+				continue
+			}
+			var freq analysis.TokenFrequencies
+			if all.Property().IsIndexed() {
+				freq = text.Analyze()
+			}
+			all.MergeAll(name, fieldId, text.Value(), freq)
+		}
+	}
+	doc.AddField(all)
+	return nil
+}
+
+// AnalyzerNamed returns the custom analyzer of the space, or the registered analyzer of the name
+func (im *IndexMappingImpl) AnalyzerNamed(name string) analysis.Analyzer {
+	if analyzer, ok := im.analyzers[name]; ok {
+		return analyzer
+	}
+	return registry.GetAnalyzer(name)
+}
+
+func (im *IndexMappingImpl) DateTimeParserNamed(name string) analysis.DateTimeParser {
+	return dateTimeParsers[name]
+}
+
+func (im *IndexMappingImpl) DefaultTTL() time.Duration {
+	return im.ttl
+}
+
+func includeInAll(fieldMapping FieldMapping) bool {
+	switch f := fieldMapping.(type) {
+	case *TextFieldMapping:
+		return f.IncludeInAll
+	case *KeywordFieldMapping:
+		return f.IncludeInAll
+	case *NumericFieldMapping:
+		return f.IncludeInAll
+	case *DateFieldMapping:
+		return f.IncludeInAll
+	case nil:
+		return false
+	default:
+		return true
+	}
+}
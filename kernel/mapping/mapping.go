@@ -1,6 +1,8 @@
 package mapping
 
 import (
+	"time"
+
 	"github.com/tiglabs/baudengine/kernel/document"
 	"github.com/tiglabs/baudengine/kernel/analysis"
 )
@@ -12,4 +14,10 @@ type IndexMapping interface {
 	MapDocument(doc *document.Document, data []byte) error
 	AnalyzerNamed(name string) analysis.Analyzer
 	DateTimeParserNamed(name string) analysis.DateTimeParser
+	// FieldMappingNamed returns the mapping of the field by the full path name
+	FieldMappingNamed(name string) FieldMapping
+	// FieldMappingByID returns the full path name and the mapping of the field of the ID
+	FieldMappingByID(id uint64) (string, FieldMapping)
+	// DefaultTTL returns the default time to live of the documents, 0 if the documents never expire
+	DefaultTTL() time.Duration
 }
\ No newline at end of file
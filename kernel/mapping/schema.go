@@ -0,0 +1,280 @@
+package mapping
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// MergeSchema merges the update into the current schema of a space and returns the new schema.
+// New fields are added, a field keeps its type and its ID, so the documents indexed by
+// the current schema are still valid. The fields of the new schema have explicit IDs.
+// New custom analyzers are added to the settings, the current ones can not be changed.
+// The ttl of the settings is replaced by the one of the update.
+func MergeSchema(current, update []byte) ([]byte, error) {
+	currentMapping, err := NewIndexMapping(current)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := NewIndexMapping(update); err != nil {
+		return nil, err
+	}
+
+	merged := make(map[string]interface{})
+	if err := json.Unmarshal(current, &merged); err != nil {
+		return nil, err
+	}
+	updated := make(map[string]interface{})
+	if err := json.Unmarshal(update, &updated); err != nil {
+		return nil, err
+	}
+	docType, mergedDoc, err := docSchema(merged)
+	if err != nil {
+		return nil, err
+	}
+	updateType, updatedDoc, err := docSchema(updated)
+	if err != nil {
+		return nil, err
+	}
+	if docType != updateType {
+		return nil, fmt.Errorf("document type %s can not be changed to %s", docType, updateType)
+	}
+	if err := mergeFieldSchema("", mergedDoc, updatedDoc); err != nil {
+		return nil, err
+	}
+	if err := mergeAnalyzerSchema(merged, updated); err != nil {
+		return nil, err
+	}
+	mergeTTLSchema(merged, updated)
+
+	// the _all field is allocated by the parser when it is absent, so it needs an explicit ID too
+	if _, ok := mergedDoc[allFieldName]; !ok {
+		mergedDoc[allFieldName] = make(map[string]interface{})
+	}
+	var maxID uint64
+	for _, field := range currentMapping.fields {
+		if field.ID() > maxID {
+			maxID = field.ID()
+		}
+	}
+	err = walkSchema(mergedDoc, func(name string, field map[string]interface{}) error {
+		if fieldMapping := currentMapping.fields[name]; fieldMapping != nil {
+			field["id"] = fieldMapping.ID()
+		} else {
+			maxID++
+			field["id"] = maxID
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	schema, err := json.Marshal(merged)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := NewIndexMapping(schema); err != nil {
+		return nil, err
+	}
+	return schema, nil
+}
+
+// applyFieldIDs sets the explicit IDs of the schema to the fields, the IDs must be unique
+func (im *IndexMappingImpl) applyFieldIDs(data []byte) error {
+	schema := make(map[string]interface{})
+	if err := json.Unmarshal(data, &schema); err != nil {
+		return err
+	}
+	_, doc, err := docSchema(schema)
+	if err != nil {
+		return err
+	}
+	err = walkSchema(doc, func(name string, field map[string]interface{}) error {
+		val, ok := field["id"]
+		if !ok {
+			return nil
+		}
+		id, err := parseInt(val)
+		if err != nil || id <= 0 {
+			return fmt.Errorf("invalid id of field %s", name)
+		}
+		if fieldMapping := im.fields[name]; fieldMapping != nil {
+			return setFieldID(fieldMapping, uint64(id))
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	names := make(map[uint64]string, len(im.fields))
+	for name, field := range im.fields {
+		if other, ok := names[field.ID()]; ok {
+			return fmt.Errorf("field %s and field %s have the same id %d", name, other, field.ID())
+		}
+		names[field.ID()] = name
+	}
+	return nil
+}
+
+func setFieldID(field FieldMapping, id uint64) error {
+	val := reflect.ValueOf(field)
+	if val.Kind() == reflect.Ptr {
+		val = val.Elem()
+	}
+	idVal := val.FieldByName("Id")
+	if !idVal.IsValid() || !idVal.CanSet() || idVal.Kind() != reflect.Uint64 {
+		return fmt.Errorf("field %s has no id", field.Name())
+	}
+	idVal.SetUint(id)
+	return nil
+}
+
+// docSchema returns the name and the schema of the only document type
+func docSchema(schema map[string]interface{}) (string, map[string]interface{}, error) {
+	docs, ok := schema["mappings"].(map[string]interface{})
+	if !ok || len(docs) != 1 {
+		return "", nil, errors.New("invalid schema")
+	}
+	for name, doc := range docs {
+		if doc, ok := doc.(map[string]interface{}); ok {
+			return name, doc, nil
+		}
+	}
+	return "", nil, errors.New("invalid schema")
+}
+
+// updatableAttributes are the attributes of an existing field which do not change how the indexed documents
+// are read, the other attributes can not be set or changed, or the documents indexed before are invalid
+var updatableAttributes = map[string]bool{
+	"dynamic":         true,
+	"ignore_above":    true,
+	"search_analyzer": true,
+}
+
+// mergeFieldSchema merges the update of an object or a field into the current one,
+// new fields and properties are added while the attributes of the current ones are kept
+func mergeFieldSchema(name string, current, update map[string]interface{}) error {
+	for key, val := range update {
+		switch key {
+		case "properties", "fields":
+			updateFields, ok := val.(map[string]interface{})
+			if !ok {
+				return fmt.Errorf("invalid %s of field %s", key, name)
+			}
+			currentFields, ok := current[key].(map[string]interface{})
+			if !ok {
+				currentFields = make(map[string]interface{})
+				current[key] = currentFields
+			}
+			for fieldName, updateField := range updateFields {
+				fullName := childFieldName(name, fieldName)
+				updateField, ok := updateField.(map[string]interface{})
+				if !ok {
+					return fmt.Errorf("invalid field %s", fullName)
+				}
+				currentField, ok := currentFields[fieldName].(map[string]interface{})
+				if !ok {
+					currentFields[fieldName] = updateField
+					continue
+				}
+				currentType, updateType := fieldSchemaType(currentField), fieldSchemaType(updateField)
+				// the new fields of a nested object are in an object without type, like the dynamic fields
+				if _, ok := updateField["type"]; !ok && currentType == "nested" && updateType == "object" {
+					updateType = currentType
+				}
+				if currentType != updateType {
+					return fmt.Errorf("field %s of type %s can not be changed to %s", fullName, currentType, updateType)
+				}
+				if err := mergeFieldSchema(fullName, currentField, updateField); err != nil {
+					return err
+				}
+			}
+		case allFieldName, "_source":
+			updateField, ok := val.(map[string]interface{})
+			if !ok {
+				return fmt.Errorf("invalid field %s", key)
+			}
+			currentField, ok := current[key].(map[string]interface{})
+			if !ok {
+				currentField = make(map[string]interface{})
+				current[key] = currentField
+			}
+			if err := mergeFieldSchema(key, currentField, updateField); err != nil {
+				return err
+			}
+		case "id", "type":
+			// the IDs are allocated by the merge, and the types are checked by the parent
+		default:
+			if currentVal, ok := current[key]; ok && reflect.DeepEqual(currentVal, val) {
+				continue
+			}
+			if !updatableAttributes[key] {
+				return fmt.Errorf("attribute %s of field %s can not be changed", key, name)
+			}
+			current[key] = val
+		}
+	}
+	return nil
+}
+
+// walkSchema calls fn with the full path name and the schema of every field of the document,
+// the objects have no field of their own
+func walkSchema(doc map[string]interface{}, fn func(name string, field map[string]interface{}) error) error {
+	for _, name := range []string{allFieldName, "_source"} {
+		if field, ok := doc[name].(map[string]interface{}); ok {
+			if err := fn(name, field); err != nil {
+				return err
+			}
+		}
+	}
+	return walkFieldsSchema("", doc, fn)
+}
+
+func walkFieldsSchema(name string, schema map[string]interface{}, fn func(name string, field map[string]interface{}) error) error {
+	for _, key := range []string{"properties", "fields"} {
+		fields, ok := schema[key].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		fieldNames := make([]string, 0, len(fields))
+		for fieldName := range fields {
+			fieldNames = append(fieldNames, fieldName)
+		}
+		sort.Strings(fieldNames)
+		for _, fieldName := range fieldNames {
+			field, ok := fields[fieldName].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			fullName := childFieldName(name, fieldName)
+			if fieldSchemaType(field) != "object" {
+				if err := fn(fullName, field); err != nil {
+					return err
+				}
+			}
+			if err := walkFieldsSchema(fullName, field, fn); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func fieldSchemaType(field map[string]interface{}) string {
+	typ, _ := field["type"].(string)
+	if _, ok := field["properties"]; ok && typ != "nested" {
+		return "object"
+	}
+	return typ
+}
+
+func childFieldName(parent, name string) string {
+	if parent == "" {
+		return name
+	}
+	return parent + pathSeparator + name
+}
@@ -0,0 +1,104 @@
+package mapping
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMergeSchema(t *testing.T) {
+	schema := []byte(`{"mappings": {"doc": {"properties": {
+		"title": {"type": "text"},
+		"price": {"type": "long"}
+	}}}}`)
+	current, err := NewIndexMapping(schema)
+	if err != nil {
+		t.Fatalf("new mapping failed, err %v", err)
+	}
+	ids := make(map[string]uint64)
+	for _, name := range []string{"title", "price", "_all"} {
+		ids[name] = current.FieldMappingNamed(name).ID()
+	}
+
+	// the new fields are sorted before the existing fields, they must not take the IDs of them
+	merged, err := MergeSchema(schema, []byte(`{"settings": {"ttl": "1h"}, "mappings": {"doc": {"properties": {
+		"author": {"type": "keyword"},
+		"price":  {"type": "long"},
+		"brand":  {"properties": {"name": {"type": "keyword"}}}
+	}}}}`))
+	if err != nil {
+		t.Fatalf("merge mapping failed, err %v", err)
+	}
+	im, err := NewIndexMapping(merged)
+	if err != nil {
+		t.Fatalf("new merged mapping failed, err %v", err)
+	}
+	for name, id := range ids {
+		if got := im.FieldMappingNamed(name).ID(); got != id {
+			t.Fatalf("field %s id changed from %d to %d", name, id, got)
+		}
+	}
+	seen := make(map[uint64]string)
+	for _, name := range []string{"title", "price", "_all", "author", "brand.name"} {
+		field := im.FieldMappingNamed(name)
+		if field == nil {
+			t.Fatalf("field %s not found", name)
+		}
+		if other, ok := seen[field.ID()]; ok {
+			t.Fatalf("field %s and field %s have the same id %d", name, other, field.ID())
+		}
+		seen[field.ID()] = name
+	}
+	if im.DefaultTTL() != time.Hour {
+		t.Fatalf("ttl should be replaced, got %v", im.DefaultTTL())
+	}
+
+	// the merge of the merged schema keeps the IDs
+	again, err := MergeSchema(merged, []byte(`{"mappings": {"doc": {"properties": {"color": {"type": "keyword"}}}}}`))
+	if err != nil {
+		t.Fatalf("merge mapping failed, err %v", err)
+	}
+	againMapping, err := NewIndexMapping(again)
+	if err != nil {
+		t.Fatalf("new merged mapping failed, err %v", err)
+	}
+	for id, name := range seen {
+		if got := againMapping.FieldMappingNamed(name).ID(); got != id {
+			t.Fatalf("field %s id changed from %d to %d", name, id, got)
+		}
+	}
+	if _, ok := seen[againMapping.FieldMappingNamed("color").ID()]; ok {
+		t.Fatal("new field should have a new id")
+	}
+}
+
+func TestMergeSchemaIncompatible(t *testing.T) {
+	schema := []byte(`{"mappings": {"doc": {"properties": {
+		"title": {"type": "text"},
+		"price": {"type": "long"}
+	}}}}`)
+	incompatible := []string{
+		`{"mappings": {"doc": {"properties": {"price": {"type": "keyword"}}}}}`,
+		`{"mappings": {"doc": {"properties": {"title": {"properties": {"name": {"type": "keyword"}}}}}}}`,
+		`{"mappings": {"item": {"properties": {"author": {"type": "keyword"}}}}}`,
+		`{"mappings": {"doc": {"properties": {"author": {"type": "unknown"}}}}}`,
+		// the attributes of the indexed fields can not be changed
+		`{"mappings": {"doc": {"properties": {"price": {"type": "long", "store": true}}}}}`,
+		`{"mappings": {"doc": {"properties": {"title": {"type": "text", "analyzer": "keyword"}}}}}`,
+		`{"mappings": {"doc": {"properties": {"title": {"type": "text", "index": false}}}}}`,
+		`{"mappings": {"doc": {"properties": {"price": {"type": "long", "doc_values": false}}}}}`,
+		`{"mappings": {"doc": {"_all": {"analyzer": "keyword"}, "properties": {}}}}`,
+	}
+	// the same attributes and the updatable ones
+	compatible := `{"mappings": {"doc": {"dynamic": "strict", "properties": {
+		"title": {"type": "text", "search_analyzer": "keyword"},
+		"price": {"type": "long"}
+	}}}}`
+	if _, err := MergeSchema(schema, []byte(compatible)); err != nil {
+		t.Fatalf("compatible update failed, err %v", err)
+	}
+	for i, update := range incompatible {
+		if _, err := MergeSchema(schema, []byte(update)); err == nil {
+			t.Fatalf("incompatible update %d should fail", i)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package mapping
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// parseTTL returns the default time to live of the documents of the space in the settings,
+// like {"settings": {"ttl": "7d"}}, 0 if the documents never expire
+func parseTTL(data []byte) (time.Duration, error) {
+	schema := make(map[string]interface{})
+	if err := json.Unmarshal(data, &schema); err != nil {
+		return 0, err
+	}
+	settings, ok := schema["settings"].(map[string]interface{})
+	if !ok {
+		return 0, nil
+	}
+	val, ok := settings["ttl"]
+	if !ok {
+		return 0, nil
+	}
+	s, ok := val.(string)
+	if !ok {
+		return 0, fmt.Errorf("invalid ttl %v", val)
+	}
+	return ParseTTL(s)
+}
+
+// ParseTTL parses the time to live, a duration like "12h" or a number of days like "7d"
+func ParseTTL(s string) (time.Duration, error) {
+	var ttl time.Duration
+	var err error
+	if strings.HasSuffix(s, "d") {
+		var days int64
+		days, err = strconv.ParseInt(strings.TrimSuffix(s, "d"), 10, 64)
+		ttl = time.Duration(days) * 24 * time.Hour
+	} else {
+		ttl, err = time.ParseDuration(s)
+	}
+	if err != nil || ttl < 0 {
+		return 0, fmt.Errorf("invalid ttl %s", s)
+	}
+	return ttl, nil
+}
+
+// mergeTTLSchema sets the ttl of the update, the new ttl is for the documents written after it
+func mergeTTLSchema(current, update map[string]interface{}) {
+	updateSettings, ok := update["settings"].(map[string]interface{})
+	if !ok {
+		return
+	}
+	ttl, ok := updateSettings["ttl"]
+	if !ok {
+		return
+	}
+	settings, ok := current["settings"].(map[string]interface{})
+	if !ok {
+		settings = make(map[string]interface{})
+		current["settings"] = settings
+	}
+	settings["ttl"] = ttl
+}
@@ -1,6 +1,10 @@
 package registry
 
-import "github.com/tiglabs/baudengine/kernel/analysis"
+import (
+	"fmt"
+
+	"github.com/tiglabs/baudengine/kernel/analysis"
+)
 
 var analyzers *Registry
 
@@ -28,17 +32,122 @@ func GetTokenFilter(name string) analysis.TokenFilter {
 	return analyzers.GetTokenFilter(name)
 }
 
+// Config is the parameters of a component of a custom analyzer, like the stop words of the stop filter
+type Config map[string]interface{}
+
+// String returns the string parameter, empty if it is absent
+func (c Config) String(name string) (string, error) {
+	val, ok := c[name]
+	if !ok {
+		return "", nil
+	}
+	s, ok := val.(string)
+	if !ok {
+		return "", fmt.Errorf("parameter %s is not a string", name)
+	}
+	return s, nil
+}
+
+// Bool returns the bool parameter, false if it is absent
+func (c Config) Bool(name string) (bool, error) {
+	val, ok := c[name]
+	if !ok {
+		return false, nil
+	}
+	b, ok := val.(bool)
+	if !ok {
+		return false, fmt.Errorf("parameter %s is not a bool", name)
+	}
+	return b, nil
+}
+
+// Int returns the int parameter, def if it is absent
+func (c Config) Int(name string, def int) (int, error) {
+	val, ok := c[name]
+	if !ok {
+		return def, nil
+	}
+	switch v := val.(type) {
+	case float64:
+		if v == float64(int(v)) {
+			return int(v), nil
+		}
+	case int:
+		return v, nil
+	}
+	return 0, fmt.Errorf("parameter %s is not an integer", name)
+}
+
+// Strings returns the string list parameter, nil if it is absent
+func (c Config) Strings(name string) ([]string, error) {
+	val, ok := c[name]
+	if !ok {
+		return nil, nil
+	}
+	switch v := val.(type) {
+	case []string:
+		return v, nil
+	case []interface{}:
+		strs := make([]string, 0, len(v))
+		for _, item := range v {
+			s, ok := item.(string)
+			if !ok {
+				return nil, fmt.Errorf("parameter %s is not a string list", name)
+			}
+			strs = append(strs, s)
+		}
+		return strs, nil
+	}
+	return nil, fmt.Errorf("parameter %s is not a string list", name)
+}
+
+// the constructors build the components of the custom analyzers by the parameters of the space mapping
+type CharFilterConstructor func(config Config) (analysis.CharFilter, error)
+type TokenizerConstructor func(config Config) (analysis.Tokenizer, error)
+type TokenFilterConstructor func(config Config) (analysis.TokenFilter, error)
+
+func RegisterCharFilterConstructor(name string, constructor CharFilterConstructor) {
+	analyzers.RegisterCharFilterConstructor(name, constructor)
+}
+
+func RegisterTokenizerConstructor(name string, constructor TokenizerConstructor) {
+	analyzers.RegisterTokenizerConstructor(name, constructor)
+}
+
+func RegisterTokenFilterConstructor(name string, constructor TokenFilterConstructor) {
+	analyzers.RegisterTokenFilterConstructor(name, constructor)
+}
+
+func NewCharFilter(name string, config Config) (analysis.CharFilter, error) {
+	return analyzers.NewCharFilter(name, config)
+}
+
+func NewTokenizer(name string, config Config) (analysis.Tokenizer, error) {
+	return analyzers.NewTokenizer(name, config)
+}
+
+func NewTokenFilter(name string, config Config) (analysis.TokenFilter, error) {
+	return analyzers.NewTokenFilter(name, config)
+}
+
 type Registry struct {
 	analyzers map[string]analysis.Analyzer
 	tokenizer map[string]analysis.Tokenizer
 	filter    map[string]analysis.TokenFilter
+
+	charFilterConstructors  map[string]CharFilterConstructor
+	tokenizerConstructors   map[string]TokenizerConstructor
+	tokenFilterConstructors map[string]TokenFilterConstructor
 }
 
 func NewRegistry() *Registry {
 	return &Registry{
 		analyzers: make(map[string]analysis.Analyzer),
 		tokenizer: make(map[string]analysis.Tokenizer),
-		filter: make(map[string]analysis.TokenFilter)}
+		filter: make(map[string]analysis.TokenFilter),
+		charFilterConstructors:  make(map[string]CharFilterConstructor),
+		tokenizerConstructors:   make(map[string]TokenizerConstructor),
+		tokenFilterConstructors: make(map[string]TokenFilterConstructor)}
 }
 
 func (r *Registry) RegisterAnalyzer(name string, analyzer analysis.Analyzer) {
@@ -89,6 +198,65 @@ func (r *Registry) GetTokenFilter(name string) analysis.TokenFilter {
 	return nil
 }
 
+func (r *Registry) RegisterCharFilterConstructor(name string, constructor CharFilterConstructor) {
+	if _, ok := r.charFilterConstructors[name]; ok {
+		return
+	}
+	r.charFilterConstructors[name] = constructor
+}
+
+func (r *Registry) RegisterTokenizerConstructor(name string, constructor TokenizerConstructor) {
+	if _, ok := r.tokenizerConstructors[name]; ok {
+		return
+	}
+	r.tokenizerConstructors[name] = constructor
+}
+
+func (r *Registry) RegisterTokenFilterConstructor(name string, constructor TokenFilterConstructor) {
+	if _, ok := r.tokenFilterConstructors[name]; ok {
+		return
+	}
+	r.tokenFilterConstructors[name] = constructor
+}
+
+// NewCharFilter builds the char filter by the parameters, the char filters have no shared instance
+func (r *Registry) NewCharFilter(name string, config Config) (analysis.CharFilter, error) {
+	if constructor, ok := r.charFilterConstructors[name]; ok {
+		return constructor(config)
+	}
+	return nil, fmt.Errorf("unknown char filter %s", name)
+}
+
+// NewTokenizer builds the tokenizer by the parameters,
+// the registered tokenizer is shared when there is no parameter
+func (r *Registry) NewTokenizer(name string, config Config) (analysis.Tokenizer, error) {
+	if constructor, ok := r.tokenizerConstructors[name]; ok {
+		return constructor(config)
+	}
+	if len(config) > 0 {
+		return nil, fmt.Errorf("tokenizer %s has no parameter", name)
+	}
+	if tokenizer := r.GetTokenizer(name); tokenizer != nil {
+		return tokenizer, nil
+	}
+	return nil, fmt.Errorf("unknown tokenizer %s", name)
+}
+
+// NewTokenFilter builds the token filter by the parameters,
+// the registered filter is shared when there is no parameter
+func (r *Registry) NewTokenFilter(name string, config Config) (analysis.TokenFilter, error) {
+	if constructor, ok := r.tokenFilterConstructors[name]; ok {
+		return constructor(config)
+	}
+	if len(config) > 0 {
+		return nil, fmt.Errorf("token filter %s has no parameter", name)
+	}
+	if filter := r.GetTokenFilter(name); filter != nil {
+		return filter, nil
+	}
+	return nil, fmt.Errorf("unknown token filter %s", name)
+}
+
 func init() {
 	analyzers = NewRegistry()
 }
\ No newline at end of file
@@ -0,0 +1,96 @@
+package registry
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// Reloadable is the component with dictionaries which can be reloaded at runtime, like the chinese tokenizers,
+// the version increases on every successful reload
+type Reloadable interface {
+	Reload() error
+	Version() uint64
+	// LoadError returns the error of the last loading, nil if it succeeded
+	LoadError() error
+}
+
+var reloadables = struct {
+	sync.RWMutex
+	m map[string]Reloadable
+}{m: make(map[string]Reloadable)}
+
+func RegisterReloadable(name string, r Reloadable) {
+	reloadables.Lock()
+	defer reloadables.Unlock()
+	if _, ok := reloadables.m[name]; ok {
+		return
+	}
+	reloadables.m[name] = r
+}
+
+func GetReloadable(name string) Reloadable {
+	reloadables.RLock()
+	defer reloadables.RUnlock()
+	return reloadables.m[name]
+}
+
+// ReloadableNames returns the sorted names of the reloadable components
+func ReloadableNames() []string {
+	reloadables.RLock()
+	defer reloadables.RUnlock()
+	names := make([]string, 0, len(reloadables.m))
+	for name := range reloadables.m {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Reload reloads the dictionaries of the components of the names, or of all the components if no name is given.
+// A failed component keeps the dictionaries loaded before, and the others are still reloaded,
+// the error is of the first failed component.
+func Reload(names ...string) error {
+	if len(names) == 0 {
+		names = ReloadableNames()
+	}
+	var firstErr error
+	for _, name := range names {
+		r := GetReloadable(name)
+		if r == nil {
+			if firstErr == nil {
+				firstErr = fmt.Errorf("unknown reloadable component %s", name)
+			}
+			continue
+		}
+		if err := r.Reload(); err != nil && firstErr == nil {
+			firstErr = fmt.Errorf("reload %s failed, err %v", name, err)
+		}
+	}
+	return firstErr
+}
+
+// Versions returns the dictionary versions of the reloadable components
+func Versions() map[string]uint64 {
+	reloadables.RLock()
+	defer reloadables.RUnlock()
+	versions := make(map[string]uint64, len(reloadables.m))
+	for name, r := range reloadables.m {
+		versions[name] = r.Version()
+	}
+	return versions
+}
+
+// LoadErrors returns the errors of the reloadable components whose last loading failed,
+// the components of version 0 have no dictionary loaded
+func LoadErrors() map[string]string {
+	reloadables.RLock()
+	defer reloadables.RUnlock()
+	errs := make(map[string]string)
+	for name, r := range reloadables.m {
+		if err := r.LoadError(); err != nil {
+			errs[name] = err.Error()
+		}
+	}
+	return errs
+}
@@ -1,5 +1,244 @@
 package kernel
 
+import "github.com/tiglabs/baudengine/proto/metapb"
+
+// DefaultSearchSize is the number of hits returned when the request does not set a size.
+const DefaultSearchSize = 10
+
+// Query is the interface implemented by all the queries an engine can execute.
+type Query interface {
+	isQuery()
+}
+
+// TermQuery matches the documents whose field contains the exact term.
+type TermQuery struct {
+	FieldId uint32
+	Term    []byte
+}
+
+// TermsQuery matches the documents whose field contains any of the terms.
+type TermsQuery struct {
+	FieldId uint32
+	Terms   [][]byte
+}
+
+// PhraseQuery matches the documents whose field contains the terms in order.
+// Slop is the number of position moves allowed to match the terms,
+// it only works on the fields indexed with positions.
+type PhraseQuery struct {
+	FieldId uint32
+	Terms   [][]byte
+	Slop    int
+}
+
+// RangeQuery matches the documents whose field has a value in the range.
+// The bounds are value encoded as the field data and have the type of the field values,
+// the bound is not set when empty.
+type RangeQuery struct {
+	FieldId uint32
+	Gt      []byte
+	Gte     []byte
+	Lt      []byte
+	Lte     []byte
+}
+
+// DefaultMaxExpansions is the number of the terms a multi term query expands to when it does not set a limit.
+const DefaultMaxExpansions = 50
+
+// PrefixQuery matches the documents whose field contains a term starting with the prefix.
+// MaxExpansions <= 0 means DefaultMaxExpansions, the matched documents have the same score.
+type PrefixQuery struct {
+	FieldId       uint32
+	Prefix        []byte
+	MaxExpansions int
+}
+
+// WildcardQuery matches the documents whose field contains a term matching the pattern,
+// * matches any sequence of characters and ? matches any single character.
+// MaxExpansions <= 0 means DefaultMaxExpansions, the matched documents have the same score.
+type WildcardQuery struct {
+	FieldId       uint32
+	Pattern       string
+	MaxExpansions int
+}
+
+// RegexpQuery matches the documents whose field contains a term matching the whole regular expression.
+// MaxExpansions <= 0 means DefaultMaxExpansions, the matched documents have the same score.
+type RegexpQuery struct {
+	FieldId       uint32
+	Pattern       string
+	MaxExpansions int
+}
+
+// FuzzyQuery matches the documents whose field contains a term within MaxEdits Levenshtein edits of the term.
+// MaxEdits <= 0 means 0, 1 or 2 edits by the length of the term, and the first PrefixLength characters
+// must be the same. The terms with the fewest edits are kept up to MaxExpansions,
+// and the score of a term is lowered by its edits.
+type FuzzyQuery struct {
+	FieldId       uint32
+	Term          []byte
+	MaxEdits      int
+	PrefixLength  int
+	MaxExpansions int
+}
+
+// GeoPoint is a point of latitude and longitude in degrees.
+type GeoPoint struct {
+	Lat float64
+	Lon float64
+}
+
+// GeoBoundingBoxQuery matches the documents whose geo point field has a point in the box,
+// the box crosses the dateline when Left is greater than Right.
+type GeoBoundingBoxQuery struct {
+	FieldId     uint32
+	TopLeft     GeoPoint
+	BottomRight GeoPoint
+}
+
+// GeoDistanceQuery matches the documents whose geo point field has a point within the distance in meters.
+type GeoDistanceQuery struct {
+	FieldId  uint32
+	Origin   GeoPoint
+	Distance float64
+}
+
+// GeoPolygonQuery matches the documents whose geo point field has a point in the polygon,
+// the polygon is closed from the last point to the first point.
+type GeoPolygonQuery struct {
+	FieldId uint32
+	Points  []GeoPoint
+}
+
+// MatchAllQuery matches all the documents.
+type MatchAllQuery struct {
+}
+
+// BooleanQuery combines the sub queries.
+// A document must match all the Must queries and none of the MustNot queries.
+// When there is no Must query at least one Should query must match,
+// otherwise Should queries only add to the score unless MinShould is set.
+type BooleanQuery struct {
+	Must      []Query
+	Should    []Query
+	MustNot   []Query
+	MinShould int
+}
+
+// DefaultInnerHitsSize is the number of the inner hits of a hit when the nested query does not set a size.
+const DefaultInnerHitsSize = 3
+
+// NestedQuery matches the documents having an object of the nested field matched by the query,
+// the objects are indexed as hidden documents and the query runs on them.
+// The score of a document is computed from the scores of its matched objects by ScoreMode,
+// one of "avg", "max", "min", "sum" and "none", "avg" if empty.
+// The best InnerHitsSize matched objects are returned with the hit when InnerHits is set.
+// A nested field of the nested objects is queried by a nested query in the query of the parent field.
+type NestedQuery struct {
+	FieldId       uint32
+	Query         Query
+	ScoreMode     string
+	InnerHits     bool
+	InnerHitsSize int
+}
+
+func (*TermQuery) isQuery()           {}
+func (*TermsQuery) isQuery()          {}
+func (*PhraseQuery) isQuery()         {}
+func (*RangeQuery) isQuery()          {}
+func (*PrefixQuery) isQuery()         {}
+func (*WildcardQuery) isQuery()       {}
+func (*RegexpQuery) isQuery()         {}
+func (*FuzzyQuery) isQuery()          {}
+func (*GeoBoundingBoxQuery) isQuery() {}
+func (*GeoDistanceQuery) isQuery()    {}
+func (*GeoPolygonQuery) isQuery()     {}
+func (*MatchAllQuery) isQuery()       {}
+func (*BooleanQuery) isQuery()        {}
+func (*NestedQuery) isQuery()         {}
+
+// SortField orders the hits by the stored value of the field, or by the score when FieldId is 0.
+// The hits are ordered by score when no sort field is given.
+type SortField struct {
+	FieldId uint32
+	Reverse bool
+	// order by the distance of the nearest point of the geo point field to the origin if not nil
+	GeoDistance *GeoPoint
+}
+
+// the defaults of Highlight
+const (
+	DefaultHighlightPreTag  = "<em>"
+	DefaultHighlightPostTag = "</em>"
+	DefaultFragmentSize     = 100
+	DefaultNumFragments     = 5
+)
+
+// Highlight returns the fragments of the stored fields of the hits containing the terms of the query,
+// the terms are wrapped with the tags. The fragments are read from the indexed offsets, and the fields
+// indexed without offsets are analyzed again by their analyzers.
+type Highlight struct {
+	// highlighted fields, all the fields of the query if empty
+	Fields []uint32
+	// DefaultHighlightPreTag and DefaultHighlightPostTag when empty
+	PreTag  string
+	PostTag string
+	// FragmentSize <= 0 means DefaultFragmentSize bytes
+	FragmentSize int
+	// NumFragments <= 0 means DefaultNumFragments
+	NumFragments int
+	// analyzers of the fields indexed without offsets, the field is not highlighted without analyzer
+	Analyzers map[uint32]string
+}
+
 type Request struct {
-	//TODO
+	Query Query
+	From  int
+	// Size <= 0 means DefaultSearchSize
+	Size int
+	Sort []SortField
+	// stored fields returned with the hits, all the stored fields if empty
+	Fields []uint32
+	// similarity names of the fields, BM25 for the fields not set
+	Similarities map[uint32]string
+	// statistics of all the partitions, the statistics of the engine are used if nil
+	Statistics *Statistics
+	// aggregations computed over all the matched documents by name
+	Aggregations map[string]Aggregation
+	// highlight the hits if not nil
+	Highlight *Highlight
+}
+
+// AnalyzeRequest analyzes the text by the analyzer of the name, or by the analyzer of the field in the mapping
+type AnalyzeRequest struct {
+	Analyzer string
+	// the full path name of the field, used when the analyzer is empty
+	Field string
+	Text  []byte
+	// explain the output of every component of the analyzer
+	Explain bool
+}
+
+// TermVectorsRequest returns the indexed terms of the fields of the document
+type TermVectorsRequest struct {
+	DocID metapb.Key
+	// the indexed fields, all the indexed fields of the document if empty
+	Fields []uint32
+	// include the document frequency and the total term frequency of the terms in the engine
+	TermStatistics bool
+}
+
+// MergeRequest updates the fields of the document by the partial JSON document, or by the update script,
+// see the script package for the language of the script
+type MergeRequest struct {
+	DocID metapb.Key
+	// the fields in the partial document replace the fields of the document, a null value removes the field
+	Partial []byte
+	Script  string
+	// the JSON object of the parameters of the script
+	Params []byte
+	// create the document by the partial document or the script if it is not found
+	Upsert bool
+	// the new expiration time of the document in unix milliseconds, 0 keeps the current one
+	ExpireAt int64
 }
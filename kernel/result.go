@@ -1,5 +1,71 @@
 package kernel
 
+import (
+	"github.com/tiglabs/baudengine/kernel/analysis"
+	"github.com/tiglabs/baudengine/proto/metapb"
+	"github.com/tiglabs/baudengine/proto/pspb"
+)
+
+type Hit struct {
+	DocID  metapb.Key
+	Score  float64
+	Fields map[uint32]pspb.FieldValue
+	// highlighted fragments of the fields
+	Highlights map[uint32][]string
+	// the nested objects matched by the nested queries with inner hits, by the field ID of the nested field
+	InnerHits map[uint32][]*InnerHit
+}
+
+// InnerHit is a nested object matched by a nested query,
+// Offset is the position of the object in the values of the nested field.
+type InnerHit struct {
+	Offset int
+	Score  float64
+	Fields map[uint32]pspb.FieldValue
+}
+
 type Result struct {
-	//TODO
+	// number of the documents matched
+	Total int
+	Hits  []*Hit
+	// partial results of the aggregations of the request by name
+	Aggregations map[string]*AggregationResult
+}
+
+// DocVersion is the version of the document, it increases on every write of the document and is not
+// less than the raft index of the write, SeqNo is the raft index of the last write
+type DocVersion struct {
+	Version uint64
+	SeqNo   uint64
+}
+
+// AnalyzeResult is the tokens of the analyzed text
+type AnalyzeResult struct {
+	// the name of the analyzer used
+	Analyzer string
+	Tokens   analysis.TokenSet
+	// the output of every component when explained, nil if the analyzer is not made of the components
+	Stages []*analysis.Stage
+}
+
+// TermVectorsResult is the indexed terms of the fields of the document by field ID, in term order
+type TermVectorsResult struct {
+	Found  bool
+	Fields map[uint32][]*TermVector
+}
+
+// TermVector is an indexed term of a field of the document, the positions are empty
+// when the field is indexed without positions, and the offsets are 0 without offsets
+type TermVector struct {
+	Term      []byte
+	Freq      int
+	Positions []TermPosition
+	// the statistics of the term in the engine, set when required
+	DocFreq       int64
+	TotalTermFreq int64
+}
+
+type TermPosition struct {
+	Position   int
+	Start, End int
 }
@@ -0,0 +1,434 @@
+package script
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdent
+	tokenNumber
+	tokenString
+	tokenOp
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	// the byte offset in the source for the errors
+	pos int
+}
+
+// the operators, the longer ones first
+var operators = []string{"&&", "||", "==", "!=", "<=", ">=", "+=", "-=", "+", "-", "*", "/", "%", "<", ">", "=", "!",
+	"(", ")", "[", "]", "{", "}", ",", ";", "."}
+
+func lex(source string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(source); {
+		r, size := utf8.DecodeRuneInString(source[i:])
+		switch {
+		case unicode.IsSpace(r):
+			i += size
+		case r == '_' || unicode.IsLetter(r):
+			start := i
+			for i < len(source) {
+				r, size := utf8.DecodeRuneInString(source[i:])
+				if r != '_' && !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+					break
+				}
+				i += size
+			}
+			tokens = append(tokens, token{kind: tokenIdent, text: source[start:i], pos: start})
+		case r >= '0' && r <= '9':
+			start := i
+			for i < len(source) && (isDigit(source[i]) || source[i] == '.' ||
+				source[i] == 'e' || source[i] == 'E' ||
+				(source[i] == '-' || source[i] == '+') && (source[i-1] == 'e' || source[i-1] == 'E')) {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenNumber, text: source[start:i], pos: start})
+		case r == '"' || r == '\'':
+			start := i
+			i++
+			for i < len(source) && source[i] != byte(r) {
+				if source[i] == '\\' {
+					i++
+				}
+				i++
+			}
+			if i >= len(source) {
+				return nil, fmt.Errorf("unterminated string at %d", start)
+			}
+			i++
+			text := source[start:i]
+			if r == '\'' {
+				// the single quoted string is unquoted as the double quoted one
+				text = `"` + strings.Replace(strings.Replace(text[1:len(text)-1], `\'`, `'`, -1), `"`, `\"`, -1) + `"`
+			}
+			s, err := strconv.Unquote(text)
+			if err != nil {
+				return nil, fmt.Errorf("invalid string at %d", start)
+			}
+			tokens = append(tokens, token{kind: tokenString, text: s, pos: start})
+		default:
+			op := ""
+			for _, o := range operators {
+				if strings.HasPrefix(source[i:], o) {
+					op = o
+					break
+				}
+			}
+			if op == "" {
+				return nil, fmt.Errorf("unexpected character %q at %d", r, i)
+			}
+			tokens = append(tokens, token{kind: tokenOp, text: op, pos: i})
+			i += len(op)
+		}
+	}
+	return append(tokens, token{kind: tokenEOF, pos: len(source)}), nil
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// the statements and the expressions of the syntax tree
+type (
+	stmt interface{}
+	expr interface{}
+
+	// path = value, path += value, path -= value
+	assignStmt struct {
+		path  []string
+		op    string
+		value expr
+	}
+	// path.append(value)
+	appendStmt struct {
+		path  []string
+		value expr
+	}
+	// if (cond) {...} else {...}
+	ifStmt struct {
+		cond expr
+		then []stmt
+		els  []stmt
+	}
+
+	literalExpr struct {
+		value interface{}
+	}
+	// the field of the document, or the parameter if the path starts with params
+	pathExpr struct {
+		path []string
+	}
+	listExpr struct {
+		items []expr
+	}
+	unaryExpr struct {
+		op string
+		x  expr
+	}
+	binaryExpr struct {
+		op   string
+		x, y expr
+	}
+	callExpr struct {
+		name string
+		args []expr
+	}
+)
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) isOp(op string) bool {
+	t := p.peek()
+	return t.kind == tokenOp && t.text == op
+}
+
+func (p *parser) isKeyword(keyword string) bool {
+	t := p.peek()
+	return t.kind == tokenIdent && t.text == keyword
+}
+
+func (p *parser) expect(op string) error {
+	if !p.isOp(op) {
+		return p.unexpected(fmt.Sprintf("%q", op))
+	}
+	p.next()
+	return nil
+}
+
+func (p *parser) unexpected(expected string) error {
+	t := p.peek()
+	if t.kind == tokenEOF {
+		return fmt.Errorf("unexpected end of script, expect %s", expected)
+	}
+	return fmt.Errorf("unexpected %q at %d, expect %s", t.text, t.pos, expected)
+}
+
+// parseStmts parses the statements until the end of the script or the closing brace of the block
+func (p *parser) parseStmts() ([]stmt, error) {
+	var stmts []stmt
+	for p.peek().kind != tokenEOF && !p.isOp("}") {
+		if p.isOp(";") {
+			p.next()
+			continue
+		}
+		s, err := p.parseStmt()
+		if err != nil {
+			return nil, err
+		}
+		stmts = append(stmts, s)
+	}
+	return stmts, nil
+}
+
+func (p *parser) parseStmt() (stmt, error) {
+	if p.isKeyword("if") {
+		p.next()
+		if err := p.expect("("); err != nil {
+			return nil, err
+		}
+		cond, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+		s := &ifStmt{cond: cond}
+		if s.then, err = p.parseBody(); err != nil {
+			return nil, err
+		}
+		if p.isKeyword("else") {
+			p.next()
+			if s.els, err = p.parseBody(); err != nil {
+				return nil, err
+			}
+		}
+		return s, nil
+	}
+
+	path, err := p.parsePath()
+	if err != nil {
+		return nil, err
+	}
+	if p.isOp("(") && len(path) > 1 && path[len(path)-1] == "append" {
+		p.next()
+		value, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+		return &appendStmt{path: path[:len(path)-1], value: value}, nil
+	}
+	t := p.peek()
+	if t.kind != tokenOp || (t.text != "=" && t.text != "+=" && t.text != "-=") {
+		return nil, p.unexpected("assignment or append")
+	}
+	p.next()
+	value, err := p.parseExpr()
+	if err != nil {
+		return nil, err
+	}
+	return &assignStmt{path: path, op: t.text, value: value}, nil
+}
+
+// parseBody parses a statement or a block of statements in braces
+func (p *parser) parseBody() ([]stmt, error) {
+	if !p.isOp("{") {
+		s, err := p.parseStmt()
+		if err != nil {
+			return nil, err
+		}
+		return []stmt{s}, nil
+	}
+	p.next()
+	stmts, err := p.parseStmts()
+	if err != nil {
+		return nil, err
+	}
+	return stmts, p.expect("}")
+}
+
+func (p *parser) parsePath() ([]string, error) {
+	t := p.peek()
+	if t.kind != tokenIdent {
+		return nil, p.unexpected("field")
+	}
+	p.next()
+	path := []string{t.text}
+	for p.isOp(".") {
+		p.next()
+		t := p.peek()
+		if t.kind != tokenIdent {
+			return nil, p.unexpected("field")
+		}
+		p.next()
+		path = append(path, t.text)
+	}
+	return path, nil
+}
+
+// the binary operators by the precedence, from the lowest
+var precedences = [][]string{
+	{"||"},
+	{"&&"},
+	{"==", "!="},
+	{"<", "<=", ">", ">="},
+	{"+", "-"},
+	{"*", "/", "%"},
+}
+
+func (p *parser) parseExpr() (expr, error) {
+	return p.parseBinary(0)
+}
+
+func (p *parser) parseBinary(level int) (expr, error) {
+	if level == len(precedences) {
+		return p.parseUnary()
+	}
+	x, err := p.parseBinary(level + 1)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		t := p.peek()
+		if t.kind != tokenOp || !contains(precedences[level], t.text) {
+			return x, nil
+		}
+		p.next()
+		y, err := p.parseBinary(level + 1)
+		if err != nil {
+			return nil, err
+		}
+		x = &binaryExpr{op: t.text, x: x, y: y}
+	}
+}
+
+func contains(ops []string, op string) bool {
+	for _, o := range ops {
+		if o == op {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *parser) parseUnary() (expr, error) {
+	if p.isOp("!") || p.isOp("-") {
+		op := p.next().text
+		x, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &unaryExpr{op: op, x: x}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *parser) parsePrimary() (expr, error) {
+	t := p.peek()
+	switch t.kind {
+	case tokenNumber:
+		p.next()
+		if i, err := strconv.ParseInt(t.text, 10, 64); err == nil {
+			return &literalExpr{value: i}, nil
+		}
+		f, err := strconv.ParseFloat(t.text, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %s at %d", t.text, t.pos)
+		}
+		return &literalExpr{value: f}, nil
+	case tokenString:
+		p.next()
+		return &literalExpr{value: t.text}, nil
+	case tokenIdent:
+		switch t.text {
+		case "true", "false":
+			p.next()
+			return &literalExpr{value: t.text == "true"}, nil
+		case "null":
+			p.next()
+			return &literalExpr{value: nil}, nil
+		}
+		path, err := p.parsePath()
+		if err != nil {
+			return nil, err
+		}
+		if len(path) == 1 && p.isOp("(") {
+			p.next()
+			args, err := p.parseList(")")
+			if err != nil {
+				return nil, err
+			}
+			if _, ok := functions[path[0]]; !ok {
+				return nil, fmt.Errorf("unknown function %s at %d", path[0], t.pos)
+			}
+			return &callExpr{name: path[0], args: args}, nil
+		}
+		return &pathExpr{path: path}, nil
+	case tokenOp:
+		switch t.text {
+		case "(":
+			p.next()
+			x, err := p.parseExpr()
+			if err != nil {
+				return nil, err
+			}
+			return x, p.expect(")")
+		case "[":
+			p.next()
+			items, err := p.parseList("]")
+			if err != nil {
+				return nil, err
+			}
+			return &listExpr{items: items}, nil
+		}
+	}
+	return nil, p.unexpected("value")
+}
+
+// parseList parses the expressions separated by commas until the closing operator
+func (p *parser) parseList(end string) ([]expr, error) {
+	var items []expr
+	for !p.isOp(end) {
+		if len(items) > 0 {
+			if err := p.expect(","); err != nil {
+				return nil, err
+			}
+		}
+		x, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, x)
+	}
+	p.next()
+	return items, nil
+}
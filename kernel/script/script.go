@@ -0,0 +1,519 @@
+// Package script is the update language of the partial updates, a script sets the fields of a document by the
+// current values of the fields and the parameters, like
+//
+//	views += 1; tags.append(params.tag); if (price > params.max) { price = params.max; capped = true }
+//
+// The language has no loops, no functions with side effects and no access out of the document and the parameters,
+// and a script runs in the time linear in its length, so it is evaluated deterministically when the raft log is applied.
+package script
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"unicode/utf8"
+)
+
+// the limits keep a script from growing the document without bound
+const (
+	MaxSourceLength = 16 << 10
+	MaxStringLength = 1 << 20
+	MaxListLength   = 10000
+)
+
+// Script is a compiled update script, it is safe to run concurrently
+type Script struct {
+	stmts []stmt
+}
+
+// Compile parses the source of the script
+func Compile(source string) (*Script, error) {
+	if len(source) > MaxSourceLength {
+		return nil, fmt.Errorf("script is longer than %d bytes", MaxSourceLength)
+	}
+	tokens, err := lex(source)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+	stmts, err := p.parseStmts()
+	if err != nil {
+		return nil, err
+	}
+	if p.peek().kind != tokenEOF {
+		return nil, p.unexpected("statement")
+	}
+	return &Script{stmts: stmts}, nil
+}
+
+// ParseParams decodes the JSON object of the parameters, the integers are int64 and the other numbers float64
+func ParseParams(data []byte) (map[string]interface{}, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var params interface{}
+	if err := decoder.Decode(&params); err != nil {
+		return nil, err
+	}
+	m, ok := normalize(params).(map[string]interface{})
+	if !ok {
+		return nil, errors.New("script params is not a JSON object")
+	}
+	return m, nil
+}
+
+func normalize(v interface{}) interface{} {
+	switch v := v.(type) {
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+		f, _ := v.Float64()
+		return f
+	case []interface{}:
+		for i := range v {
+			v[i] = normalize(v[i])
+		}
+	case map[string]interface{}:
+		for k := range v {
+			v[k] = normalize(v[k])
+		}
+	}
+	return v
+}
+
+// Run runs the script on the values of the fields by the full path names, a value is nil, bool, int64, float64,
+// string or a list of them. The fields set by the script are returned with the new values, the fields are not changed.
+func (s *Script) Run(fields map[string]interface{}, params map[string]interface{}) (map[string]interface{}, error) {
+	e := &env{fields: fields, params: params, set: make(map[string]interface{})}
+	if err := e.exec(s.stmts); err != nil {
+		return nil, err
+	}
+	return e.set, nil
+}
+
+type env struct {
+	fields map[string]interface{}
+	params map[string]interface{}
+	// the fields set by the script
+	set map[string]interface{}
+}
+
+func (e *env) exec(stmts []stmt) error {
+	for _, s := range stmts {
+		var err error
+		switch s := s.(type) {
+		case *assignStmt:
+			err = e.execAssign(s)
+		case *appendStmt:
+			err = e.execAppend(s)
+		case *ifStmt:
+			var cond interface{}
+			if cond, err = e.eval(s.cond); err != nil {
+				return err
+			}
+			b, ok := cond.(bool)
+			if !ok {
+				return fmt.Errorf("if condition is %s, not bool", typeName(cond))
+			}
+			if b {
+				err = e.exec(s.then)
+			} else {
+				err = e.exec(s.els)
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *env) execAssign(s *assignStmt) error {
+	value, err := e.eval(s.value)
+	if err != nil {
+		return err
+	}
+	if s.op != "=" {
+		current, err := e.field(s.path)
+		if err != nil {
+			return err
+		}
+		// the missing field starts from the value added
+		if current == nil {
+			if s.op == "-=" {
+				current = zero(value)
+			} else {
+				return e.setField(s.path, value)
+			}
+		}
+		if value, err = binary(strings.TrimSuffix(s.op, "="), current, value); err != nil {
+			return err
+		}
+	}
+	return e.setField(s.path, value)
+}
+
+func (e *env) execAppend(s *appendStmt) error {
+	value, err := e.eval(s.value)
+	if err != nil {
+		return err
+	}
+	current, err := e.field(s.path)
+	if err != nil {
+		return err
+	}
+	var list []interface{}
+	switch current := current.(type) {
+	case nil:
+	case []interface{}:
+		// the list of the field is not changed
+		list = append(list, current...)
+	default:
+		// a single value is a list of one value
+		list = append(list, current)
+	}
+	return e.setField(s.path, append(list, value))
+}
+
+func (e *env) field(path []string) (interface{}, error) {
+	if path[0] == "params" {
+		return nil, errors.New("params can not be changed")
+	}
+	return e.lookup(path), nil
+}
+
+func (e *env) setField(path []string, value interface{}) error {
+	if path[0] == "params" {
+		return errors.New("params can not be changed")
+	}
+	if err := checkLimits(value); err != nil {
+		return err
+	}
+	e.set[strings.Join(path, ".")] = value
+	return nil
+}
+
+// lookup returns the value of the field or the parameter, nil if it is missing
+func (e *env) lookup(path []string) interface{} {
+	if path[0] != "params" {
+		name := strings.Join(path, ".")
+		if value, ok := e.set[name]; ok {
+			return value
+		}
+		return e.fields[name]
+	}
+	var value interface{} = e.params
+	for _, key := range path[1:] {
+		m, ok := value.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		value = m[key]
+	}
+	return value
+}
+
+func (e *env) eval(x expr) (interface{}, error) {
+	switch x := x.(type) {
+	case *literalExpr:
+		return x.value, nil
+	case *pathExpr:
+		return e.lookup(x.path), nil
+	case *listExpr:
+		list := make([]interface{}, 0, len(x.items))
+		for _, item := range x.items {
+			v, err := e.eval(item)
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, v)
+		}
+		return list, nil
+	case *unaryExpr:
+		v, err := e.eval(x.x)
+		if err != nil {
+			return nil, err
+		}
+		switch v := v.(type) {
+		case bool:
+			if x.op == "!" {
+				return !v, nil
+			}
+		case int64:
+			if x.op == "-" {
+				return -v, nil
+			}
+		case float64:
+			if x.op == "-" {
+				return -v, nil
+			}
+		}
+		return nil, fmt.Errorf("invalid operation %s on %s", x.op, typeName(v))
+	case *binaryExpr:
+		v, err := e.eval(x.x)
+		if err != nil {
+			return nil, err
+		}
+		// the logical operators are short-circuit
+		if x.op == "&&" || x.op == "||" {
+			b, ok := v.(bool)
+			if !ok {
+				return nil, fmt.Errorf("invalid operation %s on %s", x.op, typeName(v))
+			}
+			if b == (x.op == "||") {
+				return b, nil
+			}
+			v, err = e.eval(x.y)
+			if err != nil {
+				return nil, err
+			}
+			if _, ok := v.(bool); !ok {
+				return nil, fmt.Errorf("invalid operation %s on %s", x.op, typeName(v))
+			}
+			return v, nil
+		}
+		w, err := e.eval(x.y)
+		if err != nil {
+			return nil, err
+		}
+		return binary(x.op, v, w)
+	case *callExpr:
+		args := make([]interface{}, 0, len(x.args))
+		for _, arg := range x.args {
+			v, err := e.eval(arg)
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, v)
+		}
+		return functions[x.name](args)
+	}
+	return nil, fmt.Errorf("invalid expression %T", x)
+}
+
+func binary(op string, x, y interface{}) (interface{}, error) {
+	switch op {
+	case "==":
+		return equal(x, y), nil
+	case "!=":
+		return !equal(x, y), nil
+	case "<", "<=", ">", ">=":
+		c, err := compare(x, y)
+		if err != nil {
+			return nil, err
+		}
+		switch op {
+		case "<":
+			return c < 0, nil
+		case "<=":
+			return c <= 0, nil
+		case ">":
+			return c > 0, nil
+		default:
+			return c >= 0, nil
+		}
+	case "+":
+		switch a := x.(type) {
+		case string:
+			if b, ok := y.(string); ok {
+				return a + b, nil
+			}
+		case []interface{}:
+			if b, ok := y.([]interface{}); ok {
+				return append(append(make([]interface{}, 0, len(a)+len(b)), a...), b...), nil
+			}
+		}
+	}
+	a, aInt, ok1 := number(x)
+	b, bInt, ok2 := number(y)
+	if !ok1 || !ok2 {
+		return nil, fmt.Errorf("invalid operation %s on %s and %s", op, typeName(x), typeName(y))
+	}
+	if aInt && bInt {
+		i, j := x.(int64), y.(int64)
+		switch op {
+		case "+":
+			return i + j, nil
+		case "-":
+			return i - j, nil
+		case "*":
+			return i * j, nil
+		case "/", "%":
+			if j == 0 {
+				return nil, errors.New("division by zero")
+			}
+			if op == "/" {
+				return i / j, nil
+			}
+			return i % j, nil
+		}
+	}
+	switch op {
+	case "+":
+		return a + b, nil
+	case "-":
+		return a - b, nil
+	case "*":
+		return a * b, nil
+	case "/":
+		if b == 0 {
+			return nil, errors.New("division by zero")
+		}
+		return a / b, nil
+	case "%":
+		if b == 0 {
+			return nil, errors.New("division by zero")
+		}
+		return math.Mod(a, b), nil
+	}
+	return nil, fmt.Errorf("invalid operation %s", op)
+}
+
+func number(v interface{}) (f float64, isInt bool, ok bool) {
+	switch v := v.(type) {
+	case int64:
+		return float64(v), true, true
+	case float64:
+		return v, false, true
+	}
+	return 0, false, false
+}
+
+func equal(x, y interface{}) bool {
+	a, _, ok1 := number(x)
+	b, _, ok2 := number(y)
+	if ok1 && ok2 {
+		return a == b
+	}
+	if l1, ok := x.([]interface{}); ok {
+		l2, ok := y.([]interface{})
+		if !ok || len(l1) != len(l2) {
+			return false
+		}
+		for i := range l1 {
+			if !equal(l1[i], l2[i]) {
+				return false
+			}
+		}
+		return true
+	}
+	return reflect.DeepEqual(x, y)
+}
+
+func compare(x, y interface{}) (int, error) {
+	a, _, ok1 := number(x)
+	b, _, ok2 := number(y)
+	if ok1 && ok2 {
+		switch {
+		case a < b:
+			return -1, nil
+		case a > b:
+			return 1, nil
+		}
+		return 0, nil
+	}
+	s1, ok1 := x.(string)
+	s2, ok2 := y.(string)
+	if ok1 && ok2 {
+		return strings.Compare(s1, s2), nil
+	}
+	return 0, fmt.Errorf("can not compare %s and %s", typeName(x), typeName(y))
+}
+
+// zero returns the zero value of the type of the value, for the operations on the missing fields
+func zero(v interface{}) interface{} {
+	switch v.(type) {
+	case int64:
+		return int64(0)
+	case float64:
+		return float64(0)
+	}
+	return nil
+}
+
+func checkLimits(v interface{}) error {
+	switch v := v.(type) {
+	case string:
+		if len(v) > MaxStringLength {
+			return fmt.Errorf("string is longer than %d bytes", MaxStringLength)
+		}
+	case []interface{}:
+		if len(v) > MaxListLength {
+			return fmt.Errorf("list has more than %d values", MaxListLength)
+		}
+		for _, item := range v {
+			if err := checkLimits(item); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func typeName(v interface{}) string {
+	switch v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "bool"
+	case int64, float64:
+		return "number"
+	case string:
+		return "string"
+	case []interface{}:
+		return "list"
+	case map[string]interface{}:
+		return "object"
+	}
+	return fmt.Sprintf("%T", v)
+}
+
+// the functions of the expressions, they have no side effect
+var functions = map[string]func(args []interface{}) (interface{}, error){
+	// size returns the length of the string in chars, or of the list
+	"size": func(args []interface{}) (interface{}, error) {
+		if len(args) != 1 {
+			return nil, errors.New("size has one argument")
+		}
+		switch v := args[0].(type) {
+		case nil:
+			return int64(0), nil
+		case string:
+			return int64(utf8.RuneCountInString(v)), nil
+		case []interface{}:
+			return int64(len(v)), nil
+		}
+		return int64(1), nil
+	},
+	// contains reports whether the list has the value, or the string has the substring
+	"contains": func(args []interface{}) (interface{}, error) {
+		if len(args) != 2 {
+			return nil, errors.New("contains has two arguments")
+		}
+		switch v := args[0].(type) {
+		case nil:
+			return false, nil
+		case string:
+			s, ok := args[1].(string)
+			if !ok {
+				return nil, fmt.Errorf("contains of string needs string, not %s", typeName(args[1]))
+			}
+			return strings.Contains(v, s), nil
+		case []interface{}:
+			for _, item := range v {
+				if equal(item, args[1]) {
+					return true, nil
+				}
+			}
+			return false, nil
+		}
+		return equal(args[0], args[1]), nil
+	},
+}
@@ -0,0 +1,98 @@
+package script
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRun(t *testing.T) {
+	fields := map[string]interface{}{
+		"views":     int64(10),
+		"price":     12.5,
+		"tags":      []interface{}{"red"},
+		"title":     "quick fox",
+		"user.name": "alice",
+		"sold":      false,
+	}
+	params, err := ParseParams([]byte(`{"n": 2, "tag": "blue", "max": 10, "limits": {"views": 100}}`))
+	if err != nil {
+		t.Fatalf("parse params failed, err %v", err)
+	}
+	tests := []struct {
+		source string
+		set    map[string]interface{}
+	}{
+		{`views += 1`, map[string]interface{}{"views": int64(11)}},
+		{`views -= params.n; views += 3`, map[string]interface{}{"views": int64(11)}},
+		{`count += params.n`, map[string]interface{}{"count": int64(2)}},
+		{`price *= 2`, nil},
+		{`tags.append(params.tag)`, map[string]interface{}{"tags": []interface{}{"red", "blue"}}},
+		{`title.append("lazy dog")`, map[string]interface{}{"title": []interface{}{"quick fox", "lazy dog"}}},
+		{`labels.append('new')`, map[string]interface{}{"labels": []interface{}{"new"}}},
+		{`if (price > params.max) price = params.max`, map[string]interface{}{"price": int64(10)}},
+		{`if (price > 100) { price = 100 } else { sold = true; user.name = user.name + "!" }`,
+			map[string]interface{}{"sold": true, "user.name": "alice!"}},
+		{`if (!contains(tags, "red") || size(tags) > 1) sold = true`, map[string]interface{}{}},
+		{`if (contains(tags, "red") && views < params.limits.views) { tags = tags + ["hot"]; views = views * 2 }`,
+			map[string]interface{}{"tags": []interface{}{"red", "hot"}, "views": int64(20)}},
+		{`price = price / 2 + (views % 3) - -1.5`, map[string]interface{}{"price": 8.75}},
+		{`title = null`, map[string]interface{}{"title": nil}},
+	}
+	for i, test := range tests {
+		s, err := Compile(test.source)
+		if test.set == nil {
+			if err == nil {
+				t.Fatalf("test %d compile should fail", i)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("test %d compile failed, err %v", i, err)
+		}
+		set, err := s.Run(fields, params)
+		if err != nil {
+			t.Fatalf("test %d run failed, err %v", i, err)
+		}
+		if !reflect.DeepEqual(set, test.set) {
+			t.Fatalf("test %d failed, expect %v, got %v", i, test.set, set)
+		}
+	}
+	if !reflect.DeepEqual(fields["tags"], []interface{}{"red"}) {
+		t.Fatalf("fields changed by the script, tags %v", fields["tags"])
+	}
+}
+
+func TestRunError(t *testing.T) {
+	fields := map[string]interface{}{"views": int64(10), "title": "quick fox"}
+	invalid := []string{
+		`views +`,
+		`views = (1`,
+		`if views > 1 views = 1`,
+		`views == 1`,
+		`views = now()`,
+		`views = "unterminated`,
+		`views = 1 #`,
+	}
+	for i, source := range invalid {
+		if _, err := Compile(source); err == nil {
+			t.Fatalf("invalid script %d should fail", i)
+		}
+	}
+	failed := []string{
+		`views = views / 0`,
+		`views = title - 1`,
+		`if (title) views = 1`,
+		`if (views > title) views = 1`,
+		`params.n = 1`,
+		`views = !views`,
+	}
+	for i, source := range failed {
+		s, err := Compile(source)
+		if err != nil {
+			t.Fatalf("script %d compile failed, err %v", i, err)
+		}
+		if _, err := s.Run(fields, nil); err == nil {
+			t.Fatalf("script %d should fail", i)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package kernel
+
+// FieldStatistics is the statistics of a field used for scoring.
+type FieldStatistics struct {
+	// number of the documents having the field
+	DocCount int64
+	// total number of the tokens of the field in all the documents
+	SumLength int64
+}
+
+// Statistics is the statistics of the fields and terms of a query.
+// The statistics of all the partitions can be merged for consistent scores across partitions.
+type Statistics struct {
+	Fields map[uint32]*FieldStatistics
+	// document frequency of the terms by field
+	DocFreqs map[uint32]map[string]int64
+}
+
+func NewStatistics() *Statistics {
+	return &Statistics{
+		Fields:   make(map[uint32]*FieldStatistics),
+		DocFreqs: make(map[uint32]map[string]int64),
+	}
+}
+
+func (s *Statistics) AddField(fieldId uint32, docCount, sumLength int64) {
+	if fs, ok := s.Fields[fieldId]; ok {
+		fs.DocCount += docCount
+		fs.SumLength += sumLength
+		return
+	}
+	s.Fields[fieldId] = &FieldStatistics{DocCount: docCount, SumLength: sumLength}
+}
+
+func (s *Statistics) AddDocFreq(fieldId uint32, term []byte, docFreq int64) {
+	terms, ok := s.DocFreqs[fieldId]
+	if !ok {
+		terms = make(map[string]int64)
+		s.DocFreqs[fieldId] = terms
+	}
+	terms[string(term)] += docFreq
+}
+
+// Merge adds the statistics of other partition
+func (s *Statistics) Merge(other *Statistics) {
+	if other == nil {
+		return
+	}
+	for fieldId, fs := range other.Fields {
+		s.AddField(fieldId, fs.DocCount, fs.SumLength)
+	}
+	for fieldId, terms := range other.DocFreqs {
+		for term, docFreq := range terms {
+			s.AddDocFreq(fieldId, []byte(term), docFreq)
+		}
+	}
+}
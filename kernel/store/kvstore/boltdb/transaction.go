@@ -58,8 +58,8 @@ func(tx *Transaction) PrefixIterator(prefix []byte) kvstore.KVIterator {
 
 func(tx *Transaction) RangeIterator(start, end []byte) kvstore.KVIterator {
 	cursor := tx.bucket.Cursor()
+	// the iterator must not close the transaction
 	rv := &Iterator{
-		tx:     tx.tx,
 		cursor: cursor,
 		start:  start,
 		end:    end,
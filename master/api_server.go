@@ -3,6 +3,8 @@ package master
 import (
 	"encoding/json"
 	"fmt"
+	"github.com/pkg/errors"
+	"github.com/tiglabs/baudengine/kernel/mapping"
 	"github.com/tiglabs/baudengine/util"
 	"github.com/tiglabs/baudengine/util/log"
 	"github.com/tiglabs/baudengine/util/netutil"
@@ -27,6 +29,8 @@ const (
 	PARTITION_KEY   = "partition_key"
 	PARTITION_FUNC  = "partition_func"
 	PARTITION_NUM   = "partition_num"
+	SPACE_MAPPING   = "mapping"
+	MAPPING_VERSION = "version"
 )
 
 type ApiServer struct {
@@ -93,6 +97,11 @@ func (s *ApiServer) initAdminHandler() {
 	s.httpServer.Handle(netutil.GET, "/manage/space/list", s.handleSpaceList)
 	s.httpServer.Handle(netutil.GET, "/manage/space/detail", s.handleSpaceDetail)
 
+	s.httpServer.Handle(netutil.POST, "/manage/space/mapping/put", s.handleSpaceMappingPut)
+	s.httpServer.Handle(netutil.POST, "/manage/space/mapping/update", s.handleSpaceMappingUpdate)
+	s.httpServer.Handle(netutil.GET, "/manage/space/mapping/get", s.handleSpaceMappingGet)
+	s.httpServer.Handle(netutil.GET, "/manage/space/mapping/history", s.handleSpaceMappingHistory)
+
     s.httpServer.Handle(netutil.GET, "/manage/partition/list", s.handlePartitionList)
 	s.httpServer.Handle(netutil.GET, "/manage/ps/list", s.handlePSList)
 }
@@ -200,12 +209,23 @@ func (s *ApiServer) handleSpaceCreate(w http.ResponseWriter, r *http.Request, pa
 		return
 	}
 
+	// the mapping is optional, the space without mapping only accepts the documents of fields
+	spaceMapping := r.FormValue(SPACE_MAPPING)
+	if spaceMapping != "" {
+		if _, err := mapping.NewIndexMapping([]byte(spaceMapping)); err != nil {
+			reply := newHttpErrReply(ErrParamError)
+			reply.Msg = fmt.Sprintf("%s. invalid[%s]: %v", reply.Msg, SPACE_MAPPING, err)
+			sendReply(w, reply)
+			return
+		}
+	}
+
     policy := &PartitionPolicy{
         Key:      partitionKey,
         Function: partitionFunc,
         Number:   partitionNum,
     }
-    space, err := s.cluster.CreateSpace(dbName, spaceName, policy)
+    space, err := s.cluster.CreateSpace(dbName, spaceName, policy, []byte(spaceMapping))
     if err != nil {
         sendReply(w, newHttpErrReply(err))
         return
@@ -293,6 +313,130 @@ func (s *ApiServer) handleSpaceList(w http.ResponseWriter, r *http.Request, para
 	sendReply(w, newHttpSucReply(db.SpaceCache.GetAllSpaces()))
 }
 
+type SpaceMapping struct {
+	Version uint64          `json:"version"`
+	Mapping json.RawMessage `json:"mapping"`
+}
+
+func (s *ApiServer) handleSpaceMappingPut(w http.ResponseWriter, r *http.Request, params netutil.UriParams) {
+	if err := s.checkLeader(w); err != nil {
+		return
+	}
+
+	dbName, err := checkMissingParam(w, r, DB_NAME)
+	if err != nil {
+		return
+	}
+	spaceName, err := checkMissingParam(w, r, SPACE_NAME)
+	if err != nil {
+		return
+	}
+	spaceMapping, err := checkMissingParam(w, r, SPACE_MAPPING)
+	if err != nil {
+		return
+	}
+
+	space, err := s.cluster.PutSpaceMapping(dbName, spaceName, []byte(spaceMapping))
+	if err != nil {
+		sendReply(w, newHttpErrReplyWithCause(err))
+		return
+	}
+
+	sendReply(w, newHttpSucReply(space))
+}
+
+func (s *ApiServer) handleSpaceMappingUpdate(w http.ResponseWriter, r *http.Request, params netutil.UriParams) {
+	if err := s.checkLeader(w); err != nil {
+		return
+	}
+
+	dbName, err := checkMissingParam(w, r, DB_NAME)
+	if err != nil {
+		return
+	}
+	spaceName, err := checkMissingParam(w, r, SPACE_NAME)
+	if err != nil {
+		return
+	}
+	spaceMapping, err := checkMissingParam(w, r, SPACE_MAPPING)
+	if err != nil {
+		return
+	}
+
+	space, err := s.cluster.UpdateSpaceMapping(dbName, spaceName, []byte(spaceMapping))
+	if err != nil {
+		sendReply(w, newHttpErrReplyWithCause(err))
+		return
+	}
+
+	sendReply(w, newHttpSucReply(space))
+}
+
+func (s *ApiServer) handleSpaceMappingGet(w http.ResponseWriter, r *http.Request, params netutil.UriParams) {
+	if err := s.checkLeader(w); err != nil {
+		return
+	}
+
+	dbName, err := checkMissingParam(w, r, DB_NAME)
+	if err != nil {
+		return
+	}
+	spaceName, err := checkMissingParam(w, r, SPACE_NAME)
+	if err != nil {
+		return
+	}
+	// the version is optional, the current mapping is returned without it
+	var version uint64
+	if versionStr := r.FormValue(MAPPING_VERSION); versionStr != "" {
+		if version, err = strconv.ParseUint(versionStr, 10, 64); err != nil {
+			reply := newHttpErrReply(ErrParamError)
+			reply.Msg = fmt.Sprintf("%s, unmatched type[%s]", reply.Msg, MAPPING_VERSION)
+			sendReply(w, reply)
+			return
+		}
+	}
+
+	spaceMapping, version, err := s.cluster.GetSpaceMapping(dbName, spaceName, version)
+	if err != nil {
+		sendReply(w, newHttpErrReply(err))
+		return
+	}
+
+	sendReply(w, newHttpSucReply(&SpaceMapping{Version: version, Mapping: spaceMapping}))
+}
+
+func (s *ApiServer) handleSpaceMappingHistory(w http.ResponseWriter, r *http.Request, params netutil.UriParams) {
+	if err := s.checkLeader(w); err != nil {
+		return
+	}
+
+	dbName, err := checkMissingParam(w, r, DB_NAME)
+	if err != nil {
+		return
+	}
+	spaceName, err := checkMissingParam(w, r, SPACE_NAME)
+	if err != nil {
+		return
+	}
+
+	versions, err := s.cluster.GetSpaceMappingVersions(dbName, spaceName)
+	if err != nil {
+		sendReply(w, newHttpErrReply(err))
+		return
+	}
+	history := make([]*SpaceMapping, 0, len(versions))
+	for _, version := range versions {
+		spaceMapping, _, err := s.cluster.GetSpaceMapping(dbName, spaceName, version)
+		if err != nil {
+			sendReply(w, newHttpErrReply(err))
+			return
+		}
+		history = append(history, &SpaceMapping{Version: version, Mapping: spaceMapping})
+	}
+
+	sendReply(w, newHttpSucReply(history))
+}
+
 func (s *ApiServer) handlePartitionList(w http.ResponseWriter, r *http.Request, params netutil.UriParams) {
 	if err := s.checkLeader(w); err != nil {
 		return
@@ -344,6 +488,15 @@ func newHttpErrReply(err error) *HttpReply {
 	}
 }
 
+// newHttpErrReplyWithCause replies the code of the cause error with the whole message of the error
+func newHttpErrReplyWithCause(err error) *HttpReply {
+	reply := newHttpErrReply(errors.Cause(err))
+	if errors.Cause(err) != err {
+		reply.Msg = err.Error()
+	}
+	return reply
+}
+
 func (s *ApiServer) checkLeader(w http.ResponseWriter) error {
 	leaderInfo := s.cluster.store.GetLeaderSync()
 
@@ -1,9 +1,12 @@
 package master
 
 import (
+	"github.com/pkg/errors"
+	"github.com/tiglabs/baudengine/kernel/mapping"
 	"github.com/tiglabs/baudengine/proto/metapb"
 	"github.com/tiglabs/baudengine/util"
 	"github.com/tiglabs/baudengine/util/log"
+	"bytes"
 	"math"
 	"sync"
 )
@@ -228,7 +231,7 @@ func (c *Cluster) RenameDb(srcDbName, destDbName string) error {
 	return nil
 }
 
-func (c *Cluster) CreateSpace(dbName, spaceName string, policy *PartitionPolicy) (*Space, error) {
+func (c *Cluster) CreateSpace(dbName, spaceName string, policy *PartitionPolicy, mapping []byte) (*Space, error) {
 	c.clusterLock.Lock()
 	defer c.clusterLock.Unlock()
 
@@ -247,6 +250,11 @@ func (c *Cluster) CreateSpace(dbName, spaceName string, policy *PartitionPolicy)
 	if err != nil {
 		return nil, err
 	}
+	if len(mapping) > 0 {
+		space.Mapping = mapping
+		space.MappingVersion = 1
+		batch.Put(spaceMappingKey(space.ID, space.MappingVersion), mapping)
+	}
 	if err := space.batchPersistent(batch); err != nil {
 		return nil, err
 	}
@@ -262,6 +270,9 @@ func (c *Cluster) CreateSpace(dbName, spaceName string, policy *PartitionPolicy)
 		if err != nil {
 			return nil, err
 		}
+		// the partitions map the source documents by the mapping of the space
+		partition.Mapping = space.Mapping
+		partition.MappingVersion = space.MappingVersion
 		partitions = append(partitions, partition)
 		if err := partition.batchPersistent(batch); err != nil {
 			return nil, err
@@ -313,3 +324,82 @@ func (c *Cluster) RenameSpace(dbName, srcSpaceName, destSpaceName string) error
 
 	return nil
 }
+
+func (c *Cluster) findSpace(dbName, spaceName string) (*Space, error) {
+	db := c.DbCache.FindDbByName(dbName)
+	if db == nil {
+		return nil, ErrDbNotExists
+	}
+	space := db.SpaceCache.FindSpaceByName(spaceName)
+	if space == nil {
+		return nil, ErrSpaceNotExists
+	}
+	return space, nil
+}
+
+// PutSpaceMapping sets the first version of the mapping to the space without mapping
+func (c *Cluster) PutSpaceMapping(dbName, spaceName string, spaceMapping []byte) (*Space, error) {
+	c.clusterLock.Lock()
+	defer c.clusterLock.Unlock()
+
+	space, err := c.findSpace(dbName, spaceName)
+	if err != nil {
+		return nil, err
+	}
+	if len(space.Mapping) > 0 {
+		return nil, ErrDupSpaceMapping
+	}
+	if _, err := mapping.NewIndexMapping(spaceMapping); err != nil {
+		return nil, errors.Wrap(ErrParamError, err.Error())
+	}
+
+	if err := space.putMapping(c.store, spaceMapping); err != nil {
+		return nil, err
+	}
+	return space, nil
+}
+
+// UpdateSpaceMapping merges the update into the mapping of the space as the next version,
+// the update can add new fields, but it can not change the type of the existing fields
+func (c *Cluster) UpdateSpaceMapping(dbName, spaceName string, update []byte) (*Space, error) {
+	c.clusterLock.Lock()
+	defer c.clusterLock.Unlock()
+
+	space, err := c.findSpace(dbName, spaceName)
+	if err != nil {
+		return nil, err
+	}
+	if len(space.Mapping) == 0 {
+		return nil, ErrSpaceMappingNotExists
+	}
+	merged, err := mapping.MergeSchema(space.Mapping, update)
+	if err != nil {
+		return nil, errors.Wrap(ErrIncompatibleMapping, err.Error())
+	}
+	// the replicas of a partition send the same dynamic fields, only the first one makes a new version
+	if bytes.Equal(merged, space.Mapping) {
+		return space, nil
+	}
+
+	if err := space.putMapping(c.store, merged); err != nil {
+		return nil, err
+	}
+	return space, nil
+}
+
+// GetSpaceMapping returns the mapping of the version, the version 0 is the current mapping
+func (c *Cluster) GetSpaceMapping(dbName, spaceName string, version uint64) ([]byte, uint64, error) {
+	space, err := c.findSpace(dbName, spaceName)
+	if err != nil {
+		return nil, 0, err
+	}
+	return space.getMapping(c.store, version)
+}
+
+func (c *Cluster) GetSpaceMappingVersions(dbName, spaceName string) ([]uint64, error) {
+	space, err := c.findSpace(dbName, spaceName)
+	if err != nil {
+		return nil, err
+	}
+	return space.getMappingVersions(c.store)
+}
@@ -26,6 +26,10 @@ var (
     ErrUnknownRaftCmdType = errors.New("unknown raft command type")
     ErrRouteNotFound      = errors.New("route not found")
 
+    ErrDupSpaceMapping       = errors.New("duplicated space mapping")
+    ErrSpaceMappingNotExists = errors.New("space mapping not exists")
+    ErrIncompatibleMapping   = errors.New("incompatible space mapping")
+
     ErrRpcGetClientFailed  = errors.New("get rpc client handle is failed")
     ErrRpcInvalidResp      = errors.New("invalid rpc response")
     ErrRpcInvokeFailed     = errors.New("invoke rpc is failed")
@@ -64,6 +68,10 @@ const (
 	ERRCODE_GENID_FAILED
 	ERRCODE_LOCALDB_OPTFAILED
 
+	ERRCODE_DUP_SPACE_MAPPING
+	ERRCODE_SPACE_MAPPING_NOTEXISTS
+	ERRCODE_INCOMPATIBLE_MAPPING
+
 //	ERRCODE_UNKNOWN_RAFTCMDTYPE
 )
 
@@ -84,6 +92,10 @@ var Err2CodeMap = map[error]int32{
 
     ErrGenIdFailed:      ERRCODE_GENID_FAILED,
     ErrLocalDbOpsFailed: ERRCODE_LOCALDB_OPTFAILED,
+
+    ErrDupSpaceMapping:       ERRCODE_DUP_SPACE_MAPPING,
+    ErrSpaceMappingNotExists: ERRCODE_SPACE_MAPPING_NOTEXISTS,
+    ErrIncompatibleMapping:   ERRCODE_INCOMPATIBLE_MAPPING,
 }
 
 var Err2RpcCodeMap = map[error]metapb.RespCode{
@@ -70,6 +70,37 @@ func (p *Partition) batchPersistent(batch Batch) error {
 	return nil
 }
 
+func (p *Partition) batchPersistentMapping(batch Batch, mapping []byte, version uint64) error {
+	p.propertyLock.Lock()
+	defer p.propertyLock.Unlock()
+
+	copy := deepcopy.Iface(p.Partition).(*metapb.Partition)
+	copy.Mapping = mapping
+	copy.MappingVersion = version
+	key, val, err := doMetaMarshal(copy)
+	if err != nil {
+		return err
+	}
+	batch.Put(key, val)
+
+	return nil
+}
+
+func (p *Partition) setMapping(mapping []byte, version uint64) {
+	p.propertyLock.Lock()
+	defer p.propertyLock.Unlock()
+
+	p.Mapping = mapping
+	p.MappingVersion = version
+}
+
+func (p *Partition) getMapping() ([]byte, uint64) {
+	p.propertyLock.RLock()
+	defer p.propertyLock.RUnlock()
+
+	return p.Mapping, p.MappingVersion
+}
+
 func (p *Partition) erase(store Store) error {
 	p.propertyLock.Lock()
 	defer p.propertyLock.Unlock()
@@ -503,6 +534,16 @@ func (t *PartitionTree) find(rng *Partition) *PartitionItem {
 	return result
 }
 
+// all returns all the partitions in the tree in order
+func (t *PartitionTree) all() []*Partition {
+	partitions := make([]*Partition, 0, t.tree.Len())
+	t.tree.Ascend(func(i btree.Item) bool {
+		partitions = append(partitions, i.(*PartitionItem).partition)
+		return true
+	})
+	return partitions
+}
+
 func (t *PartitionTree) ascendScan(rng *Partition, num int) []*PartitionItem {
 	result := t.find(rng)
 	if result == nil {
@@ -30,6 +30,10 @@ const (
 type PartitionServer struct {
 	*metapb.Node
 	*masterpb.NodeSysStats
+	// the dict versions of the analysis components reported by the heartbeat
+	DictVersions map[string]uint64
+	// the errors of the analysis components failed to load their dicts reported by the heartbeat
+	DictErrors map[string]string
 
 	adminPort 	   uint32
 	status         PSStatus
@@ -106,6 +110,19 @@ func (p *PartitionServer) updateHb() {
 	p.lastHeartbeat = time.Now()
 }
 
+func (p *PartitionServer) updateDictVersions(versions map[string]uint64, errs map[string]string) {
+	p.propertyLock.Lock()
+	defer p.propertyLock.Unlock()
+
+	for name, err := range errs {
+		if _, ok := p.DictErrors[name]; !ok {
+			log.Warn("ps[%d] component %s load dicts failed, version %d, err %s", p.ID, name, versions[name], err)
+		}
+	}
+	p.DictVersions = versions
+	p.DictErrors = errs
+}
+
 func (p *PartitionServer) changeStatus(newStatus PSStatus) {
 	p.propertyLock.Lock()
 	defer p.propertyLock.Unlock()
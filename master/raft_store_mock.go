@@ -71,13 +71,15 @@ func (mr *MockStoreMockRecorder) Get(arg0 interface{}) *gomock.Call {
 }
 
 // GetLeaderAsync mocks base method
-func (m *MockStore) GetLeaderAsync(arg0 chan *LeaderInfo) {
-	m.ctrl.Call(m, "GetLeaderAsync", arg0)
+func (m *MockStore) GetLeaderAsync() <-chan *LeaderInfo {
+	ret := m.ctrl.Call(m, "GetLeaderAsync")
+	ret0, _ := ret[0].(<-chan *LeaderInfo)
+	return ret0
 }
 
 // GetLeaderAsync indicates an expected call of GetLeaderAsync
-func (mr *MockStoreMockRecorder) GetLeaderAsync(arg0 interface{}) *gomock.Call {
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLeaderAsync", reflect.TypeOf((*MockStore)(nil).GetLeaderAsync), arg0)
+func (mr *MockStoreMockRecorder) GetLeaderAsync() *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLeaderAsync", reflect.TypeOf((*MockStore)(nil).GetLeaderAsync))
 }
 
 // GetLeaderSync mocks base method
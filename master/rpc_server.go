@@ -148,6 +148,46 @@ func (s *RpcServer) GetSpace(ctx context.Context, req *masterpb.GetSpaceRequest)
 	return resp, nil
 }
 
+// UpdateMapping merges the dynamic fields found by a partition into the mapping of the space,
+// the partition maps its documents by the merged mapping in the response
+func (s *RpcServer) UpdateMapping(ctx context.Context,
+	req *masterpb.UpdateMappingRequest) (*masterpb.UpdateMappingResponse, error) {
+	resp := new(masterpb.UpdateMappingResponse)
+
+	if err, msLeader := s.validateLeader(); err != nil {
+		resp.ResponseHeader = *makeRpcRespHeaderWithError(err, msLeader)
+		return resp, nil
+	}
+
+	db := s.cluster.DbCache.FindDbById(req.DB)
+	if db == nil {
+		resp.ResponseHeader = *makeRpcRespHeader(ErrDbNotExists)
+		return resp, nil
+	}
+	space := db.SpaceCache.FindSpaceById(req.Space)
+	if space == nil {
+		resp.ResponseHeader = *makeRpcRespHeader(ErrSpaceNotExists)
+		return resp, nil
+	}
+
+	if _, err := s.cluster.UpdateSpaceMapping(db.Name, space.Name, req.Mapping); err != nil {
+		log.Error("update mapping of space[%d] from partition error: %v", req.Space, err)
+		resp.ResponseHeader = *makeRpcRespHeader(err)
+		resp.Message = err.Error()
+		return resp, nil
+	}
+	mapping, version, err := s.cluster.GetSpaceMapping(db.Name, space.Name, 0)
+	if err != nil {
+		resp.ResponseHeader = *makeRpcRespHeader(err)
+		return resp, nil
+	}
+
+	resp.Mapping = mapping
+	resp.MappingVersion = version
+	resp.ResponseHeader = *makeRpcRespHeader(ErrSuc)
+	return resp, nil
+}
+
 func (s *RpcServer) PSRegister(ctx context.Context,
 	req *masterpb.PSRegisterRequest) (*masterpb.PSRegisterResponse, error) {
 	resp := new(masterpb.PSRegisterResponse)
@@ -220,6 +260,7 @@ func (s *RpcServer) PSHeartbeat(ctx context.Context,
 		return resp, nil
 	}
 	ps.updateHb()
+	ps.updateDictVersions(req.DictVersions, req.DictErrors)
 
 	partitionInfos := req.Partitions
 	if partitionInfos == nil {
@@ -240,6 +281,16 @@ func (s *RpcServer) PSHeartbeat(ctx context.Context,
 			continue
 		}
 
+		// the leader of the partition learns the current version of the space mapping and proposes it by raft,
+		// the followers set it in the apply
+		if mapping, mappingVersion := partitionMS.getMapping(); partitionInfo.IsLeader && partitionInfo.MappingVersion < mappingVersion {
+			resp.Mappings = append(resp.Mappings, masterpb.PartitionMapping{
+				ID:             partitionId,
+				Mapping:        mapping,
+				MappingVersion: mappingVersion,
+			})
+		}
+
 		confVerMS := partitionMS.Epoch.ConfVersion
 		confVerHb := partitionInfo.Epoch.ConfVersion
 		log.Info("partition id[%v], confVerHb[%v], confVerMS[%v]", partitionId, confVerHb, confVerMS)
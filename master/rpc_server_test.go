@@ -459,8 +459,7 @@ func CreateStoreMocks(ctrl *gomock.Controller) (*MockStore, *MockBatch, *MockIte
     mockStore.EXPECT().Get(gomock.Any()).Return(nil, nil).AnyTimes()
     mockStore.EXPECT().Scan(gomock.Any(), gomock.Any()).Return(mockIterator).AnyTimes()
     mockStore.EXPECT().NewBatch().Return(mockBatch).AnyTimes()
-    mockStore.EXPECT().GetLeaderAsync(gomock.Any()).DoAndReturn(func() {
-    }).AnyTimes()
+    mockStore.EXPECT().GetLeaderAsync().Return(nil).AnyTimes()
     mockStore.EXPECT().GetLeaderSync().Return(&LeaderInfo{
         becomeLeader: true,
     }).AnyTimes()
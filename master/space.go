@@ -7,11 +7,15 @@ import (
 	"github.com/tiglabs/baudengine/util"
 	"github.com/tiglabs/baudengine/util/deepcopy"
 	"github.com/tiglabs/baudengine/util/log"
+	"sort"
+	"strconv"
+	"strings"
 	"sync"
 )
 
 const (
-	PREFIX_SPACE = "schema space "
+	PREFIX_SPACE         = "schema space "
+	PREFIX_SPACE_MAPPING = "schema mapping "
 )
 
 type PartitionPolicy struct {
@@ -117,6 +121,90 @@ func (s *Space) rename(newName string) {
 	s.Name = newName
 }
 
+// putMapping persists the mapping as the next version of the space mapping with the partitions of the space,
+// the old versions are kept in the store
+func (s *Space) putMapping(store Store, mapping []byte) error {
+	s.propertyLock.Lock()
+	defer s.propertyLock.Unlock()
+
+	batch := store.NewBatch()
+	copy := deepcopy.Iface(s.Space).(*metapb.Space)
+	copy.Mapping = mapping
+	copy.MappingVersion++
+	spaceVal, err := proto.Marshal(copy)
+	if err != nil {
+		log.Error("fail to marshal space[%v]. err:[%v]", copy, err)
+		return err
+	}
+	batch.Put([]byte(fmt.Sprintf("%s%d", PREFIX_SPACE, copy.ID)), spaceVal)
+	batch.Put(spaceMappingKey(copy.ID, copy.MappingVersion), mapping)
+
+	partitions := s.searchTree.all()
+	for _, partition := range partitions {
+		if err := partition.batchPersistentMapping(batch, mapping, copy.MappingVersion); err != nil {
+			return err
+		}
+	}
+	if err := batch.Commit(); err != nil {
+		log.Error("fail to put mapping of space[%v] into store. err:[%v]", copy, err)
+		return ErrLocalDbOpsFailed
+	}
+
+	s.Space = copy
+	for _, partition := range partitions {
+		partition.setMapping(mapping, copy.MappingVersion)
+	}
+	return nil
+}
+
+// getMapping returns the mapping of the version, the version 0 is the current mapping
+func (s *Space) getMapping(store Store, version uint64) ([]byte, uint64, error) {
+	s.propertyLock.RLock()
+	spaceId, mapping, curVersion := s.ID, s.Mapping, s.MappingVersion
+	s.propertyLock.RUnlock()
+
+	if curVersion == 0 || version > curVersion {
+		return nil, 0, ErrSpaceMappingNotExists
+	}
+	if version == 0 || version == curVersion {
+		return mapping, curVersion, nil
+	}
+	mapping, err := store.Get(spaceMappingKey(spaceId, version))
+	if err != nil {
+		log.Error("fail to get mapping[%v] of space[%v] from store. err:[%v]", version, spaceId, err)
+		return nil, 0, ErrLocalDbOpsFailed
+	}
+	if mapping == nil {
+		return nil, 0, ErrSpaceMappingNotExists
+	}
+	return mapping, version, nil
+}
+
+// getMappingVersions returns all the kept versions of the space mapping in order
+func (s *Space) getMappingVersions(store Store) ([]uint64, error) {
+	prefix := []byte(fmt.Sprintf("%s%d ", PREFIX_SPACE_MAPPING, s.ID))
+	startKey, limitKey := util.BytesPrefix(prefix)
+
+	versions := make([]uint64, 0)
+	iterator := store.Scan(startKey, limitKey)
+	defer iterator.Release()
+	for iterator.Next() {
+		version, err := strconv.ParseUint(strings.TrimPrefix(string(iterator.Key()), string(prefix)), 10, 64)
+		if err != nil {
+			log.Error("invalid space mapping key[%s]. never happened!!!", iterator.Key())
+			continue
+		}
+		versions = append(versions, version)
+	}
+	sort.Slice(versions, func(i, j int) bool { return versions[i] < versions[j] })
+
+	return versions, nil
+}
+
+func spaceMappingKey(spaceId metapb.SpaceID, version uint64) []byte {
+	return []byte(fmt.Sprintf("%s%d %d", PREFIX_SPACE_MAPPING, spaceId, version))
+}
+
 func (s *Space) putPartition(partition *Partition) {
 	s.propertyLock.Lock()
 	defer s.propertyLock.Unlock()
@@ -0,0 +1,255 @@
+package master
+
+import (
+	"sort"
+	"strconv"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/tiglabs/baudengine/proto/metapb"
+	"github.com/tiglabs/baudengine/util/assert"
+	"github.com/tiglabs/baudengine/util/raftkvstore"
+)
+
+const T_SPACEID = 2000
+
+var (
+	tMapping = []byte(`{"mappings": {"doc": {"properties": {"title": {"type": "text"}}}}}`)
+	tUpdate  = []byte(`{"mappings": {"doc": {"properties": {"author": {"type": "keyword"}}}}}`)
+)
+
+// CreateMapStoreMocks returns the store mock keeping the values in the map, so the values put are read back
+func CreateMapStoreMocks(ctrl *gomock.Controller) (*MockStore, map[string][]byte) {
+	kvs := make(map[string][]byte)
+
+	mockBatch := NewMockBatch(ctrl)
+	mockBatch.EXPECT().Put(gomock.Any(), gomock.Any()).Do(func(key, value []byte) {
+		kvs[string(key)] = value
+	}).AnyTimes()
+	mockBatch.EXPECT().Delete(gomock.Any()).Do(func(key []byte) {
+		delete(kvs, string(key))
+	}).AnyTimes()
+	mockBatch.EXPECT().Commit().Return(nil).AnyTimes()
+
+	mockStore := NewMockStore(ctrl)
+	mockStore.EXPECT().Put(gomock.Any(), gomock.Any()).DoAndReturn(func(key, value []byte) error {
+		kvs[string(key)] = value
+		return nil
+	}).AnyTimes()
+	mockStore.EXPECT().Delete(gomock.Any()).DoAndReturn(func(key []byte) error {
+		delete(kvs, string(key))
+		return nil
+	}).AnyTimes()
+	mockStore.EXPECT().Get(gomock.Any()).DoAndReturn(func(key []byte) ([]byte, error) {
+		return kvs[string(key)], nil
+	}).AnyTimes()
+	mockStore.EXPECT().Scan(gomock.Any(), gomock.Any()).DoAndReturn(func(startKey, limitKey []byte) raftkvstore.Iterator {
+		keys := make([]string, 0)
+		for key := range kvs {
+			if key >= string(startKey) && (limitKey == nil || key < string(limitKey)) {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+
+		pos := -1
+		mockIterator := NewMockIterator(ctrl)
+		mockIterator.EXPECT().Next().DoAndReturn(func() bool {
+			pos++
+			return pos < len(keys)
+		}).AnyTimes()
+		mockIterator.EXPECT().Key().DoAndReturn(func() []byte {
+			return []byte(keys[pos])
+		}).AnyTimes()
+		mockIterator.EXPECT().Value().DoAndReturn(func() []byte {
+			return kvs[keys[pos]]
+		}).AnyTimes()
+		mockIterator.EXPECT().Error().Return(nil).AnyTimes()
+		mockIterator.EXPECT().Release().AnyTimes()
+		return mockIterator
+	}).AnyTimes()
+	mockStore.EXPECT().NewBatch().Return(mockBatch).AnyTimes()
+	mockStore.EXPECT().GetLeaderAsync().Return(nil).AnyTimes()
+	mockStore.EXPECT().GetLeaderSync().Return(&LeaderInfo{
+		becomeLeader: true,
+	}).AnyTimes()
+
+	return mockStore, kvs
+}
+
+// InitMappingSpace adds the space without mapping and its partitions to the cluster
+func InitMappingSpace(cluster *Cluster) *Space {
+	db := NewDBByMeta(&metapb.DB{ID: T_DBID, Name: T_DB1})
+	cluster.DbCache.AddDb(db)
+	space := NewSpaceByMeta(&metapb.Space{ID: T_SPACEID, DB: T_DBID, DbName: T_DB1, Name: T_SPACE1})
+	db.SpaceCache.AddSpace(space)
+
+	for pIdx := 0; pIdx < T_PARTITION_MAX; pIdx++ {
+		partition := NewPartitionByMeta(&metapb.Partition{
+			ID:        metapb.PartitionID(T_PARTITIONID_START + pIdx),
+			DB:        T_DBID,
+			Space:     T_SPACEID,
+			StartSlot: metapb.SlotID(pIdx * 100),
+			EndSlot:   metapb.SlotID((pIdx + 1) * 100),
+		})
+		space.putPartition(partition)
+		cluster.PartitionCache.AddPartition(partition)
+	}
+	return space
+}
+
+func TestPutSpaceMapping(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStore, kvs := CreateMapStoreMocks(ctrl)
+	cluster := NewCluster(nil, mockStore)
+	InitMappingSpace(cluster)
+
+	_, err := cluster.PutSpaceMapping(T_DB1, T_SPACE1, []byte(`{"mappings": {"doc": {"properties": {"title": {"type": "unknown"}}}}}`))
+	assert.Equal(t, errors.Cause(err), ErrParamError, "invalid mapping should be rejected")
+
+	space, err := cluster.PutSpaceMapping(T_DB1, T_SPACE1, tMapping)
+	assert.NilError(t, err)
+	assert.Equal(t, space.MappingVersion, uint64(1), "unmatched mapping version")
+	assert.Equal(t, string(kvs[string(spaceMappingKey(T_SPACEID, 1))]), string(tMapping), "mapping not persisted")
+	for _, partition := range space.searchTree.all() {
+		mapping, version := partition.getMapping()
+		assert.Equal(t, version, uint64(1), "unmatched partition mapping version")
+		assert.Equal(t, string(mapping), string(tMapping), "unmatched partition mapping")
+	}
+
+	_, err = cluster.PutSpaceMapping(T_DB1, T_SPACE1, tMapping)
+	assert.Equal(t, err, ErrDupSpaceMapping, "the mapping can be put only once")
+
+	_, err = cluster.PutSpaceMapping(T_DB1, "space2", tMapping)
+	assert.Equal(t, err, ErrSpaceNotExists, "space should not exist")
+}
+
+func TestUpdateSpaceMapping(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStore, _ := CreateMapStoreMocks(ctrl)
+	cluster := NewCluster(nil, mockStore)
+	InitMappingSpace(cluster)
+
+	_, err := cluster.UpdateSpaceMapping(T_DB1, T_SPACE1, tUpdate)
+	assert.Equal(t, err, ErrSpaceMappingNotExists, "the space has no mapping to update")
+
+	_, err = cluster.PutSpaceMapping(T_DB1, T_SPACE1, tMapping)
+	assert.NilError(t, err)
+	space, err := cluster.UpdateSpaceMapping(T_DB1, T_SPACE1, tUpdate)
+	assert.NilError(t, err)
+	assert.Equal(t, space.MappingVersion, uint64(2), "the update should make a new version")
+	merged := space.Mapping
+	for _, partition := range space.searchTree.all() {
+		mapping, version := partition.getMapping()
+		assert.Equal(t, version, uint64(2), "unmatched partition mapping version")
+		assert.Equal(t, string(mapping), string(merged), "unmatched partition mapping")
+	}
+
+	// the replicas of a partition send the same dynamic fields, the same update makes no new version
+	space, err = cluster.UpdateSpaceMapping(T_DB1, T_SPACE1, tUpdate)
+	assert.NilError(t, err)
+	assert.Equal(t, space.MappingVersion, uint64(2), "the same update should not make a new version")
+
+	_, err = cluster.UpdateSpaceMapping(T_DB1, T_SPACE1, []byte(`{"mappings": {"doc": {"properties": {"title": {"type": "long"}}}}}`))
+	assert.Equal(t, errors.Cause(err), ErrIncompatibleMapping, "the type of the field can not be changed")
+	assert.Equal(t, space.MappingVersion, uint64(2), "the failed update should not make a new version")
+
+	mapping, version, err := cluster.GetSpaceMapping(T_DB1, T_SPACE1, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, version, uint64(1), "unmatched mapping version")
+	assert.Equal(t, string(mapping), string(tMapping), "the old version should be kept")
+	mapping, version, err = cluster.GetSpaceMapping(T_DB1, T_SPACE1, 0)
+	assert.NilError(t, err)
+	assert.Equal(t, version, uint64(2), "unmatched current mapping version")
+	assert.Equal(t, string(mapping), string(merged), "unmatched current mapping")
+	_, _, err = cluster.GetSpaceMapping(T_DB1, T_SPACE1, 3)
+	assert.Equal(t, err, ErrSpaceMappingNotExists, "the version should not exist")
+}
+
+func TestGetMappingVersions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStore, kvs := CreateMapStoreMocks(ctrl)
+	cluster := NewCluster(nil, mockStore)
+	InitMappingSpace(cluster)
+	// the mapping of another space is not listed
+	kvs[string(spaceMappingKey(T_SPACEID+1, 1))] = tMapping
+
+	versions, err := cluster.GetSpaceMappingVersions(T_DB1, T_SPACE1)
+	assert.NilError(t, err)
+	assert.Equal(t, len(versions), 0, "the space has no mapping version")
+
+	_, err = cluster.PutSpaceMapping(T_DB1, T_SPACE1, tMapping)
+	assert.NilError(t, err)
+	for i := 0; i < 10; i++ {
+		update := []byte(`{"mappings": {"doc": {"properties": {"field` + strconv.Itoa(i) + `": {"type": "keyword"}}}}}`)
+		_, err = cluster.UpdateSpaceMapping(T_DB1, T_SPACE1, update)
+		assert.NilError(t, err)
+	}
+
+	// the versions are sorted by number, not by the keys in the store
+	versions, err = cluster.GetSpaceMappingVersions(T_DB1, T_SPACE1)
+	assert.NilError(t, err)
+	assert.Equal(t, len(versions), 11, "unmatched number of mapping versions")
+	for i, version := range versions {
+		assert.Equal(t, version, uint64(i+1), "unmatched mapping version")
+	}
+}
+
+func TestPSHeartbeatMapping(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStore, _ := CreateMapStoreMocks(ctrl)
+	MockPushEvent(ctrl)
+	cluster := NewCluster(nil, mockStore)
+	rpcServer := new(RpcServer)
+	rpcServer.cluster = cluster
+
+	InitPsCache(cluster)
+	InitMappingSpace(cluster)
+	_, err := cluster.PutSpaceMapping(T_DB1, T_SPACE1, tMapping)
+	assert.NilError(t, err)
+
+	var leaderPsId = T_PSID_START
+	var followerPsId = T_PSID_START + 1
+
+	// the followers set the mapping in the apply of the raft command proposed by the leader
+	req := NewPSHeartbeatRequest(t, followerPsId, leaderPsId, 1, T_REPLICAID_START, 0, 0)
+	resp, err := rpcServer.PSHeartbeat(nil, req)
+	assert.NilError(t, err)
+	assert.Equal(t, len(resp.Mappings), 0, "the follower should not learn the mapping")
+
+	req = NewPSHeartbeatRequest(t, leaderPsId, leaderPsId, 1, T_REPLICAID_START, 0, 0)
+	resp, err = rpcServer.PSHeartbeat(nil, req)
+	assert.NilError(t, err)
+	assert.Equal(t, len(resp.Mappings), T_PARTITION_MAX, "the leader should learn the mapping of every partition")
+	for _, m := range resp.Mappings {
+		assert.Equal(t, m.MappingVersion, uint64(1), "unmatched mapping version")
+		assert.Equal(t, string(m.Mapping), string(tMapping), "unmatched mapping")
+	}
+
+	// the leader reports the current version after the apply
+	req = NewPSHeartbeatRequest(t, leaderPsId, leaderPsId, 1, T_REPLICAID_START, 0, 0)
+	for i := range req.Partitions {
+		req.Partitions[i].MappingVersion = 1
+	}
+	resp, err = rpcServer.PSHeartbeat(nil, req)
+	assert.NilError(t, err)
+	assert.Equal(t, len(resp.Mappings), 0, "the leader has the current mapping")
+
+	_, err = cluster.UpdateSpaceMapping(T_DB1, T_SPACE1, tUpdate)
+	assert.NilError(t, err)
+	resp, err = rpcServer.PSHeartbeat(nil, req)
+	assert.NilError(t, err)
+	assert.Equal(t, len(resp.Mappings), T_PARTITION_MAX, "the leader should learn the updated mapping")
+	for _, m := range resp.Mappings {
+		assert.Equal(t, m.MappingVersion, uint64(2), "unmatched mapping version")
+	}
+}
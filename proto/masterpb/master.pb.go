@@ -30,12 +30,15 @@
 		PSConfig
 		PSHeartbeatRequest
 		PSHeartbeatResponse
+		PartitionMapping
 		PartitionInfo
 		RuntimeInfo
 		RaftStatus
 		RaftFollowerStatus
 		NodeSysStats
 		PartitionStats
+		UpdateMappingRequest
+		UpdateMappingResponse
 */
 package masterpb
 
@@ -47,6 +50,8 @@ import meta "github.com/tiglabs/baudengine/proto/metapb"
 
 import github_com_tiglabs_baudengine_proto_metapb "github.com/tiglabs/baudengine/proto/metapb"
 
+import bytes "bytes"
+
 import context "golang.org/x/net/context"
 import grpc "google.golang.org/grpc"
 
@@ -54,6 +59,7 @@ import binary "encoding/binary"
 
 import strings "strings"
 import reflect "reflect"
+import sortkeys "github.com/gogo/protobuf/sortkeys"
 
 import io "io"
 
@@ -289,6 +295,10 @@ type PSHeartbeatRequest struct {
 	NodeID             github_com_tiglabs_baudengine_proto_metapb.NodeID `protobuf:"varint,2,opt,name=nodeID,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.NodeID" json:"nodeID,omitempty"`
 	Partitions         []PartitionInfo                                   `protobuf:"bytes,3,rep,name=partitions" json:"partitions"`
 	SysStats           NodeSysStats                                      `protobuf:"bytes,4,opt,name=sys_stats,json=sysStats" json:"sys_stats"`
+	// the dict versions of the reloadable analysis components on the node
+	DictVersions map[string]uint64 `protobuf:"bytes,5,rep,name=dict_versions,json=dictVersions" json:"dict_versions,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// the errors of the last failed loadings of the dicts of the components, by the component names
+	DictErrors map[string]string `protobuf:"bytes,6,rep,name=dict_errors,json=dictErrors" json:"dict_errors,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (m *PSHeartbeatRequest) Reset()                    { *m = PSHeartbeatRequest{} }
@@ -297,24 +307,37 @@ func (*PSHeartbeatRequest) Descriptor() ([]byte, []int) { return fileDescriptorM
 
 type PSHeartbeatResponse struct {
 	meta.ResponseHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	// the mappings of the partitions which report an old mapping version
+	Mappings []PartitionMapping `protobuf:"bytes,2,rep,name=mappings" json:"mappings"`
 }
 
 func (m *PSHeartbeatResponse) Reset()                    { *m = PSHeartbeatResponse{} }
 func (*PSHeartbeatResponse) ProtoMessage()               {}
 func (*PSHeartbeatResponse) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{21} }
 
+type PartitionMapping struct {
+	ID             github_com_tiglabs_baudengine_proto_metapb.PartitionID `protobuf:"varint,1,opt,name=id,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.PartitionID" json:"id,omitempty"`
+	Mapping        []byte                                                 `protobuf:"bytes,2,opt,name=mapping,proto3" json:"mapping,omitempty"`
+	MappingVersion uint64                                                 `protobuf:"varint,3,opt,name=mapping_version,json=mappingVersion,proto3" json:"mapping_version,omitempty"`
+}
+
+func (m *PartitionMapping) Reset()                    { *m = PartitionMapping{} }
+func (*PartitionMapping) ProtoMessage()               {}
+func (*PartitionMapping) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{22} }
+
 type PartitionInfo struct {
-	ID         github_com_tiglabs_baudengine_proto_metapb.PartitionID `protobuf:"varint,1,opt,name=id,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.PartitionID" json:"id,omitempty"`
-	IsLeader   bool                                                   `protobuf:"varint,2,opt,name=is_leader,json=isLeader,proto3" json:"is_leader,omitempty"`
-	Status     meta.PartitionStatus                                   `protobuf:"varint,3,opt,name=status,proto3,enum=PartitionStatus" json:"status,omitempty"`
-	Epoch      meta.PartitionEpoch                                    `protobuf:"bytes,4,opt,name=epoch" json:"epoch"`
-	Statistics PartitionStats                                         `protobuf:"bytes,5,opt,name=statistics" json:"statistics"`
-	RaftStatus *RaftStatus                                            `protobuf:"bytes,6,opt,name=raft_status,json=raftStatus" json:"raft_status,omitempty"`
+	ID             github_com_tiglabs_baudengine_proto_metapb.PartitionID `protobuf:"varint,1,opt,name=id,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.PartitionID" json:"id,omitempty"`
+	IsLeader       bool                                                   `protobuf:"varint,2,opt,name=is_leader,json=isLeader,proto3" json:"is_leader,omitempty"`
+	Status         meta.PartitionStatus                                   `protobuf:"varint,3,opt,name=status,proto3,enum=PartitionStatus" json:"status,omitempty"`
+	Epoch          meta.PartitionEpoch                                    `protobuf:"bytes,4,opt,name=epoch" json:"epoch"`
+	Statistics     PartitionStats                                         `protobuf:"bytes,5,opt,name=statistics" json:"statistics"`
+	RaftStatus     *RaftStatus                                            `protobuf:"bytes,6,opt,name=raft_status,json=raftStatus" json:"raft_status,omitempty"`
+	MappingVersion uint64                                                 `protobuf:"varint,7,opt,name=mapping_version,json=mappingVersion,proto3" json:"mapping_version,omitempty"`
 }
 
 func (m *PartitionInfo) Reset()                    { *m = PartitionInfo{} }
 func (*PartitionInfo) ProtoMessage()               {}
-func (*PartitionInfo) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{22} }
+func (*PartitionInfo) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{23} }
 
 type RuntimeInfo struct {
 	AppVersion string `protobuf:"bytes,1,opt,name=app_version,json=appVersion,proto3" json:"app_version,omitempty"`
@@ -325,7 +348,7 @@ type RuntimeInfo struct {
 
 func (m *RuntimeInfo) Reset()                    { *m = RuntimeInfo{} }
 func (*RuntimeInfo) ProtoMessage()               {}
-func (*RuntimeInfo) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{23} }
+func (*RuntimeInfo) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{24} }
 
 type RaftStatus struct {
 	meta.Replica `protobuf:"bytes,1,opt,name=replica,embedded=replica" json:"replica"`
@@ -338,7 +361,7 @@ type RaftStatus struct {
 
 func (m *RaftStatus) Reset()                    { *m = RaftStatus{} }
 func (*RaftStatus) ProtoMessage()               {}
-func (*RaftStatus) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{24} }
+func (*RaftStatus) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{25} }
 
 type RaftFollowerStatus struct {
 	meta.Replica `protobuf:"bytes,1,opt,name=replica,embedded=replica" json:"replica"`
@@ -351,7 +374,7 @@ type RaftFollowerStatus struct {
 
 func (m *RaftFollowerStatus) Reset()                    { *m = RaftFollowerStatus{} }
 func (*RaftFollowerStatus) ProtoMessage()               {}
-func (*RaftFollowerStatus) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{25} }
+func (*RaftFollowerStatus) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{26} }
 
 type NodeSysStats struct {
 	// Memory
@@ -388,7 +411,7 @@ type NodeSysStats struct {
 
 func (m *NodeSysStats) Reset()                    { *m = NodeSysStats{} }
 func (*NodeSysStats) ProtoMessage()               {}
-func (*NodeSysStats) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{26} }
+func (*NodeSysStats) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{27} }
 
 type PartitionStats struct {
 	Size_                  uint64 `protobuf:"varint,1,opt,name=size,proto3" json:"size,omitempty"`
@@ -401,7 +424,29 @@ type PartitionStats struct {
 
 func (m *PartitionStats) Reset()                    { *m = PartitionStats{} }
 func (*PartitionStats) ProtoMessage()               {}
-func (*PartitionStats) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{27} }
+func (*PartitionStats) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{28} }
+
+// the partition merges the new fields of the dynamic mapping into the space mapping
+type UpdateMappingRequest struct {
+	meta.RequestHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	DB                 github_com_tiglabs_baudengine_proto_metapb.DBID    `protobuf:"varint,2,opt,name=db,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.DBID" json:"db,omitempty"`
+	Space              github_com_tiglabs_baudengine_proto_metapb.SpaceID `protobuf:"varint,3,opt,name=space,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.SpaceID" json:"space,omitempty"`
+	Mapping            []byte                                             `protobuf:"bytes,4,opt,name=mapping,proto3" json:"mapping,omitempty"`
+}
+
+func (m *UpdateMappingRequest) Reset()                    { *m = UpdateMappingRequest{} }
+func (*UpdateMappingRequest) ProtoMessage()               {}
+func (*UpdateMappingRequest) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{29} }
+
+type UpdateMappingResponse struct {
+	meta.ResponseHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	Mapping             []byte `protobuf:"bytes,2,opt,name=mapping,proto3" json:"mapping,omitempty"`
+	MappingVersion      uint64 `protobuf:"varint,3,opt,name=mapping_version,json=mappingVersion,proto3" json:"mapping_version,omitempty"`
+}
+
+func (m *UpdateMappingResponse) Reset()                    { *m = UpdateMappingResponse{} }
+func (*UpdateMappingResponse) ProtoMessage()               {}
+func (*UpdateMappingResponse) Descriptor() ([]byte, []int) { return fileDescriptorMaster, []int{30} }
 
 func init() {
 	proto.RegisterType((*GMaster)(nil), "GMaster")
@@ -426,12 +471,15 @@ func init() {
 	proto.RegisterType((*PSConfig)(nil), "PSConfig")
 	proto.RegisterType((*PSHeartbeatRequest)(nil), "PSHeartbeatRequest")
 	proto.RegisterType((*PSHeartbeatResponse)(nil), "PSHeartbeatResponse")
+	proto.RegisterType((*PartitionMapping)(nil), "PartitionMapping")
 	proto.RegisterType((*PartitionInfo)(nil), "PartitionInfo")
 	proto.RegisterType((*RuntimeInfo)(nil), "RuntimeInfo")
 	proto.RegisterType((*RaftStatus)(nil), "RaftStatus")
 	proto.RegisterType((*RaftFollowerStatus)(nil), "RaftFollowerStatus")
 	proto.RegisterType((*NodeSysStats)(nil), "NodeSysStats")
 	proto.RegisterType((*PartitionStats)(nil), "PartitionStats")
+	proto.RegisterType((*UpdateMappingRequest)(nil), "UpdateMappingRequest")
+	proto.RegisterType((*UpdateMappingResponse)(nil), "UpdateMappingResponse")
 	proto.RegisterEnum("ReplicaChangeType", ReplicaChangeType_name, ReplicaChangeType_value)
 }
 func (this *GMaster) Equal(that interface{}) bool {
@@ -1070,6 +1118,22 @@ func (this *PSHeartbeatRequest) Equal(that interface{}) bool {
 	if !this.SysStats.Equal(&that1.SysStats) {
 		return false
 	}
+	if len(this.DictVersions) != len(that1.DictVersions) {
+		return false
+	}
+	for i := range this.DictVersions {
+		if this.DictVersions[i] != that1.DictVersions[i] {
+			return false
+		}
+	}
+	if len(this.DictErrors) != len(that1.DictErrors) {
+		return false
+	}
+	for i := range this.DictErrors {
+		if this.DictErrors[i] != that1.DictErrors[i] {
+			return false
+		}
+	}
 	return true
 }
 func (this *PSHeartbeatResponse) Equal(that interface{}) bool {
@@ -1094,6 +1158,44 @@ func (this *PSHeartbeatResponse) Equal(that interface{}) bool {
 	if !this.ResponseHeader.Equal(&that1.ResponseHeader) {
 		return false
 	}
+	if len(this.Mappings) != len(that1.Mappings) {
+		return false
+	}
+	for i := range this.Mappings {
+		if !this.Mappings[i].Equal(&that1.Mappings[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *PartitionMapping) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PartitionMapping)
+	if !ok {
+		that2, ok := that.(PartitionMapping)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ID != that1.ID {
+		return false
+	}
+	if !bytes.Equal(this.Mapping, that1.Mapping) {
+		return false
+	}
+	if this.MappingVersion != that1.MappingVersion {
+		return false
+	}
 	return true
 }
 func (this *PartitionInfo) Equal(that interface{}) bool {
@@ -1133,6 +1235,9 @@ func (this *PartitionInfo) Equal(that interface{}) bool {
 	if !this.RaftStatus.Equal(that1.RaftStatus) {
 		return false
 	}
+	if this.MappingVersion != that1.MappingVersion {
+		return false
+	}
 	return true
 }
 func (this *RuntimeInfo) Equal(that interface{}) bool {
@@ -1362,6 +1467,69 @@ func (this *PartitionStats) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *UpdateMappingRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UpdateMappingRequest)
+	if !ok {
+		that2, ok := that.(UpdateMappingRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.RequestHeader.Equal(&that1.RequestHeader) {
+		return false
+	}
+	if this.DB != that1.DB {
+		return false
+	}
+	if this.Space != that1.Space {
+		return false
+	}
+	if !bytes.Equal(this.Mapping, that1.Mapping) {
+		return false
+	}
+	return true
+}
+func (this *UpdateMappingResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UpdateMappingResponse)
+	if !ok {
+		that2, ok := that.(UpdateMappingResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ResponseHeader.Equal(&that1.ResponseHeader) {
+		return false
+	}
+	if !bytes.Equal(this.Mapping, that1.Mapping) {
+		return false
+	}
+	if this.MappingVersion != that1.MappingVersion {
+		return false
+	}
+	return true
+}
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
@@ -1383,6 +1551,7 @@ type MasterRpcClient interface {
 	DeletePartition(ctx context.Context, in *DeletePartitionRequest, opts ...grpc.CallOption) (*DeletePartitionResponse, error)
 	ChangeReplica(ctx context.Context, in *ChangeReplicaRequest, opts ...grpc.CallOption) (*ChangeReplicaResponse, error)
 	ChangeLeader(ctx context.Context, in *ChangeLeaderRequest, opts ...grpc.CallOption) (*ChangeLeaderResponse, error)
+	UpdateMapping(ctx context.Context, in *UpdateMappingRequest, opts ...grpc.CallOption) (*UpdateMappingResponse, error)
 }
 
 type masterRpcClient struct {
@@ -1474,6 +1643,15 @@ func (c *masterRpcClient) ChangeLeader(ctx context.Context, in *ChangeLeaderRequ
 	return out, nil
 }
 
+func (c *masterRpcClient) UpdateMapping(ctx context.Context, in *UpdateMappingRequest, opts ...grpc.CallOption) (*UpdateMappingResponse, error) {
+	out := new(UpdateMappingResponse)
+	err := grpc.Invoke(ctx, "/MasterRpc/UpdateMapping", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for MasterRpc service
 
 type MasterRpcServer interface {
@@ -1486,6 +1664,7 @@ type MasterRpcServer interface {
 	DeletePartition(context.Context, *DeletePartitionRequest) (*DeletePartitionResponse, error)
 	ChangeReplica(context.Context, *ChangeReplicaRequest) (*ChangeReplicaResponse, error)
 	ChangeLeader(context.Context, *ChangeLeaderRequest) (*ChangeLeaderResponse, error)
+	UpdateMapping(context.Context, *UpdateMappingRequest) (*UpdateMappingResponse, error)
 }
 
 func RegisterMasterRpcServer(s *grpc.Server, srv MasterRpcServer) {
@@ -1654,6 +1833,24 @@ func _MasterRpc_ChangeLeader_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MasterRpc_UpdateMapping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMappingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MasterRpcServer).UpdateMapping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/MasterRpc/UpdateMapping",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterRpcServer).UpdateMapping(ctx, req.(*UpdateMappingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _MasterRpc_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "MasterRpc",
 	HandlerType: (*MasterRpcServer)(nil),
@@ -1694,6 +1891,10 @@ var _MasterRpc_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ChangeLeader",
 			Handler:    _MasterRpc_ChangeLeader_Handler,
 		},
+		{
+			MethodName: "UpdateMapping",
+			Handler:    _MasterRpc_UpdateMapping_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "master.proto",
@@ -2477,6 +2678,39 @@ func (m *PSHeartbeatRequest) MarshalTo(dAtA []byte) (int, error) {
 		return 0, err
 	}
 	i += n25
+	if len(m.DictVersions) > 0 {
+		for k, _ := range m.DictVersions {
+			dAtA[i] = 0x2a
+			i++
+			v := m.DictVersions[k]
+			mapSize := 1 + len(k) + sovMaster(uint64(len(k))) + 1 + sovMaster(uint64(v))
+			i = encodeVarintMaster(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintMaster(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x10
+			i++
+			i = encodeVarintMaster(dAtA, i, uint64(v))
+		}
+	}
+	if len(m.DictErrors) > 0 {
+		for k, _ := range m.DictErrors {
+			dAtA[i] = 0x32
+			i++
+			v := m.DictErrors[k]
+			mapSize := 1 + len(k) + sovMaster(uint64(len(k))) + 1 + len(v) + sovMaster(uint64(len(v)))
+			i = encodeVarintMaster(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintMaster(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintMaster(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
 	return i, nil
 }
 
@@ -2503,6 +2737,52 @@ func (m *PSHeartbeatResponse) MarshalTo(dAtA []byte) (int, error) {
 		return 0, err
 	}
 	i += n26
+	if len(m.Mappings) > 0 {
+		for _, msg := range m.Mappings {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintMaster(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *PartitionMapping) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PartitionMapping) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.ID != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintMaster(dAtA, i, uint64(m.ID))
+	}
+	if len(m.Mapping) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintMaster(dAtA, i, uint64(len(m.Mapping)))
+		i += copy(dAtA[i:], m.Mapping)
+	}
+	if m.MappingVersion != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintMaster(dAtA, i, uint64(m.MappingVersion))
+	}
 	return i, nil
 }
 
@@ -2567,6 +2847,11 @@ func (m *PartitionInfo) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n29
 	}
+	if m.MappingVersion != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintMaster(dAtA, i, uint64(m.MappingVersion))
+	}
 	return i, nil
 }
 
@@ -2888,6 +3173,85 @@ func (m *PartitionStats) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *UpdateMappingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateMappingRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintMaster(dAtA, i, uint64(m.RequestHeader.Size()))
+	n32, err := m.RequestHeader.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n32
+	if m.DB != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintMaster(dAtA, i, uint64(m.DB))
+	}
+	if m.Space != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintMaster(dAtA, i, uint64(m.Space))
+	}
+	if len(m.Mapping) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintMaster(dAtA, i, uint64(len(m.Mapping)))
+		i += copy(dAtA[i:], m.Mapping)
+	}
+	return i, nil
+}
+
+func (m *UpdateMappingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateMappingResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintMaster(dAtA, i, uint64(m.ResponseHeader.Size()))
+	n33, err := m.ResponseHeader.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n33
+	if len(m.Mapping) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintMaster(dAtA, i, uint64(len(m.Mapping)))
+		i += copy(dAtA[i:], m.Mapping)
+	}
+	if m.MappingVersion != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintMaster(dAtA, i, uint64(m.MappingVersion))
+	}
+	return i, nil
+}
+
 func encodeVarintMaster(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -3150,6 +3514,21 @@ func NewPopulatedPSHeartbeatRequest(r randyMaster, easy bool) *PSHeartbeatReques
 	}
 	v32 := NewPopulatedNodeSysStats(r, easy)
 	this.SysStats = *v32
+	if r.Intn(10) != 0 {
+		v33 := r.Intn(10)
+		this.DictVersions = make(map[string]uint64)
+		for i := 0; i < v33; i++ {
+			v34 := randStringMaster(r)
+			this.DictVersions[v34] = uint64(uint64(r.Uint32()))
+		}
+	}
+	if r.Intn(10) != 0 {
+		v35 := r.Intn(10)
+		this.DictErrors = make(map[string]string)
+		for i := 0; i < v35; i++ {
+			this.DictErrors[randStringMaster(r)] = randStringMaster(r)
+		}
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3157,25 +3536,48 @@ func NewPopulatedPSHeartbeatRequest(r randyMaster, easy bool) *PSHeartbeatReques
 
 func NewPopulatedPSHeartbeatResponse(r randyMaster, easy bool) *PSHeartbeatResponse {
 	this := &PSHeartbeatResponse{}
-	v33 := meta.NewPopulatedResponseHeader(r, easy)
-	this.ResponseHeader = *v33
-	if !easy && r.Intn(10) != 0 {
-	}
-	return this
-}
+	v36 := meta.NewPopulatedResponseHeader(r, easy)
+	this.ResponseHeader = *v36
+	if r.Intn(10) != 0 {
+		v37 := r.Intn(5)
+		this.Mappings = make([]PartitionMapping, v37)
+		for i := 0; i < v37; i++ {
+			v38 := NewPopulatedPartitionMapping(r, easy)
+			this.Mappings[i] = *v38
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedPartitionMapping(r randyMaster, easy bool) *PartitionMapping {
+	this := &PartitionMapping{}
+	this.ID = github_com_tiglabs_baudengine_proto_metapb.PartitionID(r.Uint32())
+	v39 := r.Intn(100)
+	this.Mapping = make([]byte, v39)
+	for i := 0; i < v39; i++ {
+		this.Mapping[i] = byte(r.Intn(256))
+	}
+	this.MappingVersion = uint64(uint64(r.Uint32()))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
 
 func NewPopulatedPartitionInfo(r randyMaster, easy bool) *PartitionInfo {
 	this := &PartitionInfo{}
 	this.ID = github_com_tiglabs_baudengine_proto_metapb.PartitionID(r.Uint32())
 	this.IsLeader = bool(bool(r.Intn(2) == 0))
 	this.Status = meta.PartitionStatus([]int32{0, 1, 2, 3, 4}[r.Intn(5)])
-	v34 := meta.NewPopulatedPartitionEpoch(r, easy)
-	this.Epoch = *v34
-	v35 := NewPopulatedPartitionStats(r, easy)
-	this.Statistics = *v35
+	v40 := meta.NewPopulatedPartitionEpoch(r, easy)
+	this.Epoch = *v40
+	v41 := NewPopulatedPartitionStats(r, easy)
+	this.Statistics = *v41
 	if r.Intn(10) != 0 {
 		this.RaftStatus = NewPopulatedRaftStatus(r, easy)
 	}
+	this.MappingVersion = uint64(uint64(r.Uint32()))
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3194,18 +3596,18 @@ func NewPopulatedRuntimeInfo(r randyMaster, easy bool) *RuntimeInfo {
 
 func NewPopulatedRaftStatus(r randyMaster, easy bool) *RaftStatus {
 	this := &RaftStatus{}
-	v36 := meta.NewPopulatedReplica(r, easy)
-	this.Replica = *v36
+	v42 := meta.NewPopulatedReplica(r, easy)
+	this.Replica = *v42
 	this.Term = uint64(uint64(r.Uint32()))
 	this.Index = uint64(uint64(r.Uint32()))
 	this.Commit = uint64(uint64(r.Uint32()))
 	this.Applied = uint64(uint64(r.Uint32()))
 	if r.Intn(10) != 0 {
-		v37 := r.Intn(5)
-		this.Followers = make([]RaftFollowerStatus, v37)
-		for i := 0; i < v37; i++ {
-			v38 := NewPopulatedRaftFollowerStatus(r, easy)
-			this.Followers[i] = *v38
+		v43 := r.Intn(5)
+		this.Followers = make([]RaftFollowerStatus, v43)
+		for i := 0; i < v43; i++ {
+			v44 := NewPopulatedRaftFollowerStatus(r, easy)
+			this.Followers[i] = *v44
 		}
 	}
 	if !easy && r.Intn(10) != 0 {
@@ -3215,8 +3617,8 @@ func NewPopulatedRaftStatus(r randyMaster, easy bool) *RaftStatus {
 
 func NewPopulatedRaftFollowerStatus(r randyMaster, easy bool) *RaftFollowerStatus {
 	this := &RaftFollowerStatus{}
-	v39 := meta.NewPopulatedReplica(r, easy)
-	this.Replica = *v39
+	v45 := meta.NewPopulatedReplica(r, easy)
+	this.Replica = *v45
 	this.Match = uint64(uint64(r.Uint32()))
 	this.Commit = uint64(uint64(r.Uint32()))
 	this.Next = uint64(uint64(r.Uint32()))
@@ -3267,6 +3669,37 @@ func NewPopulatedPartitionStats(r randyMaster, easy bool) *PartitionStats {
 	return this
 }
 
+func NewPopulatedUpdateMappingRequest(r randyMaster, easy bool) *UpdateMappingRequest {
+	this := &UpdateMappingRequest{}
+	v46 := meta.NewPopulatedRequestHeader(r, easy)
+	this.RequestHeader = *v46
+	this.DB = github_com_tiglabs_baudengine_proto_metapb.DBID(r.Uint32())
+	this.Space = github_com_tiglabs_baudengine_proto_metapb.SpaceID(r.Uint32())
+	v47 := r.Intn(100)
+	this.Mapping = make([]byte, v47)
+	for i := 0; i < v47; i++ {
+		this.Mapping[i] = byte(r.Intn(256))
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedUpdateMappingResponse(r randyMaster, easy bool) *UpdateMappingResponse {
+	this := &UpdateMappingResponse{}
+	v48 := meta.NewPopulatedResponseHeader(r, easy)
+	this.ResponseHeader = *v48
+	v49 := r.Intn(100)
+	this.Mapping = make([]byte, v49)
+	for i := 0; i < v49; i++ {
+		this.Mapping[i] = byte(r.Intn(256))
+	}
+	this.MappingVersion = uint64(uint64(r.Uint32()))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
 type randyMaster interface {
 	Float32() float32
 	Float64() float64
@@ -3286,9 +3719,9 @@ func randUTF8RuneMaster(r randyMaster) rune {
 	return rune(ru + 61)
 }
 func randStringMaster(r randyMaster) string {
-	v40 := r.Intn(100)
-	tmps := make([]rune, v40)
-	for i := 0; i < v40; i++ {
+	v50 := r.Intn(100)
+	tmps := make([]rune, v50)
+	for i := 0; i < v50; i++ {
 		tmps[i] = randUTF8RuneMaster(r)
 	}
 	return string(tmps)
@@ -3310,11 +3743,11 @@ func randFieldMaster(dAtA []byte, r randyMaster, fieldNumber int, wire int) []by
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateMaster(dAtA, uint64(key))
-		v41 := r.Int63()
+		v51 := r.Int63()
 		if r.Intn(2) == 0 {
-			v41 *= -1
+			v51 *= -1
 		}
-		dAtA = encodeVarintPopulateMaster(dAtA, uint64(v41))
+		dAtA = encodeVarintPopulateMaster(dAtA, uint64(v51))
 	case 1:
 		dAtA = encodeVarintPopulateMaster(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -3631,6 +4064,22 @@ func (m *PSHeartbeatRequest) Size() (n int) {
 	}
 	l = m.SysStats.Size()
 	n += 1 + l + sovMaster(uint64(l))
+	if len(m.DictVersions) > 0 {
+		for k, v := range m.DictVersions {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovMaster(uint64(len(k))) + 1 + sovMaster(uint64(v))
+			n += mapEntrySize + 1 + sovMaster(uint64(mapEntrySize))
+		}
+	}
+	if len(m.DictErrors) > 0 {
+		for k, v := range m.DictErrors {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovMaster(uint64(len(k))) + 1 + len(v) + sovMaster(uint64(len(v)))
+			n += mapEntrySize + 1 + sovMaster(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -3639,6 +4088,28 @@ func (m *PSHeartbeatResponse) Size() (n int) {
 	_ = l
 	l = m.ResponseHeader.Size()
 	n += 1 + l + sovMaster(uint64(l))
+	if len(m.Mappings) > 0 {
+		for _, e := range m.Mappings {
+			l = e.Size()
+			n += 1 + l + sovMaster(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PartitionMapping) Size() (n int) {
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovMaster(uint64(m.ID))
+	}
+	l = len(m.Mapping)
+	if l > 0 {
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	if m.MappingVersion != 0 {
+		n += 1 + sovMaster(uint64(m.MappingVersion))
+	}
 	return n
 }
 
@@ -3662,6 +4133,9 @@ func (m *PartitionInfo) Size() (n int) {
 		l = m.RaftStatus.Size()
 		n += 1 + l + sovMaster(uint64(l))
 	}
+	if m.MappingVersion != 0 {
+		n += 1 + sovMaster(uint64(m.MappingVersion))
+	}
 	return n
 }
 
@@ -3818,6 +4292,39 @@ func (m *PartitionStats) Size() (n int) {
 	return n
 }
 
+func (m *UpdateMappingRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = m.RequestHeader.Size()
+	n += 1 + l + sovMaster(uint64(l))
+	if m.DB != 0 {
+		n += 1 + sovMaster(uint64(m.DB))
+	}
+	if m.Space != 0 {
+		n += 1 + sovMaster(uint64(m.Space))
+	}
+	l = len(m.Mapping)
+	if l > 0 {
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdateMappingResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = m.ResponseHeader.Size()
+	n += 1 + l + sovMaster(uint64(l))
+	l = len(m.Mapping)
+	if l > 0 {
+		n += 1 + l + sovMaster(uint64(l))
+	}
+	if m.MappingVersion != 0 {
+		n += 1 + sovMaster(uint64(m.MappingVersion))
+	}
+	return n
+}
+
 func sovMaster(x uint64) (n int) {
 	for {
 		n++
@@ -4070,11 +4577,33 @@ func (this *PSHeartbeatRequest) String() string {
 	if this == nil {
 		return "nil"
 	}
+	keysForDictVersions := make([]string, 0, len(this.DictVersions))
+	for k, _ := range this.DictVersions {
+		keysForDictVersions = append(keysForDictVersions, k)
+	}
+	sortkeys.Strings(keysForDictVersions)
+	mapStringForDictVersions := "map[string]uint64{"
+	for _, k := range keysForDictVersions {
+		mapStringForDictVersions += fmt.Sprintf("%v: %v,", k, this.DictVersions[k])
+	}
+	mapStringForDictVersions += "}"
+	keysForDictErrors := make([]string, 0, len(this.DictErrors))
+	for k, _ := range this.DictErrors {
+		keysForDictErrors = append(keysForDictErrors, k)
+	}
+	sortkeys.Strings(keysForDictErrors)
+	mapStringForDictErrors := "map[string]string{"
+	for _, k := range keysForDictErrors {
+		mapStringForDictErrors += fmt.Sprintf("%v: %v,", k, this.DictErrors[k])
+	}
+	mapStringForDictErrors += "}"
 	s := strings.Join([]string{`&PSHeartbeatRequest{`,
 		`RequestHeader:` + strings.Replace(strings.Replace(this.RequestHeader.String(), "RequestHeader", "meta.RequestHeader", 1), `&`, ``, 1) + `,`,
 		`NodeID:` + fmt.Sprintf("%v", this.NodeID) + `,`,
 		`Partitions:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Partitions), "PartitionInfo", "PartitionInfo", 1), `&`, ``, 1) + `,`,
 		`SysStats:` + strings.Replace(strings.Replace(this.SysStats.String(), "NodeSysStats", "NodeSysStats", 1), `&`, ``, 1) + `,`,
+		`DictVersions:` + mapStringForDictVersions + `,`,
+		`DictErrors:` + mapStringForDictErrors + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4085,6 +4614,19 @@ func (this *PSHeartbeatResponse) String() string {
 	}
 	s := strings.Join([]string{`&PSHeartbeatResponse{`,
 		`ResponseHeader:` + strings.Replace(strings.Replace(this.ResponseHeader.String(), "ResponseHeader", "meta.ResponseHeader", 1), `&`, ``, 1) + `,`,
+		`Mappings:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Mappings), "PartitionMapping", "PartitionMapping", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PartitionMapping) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PartitionMapping{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Mapping:` + fmt.Sprintf("%v", this.Mapping) + `,`,
+		`MappingVersion:` + fmt.Sprintf("%v", this.MappingVersion) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4100,6 +4642,7 @@ func (this *PartitionInfo) String() string {
 		`Epoch:` + strings.Replace(strings.Replace(this.Epoch.String(), "PartitionEpoch", "meta.PartitionEpoch", 1), `&`, ``, 1) + `,`,
 		`Statistics:` + strings.Replace(strings.Replace(this.Statistics.String(), "PartitionStats", "PartitionStats", 1), `&`, ``, 1) + `,`,
 		`RaftStatus:` + strings.Replace(fmt.Sprintf("%v", this.RaftStatus), "RaftStatus", "RaftStatus", 1) + `,`,
+		`MappingVersion:` + fmt.Sprintf("%v", this.MappingVersion) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4188,6 +4731,31 @@ func (this *PartitionStats) String() string {
 	}, "")
 	return s
 }
+func (this *UpdateMappingRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateMappingRequest{`,
+		`RequestHeader:` + strings.Replace(strings.Replace(this.RequestHeader.String(), "RequestHeader", "meta.RequestHeader", 1), `&`, ``, 1) + `,`,
+		`DB:` + fmt.Sprintf("%v", this.DB) + `,`,
+		`Space:` + fmt.Sprintf("%v", this.Space) + `,`,
+		`Mapping:` + fmt.Sprintf("%v", this.Mapping) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpdateMappingResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateMappingResponse{`,
+		`ResponseHeader:` + strings.Replace(strings.Replace(this.ResponseHeader.String(), "ResponseHeader", "meta.ResponseHeader", 1), `&`, ``, 1) + `,`,
+		`Mapping:` + fmt.Sprintf("%v", this.Mapping) + `,`,
+		`MappingVersion:` + fmt.Sprintf("%v", this.MappingVersion) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringMaster(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -6719,6 +7287,231 @@ func (m *PSHeartbeatRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DictVersions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DictVersions == nil {
+				m.DictVersions = make(map[string]uint64)
+			}
+			var mapkey string
+			var mapvalue uint64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMaster
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMaster
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthMaster
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMaster
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipMaster(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthMaster
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.DictVersions[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DictErrors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DictErrors == nil {
+				m.DictErrors = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMaster
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMaster
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthMaster
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMaster
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthMaster
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipMaster(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthMaster
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.DictErrors[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMaster(dAtA[iNdEx:])
@@ -6799,6 +7592,37 @@ func (m *PSHeartbeatResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mappings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mappings = append(m.Mappings, PartitionMapping{})
+			if err := m.Mappings[len(m.Mappings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMaster(dAtA[iNdEx:])
@@ -6820,7 +7644,7 @@ func (m *PSHeartbeatResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PartitionInfo) Unmarshal(dAtA []byte) error {
+func (m *PartitionMapping) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6843,10 +7667,10 @@ func (m *PartitionInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PartitionInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: PartitionMapping: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PartitionInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PartitionMapping: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6869,10 +7693,10 @@ func (m *PartitionInfo) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsLeader", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mapping", wireType)
 			}
-			var v int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMaster
@@ -6882,17 +7706,28 @@ func (m *PartitionInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsLeader = bool(v != 0)
+			if byteLen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mapping = append(m.Mapping[:0], dAtA[iNdEx:postIndex]...)
+			if m.Mapping == nil {
+				m.Mapping = []byte{}
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MappingVersion", wireType)
 			}
-			m.Status = 0
+			m.MappingVersion = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMaster
@@ -6902,14 +7737,122 @@ func (m *PartitionInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= (meta.PartitionStatus(b) & 0x7F) << shift
+				m.MappingVersion |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PartitionInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PartitionInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PartitionInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= (github_com_tiglabs_baudengine_proto_metapb.PartitionID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsLeader", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsLeader = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= (meta.PartitionStatus(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7000,6 +7943,25 @@ func (m *PartitionInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MappingVersion", wireType)
+			}
+			m.MappingVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MappingVersion |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMaster(dAtA[iNdEx:])
@@ -8088,6 +9050,285 @@ func (m *PartitionStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *UpdateMappingRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateMappingRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateMappingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RequestHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DB", wireType)
+			}
+			m.DB = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DB |= (github_com_tiglabs_baudengine_proto_metapb.DBID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Space", wireType)
+			}
+			m.Space = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Space |= (github_com_tiglabs_baudengine_proto_metapb.SpaceID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mapping", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mapping = append(m.Mapping[:0], dAtA[iNdEx:postIndex]...)
+			if m.Mapping == nil {
+				m.Mapping = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateMappingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateMappingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateMappingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ResponseHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mapping", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMaster
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mapping = append(m.Mapping[:0], dAtA[iNdEx:postIndex]...)
+			if m.Mapping == nil {
+				m.Mapping = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MappingVersion", wireType)
+			}
+			m.MappingVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MappingVersion |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMaster(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -8196,137 +9437,149 @@ var (
 func init() { proto.RegisterFile("master.proto", fileDescriptorMaster) }
 
 var fileDescriptorMaster = []byte{
-	// 2098 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcd, 0x8f, 0x1b, 0x49,
-	0x15, 0x77, 0xfb, 0x6b, 0xec, 0xe7, 0xf9, 0xac, 0x49, 0x66, 0x9c, 0x09, 0xd8, 0xa1, 0x85, 0xb2,
-	0x66, 0x3f, 0x3a, 0xc9, 0x2c, 0xbb, 0x61, 0x91, 0xa2, 0x4d, 0x3c, 0x26, 0x89, 0x51, 0x3e, 0x86,
-	0x76, 0x96, 0x15, 0x2b, 0xa1, 0x56, 0xbb, 0xbb, 0xc6, 0xd3, 0x8a, 0xdd, 0xd5, 0x74, 0x95, 0x93,
-	0x9d, 0x3d, 0x71, 0x63, 0xff, 0x04, 0x0e, 0x08, 0x89, 0x1b, 0x57, 0x90, 0x90, 0x56, 0x48, 0x48,
-	0x1c, 0x73, 0x63, 0x8f, 0x9c, 0xac, 0x8d, 0x39, 0x71, 0x40, 0xe2, 0x88, 0x72, 0x00, 0x54, 0xaf,
-	0xaa, 0xdb, 0x6d, 0xcf, 0x44, 0x22, 0xde, 0x5d, 0x89, 0x53, 0x77, 0xbd, 0xfa, 0xbd, 0xcf, 0xaa,
-	0x7a, 0xaf, 0x5e, 0xc1, 0xea, 0xc8, 0xe5, 0x82, 0xc6, 0x56, 0x14, 0x33, 0xc1, 0xf6, 0xde, 0x1a,
-	0x04, 0xe2, 0x78, 0xdc, 0xb7, 0x3c, 0x36, 0xba, 0x32, 0x60, 0x03, 0x76, 0x05, 0xc9, 0xfd, 0xf1,
-	0x11, 0x8e, 0x70, 0x80, 0x7f, 0x1a, 0xfe, 0x4e, 0x06, 0x2e, 0x82, 0xc1, 0xd0, 0xed, 0xf3, 0x2b,
-	0x7d, 0x77, 0xec, 0xd3, 0x70, 0x10, 0x84, 0x54, 0x31, 0x5f, 0x19, 0x51, 0xe1, 0x46, 0x7d, 0xfc,
-	0x28, 0x36, 0xb3, 0x03, 0x2b, 0x77, 0xee, 0xa3, 0x5a, 0xb2, 0x0e, 0xf9, 0xc0, 0xaf, 0x1b, 0x97,
-	0x8c, 0xd6, 0x9a, 0x9d, 0x0f, 0x7c, 0x1c, 0x47, 0xf5, 0xfc, 0x25, 0xa3, 0x55, 0xb5, 0xf3, 0x41,
-	0x44, 0x2e, 0x40, 0x25, 0x8e, 0x3c, 0x27, 0x62, 0xb1, 0xa8, 0x17, 0x10, 0xb5, 0x12, 0x47, 0xde,
-	0x21, 0x8b, 0x85, 0x94, 0xf2, 0xd1, 0x97, 0x97, 0xf2, 0x3b, 0x03, 0x4a, 0x36, 0x1b, 0x0b, 0x4a,
-	0xf6, 0xa1, 0x1a, 0xb9, 0xb1, 0x08, 0x44, 0xc0, 0x42, 0x94, 0x55, 0xdb, 0x07, 0xeb, 0x30, 0xa1,
-	0xb4, 0x2b, 0xcf, 0x26, 0xcd, 0xdc, 0xe7, 0x93, 0xa6, 0x61, 0xcf, 0x60, 0xe4, 0x22, 0x94, 0x42,
-	0xe6, 0x53, 0x5e, 0xcf, 0x5f, 0x2a, 0xb4, 0x6a, 0xfb, 0x25, 0xeb, 0x01, 0xf3, 0xa9, 0xad, 0x68,
-	0xe4, 0x43, 0x28, 0x0f, 0xa9, 0xeb, 0xd3, 0x58, 0xe9, 0x6c, 0xbf, 0x3f, 0x9d, 0x34, 0xcb, 0xf7,
-	0x90, 0xf2, 0x62, 0xd2, 0xbc, 0xf6, 0xbf, 0xc7, 0x0e, 0xa5, 0x76, 0x3b, 0xb6, 0x16, 0x67, 0xfe,
-	0x04, 0x56, 0xef, 0x50, 0xd1, 0x69, 0xdb, 0xf4, 0x67, 0x63, 0xca, 0x05, 0xb9, 0x0a, 0xe5, 0x63,
-	0xa5, 0x48, 0x99, 0xbd, 0x6e, 0xe9, 0x99, 0xbb, 0x48, 0xcd, 0x98, 0xae, 0x71, 0x64, 0x17, 0x56,
-	0x3a, 0x6d, 0x27, 0x74, 0x47, 0x54, 0x47, 0xa9, 0xdc, 0x69, 0x3f, 0x70, 0x47, 0xd4, 0xfc, 0x29,
-	0xac, 0x69, 0xd1, 0x3c, 0x62, 0x21, 0xa7, 0xe4, 0xda, 0x82, 0xec, 0x0d, 0x2b, 0x99, 0x7a, 0xa9,
-	0xf0, 0x0b, 0x90, 0xf7, 0xfb, 0x28, 0xb7, 0xb6, 0x5f, 0xb0, 0x3a, 0xed, 0x76, 0x51, 0x42, 0xec,
-	0xbc, 0xdf, 0x37, 0x7f, 0x6f, 0xc0, 0xc6, 0x1d, 0x2a, 0x7a, 0x91, 0xeb, 0xd1, 0xe5, 0xad, 0x7f,
-	0x00, 0x25, 0xbf, 0xef, 0x04, 0x3e, 0xea, 0x58, 0x6b, 0xbf, 0x37, 0x9d, 0x34, 0xf3, 0xdd, 0xce,
-	0x8b, 0x49, 0xf3, 0xca, 0x2b, 0xc4, 0xb4, 0xd3, 0xee, 0x76, 0xec, 0xa2, 0xdf, 0xef, 0xfa, 0xe4,
-	0x9b, 0x00, 0x68, 0x91, 0x0a, 0x48, 0x01, 0x03, 0x52, 0x45, 0x0a, 0xc6, 0x24, 0x80, 0xcd, 0x99,
-	0xcd, 0xcb, 0x87, 0xc5, 0x84, 0x12, 0x97, 0x32, 0x74, 0x64, 0xca, 0x16, 0x4a, 0xd4, 0xc1, 0x51,
-	0x53, 0xe6, 0x67, 0x79, 0x8c, 0x0f, 0x6e, 0xc8, 0xe5, 0xe3, 0xd3, 0x4d, 0x17, 0x40, 0x07, 0xa7,
-	0xd3, 0x5e, 0x26, 0x38, 0x79, 0xbf, 0x4f, 0x3e, 0x48, 0x8c, 0x9e, 0x6d, 0xe1, 0x12, 0xda, 0xfd,
-	0x62, 0xd2, 0xdc, 0x7f, 0x05, 0x81, 0xc8, 0xd3, 0xed, 0x68, 0x3f, 0xc9, 0x8f, 0xa0, 0xc8, 0x87,
-	0x4c, 0xd4, 0x8b, 0x28, 0xf5, 0xc6, 0x74, 0xd2, 0x2c, 0xf6, 0x86, 0x4c, 0xbc, 0xe2, 0xb1, 0x90,
-	0x2c, 0x72, 0x11, 0xa5, 0x28, 0xf3, 0x31, 0xae, 0x92, 0x8e, 0xdc, 0xf2, 0xab, 0xf4, 0x6d, 0x28,
-	0xc7, 0x52, 0x46, 0x72, 0xa4, 0xcb, 0x16, 0x8a, 0xd4, 0xcb, 0xa4, 0xe7, 0xcc, 0xbf, 0x1b, 0xb0,
-	0x75, 0xd8, 0xb3, 0xe9, 0x20, 0x90, 0xf9, 0x67, 0xf9, 0x95, 0xfa, 0x10, 0xca, 0x21, 0x9e, 0x6d,
-	0xbd, 0x5a, 0x98, 0x22, 0xd4, 0x69, 0x5f, 0x32, 0x45, 0x28, 0x71, 0x3a, 0x03, 0x16, 0xd2, 0x0c,
-	0xf8, 0x1e, 0xac, 0xc6, 0xe3, 0x50, 0x04, 0x23, 0xea, 0x04, 0xe1, 0x11, 0xc3, 0xc0, 0xd7, 0xf6,
-	0x57, 0x2d, 0x5b, 0x11, 0xbb, 0xe1, 0x11, 0xcb, 0x98, 0x57, 0x8b, 0x67, 0x64, 0xf3, 0x3f, 0x06,
-	0x90, 0xac, 0xaf, 0xcb, 0xc7, 0xf6, 0x6b, 0xf3, 0xf6, 0x0d, 0x28, 0x7b, 0x2c, 0x3c, 0x0a, 0x06,
-	0xe8, 0x71, 0x6d, 0xbf, 0x6a, 0x1d, 0xf6, 0x0e, 0x90, 0x90, 0xb5, 0x42, 0x41, 0xc8, 0x55, 0x80,
-	0x34, 0x81, 0xf3, 0x7a, 0x11, 0x57, 0x39, 0x9b, 0xe8, 0xd5, 0x4a, 0x67, 0x30, 0xe6, 0x27, 0xb0,
-	0x73, 0x10, 0x53, 0x57, 0xd0, 0x14, 0xb4, 0xfc, 0x8a, 0x5b, 0xd9, 0x2a, 0x93, 0x3f, 0x55, 0x65,
-	0x94, 0xf2, 0x19, 0xc4, 0xbc, 0x07, 0xbb, 0xa7, 0x74, 0x2f, 0xbd, 0x02, 0xe6, 0xaf, 0x0c, 0xd8,
-	0xe9, 0xd0, 0x21, 0xfd, 0x4a, 0x5c, 0x39, 0xc4, 0xaa, 0xab, 0x96, 0xf2, 0x66, 0x9a, 0x83, 0xdf,
-	0x7d, 0x85, 0x65, 0x4c, 0x8d, 0x90, 0xd9, 0x26, 0xf0, 0xa5, 0xb3, 0xa7, 0xac, 0x5b, 0xde, 0xd9,
-	0x4f, 0xf3, 0x70, 0xee, 0xe0, 0xd8, 0x0d, 0x07, 0xd4, 0xa6, 0xd1, 0x30, 0xf0, 0xdc, 0xe5, 0x5d,
-	0xbd, 0x0c, 0x45, 0x71, 0x12, 0xa9, 0xd4, 0xbd, 0xbe, 0x4f, 0x2c, 0x2d, 0x50, 0x49, 0x7f, 0x74,
-	0x12, 0x51, 0x1b, 0xe7, 0xc9, 0x10, 0x56, 0xd3, 0xa5, 0x93, 0x05, 0x4a, 0x65, 0xcd, 0xee, 0x74,
-	0xd2, 0xac, 0x65, 0x7c, 0xfd, 0x12, 0x51, 0xaa, 0xa5, 0xe2, 0xbb, 0x3e, 0x69, 0xc1, 0x4a, 0xac,
-	0x0c, 0xd1, 0xe7, 0xb9, 0x92, 0x18, 0xa6, 0xf7, 0x51, 0x32, 0x6d, 0xfe, 0x10, 0xce, 0x2f, 0x44,
-	0x62, 0xf9, 0xb0, 0xfe, 0xc1, 0x80, 0x6d, 0x25, 0x4c, 0xdd, 0x65, 0x96, 0x8f, 0xea, 0x62, 0xb4,
-	0xf2, 0x5f, 0x67, 0xb4, 0xcc, 0x6e, 0xb2, 0x1b, 0x12, 0xb3, 0x97, 0x0f, 0xc1, 0xbf, 0x0b, 0x50,
-	0x49, 0x32, 0x0c, 0x79, 0x2b, 0x73, 0xb9, 0xc4, 0x2b, 0x68, 0x9b, 0x4c, 0x27, 0xcd, 0x15, 0xfb,
-	0xf0, 0x40, 0x5e, 0x30, 0x5f, 0x4c, 0x9a, 0x85, 0x20, 0x14, 0xe9, 0x85, 0x93, 0x5c, 0x06, 0x70,
-	0xfd, 0x51, 0x10, 0x2a, 0x06, 0xe5, 0xf2, 0x4a, 0x82, 0xaa, 0xe2, 0x14, 0xe2, 0xde, 0x05, 0x72,
-	0x4c, 0xdd, 0x58, 0xf4, 0xa9, 0x2b, 0x9c, 0x20, 0x14, 0x34, 0x7e, 0xe2, 0x0e, 0xf5, 0x86, 0x4a,
-	0xf1, 0x5b, 0x29, 0xa4, 0xab, 0x11, 0xe4, 0x3a, 0x6c, 0xc7, 0xee, 0x91, 0x70, 0x66, 0xcc, 0xa8,
-	0xa8, 0xb8, 0xc0, 0x28, 0x31, 0x77, 0x13, 0x08, 0x2a, 0x4c, 0x18, 0xf5, 0x9e, 0x11, 0x54, 0x31,
-	0x96, 0xce, 0x60, 0xb4, 0x13, 0x08, 0x32, 0xbe, 0x0f, 0xbb, 0x0b, 0x1a, 0x53, 0x73, 0xcb, 0xf3,
-	0xcc, 0xe7, 0xe7, 0xb4, 0xa6, 0x26, 0xb7, 0x60, 0x53, 0x6b, 0x16, 0x6e, 0x10, 0x3a, 0x43, 0x36,
-	0xe0, 0xf5, 0x95, 0x4b, 0x46, 0xab, 0x68, 0xaf, 0x2b, 0x6d, 0x92, 0x7c, 0x8f, 0x0d, 0x38, 0xb9,
-	0x05, 0xf5, 0xac, 0x8d, 0x8e, 0xc7, 0x42, 0x6f, 0x1c, 0xc7, 0x34, 0xf4, 0x4e, 0xea, 0x95, 0x79,
-	0x5d, 0x3b, 0x19, 0x43, 0x0f, 0x66, 0x30, 0x72, 0x00, 0x17, 0x50, 0x04, 0x0f, 0xdd, 0x88, 0x1f,
-	0x33, 0x31, 0x27, 0xa3, 0x3a, 0x2f, 0x03, 0xfd, 0xea, 0x69, 0x60, 0x46, 0x88, 0xf9, 0x8b, 0xbc,
-	0xac, 0x89, 0xa9, 0x27, 0xff, 0x87, 0x17, 0x80, 0xef, 0xce, 0x55, 0xb9, 0x02, 0x56, 0xb9, 0xf5,
-	0xcc, 0xe1, 0x90, 0x05, 0xff, 0x54, 0xa5, 0x23, 0x57, 0xa1, 0xca, 0x4f, 0xb8, 0xc3, 0x85, 0x2b,
-	0xb8, 0xce, 0x29, 0x6b, 0x28, 0xb9, 0x77, 0xc2, 0x7b, 0x92, 0xa8, 0x79, 0x2a, 0x5c, 0x8f, 0xcd,
-	0xbb, 0xb0, 0x3d, 0x17, 0x88, 0xe5, 0x0f, 0xd5, 0x1f, 0xf3, 0xb0, 0x36, 0x67, 0x9f, 0x2e, 0x30,
-	0xc6, 0x57, 0x57, 0x60, 0xc8, 0x45, 0xa8, 0x06, 0xdc, 0xd1, 0x5d, 0x99, 0x8c, 0x78, 0xc5, 0xae,
-	0x04, 0x5c, 0x25, 0x04, 0xd2, 0x82, 0xb2, 0x74, 0x7c, 0xcc, 0xf1, 0x94, 0xad, 0xef, 0x6f, 0xce,
-	0xd8, 0x7b, 0x48, 0xb7, 0xf5, 0x3c, 0x79, 0x03, 0x4a, 0x34, 0x62, 0xde, 0xb1, 0x0e, 0xd1, 0xc6,
-	0x0c, 0xf8, 0x03, 0x49, 0x4e, 0xee, 0xf4, 0x88, 0x21, 0xef, 0x00, 0x48, 0xb6, 0x80, 0x8b, 0xc0,
-	0xe3, 0x78, 0x9c, 0xe6, 0x38, 0xb2, 0x61, 0xcd, 0x00, 0xc9, 0x9b, 0x50, 0x53, 0xfb, 0x54, 0x99,
-	0x54, 0x46, 0xbe, 0x9a, 0x65, 0xcb, 0x1d, 0xa9, 0xac, 0x81, 0x38, 0xfd, 0x37, 0x3f, 0x35, 0xa0,
-	0x96, 0xb9, 0xcb, 0x91, 0x26, 0xd4, 0xdc, 0x28, 0x72, 0x9e, 0xd0, 0x98, 0x27, 0xed, 0x6c, 0xd5,
-	0x06, 0x37, 0x8a, 0x7e, 0xac, 0x28, 0xb2, 0xe7, 0xe1, 0xc2, 0x8d, 0x85, 0x23, 0x59, 0x74, 0x13,
-	0x58, 0x45, 0xca, 0xa3, 0x60, 0x44, 0xe5, 0xf4, 0x80, 0xa5, 0xec, 0xba, 0x25, 0x1a, 0xb0, 0x84,
-	0x7b, 0x0f, 0x2a, 0xd1, 0xd0, 0x15, 0x47, 0x2c, 0x1e, 0x61, 0x0c, 0xaa, 0x76, 0x3a, 0x36, 0xff,
-	0x62, 0x00, 0xcc, 0xac, 0x24, 0x6f, 0xce, 0x8a, 0x94, 0xb1, 0x50, 0xa4, 0x66, 0x7b, 0x20, 0x81,
-	0x10, 0x02, 0x45, 0x41, 0xe3, 0x11, 0x1a, 0x54, 0xb4, 0xf1, 0x9f, 0x9c, 0x83, 0x52, 0x10, 0xfa,
-	0xf4, 0x63, 0x34, 0xa3, 0x68, 0xab, 0x01, 0xd9, 0x91, 0x77, 0xbe, 0xd1, 0x28, 0x50, 0xa9, 0xad,
-	0x68, 0xeb, 0x11, 0xa9, 0xc3, 0x8a, 0x1b, 0x45, 0xc3, 0x80, 0xfa, 0x18, 0xeb, 0xa2, 0x9d, 0x0c,
-	0xc9, 0x75, 0xa8, 0x1e, 0xb1, 0xe1, 0x90, 0x3d, 0xa5, 0xb1, 0x8c, 0xa7, 0x3c, 0x11, 0xdb, 0x18,
-	0xcf, 0xdb, 0x9a, 0xaa, 0x2c, 0x4e, 0xee, 0x60, 0x29, 0xd6, 0xfc, 0x93, 0x01, 0xe4, 0x34, 0xee,
-	0x15, 0x3d, 0x3b, 0x07, 0xa5, 0x91, 0x2b, 0xbc, 0x63, 0xed, 0x9a, 0x1a, 0x64, 0xbc, 0x28, 0xcc,
-	0x79, 0x41, 0xa0, 0x18, 0xd2, 0x8f, 0x13, 0xdf, 0xf0, 0x9f, 0x7c, 0x0b, 0x56, 0x7d, 0xf6, 0x34,
-	0x74, 0x38, 0xf5, 0x58, 0xe8, 0x73, 0xed, 0x5e, 0x4d, 0xd2, 0x7a, 0x8a, 0x24, 0x95, 0xc8, 0xfd,
-	0x42, 0x71, 0xbb, 0x54, 0x6d, 0x35, 0x30, 0x7f, 0x5d, 0x82, 0xd5, 0xec, 0x21, 0x96, 0x92, 0x46,
-	0x74, 0xc4, 0xe2, 0x13, 0x47, 0x30, 0xe1, 0x0e, 0xd1, 0xfc, 0xa2, 0x5d, 0x53, 0xb4, 0x47, 0x92,
-	0x44, 0x2e, 0xc3, 0x86, 0x86, 0x8c, 0x39, 0xf5, 0x9d, 0x98, 0x73, 0x6d, 0xf8, 0x9a, 0x22, 0x7f,
-	0xc0, 0xa9, 0x6f, 0x73, 0x2e, 0x37, 0x5a, 0x06, 0xa7, 0xbd, 0x80, 0x19, 0x26, 0x03, 0x38, 0x8a,
-	0x29, 0xd5, 0x0e, 0x69, 0xc0, 0xed, 0x98, 0x52, 0xf2, 0x3a, 0x6c, 0xf1, 0xa7, 0x6e, 0xe4, 0xcc,
-	0x59, 0x54, 0x46, 0xd8, 0x86, 0x9c, 0xb8, 0x9f, 0xb1, 0xaa, 0x05, 0x9b, 0x59, 0x2c, 0xaa, 0xd4,
-	0x95, 0x62, 0x06, 0x45, 0xb5, 0x0b, 0x48, 0xd4, 0x5d, 0x59, 0x44, 0xa2, 0x7e, 0x13, 0xd6, 0xbc,
-	0x68, 0xec, 0x44, 0x31, 0xf3, 0x9c, 0x58, 0xc6, 0x0e, 0x2e, 0x19, 0x2d, 0xc3, 0xae, 0x79, 0xd1,
-	0xf8, 0x30, 0x66, 0x9e, 0xed, 0x0a, 0x2a, 0xf3, 0x86, 0xc4, 0x78, 0x6c, 0x1c, 0x8a, 0x7a, 0x0d,
-	0x5f, 0x90, 0x2a, 0x5e, 0x34, 0x3e, 0x90, 0x63, 0x79, 0x56, 0xfc, 0x80, 0x3f, 0xd6, 0x96, 0x6f,
-	0xa0, 0x92, 0xaa, 0xa4, 0x28, 0x9b, 0x2f, 0x02, 0x0e, 0x94, 0xb1, 0x9b, 0x38, 0x5b, 0x91, 0x04,
-	0x34, 0x33, 0x99, 0x44, 0xfb, 0xb6, 0x66, 0x93, 0x68, 0xd9, 0x35, 0xd8, 0x09, 0xa9, 0x70, 0x02,
-	0xe6, 0x04, 0xa1, 0xd3, 0x3f, 0x91, 0x15, 0x99, 0xc6, 0x72, 0xf9, 0xeb, 0xe7, 0x11, 0xb9, 0x15,
-	0x52, 0xd1, 0x65, 0xdd, 0xb0, 0x7d, 0x22, 0xe8, 0x21, 0x8d, 0x7b, 0xd4, 0x23, 0x6f, 0xc3, 0xae,
-	0x66, 0x61, 0x63, 0x31, 0xcf, 0xb3, 0x83, 0x3c, 0x04, 0x79, 0x1e, 0x8e, 0x45, 0x86, 0xc9, 0x82,
-	0x6d, 0xc9, 0x24, 0xbc, 0x48, 0x16, 0xc3, 0x90, 0x7a, 0xaa, 0x68, 0xec, 0xa2, 0x9f, 0x52, 0xc9,
-	0x23, 0x2f, 0x3a, 0x98, 0x4d, 0x90, 0x1b, 0xf0, 0x8d, 0x04, 0xef, 0x7a, 0x22, 0x78, 0x42, 0x1d,
-	0x16, 0xd1, 0x90, 0xa7, 0x9a, 0xea, 0xa8, 0x69, 0x57, 0x31, 0xde, 0x42, 0xc4, 0x43, 0x09, 0xd0,
-	0xea, 0x36, 0xa1, 0xc0, 0x22, 0x5e, 0xbf, 0x80, 0x28, 0xf9, 0x6b, 0xfe, 0xc3, 0x80, 0xf5, 0xf9,
-	0x84, 0x28, 0x0f, 0x00, 0x0f, 0x3e, 0xa1, 0x7a, 0x6b, 0xe2, 0x7f, 0xc2, 0x98, 0x4f, 0x19, 0xc9,
-	0x6b, 0xb0, 0x29, 0x7d, 0xe4, 0x32, 0x40, 0x89, 0x76, 0xb5, 0x05, 0xd7, 0x90, 0xde, 0x0d, 0xb5,
-	0xce, 0xef, 0xc0, 0x96, 0x02, 0xca, 0xb0, 0x24, 0x48, 0xb5, 0x17, 0xd7, 0x71, 0xe2, 0xe1, 0x58,
-	0x68, 0xe8, 0xf7, 0xa0, 0x8e, 0x2b, 0xe9, 0xc8, 0xa3, 0xe8, 0x86, 0x3e, 0xc7, 0xad, 0x41, 0x39,
-	0x4f, 0x33, 0xca, 0x0e, 0xce, 0x1f, 0xe8, 0xe9, 0xc3, 0x64, 0x96, 0xbc, 0x06, 0x1b, 0x8f, 0xe9,
-	0x09, 0xbe, 0x70, 0x38, 0xa3, 0x80, 0x73, 0xca, 0xf5, 0x3e, 0x5e, 0x4f, 0xc8, 0xf7, 0x91, 0xfa,
-	0x7a, 0x0b, 0xb6, 0x4e, 0x75, 0x10, 0x64, 0x05, 0x0a, 0xb7, 0x7c, 0x7f, 0x33, 0x47, 0x00, 0xca,
-	0x36, 0x1d, 0xb1, 0x27, 0x74, 0xd3, 0xd8, 0xff, 0x4d, 0x11, 0xaa, 0xea, 0x91, 0xd3, 0x8e, 0x3c,
-	0x72, 0x0d, 0x2a, 0xc9, 0x1b, 0x07, 0xd9, 0xb4, 0x16, 0x1e, 0x8a, 0xf6, 0xb6, 0xac, 0xc5, 0x07,
-	0x10, 0x33, 0x47, 0xae, 0x03, 0xcc, 0x9a, 0x77, 0x42, 0xac, 0x53, 0xaf, 0x16, 0x7b, 0xdb, 0xd6,
-	0xe9, 0xee, 0xde, 0xcc, 0x91, 0xef, 0x43, 0x2d, 0x53, 0xd8, 0x89, 0x44, 0x2d, 0xde, 0x77, 0xf6,
-	0xce, 0x59, 0x67, 0xd4, 0x7e, 0x33, 0x47, 0x5a, 0x50, 0xc2, 0x57, 0x44, 0xb2, 0x66, 0x65, 0x1f,
-	0x2a, 0xf7, 0xd6, 0xad, 0xb9, 0xc7, 0x45, 0x33, 0xa7, 0x3d, 0xc2, 0xd7, 0x21, 0xe5, 0x51, 0xf6,
-	0x69, 0x50, 0x79, 0x34, 0xf7, 0xf0, 0x66, 0xe6, 0xc8, 0x6d, 0xd8, 0x58, 0xe8, 0x88, 0xc9, 0xae,
-	0x75, 0x76, 0x7f, 0xbe, 0x57, 0xb7, 0x5e, 0xd2, 0x3c, 0x2b, 0x39, 0x0b, 0xcd, 0x26, 0xd9, 0xb5,
-	0xce, 0x6e, 0x8e, 0xf7, 0xea, 0xd6, 0x4b, 0xfa, 0x52, 0x33, 0x47, 0x6e, 0xc2, 0xda, 0x5c, 0x6f,
-	0x45, 0xce, 0x5b, 0x67, 0x75, 0x9d, 0x7b, 0x3b, 0xd6, 0x99, 0x2d, 0x98, 0x99, 0x23, 0x37, 0x60,
-	0x35, 0xdb, 0x99, 0x90, 0x73, 0xd6, 0x19, 0xfd, 0xd5, 0xde, 0x79, 0xeb, 0xac, 0xf6, 0xc5, 0xcc,
-	0xb5, 0x6f, 0x3e, 0x7b, 0xde, 0xc8, 0xfd, 0xf5, 0x79, 0x23, 0xf7, 0xc5, 0xf3, 0x46, 0xee, 0x9f,
-	0xcf, 0x1b, 0xb9, 0x7f, 0x3d, 0x6f, 0x18, 0x3f, 0x9f, 0x36, 0x8c, 0xdf, 0x4e, 0x1b, 0xc6, 0x67,
-	0xd3, 0x46, 0xee, 0xcf, 0xd3, 0x46, 0xee, 0xd9, 0xb4, 0x61, 0x7c, 0x3e, 0x6d, 0x18, 0x5f, 0x4c,
-	0x1b, 0xc6, 0x2f, 0xff, 0xd6, 0xc8, 0xdd, 0x35, 0x3e, 0xaa, 0xa8, 0xa7, 0xff, 0xa8, 0xdf, 0x2f,
-	0xe3, 0xa5, 0xe9, 0xed, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x03, 0x6e, 0x18, 0x89, 0x0d, 0x18,
-	0x00, 0x00,
+	// 2300 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0x4d, 0x8c, 0x1b, 0x49,
+	0xf5, 0x77, 0xfb, 0x6b, 0xec, 0xe7, 0xf9, 0xf0, 0xd4, 0x7c, 0x39, 0xde, 0xff, 0xdf, 0x1e, 0x1a,
+	0xd8, 0x1d, 0xf6, 0xa3, 0x93, 0x4c, 0xd8, 0xcd, 0xee, 0x4a, 0x51, 0x12, 0x8f, 0xf3, 0xe1, 0x55,
+	0x3e, 0x86, 0x9e, 0x84, 0x15, 0x2b, 0xa1, 0x56, 0xbb, 0xbb, 0xc6, 0xd3, 0x8a, 0xdd, 0xdd, 0x74,
+	0x95, 0x93, 0x9d, 0x95, 0x90, 0x38, 0x70, 0xd8, 0x0b, 0x77, 0x90, 0x10, 0x57, 0xb8, 0x82, 0x84,
+	0xb4, 0x17, 0x24, 0x8e, 0xb9, 0xb1, 0x47, 0x4e, 0xa3, 0x8d, 0x73, 0xe2, 0x80, 0xc4, 0x11, 0xe5,
+	0x00, 0xa8, 0x5e, 0x55, 0xb7, 0xdb, 0x1e, 0x47, 0x10, 0x67, 0x23, 0x21, 0x4e, 0xd3, 0xf5, 0xea,
+	0xf7, 0x3e, 0xeb, 0xd5, 0xab, 0xe7, 0x37, 0xb0, 0x38, 0xb0, 0x19, 0xa7, 0x91, 0x11, 0x46, 0x01,
+	0x0f, 0xea, 0xef, 0xf4, 0x3c, 0x7e, 0x34, 0xec, 0x1a, 0x4e, 0x30, 0x38, 0xdb, 0x0b, 0x7a, 0xc1,
+	0x59, 0x24, 0x77, 0x87, 0x87, 0xb8, 0xc2, 0x05, 0x7e, 0x29, 0xf8, 0xbb, 0x29, 0x38, 0xf7, 0x7a,
+	0x7d, 0xbb, 0xcb, 0xce, 0x76, 0xed, 0xa1, 0x4b, 0xfd, 0x9e, 0xe7, 0x53, 0xc9, 0x7c, 0x76, 0x40,
+	0xb9, 0x1d, 0x76, 0xf1, 0x8f, 0x64, 0xd3, 0xdb, 0xb0, 0x70, 0xe3, 0x36, 0xaa, 0x25, 0xcb, 0x90,
+	0xf5, 0xdc, 0x9a, 0xb6, 0xad, 0xed, 0x2c, 0x99, 0x59, 0xcf, 0xc5, 0x75, 0x58, 0xcb, 0x6e, 0x6b,
+	0x3b, 0x65, 0x33, 0xeb, 0x85, 0xe4, 0x0c, 0x94, 0xa2, 0xd0, 0xb1, 0xc2, 0x20, 0xe2, 0xb5, 0x1c,
+	0xa2, 0x16, 0xa2, 0xd0, 0xd9, 0x0f, 0x22, 0x2e, 0xa4, 0x7c, 0xf2, 0xf2, 0x52, 0x7e, 0xab, 0x41,
+	0xc1, 0x0c, 0x86, 0x9c, 0x92, 0x5d, 0x28, 0x87, 0x76, 0xc4, 0x3d, 0xee, 0x05, 0x3e, 0xca, 0xaa,
+	0xec, 0x82, 0xb1, 0x1f, 0x53, 0x5a, 0xa5, 0xc7, 0x27, 0xcd, 0xcc, 0x97, 0x27, 0x4d, 0xcd, 0x1c,
+	0xc3, 0xc8, 0x6b, 0x50, 0xf0, 0x03, 0x97, 0xb2, 0x5a, 0x76, 0x3b, 0xb7, 0x53, 0xd9, 0x2d, 0x18,
+	0x77, 0x02, 0x97, 0x9a, 0x92, 0x46, 0x3e, 0x86, 0x62, 0x9f, 0xda, 0x2e, 0x8d, 0xa4, 0xce, 0xd6,
+	0xe5, 0xd1, 0x49, 0xb3, 0x78, 0x0b, 0x29, 0xcf, 0x4e, 0x9a, 0xe7, 0xff, 0xf3, 0xd8, 0xa1, 0xd4,
+	0x4e, 0xdb, 0x54, 0xe2, 0xf4, 0x1f, 0xc0, 0xe2, 0x0d, 0xca, 0xdb, 0x2d, 0x93, 0xfe, 0x68, 0x48,
+	0x19, 0x27, 0xe7, 0xa0, 0x78, 0x24, 0x15, 0x49, 0xb3, 0x97, 0x0d, 0xb5, 0x73, 0x13, 0xa9, 0x29,
+	0xd3, 0x15, 0x8e, 0x6c, 0xc1, 0x42, 0xbb, 0x65, 0xf9, 0xf6, 0x80, 0xaa, 0x28, 0x15, 0xdb, 0xad,
+	0x3b, 0xf6, 0x80, 0xea, 0x3f, 0x84, 0x25, 0x25, 0x9a, 0x85, 0x81, 0xcf, 0x28, 0x39, 0x3f, 0x25,
+	0x7b, 0xc5, 0x88, 0xb7, 0x9e, 0x2b, 0xfc, 0x0c, 0x64, 0xdd, 0x2e, 0xca, 0xad, 0xec, 0xe6, 0x8c,
+	0x76, 0xab, 0x95, 0x17, 0x10, 0x33, 0xeb, 0x76, 0xf5, 0xdf, 0x69, 0xb0, 0x72, 0x83, 0xf2, 0x83,
+	0xd0, 0x76, 0xe8, 0xfc, 0xd6, 0xdf, 0x81, 0x82, 0xdb, 0xb5, 0x3c, 0x17, 0x75, 0x2c, 0xb5, 0x3e,
+	0x18, 0x9d, 0x34, 0xb3, 0x9d, 0xf6, 0xb3, 0x93, 0xe6, 0xd9, 0x17, 0x88, 0x69, 0xbb, 0xd5, 0x69,
+	0x9b, 0x79, 0xb7, 0xdb, 0x71, 0xc9, 0xff, 0x03, 0xa0, 0x45, 0x32, 0x20, 0x39, 0x0c, 0x48, 0x19,
+	0x29, 0x18, 0x13, 0x0f, 0xaa, 0x63, 0x9b, 0xe7, 0x0f, 0x8b, 0x0e, 0x05, 0x26, 0x64, 0xa8, 0xc8,
+	0x14, 0x0d, 0x94, 0xa8, 0x82, 0x23, 0xb7, 0xf4, 0x2f, 0xb2, 0x18, 0x1f, 0x4c, 0xc8, 0xf9, 0xe3,
+	0xd3, 0x49, 0x0e, 0x40, 0x05, 0xa7, 0xdd, 0x9a, 0x27, 0x38, 0x59, 0xb7, 0x4b, 0xee, 0xc7, 0x46,
+	0x8f, 0x53, 0xb8, 0x80, 0x76, 0x3f, 0x3b, 0x69, 0xee, 0xbe, 0x80, 0x40, 0xe4, 0xe9, 0xb4, 0x95,
+	0x9f, 0xe4, 0x7b, 0x90, 0x67, 0xfd, 0x80, 0xd7, 0xf2, 0x28, 0xf5, 0xd2, 0xe8, 0xa4, 0x99, 0x3f,
+	0xe8, 0x07, 0xfc, 0x05, 0xaf, 0x85, 0x60, 0x11, 0x87, 0x28, 0x44, 0xe9, 0x0f, 0xa0, 0x3a, 0x8e,
+	0xdc, 0xfc, 0xa7, 0xf4, 0x2d, 0x28, 0x46, 0x42, 0x46, 0x7c, 0xa5, 0x8b, 0x06, 0x8a, 0x54, 0xc7,
+	0xa4, 0xf6, 0xf4, 0xbf, 0x68, 0xb0, 0xba, 0x7f, 0x60, 0xd2, 0x9e, 0x27, 0xea, 0xcf, 0xfc, 0x27,
+	0xf5, 0x31, 0x14, 0x7d, 0xbc, 0xdb, 0xb5, 0x6c, 0x12, 0xdf, 0xa2, 0xbc, 0xed, 0x73, 0x96, 0x08,
+	0x29, 0x4e, 0x55, 0xc0, 0x5c, 0x52, 0x01, 0x3f, 0x80, 0xc5, 0x68, 0xe8, 0x73, 0x6f, 0x40, 0x2d,
+	0xcf, 0x3f, 0x0c, 0x30, 0xf0, 0x95, 0xdd, 0x45, 0xc3, 0x94, 0xc4, 0x8e, 0x7f, 0x18, 0xa4, 0xcc,
+	0xab, 0x44, 0x63, 0xb2, 0xfe, 0x4f, 0x0d, 0x48, 0xda, 0xd7, 0xf9, 0x63, 0xfb, 0xca, 0xbc, 0x7d,
+	0x0b, 0x8a, 0x4e, 0xe0, 0x1f, 0x7a, 0x3d, 0xf4, 0xb8, 0xb2, 0x5b, 0x36, 0xf6, 0x0f, 0xf6, 0x90,
+	0x90, 0xb6, 0x42, 0x42, 0xc8, 0x39, 0x80, 0xa4, 0x80, 0xb3, 0x5a, 0x7e, 0x3b, 0x37, 0x55, 0xe8,
+	0xe5, 0x49, 0xa7, 0x30, 0xfa, 0x67, 0xb0, 0xb9, 0x17, 0x51, 0x9b, 0xd3, 0x04, 0x34, 0xff, 0x89,
+	0x1b, 0xe9, 0x57, 0x26, 0xbb, 0xad, 0xcd, 0x54, 0x3e, 0x86, 0xe8, 0xb7, 0x60, 0xeb, 0x94, 0xee,
+	0xb9, 0x4f, 0x40, 0xff, 0xa5, 0x06, 0x9b, 0x6d, 0xda, 0xa7, 0x5f, 0x8b, 0x2b, 0xfb, 0xf8, 0xea,
+	0xca, 0xa3, 0xbc, 0x92, 0xd4, 0xe0, 0xf7, 0x5e, 0xe0, 0x18, 0x13, 0x23, 0x44, 0xb5, 0xf1, 0x5c,
+	0xe1, 0xec, 0x29, 0xeb, 0xe6, 0x77, 0xf6, 0xf3, 0x2c, 0xac, 0xef, 0x1d, 0xd9, 0x7e, 0x8f, 0x9a,
+	0x34, 0xec, 0x7b, 0x8e, 0x3d, 0xbf, 0xab, 0xaf, 0x43, 0x9e, 0x1f, 0x87, 0xb2, 0x74, 0x2f, 0xef,
+	0x12, 0x43, 0x09, 0x94, 0xd2, 0xef, 0x1d, 0x87, 0xd4, 0xc4, 0x7d, 0xd2, 0x87, 0xc5, 0xe4, 0xe8,
+	0xc4, 0x03, 0x25, 0xab, 0x66, 0x67, 0x74, 0xd2, 0xac, 0xa4, 0x7c, 0x7d, 0x89, 0x28, 0x55, 0x12,
+	0xf1, 0x1d, 0x97, 0xec, 0xc0, 0x42, 0x24, 0x0d, 0x51, 0xf7, 0xb9, 0x14, 0x1b, 0xa6, 0xf2, 0x28,
+	0xde, 0xd6, 0x3f, 0x82, 0x8d, 0xa9, 0x48, 0xcc, 0x1f, 0xd6, 0xdf, 0x6b, 0xb0, 0x26, 0x85, 0xc9,
+	0x5e, 0x66, 0xfe, 0xa8, 0x4e, 0x47, 0x2b, 0xfb, 0x2a, 0xa3, 0xa5, 0x77, 0x60, 0x7d, 0xd2, 0xec,
+	0xf9, 0x43, 0xf0, 0x8f, 0x1c, 0x94, 0xe2, 0x0a, 0x43, 0xde, 0x49, 0x35, 0x97, 0xd8, 0x82, 0xb6,
+	0xc8, 0xe8, 0xa4, 0xb9, 0x60, 0xee, 0xef, 0x89, 0x06, 0xf3, 0xd9, 0x49, 0x33, 0xe7, 0xf9, 0x3c,
+	0x69, 0x38, 0xc9, 0xeb, 0x00, 0xb6, 0x3b, 0xf0, 0x7c, 0xc9, 0x20, 0x5d, 0x5e, 0x88, 0x51, 0x65,
+	0xdc, 0x42, 0xdc, 0x7b, 0x40, 0x8e, 0xa8, 0x1d, 0xf1, 0x2e, 0xb5, 0xb9, 0xe5, 0xf9, 0x9c, 0x46,
+	0x0f, 0xed, 0x7e, 0x2d, 0x37, 0x89, 0x5f, 0x4d, 0x20, 0x1d, 0x85, 0x20, 0x17, 0x61, 0x2d, 0xb2,
+	0x0f, 0xb9, 0x35, 0x66, 0x46, 0x45, 0xf9, 0x29, 0x46, 0x81, 0xb9, 0x19, 0x43, 0x50, 0x61, 0xcc,
+	0xa8, 0x72, 0x86, 0x53, 0xc9, 0x58, 0x98, 0xc1, 0x68, 0xc6, 0x10, 0x64, 0xbc, 0x0c, 0x5b, 0x53,
+	0x1a, 0x13, 0x73, 0x8b, 0x93, 0xcc, 0x1b, 0x13, 0x5a, 0x13, 0x93, 0x77, 0xa0, 0xaa, 0x34, 0x73,
+	0xdb, 0xf3, 0xad, 0x7e, 0xd0, 0x63, 0xb5, 0x85, 0x6d, 0x6d, 0x27, 0x6f, 0x2e, 0x4b, 0x6d, 0x82,
+	0x7c, 0x2b, 0xe8, 0x31, 0x72, 0x15, 0x6a, 0x69, 0x1b, 0x2d, 0x27, 0xf0, 0x9d, 0x61, 0x14, 0x51,
+	0xdf, 0x39, 0xae, 0x95, 0x26, 0x75, 0x6d, 0xa6, 0x0c, 0xdd, 0x1b, 0xc3, 0xc8, 0x1e, 0x9c, 0x41,
+	0x11, 0xcc, 0xb7, 0x43, 0x76, 0x14, 0xf0, 0x09, 0x19, 0xe5, 0x49, 0x19, 0xe8, 0xd7, 0x81, 0x02,
+	0xa6, 0x84, 0xe8, 0xbf, 0xc8, 0x8b, 0x37, 0x31, 0xf1, 0xe4, 0xbf, 0xb0, 0x01, 0xf8, 0xee, 0xc4,
+	0x2b, 0x97, 0xc3, 0x57, 0x6e, 0x39, 0x75, 0x39, 0xc4, 0x83, 0x7f, 0xea, 0xa5, 0x23, 0xe7, 0xa0,
+	0xcc, 0x8e, 0x99, 0xc5, 0xb8, 0xcd, 0x99, 0xaa, 0x29, 0x4b, 0x28, 0xf9, 0xe0, 0x98, 0x1d, 0x08,
+	0xa2, 0xe2, 0x29, 0x31, 0xb5, 0x26, 0x1f, 0xc1, 0x92, 0xeb, 0x39, 0xdc, 0x7a, 0x48, 0x23, 0x86,
+	0xaa, 0x0a, 0xa8, 0xea, 0xdb, 0xc6, 0xe9, 0xf0, 0x18, 0x6d, 0xcf, 0xe1, 0xdf, 0x57, 0xb8, 0x6b,
+	0x3e, 0x8f, 0x8e, 0xcd, 0x45, 0x37, 0x45, 0x22, 0x6d, 0xa8, 0xa0, 0x2c, 0x1a, 0x45, 0x41, 0xc4,
+	0x6a, 0x45, 0x94, 0xf4, 0xcd, 0xe7, 0x49, 0xba, 0x86, 0x28, 0x29, 0x07, 0xdc, 0x84, 0x50, 0xbf,
+	0x0c, 0xab, 0xa7, 0x14, 0x91, 0x2a, 0xe4, 0x1e, 0xd0, 0x63, 0x3c, 0x96, 0xb2, 0x29, 0x3e, 0xc9,
+	0x3a, 0x14, 0x1e, 0xda, 0xfd, 0xa1, 0xac, 0xe9, 0x79, 0x53, 0x2e, 0x3e, 0xcc, 0xbe, 0xaf, 0xd5,
+	0x2f, 0xc1, 0xca, 0x94, 0xfc, 0x7f, 0xc7, 0x5e, 0x4e, 0xb1, 0xeb, 0x3f, 0x86, 0xb5, 0x09, 0x8b,
+	0xe7, 0xef, 0x97, 0x2e, 0x40, 0x69, 0x60, 0x87, 0xa1, 0xe7, 0xf7, 0xe2, 0x6e, 0x74, 0x75, 0x7c,
+	0x82, 0xb7, 0xe5, 0x4e, 0x7c, 0x20, 0x31, 0x50, 0xff, 0xb5, 0x06, 0xd5, 0x69, 0x90, 0x7a, 0xaa,
+	0xb5, 0xaf, 0xef, 0xa9, 0x26, 0x35, 0x58, 0x50, 0x2a, 0x31, 0x02, 0x8b, 0x66, 0xbc, 0x24, 0x6f,
+	0xc0, 0x8a, 0xfa, 0x8c, 0x93, 0x02, 0xab, 0x56, 0xde, 0x5c, 0x56, 0x64, 0x75, 0x32, 0xfa, 0xd3,
+	0x2c, 0x2c, 0x4d, 0x24, 0xe4, 0x2b, 0x30, 0xf3, 0x35, 0x28, 0x7b, 0xcc, 0x52, 0x3f, 0xc3, 0x85,
+	0xa1, 0x25, 0xb3, 0xe4, 0x31, 0xf9, 0x02, 0x90, 0x1d, 0x28, 0x8a, 0x4c, 0x1f, 0x32, 0x34, 0x70,
+	0x79, 0xb7, 0x3a, 0x66, 0x3f, 0x40, 0xba, 0xa9, 0xf6, 0xc9, 0x5b, 0x50, 0xa0, 0x61, 0xe0, 0x1c,
+	0xa9, 0x3b, 0xb1, 0x32, 0x06, 0x5e, 0x13, 0xe4, 0xf8, 0x47, 0x1c, 0x62, 0xc8, 0xbb, 0x00, 0x82,
+	0xcd, 0x63, 0xdc, 0x73, 0x58, 0xad, 0x30, 0xcd, 0x91, 0xbe, 0x47, 0x29, 0x20, 0x79, 0x1b, 0x2a,
+	0xb2, 0x30, 0x49, 0x93, 0x8a, 0xc8, 0x57, 0x31, 0x4c, 0x51, 0x82, 0xa4, 0x35, 0x10, 0x25, 0xdf,
+	0xb3, 0xa2, 0xbc, 0x30, 0x33, 0xca, 0x9f, 0x6b, 0x50, 0x49, 0x75, 0xf9, 0xa4, 0x09, 0x15, 0x3b,
+	0x0c, 0x13, 0x26, 0x99, 0xd2, 0x60, 0x87, 0xa1, 0x62, 0x10, 0xbf, 0x86, 0x19, 0xb7, 0x23, 0x6e,
+	0x09, 0x16, 0x95, 0xde, 0x65, 0xa4, 0xdc, 0xf3, 0x06, 0x54, 0x6c, 0xf7, 0x82, 0x89, 0x93, 0x2d,
+	0x9b, 0xe5, 0x5e, 0x10, 0x73, 0xd7, 0xa1, 0x14, 0xf6, 0x6d, 0x7e, 0x18, 0x44, 0x03, 0x0c, 0x56,
+	0xd9, 0x4c, 0xd6, 0xfa, 0x9f, 0x34, 0x80, 0xb1, 0x3b, 0xe4, 0xed, 0x71, 0xfb, 0xa2, 0x4d, 0xb5,
+	0x2f, 0xe3, 0xbb, 0x10, 0x43, 0x08, 0x81, 0x3c, 0xa7, 0xd1, 0x40, 0x5d, 0x57, 0xfc, 0x16, 0x97,
+	0xd0, 0xf3, 0x5d, 0xfa, 0xa9, 0x4a, 0x30, 0xb9, 0x20, 0x9b, 0xe2, 0xd7, 0xc0, 0x60, 0xe0, 0xc9,
+	0x47, 0x2f, 0x6f, 0xaa, 0x95, 0x48, 0x59, 0x3b, 0x0c, 0xfb, 0x1e, 0x75, 0xf1, 0x50, 0xf2, 0x66,
+	0xbc, 0x24, 0x17, 0xa1, 0x7c, 0x18, 0xf4, 0xfb, 0xc1, 0x23, 0x9a, 0x94, 0x9d, 0x35, 0x0c, 0xfc,
+	0x75, 0x45, 0x95, 0x16, 0xc7, 0xdd, 0x79, 0x82, 0xd5, 0xff, 0xa0, 0x01, 0x39, 0x8d, 0x7b, 0x41,
+	0xcf, 0xd6, 0xa1, 0x30, 0xb0, 0xb9, 0x73, 0x14, 0x57, 0x22, 0x5c, 0xa4, 0xbc, 0xc8, 0x4d, 0x78,
+	0x41, 0x20, 0xef, 0xd3, 0x4f, 0x63, 0xdf, 0xf0, 0x9b, 0x7c, 0x03, 0x16, 0xdd, 0xe0, 0x91, 0x6f,
+	0x31, 0xea, 0x04, 0xbe, 0xcb, 0x94, 0x7b, 0x15, 0x41, 0x3b, 0x90, 0x24, 0xa1, 0x44, 0x24, 0x16,
+	0xc5, 0xbc, 0x2a, 0x9b, 0x72, 0xa1, 0xff, 0xaa, 0x00, 0x8b, 0xe9, 0xf2, 0x2e, 0x24, 0x0d, 0xe8,
+	0x20, 0x88, 0x8e, 0x2d, 0x1e, 0x70, 0xbb, 0x8f, 0xe6, 0xe7, 0xcd, 0x8a, 0xa4, 0xdd, 0x13, 0x24,
+	0xf2, 0x3a, 0xac, 0x28, 0xc8, 0x90, 0x51, 0xd7, 0x8a, 0x18, 0x53, 0x86, 0x2f, 0x49, 0xf2, 0x7d,
+	0x46, 0x5d, 0x93, 0x31, 0x91, 0x68, 0x29, 0x9c, 0xf2, 0x02, 0xc6, 0x98, 0x14, 0xe0, 0x30, 0xa2,
+	0xb4, 0x96, 0x4f, 0x03, 0xae, 0x47, 0x94, 0x92, 0x37, 0x61, 0x95, 0x3d, 0xb2, 0x43, 0x6b, 0xc2,
+	0xa2, 0x22, 0xc2, 0x56, 0xc4, 0xc6, 0xed, 0x94, 0x55, 0x3b, 0x50, 0x4d, 0x63, 0x51, 0xa5, 0xba,
+	0x10, 0x63, 0x28, 0xaa, 0x9d, 0x42, 0xa2, 0xee, 0xd2, 0x34, 0x12, 0xf5, 0xeb, 0xb0, 0xe4, 0x84,
+	0x43, 0x2b, 0x8c, 0x02, 0xc7, 0x8a, 0x44, 0xec, 0x60, 0x5b, 0xdb, 0xd1, 0xcc, 0x8a, 0x13, 0x0e,
+	0xf7, 0xa3, 0xc0, 0x31, 0x6d, 0x4e, 0x45, 0x81, 0x11, 0x18, 0x27, 0x18, 0xfa, 0xbc, 0x56, 0xc1,
+	0xd9, 0x62, 0xc9, 0x09, 0x87, 0x7b, 0x62, 0x2d, 0xee, 0x8a, 0xeb, 0xb1, 0x07, 0xca, 0xf2, 0x15,
+	0x54, 0x52, 0x16, 0x14, 0x69, 0xf3, 0x6b, 0x80, 0x0b, 0x69, 0x6c, 0x15, 0x77, 0x4b, 0x82, 0x80,
+	0x66, 0xc6, 0x9b, 0x68, 0xdf, 0xea, 0x78, 0x13, 0x2d, 0x3b, 0x0f, 0x9b, 0x3e, 0xe5, 0x96, 0x17,
+	0x58, 0x9e, 0x6f, 0x75, 0x8f, 0x45, 0xaf, 0x46, 0x23, 0x71, 0xfc, 0xb5, 0x0d, 0x44, 0xae, 0xfa,
+	0x94, 0x77, 0x82, 0x8e, 0xdf, 0x3a, 0xe6, 0x74, 0x9f, 0x46, 0x07, 0xd4, 0x21, 0x17, 0x60, 0x4b,
+	0xb1, 0x04, 0x43, 0x3e, 0xc9, 0xb3, 0x89, 0x3c, 0x04, 0x79, 0xee, 0x0e, 0x79, 0x8a, 0xc9, 0x80,
+	0x35, 0xc1, 0xc4, 0x9d, 0x50, 0xb4, 0x49, 0x3e, 0x75, 0x64, 0x3b, 0xb1, 0x85, 0x7e, 0x0a, 0x25,
+	0xf7, 0x9c, 0x70, 0x6f, 0xbc, 0x41, 0x2e, 0xc1, 0xff, 0xc5, 0x78, 0xdb, 0xe1, 0xde, 0x43, 0x6a,
+	0x05, 0x21, 0xf5, 0x59, 0xa2, 0xa9, 0x86, 0x9a, 0xb6, 0x24, 0xe3, 0x55, 0x44, 0xdc, 0x15, 0x00,
+	0xa5, 0xae, 0x0a, 0xb9, 0x20, 0x64, 0xb5, 0x33, 0x88, 0x12, 0x9f, 0xfa, 0x5f, 0x35, 0x58, 0x9e,
+	0xac, 0x9c, 0xe2, 0x02, 0x30, 0xef, 0x33, 0xaa, 0x52, 0x13, 0xbf, 0x63, 0xc6, 0x6c, 0xc2, 0x48,
+	0xde, 0x80, 0xaa, 0xf0, 0x91, 0x89, 0x00, 0xc5, 0xda, 0x65, 0x0a, 0x2e, 0x21, 0xbd, 0xe3, 0x2b,
+	0x9d, 0xdf, 0x81, 0x55, 0x09, 0x14, 0x61, 0x89, 0x91, 0x32, 0x17, 0x97, 0x71, 0xe3, 0xee, 0x90,
+	0x2b, 0xe8, 0xfb, 0x50, 0xc3, 0x93, 0xb4, 0xc4, 0x55, 0xb4, 0x7d, 0x97, 0x61, 0x6a, 0x50, 0xc6,
+	0x92, 0x8a, 0xb2, 0x89, 0xfb, 0x7b, 0x6a, 0x7b, 0x3f, 0xde, 0x15, 0xd5, 0xfa, 0x01, 0x3d, 0xc6,
+	0xd9, 0x97, 0x35, 0xf0, 0x18, 0xa3, 0x4c, 0xe5, 0xf1, 0x72, 0x4c, 0xbe, 0x8d, 0x54, 0xfd, 0xa7,
+	0x59, 0x58, 0xbf, 0x1f, 0xba, 0x36, 0xa7, 0xea, 0xe9, 0xfe, 0x5f, 0x9e, 0x02, 0xa6, 0x7a, 0x88,
+	0xfc, 0x44, 0x0f, 0xa1, 0xff, 0x4c, 0x83, 0x8d, 0xa9, 0x30, 0xcc, 0xdf, 0x46, 0xbd, 0x7c, 0xab,
+	0xf2, 0xe6, 0x0e, 0xac, 0x9e, 0xfa, 0xc9, 0x4f, 0x16, 0x20, 0x77, 0xd5, 0x75, 0xab, 0x19, 0x02,
+	0x50, 0x34, 0xe9, 0x20, 0x78, 0x48, 0xab, 0xda, 0xee, 0xd3, 0x3c, 0x94, 0xe5, 0x7f, 0x25, 0xcc,
+	0xd0, 0x21, 0xe7, 0xa1, 0x14, 0x0f, 0x25, 0x49, 0xd5, 0x98, 0x9a, 0xec, 0xd6, 0x57, 0x8d, 0xe9,
+	0x89, 0xa5, 0x9e, 0x21, 0x17, 0x01, 0xc6, 0xd3, 0x36, 0x42, 0x8c, 0x53, 0x63, 0xc6, 0xfa, 0x9a,
+	0x71, 0x7a, 0x1c, 0xa7, 0x67, 0xc8, 0x87, 0x50, 0x49, 0xf5, 0x9d, 0x64, 0x6d, 0x46, 0xdf, 0x5c,
+	0x5f, 0x37, 0x66, 0xb4, 0xa6, 0x7a, 0x86, 0xec, 0x40, 0x01, 0xc7, 0xfe, 0x64, 0xc9, 0x48, 0xff,
+	0x67, 0xa1, 0xbe, 0x6c, 0x4c, 0xfc, 0x37, 0x40, 0xcf, 0x28, 0x8f, 0xf0, 0x20, 0xa5, 0x47, 0xe9,
+	0x59, 0x7e, 0x7d, 0x35, 0x45, 0x49, 0x58, 0xae, 0xc3, 0xca, 0xd4, 0x08, 0x8b, 0x6c, 0x19, 0xb3,
+	0x07, 0x6a, 0xf5, 0x9a, 0xf1, 0x9c, 0x69, 0x97, 0x94, 0x33, 0x35, 0x1d, 0x22, 0x5b, 0xc6, 0xec,
+	0x69, 0x56, 0xbd, 0x66, 0x3c, 0x67, 0x90, 0xa4, 0x67, 0xc8, 0x15, 0x58, 0x9a, 0x18, 0x86, 0x90,
+	0x0d, 0x63, 0xd6, 0x98, 0xa8, 0xbe, 0x69, 0xcc, 0x9c, 0x99, 0xe8, 0x19, 0x72, 0x09, 0x16, 0xd3,
+	0xa3, 0x04, 0xb2, 0x6e, 0xcc, 0x18, 0x88, 0xd4, 0x37, 0x8c, 0x59, 0xf3, 0x06, 0x69, 0xc0, 0x44,
+	0x72, 0x93, 0x0d, 0x63, 0xd6, 0x9d, 0xaf, 0x6f, 0x1a, 0x33, 0xef, 0x80, 0x9e, 0x69, 0x5d, 0x79,
+	0xfc, 0xa4, 0x91, 0xf9, 0xf3, 0x93, 0x46, 0xe6, 0xab, 0x27, 0x8d, 0xcc, 0xdf, 0x9e, 0x34, 0x32,
+	0x7f, 0x7f, 0xd2, 0xd0, 0x7e, 0x32, 0x6a, 0x68, 0xbf, 0x19, 0x35, 0xb4, 0x2f, 0x46, 0x8d, 0xcc,
+	0x1f, 0x47, 0x8d, 0xcc, 0xe3, 0x51, 0x43, 0xfb, 0x72, 0xd4, 0xd0, 0xbe, 0x1a, 0x35, 0xb4, 0x9f,
+	0x3f, 0x6d, 0x64, 0x6e, 0x6a, 0x9f, 0x94, 0xe4, 0x7f, 0xfb, 0xc2, 0x6e, 0xb7, 0x88, 0x17, 0xf3,
+	0xc2, 0xbf, 0x06, 0x00, 0x0f, 0x1a, 0xd1, 0x15, 0x00, 0x1c, 0x00, 0x00,
 }
@@ -48,4 +48,6 @@ const (
 	PS_RESP_CODE_NO_LEADER      RespCode = 503
 	PS_RESP_CODE_KEY_EXISTS     RespCode = 409
 	PS_RESP_CODE_KEY_NOT_EXISTS RespCode = 410
+	PS_RESP_CODE_NO_SCROLL      RespCode = 411
+	PS_RESP_CODE_NO_TASK        RespCode = 412
 )
@@ -32,6 +32,8 @@ import fmt "fmt"
 import math "math"
 import _ "github.com/gogo/protobuf/gogoproto"
 
+import bytes "bytes"
+
 import strings "strings"
 import reflect "reflect"
 
@@ -171,6 +173,10 @@ type Space struct {
 	Type      SpaceType   `protobuf:"varint,5,opt,name=type,proto3,enum=SpaceType" json:"type,omitempty"`
 	Status    SpaceStatus `protobuf:"varint,6,opt,name=status,proto3,enum=SpaceStatus" json:"status,omitempty"`
 	KeyPolicy *KeyPolicy  `protobuf:"bytes,7,opt,name=key_policy,json=keyPolicy" json:"key_policy,omitempty"`
+	// the JSON schema mapping the source documents into fields
+	Mapping []byte `protobuf:"bytes,8,opt,name=mapping,proto3" json:"mapping,omitempty"`
+	// the version of the mapping, increased by every update of the mapping
+	MappingVersion uint64 `protobuf:"varint,9,opt,name=mapping_version,json=mappingVersion,proto3" json:"mapping_version,omitempty"`
 }
 
 func (m *Space) Reset()                    { *m = Space{} }
@@ -197,6 +203,9 @@ type Partition struct {
 	Replicas  []Replica       `protobuf:"bytes,6,rep,name=replicas" json:"replicas"`
 	Status    PartitionStatus `protobuf:"varint,7,opt,name=status,proto3,enum=PartitionStatus" json:"status,omitempty"`
 	Epoch     PartitionEpoch  `protobuf:"bytes,8,opt,name=epoch" json:"epoch"`
+	// the mapping of the space, so the partition maps the source documents by itself
+	Mapping        []byte `protobuf:"bytes,9,opt,name=mapping,proto3" json:"mapping,omitempty"`
+	MappingVersion uint64 `protobuf:"varint,10,opt,name=mapping_version,json=mappingVersion,proto3" json:"mapping_version,omitempty"`
 }
 
 func (m *Partition) Reset()                    { *m = Partition{} }
@@ -448,6 +457,12 @@ func (this *Space) Equal(that interface{}) bool {
 	if !this.KeyPolicy.Equal(that1.KeyPolicy) {
 		return false
 	}
+	if !bytes.Equal(this.Mapping, that1.Mapping) {
+		return false
+	}
+	if this.MappingVersion != that1.MappingVersion {
+		return false
+	}
 	return true
 }
 func (this *PartitionEpoch) Equal(that interface{}) bool {
@@ -525,6 +540,12 @@ func (this *Partition) Equal(that interface{}) bool {
 	if !this.Epoch.Equal(&that1.Epoch) {
 		return false
 	}
+	if !bytes.Equal(this.Mapping, that1.Mapping) {
+		return false
+	}
+	if this.MappingVersion != that1.MappingVersion {
+		return false
+	}
 	return true
 }
 func (this *Replica) Equal(that interface{}) bool {
@@ -979,6 +1000,17 @@ func (m *Space) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n1
 	}
+	if len(m.Mapping) > 0 {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(len(m.Mapping)))
+		i += copy(dAtA[i:], m.Mapping)
+	}
+	if m.MappingVersion != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(m.MappingVersion))
+	}
 	return i, nil
 }
 
@@ -1075,6 +1107,17 @@ func (m *Partition) MarshalTo(dAtA []byte) (int, error) {
 		return 0, err
 	}
 	i += n2
+	if len(m.Mapping) > 0 {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(len(m.Mapping)))
+		i += copy(dAtA[i:], m.Mapping)
+	}
+	if m.MappingVersion != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintMeta(dAtA, i, uint64(m.MappingVersion))
+	}
 	return i, nil
 }
 
@@ -1499,6 +1542,12 @@ func NewPopulatedSpace(r randyMeta, easy bool) *Space {
 	if r.Intn(10) != 0 {
 		this.KeyPolicy = NewPopulatedKeyPolicy(r, easy)
 	}
+	v1 := r.Intn(100)
+	this.Mapping = make([]byte, v1)
+	for i := 0; i < v1; i++ {
+		this.Mapping[i] = byte(r.Intn(256))
+	}
+	this.MappingVersion = uint64(uint64(r.Uint32()))
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -1521,16 +1570,22 @@ func NewPopulatedPartition(r randyMeta, easy bool) *Partition {
 	this.StartSlot = SlotID(r.Uint32())
 	this.EndSlot = SlotID(r.Uint32())
 	if r.Intn(10) != 0 {
-		v1 := r.Intn(5)
-		this.Replicas = make([]Replica, v1)
-		for i := 0; i < v1; i++ {
-			v2 := NewPopulatedReplica(r, easy)
-			this.Replicas[i] = *v2
+		v2 := r.Intn(5)
+		this.Replicas = make([]Replica, v2)
+		for i := 0; i < v2; i++ {
+			v3 := NewPopulatedReplica(r, easy)
+			this.Replicas[i] = *v3
 		}
 	}
 	this.Status = PartitionStatus([]int32{0, 1, 2, 3, 4}[r.Intn(5)])
-	v3 := NewPopulatedPartitionEpoch(r, easy)
-	this.Epoch = *v3
+	v4 := NewPopulatedPartitionEpoch(r, easy)
+	this.Epoch = *v4
+	v5 := r.Intn(100)
+	this.Mapping = make([]byte, v5)
+	for i := 0; i < v5; i++ {
+		this.Mapping[i] = byte(r.Intn(256))
+	}
+	this.MappingVersion = uint64(uint64(r.Uint32()))
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -1540,8 +1595,8 @@ func NewPopulatedReplica(r randyMeta, easy bool) *Replica {
 	this := &Replica{}
 	this.ID = ReplicaID(uint64(r.Uint32()))
 	this.NodeID = NodeID(r.Uint32())
-	v4 := NewPopulatedReplicaAddrs(r, easy)
-	this.ReplicaAddrs = *v4
+	v6 := NewPopulatedReplicaAddrs(r, easy)
+	this.ReplicaAddrs = *v6
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -1553,8 +1608,8 @@ func NewPopulatedNode(r randyMeta, easy bool) *Node {
 	this.Ip = string(randStringMeta(r))
 	this.Zone = string(randStringMeta(r))
 	this.Version = uint32(r.Uint32())
-	v5 := NewPopulatedReplicaAddrs(r, easy)
-	this.ReplicaAddrs = *v5
+	v7 := NewPopulatedReplicaAddrs(r, easy)
+	this.ReplicaAddrs = *v7
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -1585,8 +1640,8 @@ func NewPopulatedResponseHeader(r randyMeta, easy bool) *ResponseHeader {
 	this.ReqId = string(randStringMeta(r))
 	this.Code = RespCode(r.Uint32())
 	this.Message = string(randStringMeta(r))
-	v6 := NewPopulatedError(r, easy)
-	this.Error = *v6
+	v8 := NewPopulatedError(r, easy)
+	this.Error = *v8
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -1597,8 +1652,8 @@ func NewPopulatedNotLeader(r randyMeta, easy bool) *NotLeader {
 	this.PartitionID = PartitionID(r.Uint32())
 	this.Leader = NodeID(r.Uint32())
 	this.LeaderAddr = string(randStringMeta(r))
-	v7 := NewPopulatedPartitionEpoch(r, easy)
-	this.Epoch = *v7
+	v9 := NewPopulatedPartitionEpoch(r, easy)
+	this.Epoch = *v9
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -1664,9 +1719,9 @@ func randUTF8RuneMeta(r randyMeta) rune {
 	return rune(ru + 61)
 }
 func randStringMeta(r randyMeta) string {
-	v8 := r.Intn(100)
-	tmps := make([]rune, v8)
-	for i := 0; i < v8; i++ {
+	v10 := r.Intn(100)
+	tmps := make([]rune, v10)
+	for i := 0; i < v10; i++ {
 		tmps[i] = randUTF8RuneMeta(r)
 	}
 	return string(tmps)
@@ -1688,11 +1743,11 @@ func randFieldMeta(dAtA []byte, r randyMeta, fieldNumber int, wire int) []byte {
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateMeta(dAtA, uint64(key))
-		v9 := r.Int63()
+		v11 := r.Int63()
 		if r.Intn(2) == 0 {
-			v9 *= -1
+			v11 *= -1
 		}
-		dAtA = encodeVarintPopulateMeta(dAtA, uint64(v9))
+		dAtA = encodeVarintPopulateMeta(dAtA, uint64(v11))
 	case 1:
 		dAtA = encodeVarintPopulateMeta(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -1789,6 +1844,13 @@ func (m *Space) Size() (n int) {
 		l = m.KeyPolicy.Size()
 		n += 1 + l + sovMeta(uint64(l))
 	}
+	l = len(m.Mapping)
+	if l > 0 {
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	if m.MappingVersion != 0 {
+		n += 1 + sovMeta(uint64(m.MappingVersion))
+	}
 	return n
 }
 
@@ -1833,6 +1895,13 @@ func (m *Partition) Size() (n int) {
 	}
 	l = m.Epoch.Size()
 	n += 1 + l + sovMeta(uint64(l))
+	l = len(m.Mapping)
+	if l > 0 {
+		n += 1 + l + sovMeta(uint64(l))
+	}
+	if m.MappingVersion != 0 {
+		n += 1 + sovMeta(uint64(m.MappingVersion))
+	}
 	return n
 }
 
@@ -2056,6 +2125,8 @@ func (this *Space) String() string {
 		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
 		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
 		`KeyPolicy:` + strings.Replace(fmt.Sprintf("%v", this.KeyPolicy), "KeyPolicy", "KeyPolicy", 1) + `,`,
+		`Mapping:` + fmt.Sprintf("%v", this.Mapping) + `,`,
+		`MappingVersion:` + fmt.Sprintf("%v", this.MappingVersion) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2084,6 +2155,8 @@ func (this *Partition) String() string {
 		`Replicas:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Replicas), "Replica", "Replica", 1), `&`, ``, 1) + `,`,
 		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
 		`Epoch:` + strings.Replace(strings.Replace(this.Epoch.String(), "PartitionEpoch", "PartitionEpoch", 1), `&`, ``, 1) + `,`,
+		`Mapping:` + fmt.Sprintf("%v", this.Mapping) + `,`,
+		`MappingVersion:` + fmt.Sprintf("%v", this.MappingVersion) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2786,6 +2859,56 @@ func (m *Space) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mapping", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mapping = append(m.Mapping[:0], dAtA[iNdEx:postIndex]...)
+			if m.Mapping == nil {
+				m.Mapping = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MappingVersion", wireType)
+			}
+			m.MappingVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MappingVersion |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMeta(dAtA[iNdEx:])
@@ -3099,6 +3222,56 @@ func (m *Partition) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mapping", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mapping = append(m.Mapping[:0], dAtA[iNdEx:postIndex]...)
+			if m.Mapping == nil {
+				m.Mapping = []byte{}
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MappingVersion", wireType)
+			}
+			m.MappingVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MappingVersion |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMeta(dAtA[iNdEx:])
@@ -4508,85 +4681,87 @@ var (
 func init() { proto.RegisterFile("meta.proto", fileDescriptorMeta) }
 
 var fileDescriptorMeta = []byte{
-	// 1270 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcf, 0x8f, 0xdb, 0xc4,
-	0x17, 0x8f, 0xbd, 0xce, 0x0f, 0x3f, 0x27, 0x5b, 0x77, 0xfa, 0xad, 0x9a, 0xf6, 0x2b, 0x9c, 0xc5,
-	0x50, 0xb4, 0x2c, 0x90, 0x56, 0x8b, 0x84, 0x50, 0x85, 0x10, 0xeb, 0x26, 0x6d, 0x2d, 0xb6, 0xe9,
-	0xca, 0x89, 0x0a, 0xed, 0xc5, 0x72, 0xe2, 0xd9, 0xd4, 0x6a, 0xe2, 0x71, 0xed, 0x49, 0xa5, 0xed,
-	0x89, 0x1b, 0xfc, 0x05, 0x08, 0x71, 0x42, 0x82, 0x03, 0x7f, 0x02, 0x47, 0x8e, 0x2b, 0x4e, 0x3d,
-	0x21, 0x4e, 0x51, 0x37, 0x5c, 0x90, 0xb8, 0x70, 0x44, 0x7b, 0x42, 0x33, 0x1e, 0xcf, 0xba, 0x5b,
-	0x09, 0x15, 0xa9, 0xa7, 0xcc, 0xfb, 0x31, 0x6f, 0x3e, 0xf3, 0xf9, 0xbc, 0x79, 0x0e, 0xc0, 0x1c,
-	0xd3, 0xa0, 0x9b, 0xa4, 0x84, 0x92, 0x4b, 0xef, 0x4d, 0x23, 0xfa, 0x60, 0x31, 0xee, 0x4e, 0xc8,
-	0xfc, 0xca, 0x94, 0x4c, 0xc9, 0x15, 0xee, 0x1e, 0x2f, 0xf6, 0xb9, 0xc5, 0x0d, 0xbe, 0xca, 0xd3,
-	0xed, 0xcf, 0x41, 0xbb, 0x4f, 0x62, 0x8c, 0x10, 0x68, 0x71, 0x30, 0xc7, 0x6d, 0x65, 0x43, 0xd9,
-	0xd4, 0x3d, 0xbe, 0x46, 0xaf, 0x43, 0x33, 0xc3, 0xe9, 0x63, 0x9c, 0xfa, 0x41, 0x18, 0xa6, 0x59,
-	0x5b, 0xe5, 0x31, 0x23, 0xf7, 0xed, 0x30, 0x17, 0xba, 0x08, 0x8d, 0x94, 0x10, 0xea, 0x87, 0x51,
-	0xda, 0x5e, 0xe3, 0xe1, 0x3a, 0xb3, 0x7b, 0x51, 0x6a, 0x7f, 0x08, 0x6a, 0xcf, 0x41, 0x16, 0xa8,
-	0x51, 0xc8, 0xab, 0xb6, 0x9c, 0xf5, 0xd5, 0xb2, 0xa3, 0xba, 0xbd, 0xe3, 0x65, 0x47, 0xeb, 0x39,
-	0x6e, 0xcf, 0x53, 0xa3, 0x50, 0x9e, 0xab, 0x9e, 0x9c, 0x6b, 0x5f, 0x07, 0xfd, 0x53, 0x7c, 0xb0,
-	0x47, 0x66, 0xd1, 0xe4, 0x00, 0xfd, 0x1f, 0xf4, 0x87, 0xf8, 0xc0, 0xdf, 0x8f, 0xf0, 0x2c, 0x14,
-	0xe8, 0x1a, 0x0f, 0xf1, 0xc1, 0x0d, 0x66, 0xb3, 0xe3, 0x79, 0x70, 0x11, 0x4f, 0x44, 0x85, 0x3a,
-	0x8b, 0x2d, 0xe2, 0x89, 0xfd, 0x87, 0x02, 0xd5, 0x61, 0x12, 0x4c, 0xd8, 0x35, 0x4e, 0x20, 0x9c,
-	0x95, 0x10, 0xea, 0x3c, 0x28, 0x50, 0x58, 0xa0, 0x86, 0x63, 0x5e, 0x41, 0xa0, 0xec, 0x39, 0x27,
-	0x28, 0xc3, 0x31, 0xba, 0x00, 0xf5, 0x70, 0xec, 0x73, 0xa0, 0xf9, 0x2d, 0x6b, 0xe1, 0x78, 0xc0,
-	0x28, 0x2a, 0xe0, 0x6b, 0x25, 0xda, 0x2c, 0xd0, 0xe8, 0x41, 0x82, 0xdb, 0xd5, 0x0d, 0x65, 0x73,
-	0x7d, 0x1b, 0xba, 0xfc, 0xa0, 0xd1, 0x41, 0x82, 0x3d, 0xee, 0x47, 0x6f, 0x42, 0x2d, 0xa3, 0x01,
-	0x5d, 0x64, 0xed, 0x1a, 0xcf, 0x68, 0xe6, 0x19, 0x43, 0xee, 0xf3, 0x44, 0x0c, 0xbd, 0x0d, 0xc0,
-	0xae, 0x96, 0x70, 0x16, 0xda, 0xf5, 0x0d, 0x65, 0xd3, 0xd8, 0x86, 0xae, 0xe4, 0xc5, 0x63, 0xac,
-	0xe4, 0x4b, 0xfb, 0x36, 0xac, 0xef, 0x05, 0x29, 0x8d, 0x68, 0x44, 0xe2, 0x7e, 0x42, 0x26, 0x0f,
-	0x98, 0x72, 0x13, 0x12, 0xef, 0xfb, 0x8f, 0x71, 0x9a, 0x45, 0x24, 0xe6, 0x97, 0xd7, 0x3c, 0x83,
-	0xf9, 0xee, 0xe6, 0x2e, 0xd4, 0x86, 0x7a, 0x11, 0x55, 0x79, 0xb4, 0x30, 0xed, 0x3f, 0x55, 0xd0,
-	0x65, 0x3d, 0x74, 0xb9, 0xc4, 0xde, 0x79, 0xc9, 0x9e, 0x21, 0x13, 0x5e, 0x92, 0xc1, 0x2d, 0xa8,
-	0x66, 0xec, 0x96, 0x9c, 0xbf, 0x96, 0xf3, 0xbf, 0xd5, 0xb2, 0x93, 0xcb, 0x53, 0x96, 0x22, 0x4f,
-	0x41, 0x1f, 0x00, 0x64, 0x34, 0x48, 0xa9, 0x9f, 0xcd, 0x08, 0xe5, 0xd4, 0xb6, 0x9c, 0x0b, 0xab,
-	0x65, 0x47, 0x1f, 0x32, 0xef, 0x70, 0x46, 0xe8, 0xf1, 0xb2, 0x53, 0x63, 0xbf, 0x6e, 0xcf, 0xd3,
-	0xb3, 0xc2, 0x89, 0xae, 0x42, 0x03, 0xc7, 0x61, 0xbe, 0xab, 0x2a, 0x01, 0xd7, 0xfb, 0x71, 0x78,
-	0x6a, 0x4f, 0x1d, 0xe7, 0x2e, 0xb4, 0x05, 0x8d, 0x14, 0x27, 0xb3, 0x68, 0x12, 0x30, 0x31, 0xd6,
-	0x36, 0x8d, 0xed, 0x46, 0xd7, 0xcb, 0x1d, 0x8e, 0x76, 0xb8, 0xec, 0x54, 0x3c, 0x19, 0x47, 0x9b,
-	0x52, 0xb6, 0x3a, 0x97, 0xcd, 0xec, 0x4a, 0x0e, 0x4e, 0x49, 0xf7, 0x0e, 0x54, 0x31, 0x93, 0xa1,
-	0xdd, 0xe0, 0xaa, 0x9d, 0xe9, 0x3e, 0xaf, 0x8e, 0xa8, 0x9c, 0xe7, 0xd8, 0xdf, 0x2a, 0x50, 0x17,
-	0x47, 0xa2, 0x37, 0x24, 0xd7, 0x9a, 0x73, 0x4e, 0x72, 0xad, 0x8b, 0xb0, 0x60, 0xfa, 0x5d, 0xa8,
-	0xc5, 0x24, 0xc4, 0x6e, 0x4f, 0xb0, 0xcd, 0xa8, 0xac, 0x0d, 0xb8, 0xe7, 0x58, 0xae, 0x3c, 0x91,
-	0x83, 0x3e, 0x82, 0x96, 0xb8, 0x81, 0x78, 0xc4, 0x6b, 0x1c, 0x53, 0xab, 0xb8, 0x26, 0x7f, 0xc6,
-	0x4e, 0x83, 0x21, 0x7a, 0xba, 0xec, 0x28, 0x5e, 0x33, 0x2d, 0xf9, 0xed, 0x1f, 0x14, 0xd0, 0x58,
-	0x41, 0xb4, 0x51, 0xea, 0x02, 0x53, 0x22, 0x2b, 0x0e, 0x63, 0xb0, 0xd6, 0x41, 0x8d, 0x12, 0xf1,
-	0x08, 0xd5, 0x28, 0x61, 0x2f, 0xe3, 0x09, 0x89, 0x8b, 0xf7, 0xc2, 0xd7, 0xe5, 0x9e, 0xe3, 0xaa,
-	0xca, 0x9e, 0x7b, 0x11, 0x66, 0xf5, 0xbf, 0xc0, 0xfc, 0x5a, 0x81, 0x66, 0x39, 0x11, 0x5d, 0x86,
-	0xf5, 0x07, 0x38, 0x48, 0xe9, 0x18, 0x07, 0x94, 0x17, 0x14, 0x93, 0xa3, 0x25, 0xbd, 0x2c, 0x8f,
-	0xa5, 0x89, 0x3a, 0x14, 0xe7, 0x69, 0x39, 0xfe, 0x96, 0xf4, 0xf2, 0x34, 0x36, 0xe4, 0x92, 0x49,
-	0x9e, 0x50, 0x0c, 0xb9, 0x64, 0xc2, 0x43, 0xaf, 0x01, 0x04, 0xe1, 0x3c, 0x8a, 0xf3, 0x60, 0x3e,
-	0x05, 0x74, 0xee, 0x61, 0x61, 0xfb, 0x13, 0x68, 0x79, 0xf8, 0xd1, 0x02, 0x67, 0xf4, 0x16, 0x0e,
-	0x42, 0x9c, 0xa2, 0xf3, 0x50, 0x4b, 0xf1, 0x23, 0x3f, 0x2a, 0x46, 0x59, 0x35, 0xc5, 0x8f, 0xdc,
-	0x90, 0x11, 0x43, 0xa3, 0x39, 0x26, 0x0b, 0x5a, 0x8c, 0x31, 0x61, 0xda, 0x5f, 0x2a, 0xb0, 0xee,
-	0xe1, 0x2c, 0x21, 0x71, 0x86, 0xff, 0xbd, 0xc6, 0x06, 0x68, 0x13, 0x12, 0x62, 0xd1, 0x15, 0xcd,
-	0xe3, 0x65, 0xa7, 0xc1, 0x36, 0x5e, 0x27, 0x21, 0xf6, 0x78, 0x84, 0x9d, 0x32, 0xc7, 0x59, 0x16,
-	0x4c, 0x0b, 0x55, 0x0a, 0x13, 0xd9, 0x50, 0xc5, 0x69, 0x4a, 0xf2, 0x1b, 0x18, 0xdb, 0xb5, 0x6e,
-	0x9f, 0x59, 0xb2, 0x51, 0x99, 0x61, 0xff, 0xa2, 0x80, 0x3e, 0x20, 0x74, 0x37, 0x07, 0xb1, 0x03,
-	0xcd, 0xa4, 0xe8, 0x6a, 0x5f, 0xb6, 0x86, 0xb5, 0x7a, 0x7e, 0x34, 0x9c, 0x9e, 0x14, 0x86, 0xdc,
-	0xe3, 0xf2, 0x46, 0x9e, 0xf1, 0x62, 0xe5, 0x46, 0xce, 0xcb, 0x97, 0x1b, 0x39, 0xcf, 0x41, 0x1d,
-	0x30, 0xf2, 0x55, 0x59, 0x07, 0xc8, 0x5d, 0x5c, 0x0a, 0xf9, 0xea, 0xb4, 0x97, 0x78, 0x75, 0xb7,
-	0xa1, 0x31, 0x20, 0xaf, 0xec, 0x2a, 0xf6, 0x5d, 0x38, 0x2b, 0x63, 0x03, 0x42, 0x6f, 0x90, 0x45,
-	0x1c, 0xbe, 0x8a, 0xba, 0x0f, 0xc1, 0xb8, 0x9d, 0x4d, 0x47, 0x84, 0xec, 0x06, 0xe9, 0x14, 0xbf,
-	0x0a, 0xd2, 0x2f, 0x42, 0x63, 0x9e, 0x4d, 0xfd, 0x2c, 0x7a, 0x82, 0x8b, 0xb9, 0x3f, 0xcf, 0xa6,
-	0xc3, 0xe8, 0x09, 0xb6, 0x7f, 0x55, 0xa0, 0xca, 0x75, 0x67, 0xdf, 0x9e, 0x98, 0x50, 0x5f, 0xa8,
-	0xa3, 0x88, 0x6f, 0x8f, 0x14, 0xdf, 0xd3, 0x63, 0xd9, 0x07, 0x6f, 0x81, 0x1e, 0x13, 0xbf, 0xa4,
-	0xa3, 0xb1, 0xad, 0x77, 0x0b, 0x6a, 0xbd, 0x46, 0x5c, 0x90, 0xec, 0xc0, 0xb9, 0x13, 0xe8, 0xac,
-	0xf8, 0x3e, 0xe3, 0x48, 0x4c, 0x23, 0xd4, 0x7d, 0x81, 0x3d, 0xef, 0x6c, 0xf2, 0x02, 0xa1, 0x57,
-	0xa1, 0xc5, 0xb0, 0x53, 0x42, 0xfc, 0x19, 0xe3, 0x43, 0x28, 0xdd, 0xec, 0x96, 0x38, 0xf2, 0x8c,
-	0xf9, 0x89, 0x71, 0x4d, 0x3b, 0xfc, 0xae, 0xa3, 0x6c, 0x25, 0x60, 0x94, 0xbe, 0xb0, 0x68, 0x1d,
-	0x60, 0x38, 0xf4, 0xdd, 0xf8, 0x71, 0x30, 0x8b, 0x42, 0xb3, 0x82, 0x0c, 0xa8, 0x73, 0x3b, 0xa2,
-	0xa6, 0x22, 0x82, 0x7b, 0x29, 0x4e, 0x82, 0x14, 0x9b, 0xaa, 0xb0, 0xbd, 0x45, 0x1c, 0x47, 0xf1,
-	0xd4, 0x5c, 0x43, 0x2d, 0xd0, 0x87, 0x43, 0xbf, 0x87, 0x67, 0x98, 0x62, 0x53, 0x43, 0x67, 0xc0,
-	0x28, 0x4c, 0x16, 0xaf, 0x5e, 0xd2, 0xbe, 0xfa, 0xde, 0xaa, 0x6c, 0x5d, 0x03, 0x5d, 0x7e, 0xf5,
-	0xf9, 0x96, 0x91, 0xdf, 0x1f, 0x8c, 0xdc, 0xd1, 0x3d, 0x71, 0xdc, 0xc8, 0xef, 0xf7, 0x6e, 0xf6,
-	0x4d, 0x45, 0x18, 0xce, 0xee, 0x1d, 0xc7, 0x54, 0xc5, 0xde, 0x19, 0x9c, 0x39, 0xf5, 0x61, 0x61,
-	0x20, 0xf6, 0x76, 0x7c, 0x77, 0x70, 0x77, 0x67, 0xd7, 0xed, 0x99, 0x15, 0x61, 0x0f, 0xee, 0x8c,
-	0xbc, 0xfe, 0x4e, 0xcf, 0x54, 0x18, 0x8a, 0xbd, 0x1d, 0x9f, 0x19, 0x77, 0x06, 0xbb, 0xf7, 0x4c,
-	0x15, 0x99, 0xd0, 0x14, 0x8e, 0xcf, 0x3c, 0x77, 0xd4, 0x37, 0xd7, 0x84, 0x67, 0xb8, 0xb7, 0xeb,
-	0x8e, 0x46, 0xee, 0xe0, 0xa6, 0xa9, 0xe5, 0xa7, 0x39, 0x1f, 0x1f, 0x1e, 0x59, 0x95, 0xdf, 0x8e,
-	0xac, 0xca, 0xb3, 0x23, 0xab, 0xf2, 0xd7, 0x91, 0x55, 0xf9, 0xfb, 0xc8, 0x52, 0xbe, 0x58, 0x59,
-	0xca, 0x8f, 0x2b, 0x4b, 0xf9, 0x69, 0x65, 0x55, 0x7e, 0x5e, 0x59, 0x95, 0xc3, 0x95, 0xa5, 0x3c,
-	0x5d, 0x59, 0xca, 0xb3, 0x95, 0xa5, 0x7c, 0xf3, 0xbb, 0x55, 0xb9, 0xa5, 0xdc, 0xaf, 0xb1, 0xbf,
-	0x9c, 0xc9, 0x78, 0x5c, 0xe3, 0x7f, 0x23, 0xdf, 0xff, 0x27, 0x00, 0x00, 0xff, 0xff, 0x75, 0x5c,
-	0x22, 0x82, 0x83, 0x0a, 0x00, 0x00,
+	// 1306 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcf, 0x8f, 0xdb, 0x44,
+	0x14, 0xce, 0x78, 0x9d, 0x1f, 0x7e, 0x4e, 0xb2, 0xee, 0x94, 0xaa, 0x69, 0x11, 0xce, 0x62, 0x28,
+	0x84, 0x05, 0xd2, 0x6a, 0x91, 0x10, 0xaa, 0x10, 0x62, 0xd3, 0xa4, 0x6d, 0xc4, 0x36, 0x5d, 0x39,
+	0x51, 0xa1, 0xbd, 0x58, 0x4e, 0x3c, 0x9b, 0x5a, 0x9b, 0x78, 0x5c, 0xdb, 0xa9, 0xb4, 0x3d, 0x71,
+	0x83, 0xbf, 0x00, 0x21, 0x4e, 0x48, 0x70, 0x40, 0x42, 0xe2, 0xcc, 0x91, 0xe3, 0x8a, 0x53, 0x4f,
+	0x88, 0x53, 0xd4, 0x0d, 0xff, 0x00, 0x47, 0xb4, 0x27, 0x34, 0xe3, 0xf1, 0xac, 0xbb, 0x45, 0x14,
+	0xa4, 0x9e, 0x32, 0xef, 0x7b, 0x6f, 0xde, 0x7c, 0xf3, 0xbe, 0xe7, 0x37, 0x01, 0x98, 0x93, 0xc4,
+	0x6d, 0x87, 0x11, 0x4d, 0xe8, 0xc5, 0x77, 0xa7, 0x7e, 0x72, 0x7f, 0x31, 0x6e, 0x4f, 0xe8, 0xfc,
+	0xf2, 0x94, 0x4e, 0xe9, 0x65, 0x0e, 0x8f, 0x17, 0x7b, 0xdc, 0xe2, 0x06, 0x5f, 0xa5, 0xe1, 0xd6,
+	0x67, 0xa0, 0xde, 0xa3, 0x01, 0xc1, 0x18, 0xd4, 0xc0, 0x9d, 0x93, 0x06, 0xda, 0x40, 0x2d, 0xcd,
+	0xe6, 0x6b, 0xfc, 0x2a, 0x54, 0x63, 0x12, 0x3d, 0x24, 0x91, 0xe3, 0x7a, 0x5e, 0x14, 0x37, 0x14,
+	0xee, 0xd3, 0x53, 0x6c, 0x9b, 0x41, 0xf8, 0x02, 0x54, 0x22, 0x4a, 0x13, 0xc7, 0xf3, 0xa3, 0xc6,
+	0x1a, 0x77, 0x97, 0x99, 0xdd, 0xf5, 0x23, 0xeb, 0x03, 0x50, 0xba, 0x1d, 0x6c, 0x82, 0xe2, 0x7b,
+	0x3c, 0x6b, 0xad, 0x53, 0x5f, 0x2d, 0x9b, 0x4a, 0xbf, 0x7b, 0xbc, 0x6c, 0xaa, 0xdd, 0x4e, 0xbf,
+	0x6b, 0x2b, 0xbe, 0x27, 0xcf, 0x55, 0x4e, 0xce, 0xb5, 0xae, 0x81, 0xf6, 0x09, 0x39, 0xd8, 0xa5,
+	0x33, 0x7f, 0x72, 0x80, 0x5f, 0x06, 0x6d, 0x9f, 0x1c, 0x38, 0x7b, 0x3e, 0x99, 0x79, 0x82, 0x5d,
+	0x65, 0x9f, 0x1c, 0x5c, 0x67, 0x36, 0x3b, 0x9e, 0x3b, 0x17, 0xc1, 0x44, 0x64, 0x28, 0x33, 0xdf,
+	0x22, 0x98, 0x58, 0x3f, 0x2a, 0x50, 0x1c, 0x86, 0xee, 0x84, 0x5d, 0xe3, 0x84, 0xc2, 0x19, 0x49,
+	0xa1, 0xcc, 0x9d, 0x82, 0x85, 0x09, 0x8a, 0x37, 0x6e, 0x28, 0x27, 0x2c, 0xbb, 0x9d, 0x13, 0x96,
+	0xde, 0x18, 0x9f, 0x87, 0xb2, 0x37, 0x76, 0x38, 0xd1, 0xf4, 0x96, 0x25, 0x6f, 0x3c, 0x60, 0x25,
+	0xca, 0xe8, 0xab, 0xb9, 0xb2, 0x99, 0xa0, 0x26, 0x07, 0x21, 0x69, 0x14, 0x37, 0x50, 0xab, 0xbe,
+	0x05, 0x6d, 0x7e, 0xd0, 0xe8, 0x20, 0x24, 0x36, 0xc7, 0xf1, 0xeb, 0x50, 0x8a, 0x13, 0x37, 0x59,
+	0xc4, 0x8d, 0x12, 0x8f, 0xa8, 0xa6, 0x11, 0x43, 0x8e, 0xd9, 0xc2, 0x87, 0xdf, 0x02, 0x60, 0x57,
+	0x0b, 0x79, 0x15, 0x1a, 0xe5, 0x0d, 0xd4, 0xd2, 0xb7, 0xa0, 0x2d, 0xeb, 0x62, 0x6b, 0xfb, 0xd9,
+	0x12, 0x37, 0xa0, 0x3c, 0x77, 0xc3, 0xd0, 0x0f, 0xa6, 0x8d, 0xca, 0x06, 0x6a, 0x55, 0xed, 0xcc,
+	0xc4, 0x6f, 0xc2, 0xba, 0x58, 0x3a, 0x0f, 0x49, 0x14, 0xfb, 0x34, 0x68, 0x68, 0x1b, 0xa8, 0xa5,
+	0xda, 0x75, 0x01, 0xdf, 0x49, 0x51, 0xeb, 0x16, 0xd4, 0x77, 0xdd, 0x28, 0xf1, 0x13, 0x9f, 0x06,
+	0xbd, 0x90, 0x4e, 0xee, 0x33, 0xf1, 0x27, 0x34, 0xd8, 0x93, 0xfb, 0x10, 0xdf, 0xa7, 0x33, 0x4c,
+	0x6c, 0x62, 0xe7, 0x66, 0x5e, 0x85, 0x7b, 0x33, 0xd3, 0xfa, 0x69, 0x0d, 0x34, 0x99, 0x0f, 0x5f,
+	0xca, 0x09, 0x70, 0x4e, 0x0a, 0xa0, 0xcb, 0x80, 0xff, 0x28, 0xc2, 0x26, 0x14, 0x63, 0x56, 0x28,
+	0x2e, 0x41, 0xad, 0xf3, 0xd2, 0x6a, 0xd9, 0x4c, 0x15, 0xce, 0xab, 0x99, 0x86, 0xe0, 0xf7, 0x01,
+	0xe2, 0xc4, 0x8d, 0x12, 0x27, 0x9e, 0xd1, 0x84, 0xab, 0x53, 0xeb, 0x9c, 0x5f, 0x2d, 0x9b, 0xda,
+	0x90, 0xa1, 0xc3, 0x19, 0x4d, 0x8e, 0x97, 0xcd, 0x12, 0xfb, 0xed, 0x77, 0x6d, 0x2d, 0xce, 0x40,
+	0x7c, 0x05, 0x2a, 0x24, 0xf0, 0xd2, 0x5d, 0x45, 0x49, 0xb8, 0xdc, 0x0b, 0xbc, 0x53, 0x7b, 0xca,
+	0x24, 0x85, 0xf0, 0x26, 0x54, 0x22, 0x12, 0xce, 0xfc, 0x89, 0xcb, 0xf4, 0x5c, 0x6b, 0xe9, 0x5b,
+	0x95, 0xb6, 0x9d, 0x02, 0x1d, 0xf5, 0x70, 0xd9, 0x2c, 0xd8, 0xd2, 0x8f, 0x5b, 0x52, 0xf9, 0x32,
+	0x57, 0xde, 0x68, 0xcb, 0x1a, 0x9c, 0x52, 0xff, 0x6d, 0x28, 0x12, 0x26, 0x03, 0x17, 0x54, 0xdf,
+	0x5a, 0x6f, 0x3f, 0xad, 0x8e, 0xc8, 0x9c, 0xc6, 0xe4, 0xf5, 0xd7, 0x9e, 0xab, 0x3f, 0xfc, 0xa3,
+	0xfe, 0xdf, 0x20, 0x28, 0x0b, 0xd6, 0xf8, 0x35, 0x29, 0x97, 0xda, 0x39, 0x2b, 0xe5, 0xd2, 0x84,
+	0x5b, 0x88, 0xf5, 0x0e, 0x94, 0x02, 0xea, 0x91, 0x7e, 0xb7, 0xa1, 0x48, 0x35, 0x4a, 0x03, 0x8e,
+	0x1c, 0xcb, 0x95, 0x2d, 0x62, 0xf0, 0x87, 0x50, 0x13, 0x45, 0x10, 0xa3, 0x64, 0x8d, 0x5f, 0xab,
+	0x96, 0x55, 0x8a, 0x0f, 0x93, 0x4e, 0x85, 0x5d, 0xea, 0xf1, 0xb2, 0x89, 0xec, 0x6a, 0x94, 0xc3,
+	0xad, 0xef, 0x11, 0xa8, 0x2c, 0x21, 0xde, 0xc8, 0x35, 0x92, 0x21, 0x99, 0x65, 0x87, 0x31, 0x5a,
+	0x75, 0x50, 0xfc, 0x50, 0x8c, 0x02, 0xc5, 0x0f, 0xd9, 0xf7, 0xf9, 0x88, 0x06, 0xd9, 0x57, 0xcb,
+	0xd7, 0xf9, 0xb6, 0xe5, 0x8d, 0x21, 0xdb, 0xf6, 0x59, 0x9a, 0xc5, 0xff, 0x43, 0xf3, 0x2b, 0x04,
+	0xd5, 0x7c, 0x20, 0xbe, 0x04, 0xf5, 0xfb, 0xc4, 0x8d, 0x92, 0x31, 0x71, 0x13, 0x9e, 0x50, 0xcc,
+	0xaf, 0x9a, 0x44, 0x59, 0x1c, 0x0b, 0x13, 0x79, 0x12, 0x92, 0x86, 0xa5, 0xfc, 0x6b, 0x12, 0xe5,
+	0x61, 0x6c, 0xd4, 0x86, 0x93, 0x34, 0x20, 0x1b, 0xb5, 0xe1, 0x84, 0xbb, 0x5e, 0x01, 0x70, 0xbd,
+	0xb9, 0x1f, 0xa4, 0xce, 0x74, 0x16, 0x69, 0x1c, 0x61, 0x6e, 0xeb, 0x63, 0xa8, 0xd9, 0xe4, 0xc1,
+	0x82, 0xc4, 0xc9, 0x4d, 0xe2, 0x7a, 0x24, 0xc2, 0xe7, 0xa0, 0x14, 0x91, 0x07, 0x8e, 0x9f, 0x0d,
+	0xd4, 0x62, 0x44, 0x1e, 0xf4, 0x3d, 0x56, 0x98, 0xc4, 0x9f, 0x13, 0xba, 0x48, 0xb2, 0x61, 0x2a,
+	0x4c, 0xeb, 0x0b, 0x04, 0x75, 0x9b, 0xc4, 0x21, 0x0d, 0x62, 0xf2, 0xef, 0x39, 0x36, 0x40, 0x9d,
+	0x50, 0x8f, 0x88, 0xae, 0xa8, 0x1e, 0x2f, 0x9b, 0x15, 0xb6, 0xf1, 0x1a, 0xf5, 0x88, 0xcd, 0x3d,
+	0xbc, 0x5b, 0x49, 0x1c, 0xbb, 0xd3, 0x4c, 0x95, 0xcc, 0xc4, 0x16, 0x14, 0x49, 0x14, 0xd1, 0xf4,
+	0x06, 0xfa, 0x56, 0xa9, 0xdd, 0x63, 0x96, 0xec, 0x75, 0x66, 0x58, 0xbf, 0x22, 0xd0, 0x06, 0x34,
+	0xd9, 0x49, 0x49, 0x6c, 0x43, 0x35, 0xcc, 0x3e, 0x0c, 0x47, 0xb6, 0x86, 0xb9, 0x7a, 0x7a, 0xba,
+	0x9c, 0x1e, 0x36, 0xba, 0xdc, 0xd3, 0xe7, 0x8d, 0x3c, 0xe3, 0xc9, 0xf2, 0x8d, 0x9c, 0xa6, 0xcf,
+	0x37, 0x72, 0x1a, 0x83, 0x9b, 0xa0, 0xa7, 0xab, 0xbc, 0x0e, 0x90, 0x42, 0x5c, 0x0a, 0xf9, 0xe1,
+	0xaa, 0xcf, 0xff, 0x70, 0xad, 0x5b, 0x50, 0x19, 0xd0, 0x17, 0x76, 0x15, 0xeb, 0x0e, 0x9c, 0x91,
+	0xbe, 0x01, 0x4d, 0xae, 0xd3, 0x45, 0xe0, 0xbd, 0x88, 0xbc, 0xfb, 0xa0, 0xdf, 0x8a, 0xa7, 0x23,
+	0x4a, 0x77, 0xdc, 0x68, 0x4a, 0x5e, 0x44, 0xd1, 0x2f, 0x40, 0x65, 0x1e, 0x4f, 0x9d, 0xd8, 0x7f,
+	0x44, 0xb2, 0xa7, 0x63, 0x1e, 0x4f, 0x87, 0xfe, 0x23, 0x62, 0xfd, 0x86, 0xa0, 0xc8, 0x75, 0x67,
+	0x2f, 0x60, 0x40, 0x13, 0x47, 0xa8, 0x83, 0xc4, 0x0b, 0x28, 0xc5, 0xb7, 0xb5, 0x20, 0x5b, 0xe2,
+	0x37, 0x40, 0x0b, 0xa8, 0x93, 0xd3, 0x51, 0xdf, 0xd2, 0xda, 0x59, 0x69, 0xed, 0x4a, 0x20, 0x56,
+	0xb8, 0x03, 0x67, 0x4f, 0xa8, 0xb3, 0xe4, 0x7b, 0xac, 0x46, 0x62, 0x1a, 0xe1, 0xf6, 0x33, 0xd5,
+	0xb3, 0xcf, 0x84, 0xcf, 0x14, 0xf4, 0x0a, 0xd4, 0x18, 0xf7, 0x84, 0x52, 0x67, 0xc6, 0xea, 0x21,
+	0x94, 0xae, 0xb6, 0x73, 0x35, 0xb2, 0xf5, 0xf9, 0x89, 0x71, 0x55, 0x3d, 0xfc, 0xb6, 0x89, 0x36,
+	0x43, 0xd0, 0x73, 0xef, 0x3c, 0xae, 0x03, 0x0c, 0x87, 0x4e, 0x3f, 0x78, 0xe8, 0xce, 0x7c, 0xcf,
+	0x28, 0x60, 0x1d, 0xca, 0xdc, 0xf6, 0x13, 0x03, 0x09, 0xe7, 0x6e, 0x44, 0x42, 0x37, 0x22, 0x86,
+	0x22, 0x6c, 0x7b, 0x11, 0x04, 0x7e, 0x30, 0x35, 0xd6, 0x70, 0x0d, 0xb4, 0xe1, 0xd0, 0xe9, 0x92,
+	0x19, 0x49, 0x88, 0xa1, 0xe2, 0x75, 0xd0, 0x33, 0x93, 0xf9, 0x8b, 0x17, 0xd5, 0x2f, 0xbf, 0x33,
+	0x0b, 0x9b, 0x57, 0x41, 0x93, 0xff, 0x3d, 0xf8, 0x96, 0x91, 0xd3, 0x1b, 0x8c, 0xfa, 0xa3, 0xbb,
+	0xe2, 0xb8, 0x91, 0xd3, 0xeb, 0xde, 0xe8, 0x19, 0x48, 0x18, 0x9d, 0x9d, 0xdb, 0x1d, 0x43, 0x11,
+	0x7b, 0x67, 0xb0, 0x7e, 0xea, 0x6d, 0x62, 0x24, 0x76, 0xb7, 0x9d, 0xfe, 0xe0, 0xce, 0xf6, 0x4e,
+	0xbf, 0x6b, 0x14, 0x84, 0x3d, 0xb8, 0x3d, 0xb2, 0x7b, 0xdb, 0x5d, 0x03, 0x31, 0x16, 0xbb, 0xdb,
+	0x0e, 0x33, 0x6e, 0x0f, 0x76, 0xee, 0x1a, 0x0a, 0x36, 0xa0, 0x2a, 0x80, 0x4f, 0xed, 0xfe, 0xa8,
+	0x67, 0xac, 0x09, 0x64, 0xb8, 0xbb, 0xd3, 0x1f, 0x8d, 0xfa, 0x83, 0x1b, 0x86, 0x9a, 0x9e, 0xd6,
+	0xf9, 0xe8, 0xf0, 0xc8, 0x2c, 0xfc, 0x7e, 0x64, 0x16, 0x9e, 0x1c, 0x99, 0x85, 0x3f, 0x8f, 0xcc,
+	0xc2, 0x5f, 0x47, 0x26, 0xfa, 0x7c, 0x65, 0xa2, 0x1f, 0x56, 0x26, 0xfa, 0x79, 0x65, 0x16, 0x7e,
+	0x59, 0x99, 0x85, 0xc3, 0x95, 0x89, 0x1e, 0xaf, 0x4c, 0xf4, 0x64, 0x65, 0xa2, 0xaf, 0xff, 0x30,
+	0x0b, 0x37, 0xd1, 0xbd, 0x12, 0xfb, 0xe3, 0x1b, 0x8e, 0xc7, 0x25, 0xfe, 0x67, 0xf6, 0xbd, 0xbf,
+	0x07, 0x00, 0xf1, 0x8b, 0xc6, 0x70, 0x09, 0x0b, 0x00, 0x00,
 }
@@ -16,6 +16,8 @@
 		ChangeReplicaResponse
 		ChangeLeaderRequest
 		ChangeLeaderResponse
+		ReloadDictsRequest
+		ReloadDictsResponse
 */
 package pspb
 
@@ -32,6 +34,7 @@ import grpc "google.golang.org/grpc"
 
 import strings "strings"
 import reflect "reflect"
+import sortkeys "github.com/gogo/protobuf/sortkeys"
 
 import io "io"
 
@@ -137,6 +140,29 @@ func (m *ChangeLeaderResponse) Reset()                    { *m = ChangeLeaderRes
 func (*ChangeLeaderResponse) ProtoMessage()               {}
 func (*ChangeLeaderResponse) Descriptor() ([]byte, []int) { return fileDescriptorAdmin, []int{7} }
 
+type ReloadDictsRequest struct {
+	meta.RequestHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	// the reloadable components, like the chinese tokenizers, all the components if empty
+	Names []string `protobuf:"bytes,2,rep,name=names" json:"names,omitempty"`
+}
+
+func (m *ReloadDictsRequest) Reset()                    { *m = ReloadDictsRequest{} }
+func (*ReloadDictsRequest) ProtoMessage()               {}
+func (*ReloadDictsRequest) Descriptor() ([]byte, []int) { return fileDescriptorAdmin, []int{8} }
+
+type ReloadDictsResponse struct {
+	meta.ResponseHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	NodeID              github_com_tiglabs_baudengine_proto_metapb.NodeID `protobuf:"varint,2,opt,name=node_id,json=nodeId,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.NodeID" json:"node_id,omitempty"`
+	// the dict versions of the components on the node after the reloading
+	Versions map[string]uint64 `protobuf:"bytes,3,rep,name=versions" json:"versions,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// the errors of the components failed to load their dicts, the dicts loaded before are kept
+	Errors map[string]string `protobuf:"bytes,4,rep,name=errors" json:"errors,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *ReloadDictsResponse) Reset()                    { *m = ReloadDictsResponse{} }
+func (*ReloadDictsResponse) ProtoMessage()               {}
+func (*ReloadDictsResponse) Descriptor() ([]byte, []int) { return fileDescriptorAdmin, []int{9} }
+
 func init() {
 	proto.RegisterType((*CreatePartitionRequest)(nil), "CreatePartitionRequest")
 	proto.RegisterType((*CreatePartitionResponse)(nil), "CreatePartitionResponse")
@@ -146,6 +172,8 @@ func init() {
 	proto.RegisterType((*ChangeReplicaResponse)(nil), "ChangeReplicaResponse")
 	proto.RegisterType((*ChangeLeaderRequest)(nil), "ChangeLeaderRequest")
 	proto.RegisterType((*ChangeLeaderResponse)(nil), "ChangeLeaderResponse")
+	proto.RegisterType((*ReloadDictsRequest)(nil), "ReloadDictsRequest")
+	proto.RegisterType((*ReloadDictsResponse)(nil), "ReloadDictsResponse")
 	proto.RegisterEnum("ReplicaChangeType", ReplicaChangeType_name, ReplicaChangeType_value)
 }
 func (this *CreatePartitionRequest) Equal(that interface{}) bool {
@@ -358,6 +386,81 @@ func (this *ChangeLeaderResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ReloadDictsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ReloadDictsRequest)
+	if !ok {
+		that2, ok := that.(ReloadDictsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.RequestHeader.Equal(&that1.RequestHeader) {
+		return false
+	}
+	if len(this.Names) != len(that1.Names) {
+		return false
+	}
+	for i := range this.Names {
+		if this.Names[i] != that1.Names[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *ReloadDictsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ReloadDictsResponse)
+	if !ok {
+		that2, ok := that.(ReloadDictsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ResponseHeader.Equal(&that1.ResponseHeader) {
+		return false
+	}
+	if this.NodeID != that1.NodeID {
+		return false
+	}
+	if len(this.Versions) != len(that1.Versions) {
+		return false
+	}
+	for i := range this.Versions {
+		if this.Versions[i] != that1.Versions[i] {
+			return false
+		}
+	}
+	if len(this.Errors) != len(that1.Errors) {
+		return false
+	}
+	for i := range this.Errors {
+		if this.Errors[i] != that1.Errors[i] {
+			return false
+		}
+	}
+	return true
+}
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
@@ -374,6 +477,7 @@ type AdminGrpcClient interface {
 	DeletePartition(ctx context.Context, in *DeletePartitionRequest, opts ...grpc.CallOption) (*DeletePartitionResponse, error)
 	ChangeReplica(ctx context.Context, in *ChangeReplicaRequest, opts ...grpc.CallOption) (*ChangeReplicaResponse, error)
 	ChangeLeader(ctx context.Context, in *ChangeLeaderRequest, opts ...grpc.CallOption) (*ChangeLeaderResponse, error)
+	ReloadDicts(ctx context.Context, in *ReloadDictsRequest, opts ...grpc.CallOption) (*ReloadDictsResponse, error)
 }
 
 type adminGrpcClient struct {
@@ -420,6 +524,15 @@ func (c *adminGrpcClient) ChangeLeader(ctx context.Context, in *ChangeLeaderRequ
 	return out, nil
 }
 
+func (c *adminGrpcClient) ReloadDicts(ctx context.Context, in *ReloadDictsRequest, opts ...grpc.CallOption) (*ReloadDictsResponse, error) {
+	out := new(ReloadDictsResponse)
+	err := grpc.Invoke(ctx, "/AdminGrpc/ReloadDicts", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for AdminGrpc service
 
 type AdminGrpcServer interface {
@@ -427,6 +540,7 @@ type AdminGrpcServer interface {
 	DeletePartition(context.Context, *DeletePartitionRequest) (*DeletePartitionResponse, error)
 	ChangeReplica(context.Context, *ChangeReplicaRequest) (*ChangeReplicaResponse, error)
 	ChangeLeader(context.Context, *ChangeLeaderRequest) (*ChangeLeaderResponse, error)
+	ReloadDicts(context.Context, *ReloadDictsRequest) (*ReloadDictsResponse, error)
 }
 
 func RegisterAdminGrpcServer(s *grpc.Server, srv AdminGrpcServer) {
@@ -505,6 +619,24 @@ func _AdminGrpc_ChangeLeader_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminGrpc_ReloadDicts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReloadDictsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminGrpcServer).ReloadDicts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AdminGrpc/ReloadDicts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminGrpcServer).ReloadDicts(ctx, req.(*ReloadDictsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _AdminGrpc_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "AdminGrpc",
 	HandlerType: (*AdminGrpcServer)(nil),
@@ -525,6 +657,10 @@ var _AdminGrpc_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ChangeLeader",
 			Handler:    _AdminGrpc_ChangeLeader_Handler,
 		},
+		{
+			MethodName: "ReloadDicts",
+			Handler:    _AdminGrpc_ReloadDicts_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "admin.proto",
@@ -774,6 +910,111 @@ func (m *ChangeLeaderResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *ReloadDictsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReloadDictsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintAdmin(dAtA, i, uint64(m.RequestHeader.Size()))
+	n11, err := m.RequestHeader.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n11
+	if len(m.Names) > 0 {
+		for _, s := range m.Names {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func (m *ReloadDictsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReloadDictsResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintAdmin(dAtA, i, uint64(m.ResponseHeader.Size()))
+	n12, err := m.ResponseHeader.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n12
+	if m.NodeID != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintAdmin(dAtA, i, uint64(m.NodeID))
+	}
+	if len(m.Versions) > 0 {
+		for k, _ := range m.Versions {
+			dAtA[i] = 0x1a
+			i++
+			v := m.Versions[k]
+			mapSize := 1 + len(k) + sovAdmin(uint64(len(k))) + 1 + sovAdmin(uint64(v))
+			i = encodeVarintAdmin(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintAdmin(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x10
+			i++
+			i = encodeVarintAdmin(dAtA, i, uint64(v))
+		}
+	}
+	if len(m.Errors) > 0 {
+		for k, _ := range m.Errors {
+			dAtA[i] = 0x22
+			i++
+			v := m.Errors[k]
+			mapSize := 1 + len(k) + sovAdmin(uint64(len(k))) + 1 + len(v) + sovAdmin(uint64(len(v)))
+			i = encodeVarintAdmin(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintAdmin(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintAdmin(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	return i, nil
+}
+
 func encodeVarintAdmin(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -863,6 +1104,45 @@ func NewPopulatedChangeLeaderResponse(r randyAdmin, easy bool) *ChangeLeaderResp
 	return this
 }
 
+func NewPopulatedReloadDictsRequest(r randyAdmin, easy bool) *ReloadDictsRequest {
+	this := &ReloadDictsRequest{}
+	v11 := meta.NewPopulatedRequestHeader(r, easy)
+	this.RequestHeader = *v11
+	v12 := r.Intn(10)
+	this.Names = make([]string, v12)
+	for i := 0; i < v12; i++ {
+		this.Names[i] = string(randStringAdmin(r))
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedReloadDictsResponse(r randyAdmin, easy bool) *ReloadDictsResponse {
+	this := &ReloadDictsResponse{}
+	v13 := meta.NewPopulatedResponseHeader(r, easy)
+	this.ResponseHeader = *v13
+	this.NodeID = github_com_tiglabs_baudengine_proto_metapb.NodeID(r.Uint32())
+	if r.Intn(10) != 0 {
+		v14 := r.Intn(10)
+		this.Versions = make(map[string]uint64)
+		for i := 0; i < v14; i++ {
+			v15 := randStringAdmin(r)
+			this.Versions[v15] = uint64(uint64(r.Uint32()))
+		}
+	}
+	if r.Intn(10) != 0 {
+		v16 := r.Intn(10)
+		this.Errors = make(map[string]string)
+		for i := 0; i < v16; i++ {
+			this.Errors[randStringAdmin(r)] = randStringAdmin(r)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
 type randyAdmin interface {
 	Float32() float32
 	Float64() float64
@@ -882,9 +1162,9 @@ func randUTF8RuneAdmin(r randyAdmin) rune {
 	return rune(ru + 61)
 }
 func randStringAdmin(r randyAdmin) string {
-	v11 := r.Intn(100)
-	tmps := make([]rune, v11)
-	for i := 0; i < v11; i++ {
+	v17 := r.Intn(100)
+	tmps := make([]rune, v17)
+	for i := 0; i < v17; i++ {
 		tmps[i] = randUTF8RuneAdmin(r)
 	}
 	return string(tmps)
@@ -906,11 +1186,11 @@ func randFieldAdmin(dAtA []byte, r randyAdmin, fieldNumber int, wire int) []byte
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateAdmin(dAtA, uint64(key))
-		v12 := r.Int63()
+		v18 := r.Int63()
 		if r.Intn(2) == 0 {
-			v12 *= -1
+			v18 *= -1
 		}
-		dAtA = encodeVarintPopulateAdmin(dAtA, uint64(v12))
+		dAtA = encodeVarintPopulateAdmin(dAtA, uint64(v18))
 	case 1:
 		dAtA = encodeVarintPopulateAdmin(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -1015,6 +1295,47 @@ func (m *ChangeLeaderResponse) Size() (n int) {
 	return n
 }
 
+func (m *ReloadDictsRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = m.RequestHeader.Size()
+	n += 1 + l + sovAdmin(uint64(l))
+	if len(m.Names) > 0 {
+		for _, s := range m.Names {
+			l = len(s)
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ReloadDictsResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = m.ResponseHeader.Size()
+	n += 1 + l + sovAdmin(uint64(l))
+	if m.NodeID != 0 {
+		n += 1 + sovAdmin(uint64(m.NodeID))
+	}
+	if len(m.Versions) > 0 {
+		for k, v := range m.Versions {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovAdmin(uint64(len(k))) + 1 + sovAdmin(uint64(v))
+			n += mapEntrySize + 1 + sovAdmin(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Errors) > 0 {
+		for k, v := range m.Errors {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovAdmin(uint64(len(k))) + 1 + len(v) + sovAdmin(uint64(len(v)))
+			n += mapEntrySize + 1 + sovAdmin(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
 func sovAdmin(x uint64) (n int) {
 	for {
 		n++
@@ -1114,6 +1435,50 @@ func (this *ChangeLeaderResponse) String() string {
 	}, "")
 	return s
 }
+func (this *ReloadDictsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReloadDictsRequest{`,
+		`RequestHeader:` + strings.Replace(strings.Replace(this.RequestHeader.String(), "RequestHeader", "meta.RequestHeader", 1), `&`, ``, 1) + `,`,
+		`Names:` + fmt.Sprintf("%v", this.Names) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReloadDictsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForVersions := make([]string, 0, len(this.Versions))
+	for k, _ := range this.Versions {
+		keysForVersions = append(keysForVersions, k)
+	}
+	sortkeys.Strings(keysForVersions)
+	mapStringForVersions := "map[string]uint64{"
+	for _, k := range keysForVersions {
+		mapStringForVersions += fmt.Sprintf("%v: %v,", k, this.Versions[k])
+	}
+	mapStringForVersions += "}"
+	keysForErrors := make([]string, 0, len(this.Errors))
+	for k, _ := range this.Errors {
+		keysForErrors = append(keysForErrors, k)
+	}
+	sortkeys.Strings(keysForErrors)
+	mapStringForErrors := "map[string]string{"
+	for _, k := range keysForErrors {
+		mapStringForErrors += fmt.Sprintf("%v: %v,", k, this.Errors[k])
+	}
+	mapStringForErrors += "}"
+	s := strings.Join([]string{`&ReloadDictsResponse{`,
+		`ResponseHeader:` + strings.Replace(strings.Replace(this.ResponseHeader.String(), "ResponseHeader", "meta.ResponseHeader", 1), `&`, ``, 1) + `,`,
+		`NodeID:` + fmt.Sprintf("%v", this.NodeID) + `,`,
+		`Versions:` + mapStringForVersions + `,`,
+		`Errors:` + mapStringForErrors + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringAdmin(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -1898,6 +2263,439 @@ func (m *ChangeLeaderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ReloadDictsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReloadDictsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReloadDictsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RequestHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Names", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Names = append(m.Names, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReloadDictsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReloadDictsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReloadDictsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ResponseHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
+			}
+			m.NodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NodeID |= (github_com_tiglabs_baudengine_proto_metapb.NodeID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Versions == nil {
+				m.Versions = make(map[string]uint64)
+			}
+			var mapkey string
+			var mapvalue uint64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAdmin
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAdmin
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthAdmin
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAdmin
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipAdmin(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthAdmin
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Versions[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Errors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Errors == nil {
+				m.Errors = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAdmin
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAdmin
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthAdmin
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAdmin
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthAdmin
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipAdmin(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthAdmin
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Errors[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipAdmin(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2006,41 +2804,51 @@ var (
 func init() { proto.RegisterFile("admin.proto", fileDescriptorAdmin) }
 
 var fileDescriptorAdmin = []byte{
-	// 564 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x3f, 0x6f, 0xd3, 0x40,
-	0x14, 0xbf, 0x73, 0xa3, 0xb4, 0x7d, 0xe9, 0x3f, 0x8e, 0x26, 0xb1, 0x32, 0x5c, 0x90, 0x07, 0x14,
-	0x21, 0x71, 0x81, 0x20, 0x98, 0x40, 0x6a, 0xd3, 0x08, 0x1a, 0xd4, 0xa1, 0xb2, 0x98, 0x58, 0x90,
-	0x1d, 0x1f, 0xce, 0x49, 0x89, 0x7d, 0x38, 0x0e, 0x52, 0x99, 0x18, 0x19, 0xf9, 0x00, 0xfd, 0x00,
-	0x7c, 0x01, 0x24, 0x46, 0xc6, 0x8c, 0x1d, 0x99, 0xa2, 0xc6, 0x7c, 0x01, 0x46, 0xc4, 0x84, 0x72,
-	0x89, 0xd3, 0x90, 0x38, 0x12, 0x32, 0x74, 0xb2, 0xef, 0xde, 0x7b, 0xbf, 0x7b, 0xbf, 0xdf, 0xbd,
-	0xdf, 0x41, 0xce, 0x72, 0xba, 0xc2, 0x63, 0x32, 0xf0, 0x43, 0xbf, 0x74, 0xd7, 0x15, 0x61, 0xbb,
-	0x6f, 0xb3, 0x96, 0xdf, 0xad, 0xba, 0xbe, 0xeb, 0x57, 0xd5, 0xb6, 0xdd, 0x7f, 0xad, 0x56, 0x6a,
-	0xa1, 0xfe, 0xa6, 0xe9, 0x0f, 0xe7, 0xd2, 0x43, 0xe1, 0x76, 0x2c, 0xbb, 0x57, 0xb5, 0xad, 0xbe,
-	0xc3, 0x3d, 0x57, 0x78, 0x7c, 0x52, 0x5c, 0xed, 0xf2, 0xd0, 0x92, 0xb6, 0xfa, 0x4c, 0xca, 0x8c,
-	0x77, 0x50, 0x38, 0x0a, 0xb8, 0x15, 0xf2, 0x53, 0x2b, 0x08, 0x45, 0x28, 0x7c, 0xcf, 0xe4, 0x6f,
-	0xfa, 0xbc, 0x17, 0x92, 0x7b, 0x90, 0x6d, 0x73, 0xcb, 0xe1, 0x81, 0x8e, 0x6f, 0xe1, 0x4a, 0xae,
-	0xb6, 0xc3, 0xa6, 0x91, 0x63, 0xb5, 0x5b, 0xdf, 0x18, 0x0c, 0xcb, 0xe8, 0x62, 0x58, 0xc6, 0xe6,
-	0x34, 0x8f, 0x30, 0xd8, 0x94, 0x31, 0x8a, 0xae, 0xa9, 0x22, 0x60, 0x33, 0xdc, 0x7a, 0x66, 0x5c,
-	0x60, 0x5e, 0xa5, 0x18, 0x27, 0x50, 0x5c, 0x3a, 0xbb, 0x27, 0x7d, 0xaf, 0xc7, 0xc9, 0xfd, 0x85,
-	0xc3, 0x77, 0x59, 0x1c, 0x5a, 0x75, 0xba, 0x71, 0x8e, 0xa1, 0xd0, 0xe0, 0x1d, 0xfe, 0x5f, 0xa8,
-	0x9c, 0x82, 0x26, 0x1c, 0xc5, 0x61, 0xbb, 0x7e, 0x10, 0x0d, 0xcb, 0x5a, 0xb3, 0xf1, 0x6b, 0x58,
-	0x7e, 0xf4, 0xf7, 0x1a, 0x5f, 0xf1, 0x6e, 0x36, 0x4c, 0x4d, 0x38, 0x63, 0xb2, 0x4b, 0xdd, 0xa5,
-	0x27, 0xfb, 0x41, 0x83, 0xfd, 0xa3, 0xb6, 0xe5, 0xb9, 0xdc, 0xe4, 0xb2, 0x23, 0x5a, 0x56, 0x7a,
-	0xaa, 0xb7, 0x21, 0x13, 0x9e, 0x49, 0xae, 0xc8, 0xee, 0xd4, 0x08, 0x9b, 0x02, 0x4e, 0xd0, 0x5f,
-	0x9c, 0x49, 0x6e, 0xaa, 0x38, 0xe9, 0xc0, 0xd6, 0xec, 0xea, 0x5e, 0x09, 0x47, 0x5f, 0x53, 0xe2,
-	0x34, 0xa3, 0x61, 0x39, 0x37, 0xc7, 0xf5, 0x1f, 0x54, 0xca, 0xcd, 0xe0, 0x9b, 0x0e, 0xa9, 0xc0,
-	0x7a, 0x30, 0x69, 0x44, 0xcf, 0x28, 0x22, 0x1b, 0x71, 0x63, 0xd3, 0x39, 0x8a, 0xc3, 0xc6, 0x73,
-	0xc8, 0x2f, 0x28, 0x91, 0x5e, 0xd6, 0xcf, 0x18, 0x6e, 0x4e, 0xc0, 0x4e, 0xd4, 0x46, 0x7a, 0x55,
-	0x17, 0xd5, 0xd2, 0xae, 0x53, 0x2d, 0xa3, 0x19, 0x4f, 0x43, 0xdc, 0x76, 0x6a, 0x09, 0xee, 0x54,
-	0xe0, 0xc6, 0xd2, 0x04, 0x90, 0x75, 0x58, 0x3b, 0x74, 0x9c, 0x3d, 0x44, 0x00, 0xb2, 0x26, 0xef,
-	0xfa, 0x6f, 0xf9, 0x1e, 0xae, 0x9d, 0x6b, 0xb0, 0x79, 0x38, 0x7e, 0xb0, 0x9e, 0x05, 0xb2, 0x45,
-	0x9e, 0xc2, 0xee, 0x82, 0x99, 0x49, 0x91, 0x25, 0x3f, 0x2d, 0x25, 0x9d, 0xad, 0xf0, 0xbd, 0x81,
-	0xc6, 0x38, 0x0b, 0x3e, 0x21, 0x45, 0x96, 0xec, 0xeb, 0x92, 0xce, 0x56, 0x58, 0xca, 0x40, 0xe4,
-	0x00, 0xb6, 0xff, 0x18, 0x0b, 0x92, 0x67, 0x49, 0x86, 0x29, 0x15, 0x58, 0xe2, 0xf4, 0x18, 0x88,
-	0x3c, 0x81, 0xad, 0x79, 0x51, 0xc9, 0x3e, 0x4b, 0x18, 0x8d, 0x52, 0x9e, 0x25, 0x29, 0x6f, 0xa0,
-	0xfa, 0xe3, 0xc1, 0x88, 0xa2, 0x6f, 0x23, 0x8a, 0x2e, 0x47, 0x14, 0xfd, 0x18, 0x51, 0xf4, 0x73,
-	0x44, 0xf1, 0xfb, 0x88, 0xe2, 0x4f, 0x11, 0xc5, 0x5f, 0x22, 0x8a, 0xbe, 0x46, 0x14, 0x0d, 0x22,
-	0x8a, 0x2f, 0x22, 0x8a, 0x2f, 0x23, 0x8a, 0x3f, 0x7e, 0xa7, 0xe8, 0x18, 0xbf, 0xcc, 0xc8, 0x9e,
-	0xb4, 0xed, 0xac, 0xba, 0xf8, 0x07, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x90, 0xbb, 0x57, 0xcc,
-	0x13, 0x06, 0x00, 0x00,
+	// 734 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcd, 0x6e, 0xd3, 0x40,
+	0x18, 0xf4, 0x3a, 0x21, 0x6d, 0xbe, 0xf4, 0x8f, 0x6d, 0xda, 0x5a, 0x3e, 0x38, 0x91, 0x0f, 0x28,
+	0x42, 0x62, 0x43, 0x83, 0x40, 0xa5, 0x08, 0x68, 0xd3, 0x14, 0x1a, 0x54, 0xa1, 0xca, 0x42, 0x08,
+	0x21, 0x24, 0x64, 0xc7, 0x4b, 0x6a, 0x91, 0xd8, 0xc6, 0x76, 0x2a, 0x85, 0x13, 0x47, 0x1e, 0x80,
+	0x23, 0x0f, 0xc0, 0x0b, 0x20, 0x71, 0xe4, 0xd8, 0x63, 0x8f, 0x3d, 0x45, 0x8d, 0x9f, 0x80, 0x23,
+	0xe2, 0x84, 0xbc, 0x76, 0xd2, 0xfc, 0xb8, 0x02, 0x0c, 0x9c, 0xec, 0xdd, 0xfd, 0x66, 0xf6, 0x9b,
+	0xf1, 0xee, 0x18, 0x72, 0xaa, 0xde, 0x36, 0x4c, 0x62, 0x3b, 0x96, 0x67, 0x89, 0xd7, 0x9a, 0x86,
+	0x77, 0xd8, 0xd1, 0x48, 0xc3, 0x6a, 0x97, 0x9b, 0x56, 0xd3, 0x2a, 0xb3, 0x69, 0xad, 0xf3, 0x8a,
+	0x8d, 0xd8, 0x80, 0xbd, 0x45, 0xe5, 0x37, 0x47, 0xca, 0x3d, 0xa3, 0xd9, 0x52, 0x35, 0xb7, 0xac,
+	0xa9, 0x1d, 0x9d, 0x9a, 0x4d, 0xc3, 0xa4, 0x21, 0xb8, 0xdc, 0xa6, 0x9e, 0x6a, 0x6b, 0xec, 0x11,
+	0xc2, 0xe4, 0xb7, 0xb0, 0xba, 0xe3, 0x50, 0xd5, 0xa3, 0x07, 0xaa, 0xe3, 0x19, 0x9e, 0x61, 0x99,
+	0x0a, 0x7d, 0xd3, 0xa1, 0xae, 0x87, 0xaf, 0x43, 0xe6, 0x90, 0xaa, 0x3a, 0x75, 0x04, 0x54, 0x44,
+	0xa5, 0x5c, 0x65, 0x81, 0x44, 0x2b, 0x7b, 0x6c, 0xb6, 0x3a, 0x7b, 0xdc, 0x2b, 0x70, 0x27, 0xbd,
+	0x02, 0x52, 0xa2, 0x3a, 0x4c, 0x20, 0x6b, 0x0f, 0x58, 0x04, 0x9e, 0x81, 0x80, 0x0c, 0x79, 0xab,
+	0xe9, 0x00, 0xa0, 0x9c, 0x97, 0xc8, 0xfb, 0xb0, 0x36, 0xb5, 0xb7, 0x6b, 0x5b, 0xa6, 0x4b, 0xf1,
+	0xfa, 0xc4, 0xe6, 0x8b, 0x64, 0xb0, 0x74, 0xd1, 0xee, 0xf2, 0x47, 0x04, 0xab, 0x35, 0xda, 0xa2,
+	0xff, 0x44, 0xca, 0x01, 0xf0, 0x86, 0xce, 0x34, 0xcc, 0x57, 0xb7, 0xfc, 0x5e, 0x81, 0xaf, 0xd7,
+	0x7e, 0xf4, 0x0a, 0xb7, 0x7e, 0xdf, 0xe3, 0x73, 0xdd, 0xf5, 0x9a, 0xc2, 0x1b, 0x7a, 0x20, 0x76,
+	0xaa, 0xbb, 0xe4, 0x62, 0xdf, 0xf3, 0x90, 0xdf, 0x39, 0x54, 0xcd, 0x26, 0x55, 0xa8, 0xdd, 0x32,
+	0x1a, 0x6a, 0x72, 0xa9, 0x57, 0x20, 0xed, 0x75, 0x6d, 0xca, 0xc4, 0x2e, 0x54, 0x30, 0x89, 0x08,
+	0x43, 0xf6, 0x27, 0x5d, 0x9b, 0x2a, 0x6c, 0x1d, 0xb7, 0x60, 0x6e, 0xf8, 0xe9, 0x5e, 0x1a, 0xba,
+	0x90, 0x62, 0xe6, 0xd4, 0xfd, 0x5e, 0x21, 0x37, 0xa2, 0xf5, 0x2f, 0x5c, 0xca, 0x0d, 0xe9, 0xeb,
+	0x3a, 0x2e, 0xc1, 0x8c, 0x13, 0x36, 0x22, 0xa4, 0x99, 0x90, 0xd9, 0x41, 0x63, 0xd1, 0x39, 0x1a,
+	0x2c, 0xcb, 0x8f, 0x60, 0x65, 0xc2, 0x89, 0xe4, 0xb6, 0x7e, 0x46, 0xb0, 0x1c, 0x92, 0xed, 0xb3,
+	0x89, 0xe4, 0xae, 0x4e, 0xba, 0xc5, 0xff, 0x4f, 0xb7, 0xe4, 0x3a, 0xe4, 0xc7, 0xdb, 0x4e, 0x6e,
+	0xc1, 0x0b, 0xc0, 0x0a, 0x6d, 0x59, 0xaa, 0x5e, 0x33, 0x1a, 0x9e, 0x9b, 0xdc, 0x80, 0x3c, 0x5c,
+	0x32, 0xd5, 0x36, 0x75, 0x05, 0xbe, 0x98, 0x2a, 0x65, 0x95, 0x70, 0x20, 0x7f, 0x48, 0xc1, 0xf2,
+	0x18, 0x7d, 0xe2, 0x46, 0xf1, 0x33, 0x98, 0x31, 0x2d, 0x9d, 0x9e, 0x9b, 0x7b, 0xdf, 0xef, 0x15,
+	0x32, 0x8f, 0x2d, 0x9d, 0x32, 0x5f, 0xd7, 0xff, 0xc0, 0xd7, 0x10, 0xa4, 0x64, 0x02, 0xbe, 0xba,
+	0x8e, 0xef, 0xc1, 0xec, 0x11, 0x75, 0x5c, 0xc3, 0x32, 0x5d, 0x21, 0x55, 0x4c, 0x95, 0x72, 0x15,
+	0x99, 0xc4, 0x34, 0x4d, 0x9e, 0x46, 0x45, 0xbb, 0xa6, 0xe7, 0x74, 0x95, 0x21, 0x06, 0x6f, 0x40,
+	0x86, 0x3a, 0x8e, 0xe5, 0xb8, 0x42, 0x9a, 0xa1, 0x8b, 0xb1, 0xe8, 0x5d, 0x56, 0x12, 0x62, 0xa3,
+	0x7a, 0xf1, 0x0e, 0xcc, 0x8f, 0x91, 0xe2, 0x25, 0x48, 0xbd, 0xa6, 0x5d, 0x66, 0x4a, 0x56, 0x09,
+	0x5e, 0x03, 0x5f, 0x8f, 0xd4, 0x56, 0x27, 0xbc, 0xaf, 0x69, 0x25, 0x1c, 0x6c, 0xf2, 0x1b, 0x48,
+	0xbc, 0x0d, 0xb9, 0x11, 0xce, 0x5f, 0x41, 0xb3, 0x23, 0xd0, 0xab, 0x25, 0xb8, 0x3c, 0x75, 0xed,
+	0xf1, 0x0c, 0xa4, 0xb6, 0x75, 0x7d, 0x89, 0xc3, 0x00, 0x19, 0x85, 0xb6, 0xad, 0x23, 0xba, 0x84,
+	0x2a, 0xa7, 0x3c, 0x64, 0xb7, 0x83, 0xbf, 0xd4, 0x43, 0xc7, 0x6e, 0xe0, 0x07, 0xb0, 0x38, 0x91,
+	0xe0, 0x78, 0x8d, 0xc4, 0xff, 0x4f, 0x44, 0x81, 0x5c, 0x10, 0xf6, 0x32, 0x17, 0xf0, 0x4c, 0x84,
+	0x23, 0x5e, 0x23, 0xf1, 0x61, 0x2e, 0x0a, 0xe4, 0x82, 0x1c, 0x95, 0x39, 0xbc, 0x05, 0xf3, 0x63,
+	0x59, 0x80, 0x57, 0x48, 0x5c, 0x4a, 0x8a, 0xab, 0x24, 0x36, 0x32, 0x64, 0x0e, 0xdf, 0x85, 0xb9,
+	0xd1, 0x9b, 0x84, 0xf3, 0x24, 0x26, 0x0f, 0xc4, 0x15, 0x12, 0x77, 0xdd, 0x64, 0x0e, 0x6f, 0x42,
+	0x6e, 0xe4, 0x5b, 0xe3, 0x65, 0x32, 0x7d, 0x97, 0xc4, 0x7c, 0xdc, 0x71, 0x90, 0xb9, 0xea, 0xc6,
+	0x71, 0x5f, 0xe2, 0x4e, 0xfb, 0x12, 0x77, 0xd6, 0x97, 0xb8, 0x6f, 0x7d, 0x89, 0xfb, 0xde, 0x97,
+	0xd0, 0x3b, 0x5f, 0x42, 0x9f, 0x7c, 0x09, 0x7d, 0xf1, 0x25, 0xee, 0xab, 0x2f, 0x71, 0xc7, 0xbe,
+	0x84, 0x4e, 0x7c, 0x09, 0x9d, 0xf9, 0x12, 0xda, 0x43, 0xcf, 0xd3, 0xb6, 0x6b, 0x6b, 0x5a, 0x86,
+	0x9d, 0xe6, 0x1b, 0x3f, 0x07, 0x00, 0xac, 0x54, 0xf0, 0xdd, 0x40, 0x08, 0x00, 0x00,
 }
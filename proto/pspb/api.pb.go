@@ -18,14 +18,79 @@
 		CreateRequest
 		CreateResponse
 		UpdateRequest
+		Script
 		UpdateResponse
 		DeleteRequest
 		DeleteResponse
 		Failure
+		SearchRequest
+		SearchResponse
+		SearchHit
+		InnerHits
+		InnerHit
+		Highlight
+		HighlightFragments
+		SearchStatisticsRequest
+		SearchStatisticsResponse
+		SearchStatistics
+		FieldStatistics
+		TermStatistics
+		AnalyzeRequest
+		AnalyzeResponse
+		AnalyzeToken
+		AnalyzeStage
+		TermVectorsRequest
+		TermVectorsResponse
+		FieldTermVectors
+		TermVector
+		TermPosition
+		ScrollRequest
+		ScrollResponse
+		ScrollDocument
+		ClearScrollRequest
+		ClearScrollResponse
+		ByQueryRequest
+		ByQueryResponse
+		ByQueryTaskRequest
+		ByQueryTaskResponse
+		ByQueryStatus
+		SortField
+		Query
+		TermQuery
+		TermsQuery
+		PhraseQuery
+		RangeQuery
+		PrefixQuery
+		WildcardQuery
+		RegexpQuery
+		FuzzyQuery
+		GeoPoint
+		GeoBoundingBoxQuery
+		GeoDistanceQuery
+		GeoPolygonQuery
+		MatchAllQuery
+		NestedQuery
+		BoolQuery
 		Document
+		NestedDocument
 		Field
 		FieldValue
 		FieldDesc
+		Aggregation
+		TermsAggregation
+		HistogramAggregation
+		DateHistogramAggregation
+		RangeAggregation
+		AggregationRange
+		MinAggregation
+		MaxAggregation
+		AvgAggregation
+		SumAggregation
+		StatsAggregation
+		CardinalityAggregation
+		AggregationResult
+		AggregationBucket
+		StatsResult
 */
 package pspb
 
@@ -42,6 +107,8 @@ import bytes "bytes"
 import context "golang.org/x/net/context"
 import grpc "google.golang.org/grpc"
 
+import binary "encoding/binary"
+
 import strings "strings"
 import reflect "reflect"
 import sortkeys "github.com/gogo/protobuf/sortkeys"
@@ -129,6 +196,8 @@ const (
 	ValueType_STRING  ValueType = 6
 	ValueType_TIME    ValueType = 7
 	ValueType_BLOB    ValueType = 8
+	// the float latitude and the float longitude of every point
+	ValueType_GEO ValueType = 9
 )
 
 var ValueType_name = map[int32]string{
@@ -141,6 +210,7 @@ var ValueType_name = map[int32]string{
 	6: "STRING",
 	7: "TIME",
 	8: "BLOB",
+	9: "GEO",
 }
 var ValueType_value = map[string]int32{
 	"UNKNOWN": 0,
@@ -152,6 +222,7 @@ var ValueType_value = map[string]int32{
 	"STRING":  6,
 	"TIME":    7,
 	"BLOB":    8,
+	"GEO":     9,
 }
 
 func (x ValueType) String() string {
@@ -223,6 +294,8 @@ type GetResponse struct {
 	Id                  github_com_tiglabs_baudengine_proto_metapb.Key `protobuf:"bytes,2,opt,name=id,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.Key" json:"id,omitempty"`
 	Found               bool                                           `protobuf:"varint,3,opt,name=found,proto3" json:"found,omitempty"`
 	Fields              map[uint32]FieldValue                          `protobuf:"bytes,4,rep,name=fields" json:"fields" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value"`
+	Version             uint64                                         `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
+	SeqNo               uint64                                         `protobuf:"varint,6,opt,name=seq_no,json=seqNo,proto3" json:"seq_no,omitempty"`
 }
 
 func (m *GetResponse) Reset()                    { *m = GetResponse{} }
@@ -272,6 +345,11 @@ func (*BulkItemResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi
 
 type CreateRequest struct {
 	Doc Document `protobuf:"bytes,1,opt,name=doc" json:"doc"`
+	// the JSON source document, it is mapped into the fields of doc by the mapping of the space
+	Source []byte `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	// the preconditions of the version and the sequence number of the document, 0 is no precondition
+	IfVersion uint64 `protobuf:"varint,3,opt,name=if_version,json=ifVersion,proto3" json:"if_version,omitempty"`
+	IfSeqNo   uint64 `protobuf:"varint,4,opt,name=if_seq_no,json=ifSeqNo,proto3" json:"if_seq_no,omitempty"`
 }
 
 func (m *CreateRequest) Reset()                    { *m = CreateRequest{} }
@@ -281,6 +359,9 @@ func (*CreateRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, [
 type CreateResponse struct {
 	Id     github_com_tiglabs_baudengine_proto_metapb.Key `protobuf:"bytes,1,opt,name=id,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.Key" json:"id,omitempty"`
 	Result WriteResult                                    `protobuf:"varint,2,opt,name=result,proto3,enum=WriteResult" json:"result,omitempty"`
+	// the version of the document increases on every write, the sequence number is the raft index of the last write
+	Version uint64 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	SeqNo   uint64 `protobuf:"varint,4,opt,name=seq_no,json=seqNo,proto3" json:"seq_no,omitempty"`
 }
 
 func (m *CreateResponse) Reset()                    { *m = CreateResponse{} }
@@ -290,56 +371,691 @@ func (*CreateResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi,
 type UpdateRequest struct {
 	Doc    Document `protobuf:"bytes,1,opt,name=doc" json:"doc"`
 	Upsert bool     `protobuf:"varint,2,opt,name=upsert,proto3" json:"upsert,omitempty"`
+	// the JSON source document, it is mapped into the fields of doc by the mapping of the space
+	Source []byte `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	// the partial JSON document merged into the fields of the document, a null value removes the field,
+	// only the changed fields are indexed again
+	Partial []byte `protobuf:"bytes,4,opt,name=partial,proto3" json:"partial,omitempty"`
+	// the script setting the fields of the document, evaluated when the raft log is applied
+	Script    *Script `protobuf:"bytes,5,opt,name=script" json:"script,omitempty"`
+	IfVersion uint64  `protobuf:"varint,6,opt,name=if_version,json=ifVersion,proto3" json:"if_version,omitempty"`
+	IfSeqNo   uint64  `protobuf:"varint,7,opt,name=if_seq_no,json=ifSeqNo,proto3" json:"if_seq_no,omitempty"`
+	// the version of the space mapping with the new fields of the partial document or the script, set by the leader
+	// before the proposal, the merge fails if the partition has not applied the version
+	MappingVersion uint64 `protobuf:"varint,8,opt,name=mapping_version,json=mappingVersion,proto3" json:"mapping_version,omitempty"`
 }
 
 func (m *UpdateRequest) Reset()                    { *m = UpdateRequest{} }
 func (*UpdateRequest) ProtoMessage()               {}
 func (*UpdateRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{9} }
 
+// Script is an update script, see kernel/script for the language
+type Script struct {
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// the JSON object of the parameters
+	Params []byte `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (m *Script) Reset()                    { *m = Script{} }
+func (*Script) ProtoMessage()               {}
+func (*Script) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{10} }
+
 type UpdateResponse struct {
-	Id     github_com_tiglabs_baudengine_proto_metapb.Key `protobuf:"bytes,1,opt,name=id,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.Key" json:"id,omitempty"`
-	Result WriteResult                                    `protobuf:"varint,2,opt,name=result,proto3,enum=WriteResult" json:"result,omitempty"`
+	Id      github_com_tiglabs_baudengine_proto_metapb.Key `protobuf:"bytes,1,opt,name=id,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.Key" json:"id,omitempty"`
+	Result  WriteResult                                    `protobuf:"varint,2,opt,name=result,proto3,enum=WriteResult" json:"result,omitempty"`
+	Version uint64                                         `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	SeqNo   uint64                                         `protobuf:"varint,4,opt,name=seq_no,json=seqNo,proto3" json:"seq_no,omitempty"`
 }
 
 func (m *UpdateResponse) Reset()                    { *m = UpdateResponse{} }
 func (*UpdateResponse) ProtoMessage()               {}
-func (*UpdateResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{10} }
+func (*UpdateResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{11} }
 
 type DeleteRequest struct {
-	Id github_com_tiglabs_baudengine_proto_metapb.Key `protobuf:"bytes,1,opt,name=id,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.Key" json:"id,omitempty"`
+	Id        github_com_tiglabs_baudengine_proto_metapb.Key `protobuf:"bytes,1,opt,name=id,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.Key" json:"id,omitempty"`
+	IfVersion uint64                                         `protobuf:"varint,2,opt,name=if_version,json=ifVersion,proto3" json:"if_version,omitempty"`
+	IfSeqNo   uint64                                         `protobuf:"varint,3,opt,name=if_seq_no,json=ifSeqNo,proto3" json:"if_seq_no,omitempty"`
+	// the document is deleted only if it is expired at the time in unix milliseconds, used by the expiration sweeper
+	IfExpiredAt int64 `protobuf:"varint,4,opt,name=if_expired_at,json=ifExpiredAt,proto3" json:"if_expired_at,omitempty"`
 }
 
 func (m *DeleteRequest) Reset()                    { *m = DeleteRequest{} }
 func (*DeleteRequest) ProtoMessage()               {}
-func (*DeleteRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{11} }
+func (*DeleteRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{12} }
 
 type DeleteResponse struct {
 	Id     github_com_tiglabs_baudengine_proto_metapb.Key `protobuf:"bytes,1,opt,name=id,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.Key" json:"id,omitempty"`
 	Result WriteResult                                    `protobuf:"varint,2,opt,name=result,proto3,enum=WriteResult" json:"result,omitempty"`
+	// the version of the deleted document
+	Version uint64 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	SeqNo   uint64 `protobuf:"varint,4,opt,name=seq_no,json=seqNo,proto3" json:"seq_no,omitempty"`
 }
 
 func (m *DeleteResponse) Reset()                    { *m = DeleteResponse{} }
 func (*DeleteResponse) ProtoMessage()               {}
-func (*DeleteResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{12} }
+func (*DeleteResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{13} }
 
 type Failure struct {
 	Id      github_com_tiglabs_baudengine_proto_metapb.Key `protobuf:"bytes,1,opt,name=id,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.Key" json:"id,omitempty"`
 	Cause   string                                         `protobuf:"bytes,2,opt,name=cause,proto3" json:"cause,omitempty"`
 	Aborted bool                                           `protobuf:"varint,3,opt,name=aborted,proto3" json:"aborted,omitempty"`
+	// the precondition of the version failed, the current version is 0 if the document is not found
+	VersionConflict bool   `protobuf:"varint,4,opt,name=version_conflict,json=versionConflict,proto3" json:"version_conflict,omitempty"`
+	CurrentVersion  uint64 `protobuf:"varint,5,opt,name=current_version,json=currentVersion,proto3" json:"current_version,omitempty"`
+	CurrentSeqNo    uint64 `protobuf:"varint,6,opt,name=current_seq_no,json=currentSeqNo,proto3" json:"current_seq_no,omitempty"`
 }
 
 func (m *Failure) Reset()                    { *m = Failure{} }
 func (*Failure) ProtoMessage()               {}
-func (*Failure) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{13} }
+func (*Failure) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{14} }
+
+type SearchRequest struct {
+	ActionRequestHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	Query               Query  `protobuf:"bytes,2,opt,name=query" json:"query"`
+	From                uint32 `protobuf:"varint,3,opt,name=from,proto3" json:"from,omitempty"`
+	// DefaultSearchSize of the kernel when size is 0
+	Size_ uint32      `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	Sort  []SortField `protobuf:"bytes,5,rep,name=sort" json:"sort"`
+	// stored fields returned with the hits, all the stored fields if empty
+	Fields []uint32 `protobuf:"varint,6,rep,packed,name=fields" json:"fields,omitempty"`
+	// similarity names of the fields, BM25 for the fields not set
+	Similarities map[uint32]string `protobuf:"bytes,7,rep,name=similarities" json:"similarities,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// statistics of all the partitions for consistent scores, the partition statistics are used if not set
+	Statistics *SearchStatistics `protobuf:"bytes,8,opt,name=statistics" json:"statistics,omitempty"`
+	// aggregations computed over all the matched documents by name
+	Aggregations map[string]Aggregation `protobuf:"bytes,9,rep,name=aggregations" json:"aggregations" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value"`
+	// highlight the hits if set
+	Highlight *Highlight `protobuf:"bytes,10,opt,name=highlight" json:"highlight,omitempty"`
+}
+
+func (m *SearchRequest) Reset()                    { *m = SearchRequest{} }
+func (*SearchRequest) ProtoMessage()               {}
+func (*SearchRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{15} }
+
+type SearchResponse struct {
+	meta.ResponseHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	Total               uint32      `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Hits                []SearchHit `protobuf:"bytes,3,rep,name=hits" json:"hits"`
+	// partial results of the aggregations of the partition, merged by the router
+	Aggregations map[string]AggregationResult `protobuf:"bytes,4,rep,name=aggregations" json:"aggregations" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (m *SearchResponse) Reset()                    { *m = SearchResponse{} }
+func (*SearchResponse) ProtoMessage()               {}
+func (*SearchResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{16} }
+
+type SearchHit struct {
+	Id     github_com_tiglabs_baudengine_proto_metapb.Key `protobuf:"bytes,1,opt,name=id,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.Key" json:"id,omitempty"`
+	Score  float64                                        `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	Fields map[uint32]FieldValue                          `protobuf:"bytes,3,rep,name=fields" json:"fields" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value"`
+	// highlighted fragments of the fields
+	Highlights map[uint32]HighlightFragments `protobuf:"bytes,4,rep,name=highlights" json:"highlights" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value"`
+	// the nested objects matched by the nested queries with inner hits, by the field ID of the nested field
+	InnerHits map[uint32]InnerHits `protobuf:"bytes,5,rep,name=inner_hits,json=innerHits" json:"inner_hits" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (m *SearchHit) Reset()                    { *m = SearchHit{} }
+func (*SearchHit) ProtoMessage()               {}
+func (*SearchHit) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{17} }
+
+type InnerHits struct {
+	Hits []InnerHit `protobuf:"bytes,1,rep,name=hits" json:"hits"`
+}
+
+func (m *InnerHits) Reset()                    { *m = InnerHits{} }
+func (*InnerHits) ProtoMessage()               {}
+func (*InnerHits) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{18} }
+
+// A nested object matched by a nested query, offset is the position of the object in the values of the nested field.
+type InnerHit struct {
+	Offset uint32                `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	Score  float64               `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	Fields map[uint32]FieldValue `protobuf:"bytes,3,rep,name=fields" json:"fields" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (m *InnerHit) Reset()                    { *m = InnerHit{} }
+func (*InnerHit) ProtoMessage()               {}
+func (*InnerHit) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{19} }
+
+// Highlights the terms of the query in the stored fields of the hits, the terms are read from
+// the indexed offsets, or the fields are analyzed again by the analyzers when indexed without offsets.
+type Highlight struct {
+	// highlighted fields, all the fields of the query if empty
+	Fields []uint32 `protobuf:"varint,1,rep,packed,name=fields" json:"fields,omitempty"`
+	// <em> and </em> when empty
+	PreTag  string `protobuf:"bytes,2,opt,name=pre_tag,json=preTag,proto3" json:"pre_tag,omitempty"`
+	PostTag string `protobuf:"bytes,3,opt,name=post_tag,json=postTag,proto3" json:"post_tag,omitempty"`
+	// 100 bytes when 0
+	FragmentSize uint32 `protobuf:"varint,4,opt,name=fragment_size,json=fragmentSize,proto3" json:"fragment_size,omitempty"`
+	// 5 when 0
+	NumberOfFragments uint32            `protobuf:"varint,5,opt,name=number_of_fragments,json=numberOfFragments,proto3" json:"number_of_fragments,omitempty"`
+	Analyzers         map[uint32]string `protobuf:"bytes,6,rep,name=analyzers" json:"analyzers,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *Highlight) Reset()                    { *m = Highlight{} }
+func (*Highlight) ProtoMessage()               {}
+func (*Highlight) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{20} }
+
+type HighlightFragments struct {
+	Fragments []string `protobuf:"bytes,1,rep,name=fragments" json:"fragments,omitempty"`
+}
+
+func (m *HighlightFragments) Reset()                    { *m = HighlightFragments{} }
+func (*HighlightFragments) ProtoMessage()               {}
+func (*HighlightFragments) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{21} }
+
+type SearchStatisticsRequest struct {
+	ActionRequestHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	Query               Query `protobuf:"bytes,2,opt,name=query" json:"query"`
+}
+
+func (m *SearchStatisticsRequest) Reset()                    { *m = SearchStatisticsRequest{} }
+func (*SearchStatisticsRequest) ProtoMessage()               {}
+func (*SearchStatisticsRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{22} }
+
+type SearchStatisticsResponse struct {
+	meta.ResponseHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	Statistics          SearchStatistics `protobuf:"bytes,2,opt,name=statistics" json:"statistics"`
+}
+
+func (m *SearchStatisticsResponse) Reset()                    { *m = SearchStatisticsResponse{} }
+func (*SearchStatisticsResponse) ProtoMessage()               {}
+func (*SearchStatisticsResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{23} }
+
+// The statistics of the fields and terms of a query used for scoring.
+type SearchStatistics struct {
+	Fields []FieldStatistics `protobuf:"bytes,1,rep,name=fields" json:"fields"`
+	Terms  []TermStatistics  `protobuf:"bytes,2,rep,name=terms" json:"terms"`
+}
+
+func (m *SearchStatistics) Reset()                    { *m = SearchStatistics{} }
+func (*SearchStatistics) ProtoMessage()               {}
+func (*SearchStatistics) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{24} }
+
+type FieldStatistics struct {
+	Field     uint32 `protobuf:"varint,1,opt,name=field,proto3" json:"field,omitempty"`
+	DocCount  int64  `protobuf:"varint,2,opt,name=doc_count,json=docCount,proto3" json:"doc_count,omitempty"`
+	SumLength int64  `protobuf:"varint,3,opt,name=sum_length,json=sumLength,proto3" json:"sum_length,omitempty"`
+}
+
+func (m *FieldStatistics) Reset()                    { *m = FieldStatistics{} }
+func (*FieldStatistics) ProtoMessage()               {}
+func (*FieldStatistics) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{25} }
+
+type TermStatistics struct {
+	Field   uint32 `protobuf:"varint,1,opt,name=field,proto3" json:"field,omitempty"`
+	Term    []byte `protobuf:"bytes,2,opt,name=term,proto3" json:"term,omitempty"`
+	DocFreq int64  `protobuf:"varint,3,opt,name=doc_freq,json=docFreq,proto3" json:"doc_freq,omitempty"`
+}
+
+func (m *TermStatistics) Reset()                    { *m = TermStatistics{} }
+func (*TermStatistics) ProtoMessage()               {}
+func (*TermStatistics) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{26} }
+
+// Analyzes the text by the analyzer of the name, or by the analyzer of the field in the space mapping.
+type AnalyzeRequest struct {
+	ActionRequestHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	Analyzer            string `protobuf:"bytes,2,opt,name=analyzer,proto3" json:"analyzer,omitempty"`
+	// the full path name of the field, used when the analyzer is empty
+	Field string `protobuf:"bytes,3,opt,name=field,proto3" json:"field,omitempty"`
+	Text  string `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
+	// explain the output of every component of a custom analyzer
+	Explain bool `protobuf:"varint,5,opt,name=explain,proto3" json:"explain,omitempty"`
+}
+
+func (m *AnalyzeRequest) Reset()                    { *m = AnalyzeRequest{} }
+func (*AnalyzeRequest) ProtoMessage()               {}
+func (*AnalyzeRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{27} }
+
+type AnalyzeResponse struct {
+	meta.ResponseHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	Analyzer            string         `protobuf:"bytes,2,opt,name=analyzer,proto3" json:"analyzer,omitempty"`
+	Tokens              []AnalyzeToken `protobuf:"bytes,3,rep,name=tokens" json:"tokens"`
+	Stages              []AnalyzeStage `protobuf:"bytes,4,rep,name=stages" json:"stages"`
+}
+
+func (m *AnalyzeResponse) Reset()                    { *m = AnalyzeResponse{} }
+func (*AnalyzeResponse) ProtoMessage()               {}
+func (*AnalyzeResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{28} }
+
+type AnalyzeToken struct {
+	Term     string `protobuf:"bytes,1,opt,name=term,proto3" json:"term,omitempty"`
+	Start    int32  `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	End      int32  `protobuf:"varint,3,opt,name=end,proto3" json:"end,omitempty"`
+	Position int32  `protobuf:"varint,4,opt,name=position,proto3" json:"position,omitempty"`
+	Type     string `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`
+}
+
+func (m *AnalyzeToken) Reset()                    { *m = AnalyzeToken{} }
+func (*AnalyzeToken) ProtoMessage()               {}
+func (*AnalyzeToken) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{29} }
+
+// The output of a component of a custom analyzer, the text of a char filter or the tokens of the others.
+type AnalyzeStage struct {
+	Name   string         `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Text   string         `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	Tokens []AnalyzeToken `protobuf:"bytes,3,rep,name=tokens" json:"tokens"`
+}
+
+func (m *AnalyzeStage) Reset()                    { *m = AnalyzeStage{} }
+func (*AnalyzeStage) ProtoMessage()               {}
+func (*AnalyzeStage) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{30} }
+
+type TermVectorsRequest struct {
+	ActionRequestHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	Id                  github_com_tiglabs_baudengine_proto_metapb.Key `protobuf:"bytes,2,opt,name=id,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.Key" json:"id,omitempty"`
+	// the indexed fields, all the indexed fields of the document if empty
+	Fields []uint32 `protobuf:"varint,3,rep,packed,name=fields" json:"fields,omitempty"`
+	// include the document frequency and the total term frequency of the terms in the partition
+	TermStatistics bool `protobuf:"varint,4,opt,name=term_statistics,json=termStatistics,proto3" json:"term_statistics,omitempty"`
+}
+
+func (m *TermVectorsRequest) Reset()                    { *m = TermVectorsRequest{} }
+func (*TermVectorsRequest) ProtoMessage()               {}
+func (*TermVectorsRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{31} }
+
+type TermVectorsResponse struct {
+	meta.ResponseHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	Id                  github_com_tiglabs_baudengine_proto_metapb.Key `protobuf:"bytes,2,opt,name=id,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.Key" json:"id,omitempty"`
+	Found               bool                                           `protobuf:"varint,3,opt,name=found,proto3" json:"found,omitempty"`
+	Fields              map[uint32]FieldTermVectors                    `protobuf:"bytes,4,rep,name=fields" json:"fields" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (m *TermVectorsResponse) Reset()                    { *m = TermVectorsResponse{} }
+func (*TermVectorsResponse) ProtoMessage()               {}
+func (*TermVectorsResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{32} }
+
+// The indexed terms of a field of the document in term order.
+type FieldTermVectors struct {
+	Terms []TermVector `protobuf:"bytes,1,rep,name=terms" json:"terms"`
+}
+
+func (m *FieldTermVectors) Reset()                    { *m = FieldTermVectors{} }
+func (*FieldTermVectors) ProtoMessage()               {}
+func (*FieldTermVectors) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{33} }
+
+type TermVector struct {
+	Term []byte `protobuf:"bytes,1,opt,name=term,proto3" json:"term,omitempty"`
+	Freq int32  `protobuf:"varint,2,opt,name=freq,proto3" json:"freq,omitempty"`
+	// empty if the field is indexed without positions, the offsets are 0 if it is indexed without offsets
+	Positions     []TermPosition `protobuf:"bytes,3,rep,name=positions" json:"positions"`
+	DocFreq       int64          `protobuf:"varint,4,opt,name=doc_freq,json=docFreq,proto3" json:"doc_freq,omitempty"`
+	TotalTermFreq int64          `protobuf:"varint,5,opt,name=total_term_freq,json=totalTermFreq,proto3" json:"total_term_freq,omitempty"`
+}
+
+func (m *TermVector) Reset()                    { *m = TermVector{} }
+func (*TermVector) ProtoMessage()               {}
+func (*TermVector) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{34} }
+
+type TermPosition struct {
+	Position int32 `protobuf:"varint,1,opt,name=position,proto3" json:"position,omitempty"`
+	Start    int32 `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	End      int32 `protobuf:"varint,3,opt,name=end,proto3" json:"end,omitempty"`
+}
+
+func (m *TermPosition) Reset()                    { *m = TermPosition{} }
+func (*TermPosition) ProtoMessage()               {}
+func (*TermPosition) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{35} }
+
+// ScrollRequest opens a scroll on a snapshot of the partition if scroll_id is empty, or returns the next page
+// of the scroll, the documents are in doc ID order.
+type ScrollRequest struct {
+	ActionRequestHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	ScrollId            string `protobuf:"bytes,2,opt,name=scroll_id,json=scrollId,proto3" json:"scroll_id,omitempty"`
+	// the number of the documents of a page
+	Size_ uint32 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	// the stored fields of the documents, all the stored fields if empty, only used when the scroll is opened
+	Fields []uint32 `protobuf:"varint,4,rep,packed,name=fields" json:"fields,omitempty"`
+	// the scroll is closed if it is idle longer than the keep alive, like "1m"
+	KeepAlive string `protobuf:"bytes,5,opt,name=keep_alive,json=keepAlive,proto3" json:"keep_alive,omitempty"`
+	// the last document of the previous page, the page starts after it, or from the first document if empty
+	After github_com_tiglabs_baudengine_proto_metapb.Key `protobuf:"bytes,6,opt,name=after,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.Key" json:"after,omitempty"`
+}
+
+func (m *ScrollRequest) Reset()                    { *m = ScrollRequest{} }
+func (*ScrollRequest) ProtoMessage()               {}
+func (*ScrollRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{36} }
+
+type ScrollResponse struct {
+	meta.ResponseHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	ScrollId            string           `protobuf:"bytes,2,opt,name=scroll_id,json=scrollId,proto3" json:"scroll_id,omitempty"`
+	Docs                []ScrollDocument `protobuf:"bytes,3,rep,name=docs" json:"docs"`
+	// no more document, the scroll is kept until it is cleared or expires
+	Done bool `protobuf:"varint,4,opt,name=done,proto3" json:"done,omitempty"`
+}
+
+func (m *ScrollResponse) Reset()                    { *m = ScrollResponse{} }
+func (*ScrollResponse) ProtoMessage()               {}
+func (*ScrollResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{37} }
+
+type ScrollDocument struct {
+	Id     github_com_tiglabs_baudengine_proto_metapb.Key `protobuf:"bytes,1,opt,name=id,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.Key" json:"id,omitempty"`
+	Fields map[uint32]FieldValue                          `protobuf:"bytes,2,rep,name=fields" json:"fields" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (m *ScrollDocument) Reset()                    { *m = ScrollDocument{} }
+func (*ScrollDocument) ProtoMessage()               {}
+func (*ScrollDocument) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{38} }
+
+type ClearScrollRequest struct {
+	ActionRequestHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	ScrollId            string `protobuf:"bytes,2,opt,name=scroll_id,json=scrollId,proto3" json:"scroll_id,omitempty"`
+}
+
+func (m *ClearScrollRequest) Reset()                    { *m = ClearScrollRequest{} }
+func (*ClearScrollRequest) ProtoMessage()               {}
+func (*ClearScrollRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{39} }
+
+type ClearScrollResponse struct {
+	meta.ResponseHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+}
+
+func (m *ClearScrollResponse) Reset()                    { *m = ClearScrollResponse{} }
+func (*ClearScrollResponse) ProtoMessage()               {}
+func (*ClearScrollResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{40} }
+
+// ByQueryRequest starts a background task on the leader deleting or updating the documents matched by the query
+// in a snapshot of the partition, the documents are written by raft in batches.
+type ByQueryRequest struct {
+	ActionRequestHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	Query               Query `protobuf:"bytes,2,opt,name=query" json:"query"`
+	// the partial JSON document or the script of the update by query
+	Partial []byte  `protobuf:"bytes,3,opt,name=partial,proto3" json:"partial,omitempty"`
+	Script  *Script `protobuf:"bytes,4,opt,name=script" json:"script,omitempty"`
+	// the number of the documents written by a raft command
+	BatchSize uint32 `protobuf:"varint,5,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	// the max number of the documents written per second, unlimited if 0
+	RequestsPerSecond float32 `protobuf:"fixed32,6,opt,name=requests_per_second,json=requestsPerSecond,proto3" json:"requests_per_second,omitempty"`
+	// the documents changed after the snapshot are counted as version conflicts,
+	// the task is aborted on the first version conflict unless proceed_on_conflicts
+	ProceedOnConflicts bool `protobuf:"varint,7,opt,name=proceed_on_conflicts,json=proceedOnConflicts,proto3" json:"proceed_on_conflicts,omitempty"`
+}
+
+func (m *ByQueryRequest) Reset()                    { *m = ByQueryRequest{} }
+func (*ByQueryRequest) ProtoMessage()               {}
+func (*ByQueryRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{41} }
+
+type ByQueryResponse struct {
+	meta.ResponseHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	TaskId              string `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+}
+
+func (m *ByQueryResponse) Reset()                    { *m = ByQueryResponse{} }
+func (*ByQueryResponse) ProtoMessage()               {}
+func (*ByQueryResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{42} }
+
+type ByQueryTaskRequest struct {
+	ActionRequestHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	TaskId              string `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+}
+
+func (m *ByQueryTaskRequest) Reset()                    { *m = ByQueryTaskRequest{} }
+func (*ByQueryTaskRequest) ProtoMessage()               {}
+func (*ByQueryTaskRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{43} }
+
+type ByQueryTaskResponse struct {
+	meta.ResponseHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	Status              ByQueryStatus `protobuf:"bytes,2,opt,name=status" json:"status"`
+}
+
+func (m *ByQueryTaskResponse) Reset()                    { *m = ByQueryTaskResponse{} }
+func (*ByQueryTaskResponse) ProtoMessage()               {}
+func (*ByQueryTaskResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{44} }
+
+// ByQueryStatus is the progress of a by query task
+type ByQueryStatus struct {
+	TaskId string `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Update bool   `protobuf:"varint,2,opt,name=update,proto3" json:"update,omitempty"`
+	// the number of the documents matched by the query
+	Total   uint64 `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	Deleted uint64 `protobuf:"varint,4,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	Updated uint64 `protobuf:"varint,5,opt,name=updated,proto3" json:"updated,omitempty"`
+	// the documents not changed by the update or deleted before
+	Noops            uint64 `protobuf:"varint,6,opt,name=noops,proto3" json:"noops,omitempty"`
+	VersionConflicts uint64 `protobuf:"varint,7,opt,name=version_conflicts,json=versionConflicts,proto3" json:"version_conflicts,omitempty"`
+	// the documents failed to write, the cause of the first failure is in error
+	Failures uint64 `protobuf:"varint,8,opt,name=failures,proto3" json:"failures,omitempty"`
+	Batches  uint64 `protobuf:"varint,9,opt,name=batches,proto3" json:"batches,omitempty"`
+	Done     bool   `protobuf:"varint,10,opt,name=done,proto3" json:"done,omitempty"`
+	Canceled bool   `protobuf:"varint,11,opt,name=canceled,proto3" json:"canceled,omitempty"`
+	Error    string `protobuf:"bytes,12,opt,name=error,proto3" json:"error,omitempty"`
+	// the start and the end time of the task in unix milliseconds
+	StartTime int64 `protobuf:"varint,13,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   int64 `protobuf:"varint,14,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+}
+
+func (m *ByQueryStatus) Reset()                    { *m = ByQueryStatus{} }
+func (*ByQueryStatus) ProtoMessage()               {}
+func (*ByQueryStatus) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{45} }
+
+type SortField struct {
+	// sort by the score when field is 0
+	Field   uint32 `protobuf:"varint,1,opt,name=field,proto3" json:"field,omitempty"`
+	Reverse bool   `protobuf:"varint,2,opt,name=reverse,proto3" json:"reverse,omitempty"`
+	// sort by the distance from the point to the nearest point of the geo field when set
+	GeoDistance *GeoPoint `protobuf:"bytes,3,opt,name=geo_distance,json=geoDistance" json:"geo_distance,omitempty"`
+}
+
+func (m *SortField) Reset()                    { *m = SortField{} }
+func (*SortField) ProtoMessage()               {}
+func (*SortField) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{46} }
+
+type Query struct {
+	Term           *TermQuery           `protobuf:"bytes,1,opt,name=term" json:"term,omitempty"`
+	Terms          *TermsQuery          `protobuf:"bytes,2,opt,name=terms" json:"terms,omitempty"`
+	MatchAll       *MatchAllQuery       `protobuf:"bytes,3,opt,name=match_all,json=matchAll" json:"match_all,omitempty"`
+	Bool           *BoolQuery           `protobuf:"bytes,4,opt,name=bool" json:"bool,omitempty"`
+	Phrase         *PhraseQuery         `protobuf:"bytes,5,opt,name=phrase" json:"phrase,omitempty"`
+	Range          *RangeQuery          `protobuf:"bytes,6,opt,name=range" json:"range,omitempty"`
+	Prefix         *PrefixQuery         `protobuf:"bytes,7,opt,name=prefix" json:"prefix,omitempty"`
+	Wildcard       *WildcardQuery       `protobuf:"bytes,8,opt,name=wildcard" json:"wildcard,omitempty"`
+	Regexp         *RegexpQuery         `protobuf:"bytes,9,opt,name=regexp" json:"regexp,omitempty"`
+	Fuzzy          *FuzzyQuery          `protobuf:"bytes,10,opt,name=fuzzy" json:"fuzzy,omitempty"`
+	GeoBoundingBox *GeoBoundingBoxQuery `protobuf:"bytes,11,opt,name=geo_bounding_box,json=geoBoundingBox" json:"geo_bounding_box,omitempty"`
+	GeoDistance    *GeoDistanceQuery    `protobuf:"bytes,12,opt,name=geo_distance,json=geoDistance" json:"geo_distance,omitempty"`
+	GeoPolygon     *GeoPolygonQuery     `protobuf:"bytes,13,opt,name=geo_polygon,json=geoPolygon" json:"geo_polygon,omitempty"`
+	Nested         *NestedQuery         `protobuf:"bytes,14,opt,name=nested" json:"nested,omitempty"`
+}
+
+func (m *Query) Reset()                    { *m = Query{} }
+func (*Query) ProtoMessage()               {}
+func (*Query) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{47} }
+
+// Matches the documents whose field contains the exact term.
+type TermQuery struct {
+	Field uint32 `protobuf:"varint,1,opt,name=field,proto3" json:"field,omitempty"`
+	Term  []byte `protobuf:"bytes,2,opt,name=term,proto3" json:"term,omitempty"`
+}
+
+func (m *TermQuery) Reset()                    { *m = TermQuery{} }
+func (*TermQuery) ProtoMessage()               {}
+func (*TermQuery) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{48} }
+
+// Matches the documents whose field contains any of the terms.
+type TermsQuery struct {
+	Field uint32   `protobuf:"varint,1,opt,name=field,proto3" json:"field,omitempty"`
+	Terms [][]byte `protobuf:"bytes,2,rep,name=terms" json:"terms,omitempty"`
+}
+
+func (m *TermsQuery) Reset()                    { *m = TermsQuery{} }
+func (*TermsQuery) ProtoMessage()               {}
+func (*TermsQuery) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{49} }
+
+// Matches the documents whose field contains the terms in order, the field must be indexed with positions.
+// Slop is the number of position moves allowed to match the terms.
+type PhraseQuery struct {
+	Field uint32   `protobuf:"varint,1,opt,name=field,proto3" json:"field,omitempty"`
+	Terms [][]byte `protobuf:"bytes,2,rep,name=terms" json:"terms,omitempty"`
+	Slop  uint32   `protobuf:"varint,3,opt,name=slop,proto3" json:"slop,omitempty"`
+}
+
+func (m *PhraseQuery) Reset()                    { *m = PhraseQuery{} }
+func (*PhraseQuery) ProtoMessage()               {}
+func (*PhraseQuery) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{50} }
+
+// Matches the documents whose field has a value in the range, the bound is not set when empty.
+// The bounds are encoded as the data of FieldValue and have the type of the field values.
+type RangeQuery struct {
+	Field uint32 `protobuf:"varint,1,opt,name=field,proto3" json:"field,omitempty"`
+	Gt    []byte `protobuf:"bytes,2,opt,name=gt,proto3" json:"gt,omitempty"`
+	Gte   []byte `protobuf:"bytes,3,opt,name=gte,proto3" json:"gte,omitempty"`
+	Lt    []byte `protobuf:"bytes,4,opt,name=lt,proto3" json:"lt,omitempty"`
+	Lte   []byte `protobuf:"bytes,5,opt,name=lte,proto3" json:"lte,omitempty"`
+}
+
+func (m *RangeQuery) Reset()                    { *m = RangeQuery{} }
+func (*RangeQuery) ProtoMessage()               {}
+func (*RangeQuery) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{51} }
+
+// Matches the documents whose field contains a term starting with the prefix.
+// The prefix, wildcard, regexp and fuzzy queries expand to max_expansions terms at most, 50 when 0.
+type PrefixQuery struct {
+	Field         uint32 `protobuf:"varint,1,opt,name=field,proto3" json:"field,omitempty"`
+	Prefix        []byte `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	MaxExpansions uint32 `protobuf:"varint,3,opt,name=max_expansions,json=maxExpansions,proto3" json:"max_expansions,omitempty"`
+}
+
+func (m *PrefixQuery) Reset()                    { *m = PrefixQuery{} }
+func (*PrefixQuery) ProtoMessage()               {}
+func (*PrefixQuery) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{52} }
+
+// Matches the documents whose field contains a term matching the pattern,
+// * matches any sequence of characters and ? matches any single character.
+type WildcardQuery struct {
+	Field         uint32 `protobuf:"varint,1,opt,name=field,proto3" json:"field,omitempty"`
+	Pattern       string `protobuf:"bytes,2,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	MaxExpansions uint32 `protobuf:"varint,3,opt,name=max_expansions,json=maxExpansions,proto3" json:"max_expansions,omitempty"`
+}
+
+func (m *WildcardQuery) Reset()                    { *m = WildcardQuery{} }
+func (*WildcardQuery) ProtoMessage()               {}
+func (*WildcardQuery) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{53} }
+
+// Matches the documents whose field contains a term matching the whole regular expression.
+type RegexpQuery struct {
+	Field         uint32 `protobuf:"varint,1,opt,name=field,proto3" json:"field,omitempty"`
+	Pattern       string `protobuf:"bytes,2,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	MaxExpansions uint32 `protobuf:"varint,3,opt,name=max_expansions,json=maxExpansions,proto3" json:"max_expansions,omitempty"`
+}
+
+func (m *RegexpQuery) Reset()                    { *m = RegexpQuery{} }
+func (*RegexpQuery) ProtoMessage()               {}
+func (*RegexpQuery) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{54} }
+
+// Matches the documents whose field contains a term within max_edits Levenshtein edits of the term,
+// the edits depend on the length of the term when 0. The first prefix_length characters must be the same.
+type FuzzyQuery struct {
+	Field         uint32 `protobuf:"varint,1,opt,name=field,proto3" json:"field,omitempty"`
+	Term          []byte `protobuf:"bytes,2,opt,name=term,proto3" json:"term,omitempty"`
+	MaxEdits      uint32 `protobuf:"varint,3,opt,name=max_edits,json=maxEdits,proto3" json:"max_edits,omitempty"`
+	PrefixLength  uint32 `protobuf:"varint,4,opt,name=prefix_length,json=prefixLength,proto3" json:"prefix_length,omitempty"`
+	MaxExpansions uint32 `protobuf:"varint,5,opt,name=max_expansions,json=maxExpansions,proto3" json:"max_expansions,omitempty"`
+}
+
+func (m *FuzzyQuery) Reset()                    { *m = FuzzyQuery{} }
+func (*FuzzyQuery) ProtoMessage()               {}
+func (*FuzzyQuery) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{55} }
+
+type GeoPoint struct {
+	Lat float64 `protobuf:"fixed64,1,opt,name=lat,proto3" json:"lat,omitempty"`
+	Lon float64 `protobuf:"fixed64,2,opt,name=lon,proto3" json:"lon,omitempty"`
+}
+
+func (m *GeoPoint) Reset()                    { *m = GeoPoint{} }
+func (*GeoPoint) ProtoMessage()               {}
+func (*GeoPoint) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{56} }
+
+// The box crosses the dateline when the left of top_left is greater than the right of bottom_right.
+type GeoBoundingBoxQuery struct {
+	Field       uint32    `protobuf:"varint,1,opt,name=field,proto3" json:"field,omitempty"`
+	TopLeft     *GeoPoint `protobuf:"bytes,2,opt,name=top_left,json=topLeft" json:"top_left,omitempty"`
+	BottomRight *GeoPoint `protobuf:"bytes,3,opt,name=bottom_right,json=bottomRight" json:"bottom_right,omitempty"`
+}
+
+func (m *GeoBoundingBoxQuery) Reset()                    { *m = GeoBoundingBoxQuery{} }
+func (*GeoBoundingBoxQuery) ProtoMessage()               {}
+func (*GeoBoundingBoxQuery) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{57} }
+
+type GeoDistanceQuery struct {
+	Field  uint32    `protobuf:"varint,1,opt,name=field,proto3" json:"field,omitempty"`
+	Origin *GeoPoint `protobuf:"bytes,2,opt,name=origin" json:"origin,omitempty"`
+	// in meters
+	Distance float64 `protobuf:"fixed64,3,opt,name=distance,proto3" json:"distance,omitempty"`
+}
+
+func (m *GeoDistanceQuery) Reset()                    { *m = GeoDistanceQuery{} }
+func (*GeoDistanceQuery) ProtoMessage()               {}
+func (*GeoDistanceQuery) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{58} }
+
+// The polygon is closed automatically, it has 3 points at least.
+type GeoPolygonQuery struct {
+	Field  uint32      `protobuf:"varint,1,opt,name=field,proto3" json:"field,omitempty"`
+	Points []*GeoPoint `protobuf:"bytes,2,rep,name=points" json:"points,omitempty"`
+}
+
+func (m *GeoPolygonQuery) Reset()                    { *m = GeoPolygonQuery{} }
+func (*GeoPolygonQuery) ProtoMessage()               {}
+func (*GeoPolygonQuery) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{59} }
+
+type MatchAllQuery struct {
+}
+
+func (m *MatchAllQuery) Reset()                    { *m = MatchAllQuery{} }
+func (*MatchAllQuery) ProtoMessage()               {}
+func (*MatchAllQuery) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{60} }
+
+// Matches the documents having an object of the nested field matched by the query,
+// the score mode is one of avg, max, min, sum and none, avg if not set.
+// The best inner_hits_size matched objects are returned with the hits when inner_hits is set.
+type NestedQuery struct {
+	Field         uint32 `protobuf:"varint,1,opt,name=field,proto3" json:"field,omitempty"`
+	Query         Query  `protobuf:"bytes,2,opt,name=query" json:"query"`
+	ScoreMode     string `protobuf:"bytes,3,opt,name=score_mode,json=scoreMode,proto3" json:"score_mode,omitempty"`
+	InnerHits     bool   `protobuf:"varint,4,opt,name=inner_hits,json=innerHits,proto3" json:"inner_hits,omitempty"`
+	InnerHitsSize uint32 `protobuf:"varint,5,opt,name=inner_hits_size,json=innerHitsSize,proto3" json:"inner_hits_size,omitempty"`
+}
+
+func (m *NestedQuery) Reset()                    { *m = NestedQuery{} }
+func (*NestedQuery) ProtoMessage()               {}
+func (*NestedQuery) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{61} }
+
+type BoolQuery struct {
+	Must      []Query `protobuf:"bytes,1,rep,name=must" json:"must"`
+	Should    []Query `protobuf:"bytes,2,rep,name=should" json:"should"`
+	MustNot   []Query `protobuf:"bytes,3,rep,name=must_not,json=mustNot" json:"must_not"`
+	MinShould uint32  `protobuf:"varint,4,opt,name=min_should,json=minShould,proto3" json:"min_should,omitempty"`
+}
+
+func (m *BoolQuery) Reset()                    { *m = BoolQuery{} }
+func (*BoolQuery) ProtoMessage()               {}
+func (*BoolQuery) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{62} }
 
 type Document struct {
 	Id     github_com_tiglabs_baudengine_proto_metapb.Key `protobuf:"bytes,1,opt,name=id,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.Key" json:"id,omitempty"`
 	Fields []Field                                        `protobuf:"bytes,2,rep,name=fields" json:"fields"`
+	// the objects of the nested fields of the document and of its nested objects,
+	// indexed as hidden documents next to the document
+	Nested []NestedDocument `protobuf:"bytes,3,rep,name=nested" json:"nested"`
+	// the expiration time of the document in unix milliseconds, 0 never expires
+	ExpireAt int64 `protobuf:"varint,4,opt,name=expire_at,json=expireAt,proto3" json:"expire_at,omitempty"`
 }
 
 func (m *Document) Reset()                    { *m = Document{} }
 func (*Document) ProtoMessage()               {}
-func (*Document) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{14} }
+func (*Document) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{63} }
+
+// An object of a nested field, offset is the position of the object in the values of the field.
+type NestedDocument struct {
+	// the document or the nested object the object belongs to
+	Parent github_com_tiglabs_baudengine_proto_metapb.Key `protobuf:"bytes,1,opt,name=parent,proto3,casttype=github.com/tiglabs/baudengine/proto/metapb.Key" json:"parent,omitempty"`
+	Field  uint32                                         `protobuf:"varint,2,opt,name=field,proto3" json:"field,omitempty"`
+	Offset uint32                                         `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Fields []Field                                        `protobuf:"bytes,4,rep,name=fields" json:"fields"`
+}
+
+func (m *NestedDocument) Reset()                    { *m = NestedDocument{} }
+func (*NestedDocument) ProtoMessage()               {}
+func (*NestedDocument) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{64} }
 
 type Field struct {
 	FieldValue `protobuf:"bytes,1,opt,name=value,embedded=value" json:"value"`
@@ -348,7 +1064,7 @@ type Field struct {
 
 func (m *Field) Reset()                    { *m = Field{} }
 func (*Field) ProtoMessage()               {}
-func (*Field) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{15} }
+func (*Field) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{65} }
 
 type FieldValue struct {
 	Id   uint32                                           `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -358,18 +1074,180 @@ type FieldValue struct {
 
 func (m *FieldValue) Reset()                    { *m = FieldValue{} }
 func (*FieldValue) ProtoMessage()               {}
-func (*FieldValue) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{16} }
+func (*FieldValue) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{66} }
 
 type FieldDesc struct {
 	Stored      bool        `protobuf:"varint,1,opt,name=stored,proto3" json:"stored,omitempty"`
 	Tokenized   bool        `protobuf:"varint,2,opt,name=tokenized,proto3" json:"tokenized,omitempty"`
 	IndexOption IndexOption `protobuf:"varint,3,opt,name=indexOption,proto3,enum=IndexOption" json:"indexOption,omitempty"`
 	Analyzer    string      `protobuf:"bytes,4,opt,name=analyzer,proto3" json:"analyzer,omitempty"`
+	// the values are also stored by field for sorting and aggregations
+	DocValues bool `protobuf:"varint,5,opt,name=doc_values,json=docValues,proto3" json:"doc_values,omitempty"`
 }
 
 func (m *FieldDesc) Reset()                    { *m = FieldDesc{} }
 func (*FieldDesc) ProtoMessage()               {}
-func (*FieldDesc) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{17} }
+func (*FieldDesc) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{67} }
+
+type Aggregation struct {
+	Terms         *TermsAggregation         `protobuf:"bytes,1,opt,name=terms" json:"terms,omitempty"`
+	Histogram     *HistogramAggregation     `protobuf:"bytes,2,opt,name=histogram" json:"histogram,omitempty"`
+	DateHistogram *DateHistogramAggregation `protobuf:"bytes,3,opt,name=date_histogram,json=dateHistogram" json:"date_histogram,omitempty"`
+	Range         *RangeAggregation         `protobuf:"bytes,4,opt,name=range" json:"range,omitempty"`
+	Min           *MinAggregation           `protobuf:"bytes,5,opt,name=min" json:"min,omitempty"`
+	Max           *MaxAggregation           `protobuf:"bytes,6,opt,name=max" json:"max,omitempty"`
+	Avg           *AvgAggregation           `protobuf:"bytes,7,opt,name=avg" json:"avg,omitempty"`
+	Sum           *SumAggregation           `protobuf:"bytes,8,opt,name=sum" json:"sum,omitempty"`
+	Stats         *StatsAggregation         `protobuf:"bytes,9,opt,name=stats" json:"stats,omitempty"`
+	Cardinality   *CardinalityAggregation   `protobuf:"bytes,10,opt,name=cardinality" json:"cardinality,omitempty"`
+}
+
+func (m *Aggregation) Reset()                    { *m = Aggregation{} }
+func (*Aggregation) ProtoMessage()               {}
+func (*Aggregation) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{68} }
+
+// Buckets the documents by the values of the field, the size buckets with the most documents are returned.
+// A partition returns shard_size buckets, size*3/2+10 when 0.
+type TermsAggregation struct {
+	Field        uint32                 `protobuf:"varint,1,opt,name=field,proto3" json:"field,omitempty"`
+	Size_        uint32                 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	ShardSize    uint32                 `protobuf:"varint,3,opt,name=shard_size,json=shardSize,proto3" json:"shard_size,omitempty"`
+	Aggregations map[string]Aggregation `protobuf:"bytes,4,rep,name=aggregations" json:"aggregations" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (m *TermsAggregation) Reset()                    { *m = TermsAggregation{} }
+func (*TermsAggregation) ProtoMessage()               {}
+func (*TermsAggregation) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{69} }
+
+// Buckets the documents by the numeric values of the field in intervals.
+type HistogramAggregation struct {
+	Field        uint32                 `protobuf:"varint,1,opt,name=field,proto3" json:"field,omitempty"`
+	Interval     float64                `protobuf:"fixed64,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	Aggregations map[string]Aggregation `protobuf:"bytes,3,rep,name=aggregations" json:"aggregations" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (m *HistogramAggregation) Reset()                    { *m = HistogramAggregation{} }
+func (*HistogramAggregation) ProtoMessage()               {}
+func (*HistogramAggregation) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{70} }
+
+// Buckets the documents by the time values of the field in intervals of nanoseconds.
+type DateHistogramAggregation struct {
+	Field        uint32                 `protobuf:"varint,1,opt,name=field,proto3" json:"field,omitempty"`
+	Interval     int64                  `protobuf:"varint,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	Aggregations map[string]Aggregation `protobuf:"bytes,3,rep,name=aggregations" json:"aggregations" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (m *DateHistogramAggregation) Reset()                    { *m = DateHistogramAggregation{} }
+func (*DateHistogramAggregation) ProtoMessage()               {}
+func (*DateHistogramAggregation) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{71} }
+
+// Buckets the documents by the ranges the numeric values of the field fall in.
+type RangeAggregation struct {
+	Field        uint32                 `protobuf:"varint,1,opt,name=field,proto3" json:"field,omitempty"`
+	Ranges       []AggregationRange     `protobuf:"bytes,2,rep,name=ranges" json:"ranges"`
+	Aggregations map[string]Aggregation `protobuf:"bytes,3,rep,name=aggregations" json:"aggregations" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (m *RangeAggregation) Reset()                    { *m = RangeAggregation{} }
+func (*RangeAggregation) ProtoMessage()               {}
+func (*RangeAggregation) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{72} }
+
+// From is included and to is excluded, the bounds are encoded as the data of FieldValue
+// and the bound is not set when empty.
+type AggregationRange struct {
+	Key  string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	From []byte `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To   []byte `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (m *AggregationRange) Reset()                    { *m = AggregationRange{} }
+func (*AggregationRange) ProtoMessage()               {}
+func (*AggregationRange) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{73} }
+
+type MinAggregation struct {
+	Field uint32 `protobuf:"varint,1,opt,name=field,proto3" json:"field,omitempty"`
+}
+
+func (m *MinAggregation) Reset()                    { *m = MinAggregation{} }
+func (*MinAggregation) ProtoMessage()               {}
+func (*MinAggregation) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{74} }
+
+type MaxAggregation struct {
+	Field uint32 `protobuf:"varint,1,opt,name=field,proto3" json:"field,omitempty"`
+}
+
+func (m *MaxAggregation) Reset()                    { *m = MaxAggregation{} }
+func (*MaxAggregation) ProtoMessage()               {}
+func (*MaxAggregation) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{75} }
+
+type AvgAggregation struct {
+	Field uint32 `protobuf:"varint,1,opt,name=field,proto3" json:"field,omitempty"`
+}
+
+func (m *AvgAggregation) Reset()                    { *m = AvgAggregation{} }
+func (*AvgAggregation) ProtoMessage()               {}
+func (*AvgAggregation) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{76} }
+
+type SumAggregation struct {
+	Field uint32 `protobuf:"varint,1,opt,name=field,proto3" json:"field,omitempty"`
+}
+
+func (m *SumAggregation) Reset()                    { *m = SumAggregation{} }
+func (*SumAggregation) ProtoMessage()               {}
+func (*SumAggregation) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{77} }
+
+type StatsAggregation struct {
+	Field uint32 `protobuf:"varint,1,opt,name=field,proto3" json:"field,omitempty"`
+}
+
+func (m *StatsAggregation) Reset()                    { *m = StatsAggregation{} }
+func (*StatsAggregation) ProtoMessage()               {}
+func (*StatsAggregation) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{78} }
+
+// Approximately counts the distinct values of the field with HyperLogLog of 2^precision registers,
+// precision is between 4 and 16, 14 when 0.
+type CardinalityAggregation struct {
+	Field     uint32 `protobuf:"varint,1,opt,name=field,proto3" json:"field,omitempty"`
+	Precision uint32 `protobuf:"varint,2,opt,name=precision,proto3" json:"precision,omitempty"`
+}
+
+func (m *CardinalityAggregation) Reset()                    { *m = CardinalityAggregation{} }
+func (*CardinalityAggregation) ProtoMessage()               {}
+func (*CardinalityAggregation) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{79} }
+
+// The partial result of an aggregation, only the state of the type of the aggregation is set.
+type AggregationResult struct {
+	Buckets []AggregationBucket `protobuf:"bytes,1,rep,name=buckets" json:"buckets"`
+	Stats   *StatsResult        `protobuf:"bytes,2,opt,name=stats" json:"stats,omitempty"`
+	// registers of the HyperLogLog of cardinality aggregation
+	Cardinality []byte `protobuf:"bytes,3,opt,name=cardinality,proto3" json:"cardinality,omitempty"`
+}
+
+func (m *AggregationResult) Reset()                    { *m = AggregationResult{} }
+func (*AggregationResult) ProtoMessage()               {}
+func (*AggregationResult) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{80} }
+
+// The key is encoded as the data of FieldValue.
+type AggregationBucket struct {
+	Key          []byte                       `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	DocCount     int64                        `protobuf:"varint,2,opt,name=doc_count,json=docCount,proto3" json:"doc_count,omitempty"`
+	Aggregations map[string]AggregationResult `protobuf:"bytes,3,rep,name=aggregations" json:"aggregations" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (m *AggregationBucket) Reset()                    { *m = AggregationBucket{} }
+func (*AggregationBucket) ProtoMessage()               {}
+func (*AggregationBucket) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{81} }
+
+type StatsResult struct {
+	Count int64   `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Sum   float64 `protobuf:"fixed64,2,opt,name=sum,proto3" json:"sum,omitempty"`
+	Min   float64 `protobuf:"fixed64,3,opt,name=min,proto3" json:"min,omitempty"`
+	Max   float64 `protobuf:"fixed64,4,opt,name=max,proto3" json:"max,omitempty"`
+}
+
+func (m *StatsResult) Reset()                    { *m = StatsResult{} }
+func (*StatsResult) ProtoMessage()               {}
+func (*StatsResult) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{82} }
 
 func init() {
 	proto.RegisterType((*ActionRequestHeader)(nil), "ActionRequestHeader")
@@ -382,14 +1260,79 @@ func init() {
 	proto.RegisterType((*CreateRequest)(nil), "CreateRequest")
 	proto.RegisterType((*CreateResponse)(nil), "CreateResponse")
 	proto.RegisterType((*UpdateRequest)(nil), "UpdateRequest")
+	proto.RegisterType((*Script)(nil), "Script")
 	proto.RegisterType((*UpdateResponse)(nil), "UpdateResponse")
 	proto.RegisterType((*DeleteRequest)(nil), "DeleteRequest")
 	proto.RegisterType((*DeleteResponse)(nil), "DeleteResponse")
 	proto.RegisterType((*Failure)(nil), "Failure")
+	proto.RegisterType((*SearchRequest)(nil), "SearchRequest")
+	proto.RegisterType((*SearchResponse)(nil), "SearchResponse")
+	proto.RegisterType((*SearchHit)(nil), "SearchHit")
+	proto.RegisterType((*InnerHits)(nil), "InnerHits")
+	proto.RegisterType((*InnerHit)(nil), "InnerHit")
+	proto.RegisterType((*Highlight)(nil), "Highlight")
+	proto.RegisterType((*HighlightFragments)(nil), "HighlightFragments")
+	proto.RegisterType((*SearchStatisticsRequest)(nil), "SearchStatisticsRequest")
+	proto.RegisterType((*SearchStatisticsResponse)(nil), "SearchStatisticsResponse")
+	proto.RegisterType((*SearchStatistics)(nil), "SearchStatistics")
+	proto.RegisterType((*FieldStatistics)(nil), "FieldStatistics")
+	proto.RegisterType((*TermStatistics)(nil), "TermStatistics")
+	proto.RegisterType((*AnalyzeRequest)(nil), "AnalyzeRequest")
+	proto.RegisterType((*AnalyzeResponse)(nil), "AnalyzeResponse")
+	proto.RegisterType((*AnalyzeToken)(nil), "AnalyzeToken")
+	proto.RegisterType((*AnalyzeStage)(nil), "AnalyzeStage")
+	proto.RegisterType((*TermVectorsRequest)(nil), "TermVectorsRequest")
+	proto.RegisterType((*TermVectorsResponse)(nil), "TermVectorsResponse")
+	proto.RegisterType((*FieldTermVectors)(nil), "FieldTermVectors")
+	proto.RegisterType((*TermVector)(nil), "TermVector")
+	proto.RegisterType((*TermPosition)(nil), "TermPosition")
+	proto.RegisterType((*ScrollRequest)(nil), "ScrollRequest")
+	proto.RegisterType((*ScrollResponse)(nil), "ScrollResponse")
+	proto.RegisterType((*ScrollDocument)(nil), "ScrollDocument")
+	proto.RegisterType((*ClearScrollRequest)(nil), "ClearScrollRequest")
+	proto.RegisterType((*ClearScrollResponse)(nil), "ClearScrollResponse")
+	proto.RegisterType((*ByQueryRequest)(nil), "ByQueryRequest")
+	proto.RegisterType((*ByQueryResponse)(nil), "ByQueryResponse")
+	proto.RegisterType((*ByQueryTaskRequest)(nil), "ByQueryTaskRequest")
+	proto.RegisterType((*ByQueryTaskResponse)(nil), "ByQueryTaskResponse")
+	proto.RegisterType((*ByQueryStatus)(nil), "ByQueryStatus")
+	proto.RegisterType((*SortField)(nil), "SortField")
+	proto.RegisterType((*Query)(nil), "Query")
+	proto.RegisterType((*TermQuery)(nil), "TermQuery")
+	proto.RegisterType((*TermsQuery)(nil), "TermsQuery")
+	proto.RegisterType((*PhraseQuery)(nil), "PhraseQuery")
+	proto.RegisterType((*RangeQuery)(nil), "RangeQuery")
+	proto.RegisterType((*PrefixQuery)(nil), "PrefixQuery")
+	proto.RegisterType((*WildcardQuery)(nil), "WildcardQuery")
+	proto.RegisterType((*RegexpQuery)(nil), "RegexpQuery")
+	proto.RegisterType((*FuzzyQuery)(nil), "FuzzyQuery")
+	proto.RegisterType((*GeoPoint)(nil), "GeoPoint")
+	proto.RegisterType((*GeoBoundingBoxQuery)(nil), "GeoBoundingBoxQuery")
+	proto.RegisterType((*GeoDistanceQuery)(nil), "GeoDistanceQuery")
+	proto.RegisterType((*GeoPolygonQuery)(nil), "GeoPolygonQuery")
+	proto.RegisterType((*MatchAllQuery)(nil), "MatchAllQuery")
+	proto.RegisterType((*NestedQuery)(nil), "NestedQuery")
+	proto.RegisterType((*BoolQuery)(nil), "BoolQuery")
 	proto.RegisterType((*Document)(nil), "Document")
+	proto.RegisterType((*NestedDocument)(nil), "NestedDocument")
 	proto.RegisterType((*Field)(nil), "Field")
 	proto.RegisterType((*FieldValue)(nil), "FieldValue")
 	proto.RegisterType((*FieldDesc)(nil), "FieldDesc")
+	proto.RegisterType((*Aggregation)(nil), "Aggregation")
+	proto.RegisterType((*TermsAggregation)(nil), "TermsAggregation")
+	proto.RegisterType((*HistogramAggregation)(nil), "HistogramAggregation")
+	proto.RegisterType((*DateHistogramAggregation)(nil), "DateHistogramAggregation")
+	proto.RegisterType((*RangeAggregation)(nil), "RangeAggregation")
+	proto.RegisterType((*AggregationRange)(nil), "AggregationRange")
+	proto.RegisterType((*MinAggregation)(nil), "MinAggregation")
+	proto.RegisterType((*MaxAggregation)(nil), "MaxAggregation")
+	proto.RegisterType((*AvgAggregation)(nil), "AvgAggregation")
+	proto.RegisterType((*SumAggregation)(nil), "SumAggregation")
+	proto.RegisterType((*StatsAggregation)(nil), "StatsAggregation")
+	proto.RegisterType((*CardinalityAggregation)(nil), "CardinalityAggregation")
+	proto.RegisterType((*AggregationResult)(nil), "AggregationResult")
+	proto.RegisterType((*AggregationBucket)(nil), "AggregationBucket")
+	proto.RegisterType((*StatsResult)(nil), "StatsResult")
 	proto.RegisterEnum("OpType", OpType_name, OpType_value)
 	proto.RegisterEnum("WriteResult", WriteResult_name, WriteResult_value)
 	proto.RegisterEnum("ValueType", ValueType_name, ValueType_value)
@@ -495,6 +1438,12 @@ func (this *GetResponse) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.Version != that1.Version {
+		return false
+	}
+	if this.SeqNo != that1.SeqNo {
+		return false
+	}
 	return true
 }
 func (this *BulkRequest) Equal(that interface{}) bool {
@@ -652,6 +1601,15 @@ func (this *CreateRequest) Equal(that interface{}) bool {
 	if !this.Doc.Equal(&that1.Doc) {
 		return false
 	}
+	if !bytes.Equal(this.Source, that1.Source) {
+		return false
+	}
+	if this.IfVersion != that1.IfVersion {
+		return false
+	}
+	if this.IfSeqNo != that1.IfSeqNo {
+		return false
+	}
 	return true
 }
 func (this *CreateResponse) Equal(that interface{}) bool {
@@ -679,6 +1637,12 @@ func (this *CreateResponse) Equal(that interface{}) bool {
 	if this.Result != that1.Result {
 		return false
 	}
+	if this.Version != that1.Version {
+		return false
+	}
+	if this.SeqNo != that1.SeqNo {
+		return false
+	}
 	return true
 }
 func (this *UpdateRequest) Equal(that interface{}) bool {
@@ -706,16 +1670,34 @@ func (this *UpdateRequest) Equal(that interface{}) bool {
 	if this.Upsert != that1.Upsert {
 		return false
 	}
+	if !bytes.Equal(this.Source, that1.Source) {
+		return false
+	}
+	if !bytes.Equal(this.Partial, that1.Partial) {
+		return false
+	}
+	if !this.Script.Equal(that1.Script) {
+		return false
+	}
+	if this.IfVersion != that1.IfVersion {
+		return false
+	}
+	if this.IfSeqNo != that1.IfSeqNo {
+		return false
+	}
+	if this.MappingVersion != that1.MappingVersion {
+		return false
+	}
 	return true
 }
-func (this *UpdateResponse) Equal(that interface{}) bool {
+func (this *Script) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*UpdateResponse)
+	that1, ok := that.(*Script)
 	if !ok {
-		that2, ok := that.(UpdateResponse)
+		that2, ok := that.(Script)
 		if ok {
 			that1 = &that2
 		} else {
@@ -727,22 +1709,55 @@ func (this *UpdateResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !bytes.Equal(this.Id, that1.Id) {
+	if this.Source != that1.Source {
 		return false
 	}
-	if this.Result != that1.Result {
+	if !bytes.Equal(this.Params, that1.Params) {
 		return false
 	}
 	return true
 }
-func (this *DeleteRequest) Equal(that interface{}) bool {
+func (this *UpdateResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DeleteRequest)
+	that1, ok := that.(*UpdateResponse)
 	if !ok {
-		that2, ok := that.(DeleteRequest)
+		that2, ok := that.(UpdateResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Id, that1.Id) {
+		return false
+	}
+	if this.Result != that1.Result {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	if this.SeqNo != that1.SeqNo {
+		return false
+	}
+	return true
+}
+func (this *DeleteRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DeleteRequest)
+	if !ok {
+		that2, ok := that.(DeleteRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -757,6 +1772,15 @@ func (this *DeleteRequest) Equal(that interface{}) bool {
 	if !bytes.Equal(this.Id, that1.Id) {
 		return false
 	}
+	if this.IfVersion != that1.IfVersion {
+		return false
+	}
+	if this.IfSeqNo != that1.IfSeqNo {
+		return false
+	}
+	if this.IfExpiredAt != that1.IfExpiredAt {
+		return false
+	}
 	return true
 }
 func (this *DeleteResponse) Equal(that interface{}) bool {
@@ -784,6 +1808,12 @@ func (this *DeleteResponse) Equal(that interface{}) bool {
 	if this.Result != that1.Result {
 		return false
 	}
+	if this.Version != that1.Version {
+		return false
+	}
+	if this.SeqNo != that1.SeqNo {
+		return false
+	}
 	return true
 }
 func (this *Failure) Equal(that interface{}) bool {
@@ -814,16 +1844,25 @@ func (this *Failure) Equal(that interface{}) bool {
 	if this.Aborted != that1.Aborted {
 		return false
 	}
+	if this.VersionConflict != that1.VersionConflict {
+		return false
+	}
+	if this.CurrentVersion != that1.CurrentVersion {
+		return false
+	}
+	if this.CurrentSeqNo != that1.CurrentSeqNo {
+		return false
+	}
 	return true
 }
-func (this *Document) Equal(that interface{}) bool {
+func (this *SearchRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Document)
+	that1, ok := that.(*SearchRequest)
 	if !ok {
-		that2, ok := that.(Document)
+		that2, ok := that.(SearchRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -835,27 +1874,68 @@ func (this *Document) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !bytes.Equal(this.Id, that1.Id) {
+	if !this.ActionRequestHeader.Equal(&that1.ActionRequestHeader) {
+		return false
+	}
+	if !this.Query.Equal(&that1.Query) {
+		return false
+	}
+	if this.From != that1.From {
+		return false
+	}
+	if this.Size_ != that1.Size_ {
+		return false
+	}
+	if len(this.Sort) != len(that1.Sort) {
 		return false
 	}
+	for i := range this.Sort {
+		if !this.Sort[i].Equal(&that1.Sort[i]) {
+			return false
+		}
+	}
 	if len(this.Fields) != len(that1.Fields) {
 		return false
 	}
 	for i := range this.Fields {
-		if !this.Fields[i].Equal(&that1.Fields[i]) {
+		if this.Fields[i] != that1.Fields[i] {
+			return false
+		}
+	}
+	if len(this.Similarities) != len(that1.Similarities) {
+		return false
+	}
+	for i := range this.Similarities {
+		if this.Similarities[i] != that1.Similarities[i] {
+			return false
+		}
+	}
+	if !this.Statistics.Equal(that1.Statistics) {
+		return false
+	}
+	if len(this.Aggregations) != len(that1.Aggregations) {
+		return false
+	}
+	for i := range this.Aggregations {
+		a := this.Aggregations[i]
+		b := that1.Aggregations[i]
+		if !(&a).Equal(&b) {
 			return false
 		}
 	}
+	if !this.Highlight.Equal(that1.Highlight) {
+		return false
+	}
 	return true
 }
-func (this *Field) Equal(that interface{}) bool {
+func (this *SearchResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Field)
+	that1, ok := that.(*SearchResponse)
 	if !ok {
-		that2, ok := that.(Field)
+		that2, ok := that.(SearchResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -867,22 +1947,40 @@ func (this *Field) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.FieldValue.Equal(&that1.FieldValue) {
+	if !this.ResponseHeader.Equal(&that1.ResponseHeader) {
 		return false
 	}
-	if !this.Desc.Equal(&that1.Desc) {
+	if this.Total != that1.Total {
+		return false
+	}
+	if len(this.Hits) != len(that1.Hits) {
+		return false
+	}
+	for i := range this.Hits {
+		if !this.Hits[i].Equal(&that1.Hits[i]) {
+			return false
+		}
+	}
+	if len(this.Aggregations) != len(that1.Aggregations) {
 		return false
 	}
+	for i := range this.Aggregations {
+		a := this.Aggregations[i]
+		b := that1.Aggregations[i]
+		if !(&a).Equal(&b) {
+			return false
+		}
+	}
 	return true
 }
-func (this *FieldValue) Equal(that interface{}) bool {
+func (this *SearchHit) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*FieldValue)
+	that1, ok := that.(*SearchHit)
 	if !ok {
-		that2, ok := that.(FieldValue)
+		that2, ok := that.(SearchHit)
 		if ok {
 			that1 = &that2
 		} else {
@@ -894,25 +1992,52 @@ func (this *FieldValue) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Id != that1.Id {
+	if !bytes.Equal(this.Id, that1.Id) {
 		return false
 	}
-	if this.Type != that1.Type {
+	if this.Score != that1.Score {
 		return false
 	}
-	if !bytes.Equal(this.Data, that1.Data) {
+	if len(this.Fields) != len(that1.Fields) {
+		return false
+	}
+	for i := range this.Fields {
+		a := this.Fields[i]
+		b := that1.Fields[i]
+		if !(&a).Equal(&b) {
+			return false
+		}
+	}
+	if len(this.Highlights) != len(that1.Highlights) {
+		return false
+	}
+	for i := range this.Highlights {
+		a := this.Highlights[i]
+		b := that1.Highlights[i]
+		if !(&a).Equal(&b) {
+			return false
+		}
+	}
+	if len(this.InnerHits) != len(that1.InnerHits) {
 		return false
 	}
+	for i := range this.InnerHits {
+		a := this.InnerHits[i]
+		b := that1.InnerHits[i]
+		if !(&a).Equal(&b) {
+			return false
+		}
+	}
 	return true
 }
-func (this *FieldDesc) Equal(that interface{}) bool {
+func (this *InnerHits) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*FieldDesc)
+	that1, ok := that.(*InnerHits)
 	if !ok {
-		that2, ok := that.(FieldDesc)
+		that2, ok := that.(InnerHits)
 		if ok {
 			that1 = &that2
 		} else {
@@ -924,1721 +2049,22268 @@ func (this *FieldDesc) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Stored != that1.Stored {
+	if len(this.Hits) != len(that1.Hits) {
 		return false
 	}
-	if this.Tokenized != that1.Tokenized {
+	for i := range this.Hits {
+		if !this.Hits[i].Equal(&that1.Hits[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *InnerHit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*InnerHit)
+	if !ok {
+		that2, ok := that.(InnerHit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
 		return false
 	}
-	if this.IndexOption != that1.IndexOption {
+	if this.Offset != that1.Offset {
 		return false
 	}
-	if this.Analyzer != that1.Analyzer {
+	if this.Score != that1.Score {
+		return false
+	}
+	if len(this.Fields) != len(that1.Fields) {
 		return false
 	}
+	for i := range this.Fields {
+		a := this.Fields[i]
+		b := that1.Fields[i]
+		if !(&a).Equal(&b) {
+			return false
+		}
+	}
 	return true
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// Client API for ApiGrpc service
-
-type ApiGrpcClient interface {
-	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error)
-	BulkWrite(ctx context.Context, in *BulkRequest, opts ...grpc.CallOption) (*BulkResponse, error)
-}
-
-type apiGrpcClient struct {
-	cc *grpc.ClientConn
-}
-
-func NewApiGrpcClient(cc *grpc.ClientConn) ApiGrpcClient {
-	return &apiGrpcClient{cc}
-}
-
-func (c *apiGrpcClient) Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error) {
-	out := new(GetResponse)
-	err := grpc.Invoke(ctx, "/ApiGrpc/Get", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (this *Highlight) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return out, nil
-}
 
-func (c *apiGrpcClient) BulkWrite(ctx context.Context, in *BulkRequest, opts ...grpc.CallOption) (*BulkResponse, error) {
-	out := new(BulkResponse)
-	err := grpc.Invoke(ctx, "/ApiGrpc/BulkWrite", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*Highlight)
+	if !ok {
+		that2, ok := that.(Highlight)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return out, nil
-}
-
-// Server API for ApiGrpc service
-
-type ApiGrpcServer interface {
-	Get(context.Context, *GetRequest) (*GetResponse, error)
-	BulkWrite(context.Context, *BulkRequest) (*BulkResponse, error)
-}
-
-func RegisterApiGrpcServer(s *grpc.Server, srv ApiGrpcServer) {
-	s.RegisterService(&_ApiGrpc_serviceDesc, srv)
-}
-
-func _ApiGrpc_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if interceptor == nil {
-		return srv.(ApiGrpcServer).Get(ctx, in)
+	if len(this.Fields) != len(that1.Fields) {
+		return false
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ApiGrpc/Get",
+	for i := range this.Fields {
+		if this.Fields[i] != that1.Fields[i] {
+			return false
+		}
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiGrpcServer).Get(ctx, req.(*GetRequest))
+	if this.PreTag != that1.PreTag {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _ApiGrpc_BulkWrite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BulkRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+	if this.PostTag != that1.PostTag {
+		return false
 	}
-	if interceptor == nil {
-		return srv.(ApiGrpcServer).BulkWrite(ctx, in)
+	if this.FragmentSize != that1.FragmentSize {
+		return false
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ApiGrpc/BulkWrite",
+	if this.NumberOfFragments != that1.NumberOfFragments {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiGrpcServer).BulkWrite(ctx, req.(*BulkRequest))
+	if len(this.Analyzers) != len(that1.Analyzers) {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
-}
-
-var _ApiGrpc_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ApiGrpc",
-	HandlerType: (*ApiGrpcServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Get",
-			Handler:    _ApiGrpc_Get_Handler,
-		},
-		{
-			MethodName: "BulkWrite",
-			Handler:    _ApiGrpc_BulkWrite_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "api.proto",
-}
-
-func (m *ActionRequestHeader) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	for i := range this.Analyzers {
+		if this.Analyzers[i] != that1.Analyzers[i] {
+			return false
+		}
 	}
-	return dAtA[:n], nil
+	return true
 }
+func (this *HighlightFragments) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ActionRequestHeader) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintApi(dAtA, i, uint64(m.RequestHeader.Size()))
-	n1, err := m.RequestHeader.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+	that1, ok := that.(*HighlightFragments)
+	if !ok {
+		that2, ok := that.(HighlightFragments)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	i += n1
-	if m.Partition != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintApi(dAtA, i, uint64(m.Partition))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return i, nil
-}
-
-func (m *GetRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if len(this.Fragments) != len(that1.Fragments) {
+		return false
 	}
-	return dAtA[:n], nil
+	for i := range this.Fragments {
+		if this.Fragments[i] != that1.Fragments[i] {
+			return false
+		}
+	}
+	return true
 }
+func (this *SearchStatisticsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *GetRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintApi(dAtA, i, uint64(m.ActionRequestHeader.Size()))
-	n2, err := m.ActionRequestHeader.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+	that1, ok := that.(*SearchStatisticsRequest)
+	if !ok {
+		that2, ok := that.(SearchStatisticsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	i += n2
-	if len(m.Id) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintApi(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.Fields) > 0 {
-		dAtA4 := make([]byte, len(m.Fields)*10)
-		var j3 int
-		for _, num := range m.Fields {
-			for num >= 1<<7 {
-				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j3++
-			}
-			dAtA4[j3] = uint8(num)
-			j3++
-		}
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintApi(dAtA, i, uint64(j3))
-		i += copy(dAtA[i:], dAtA4[:j3])
+	if !this.ActionRequestHeader.Equal(&that1.ActionRequestHeader) {
+		return false
 	}
-	return i, nil
+	if !this.Query.Equal(&that1.Query) {
+		return false
+	}
+	return true
 }
+func (this *SearchStatisticsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *GetResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*SearchStatisticsResponse)
+	if !ok {
+		that2, ok := that.(SearchStatisticsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ResponseHeader.Equal(&that1.ResponseHeader) {
+		return false
+	}
+	if !this.Statistics.Equal(&that1.Statistics) {
+		return false
+	}
+	return true
 }
+func (this *SearchStatistics) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *GetResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintApi(dAtA, i, uint64(m.ResponseHeader.Size()))
-	n5, err := m.ResponseHeader.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+	that1, ok := that.(*SearchStatistics)
+	if !ok {
+		that2, ok := that.(SearchStatistics)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	i += n5
-	if len(m.Id) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintApi(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Found {
-		dAtA[i] = 0x18
-		i++
-		if m.Found {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if len(this.Fields) != len(that1.Fields) {
+		return false
+	}
+	for i := range this.Fields {
+		if !this.Fields[i].Equal(&that1.Fields[i]) {
+			return false
 		}
-		i++
 	}
-	if len(m.Fields) > 0 {
-		for k, _ := range m.Fields {
-			dAtA[i] = 0x22
-			i++
-			v := m.Fields[k]
-			msgSize := 0
-			if (&v) != nil {
-				msgSize = (&v).Size()
-				msgSize += 1 + sovApi(uint64(msgSize))
-			}
-			mapSize := 1 + sovApi(uint64(k)) + msgSize
-			i = encodeVarintApi(dAtA, i, uint64(mapSize))
-			dAtA[i] = 0x8
-			i++
-			i = encodeVarintApi(dAtA, i, uint64(k))
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintApi(dAtA, i, uint64((&v).Size()))
-			n6, err := (&v).MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n6
+	if len(this.Terms) != len(that1.Terms) {
+		return false
+	}
+	for i := range this.Terms {
+		if !this.Terms[i].Equal(&that1.Terms[i]) {
+			return false
 		}
 	}
-	return i, nil
+	return true
 }
-
-func (m *BulkRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (this *FieldStatistics) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
 
-func (m *BulkRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintApi(dAtA, i, uint64(m.ActionRequestHeader.Size()))
-	n7, err := m.ActionRequestHeader.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+	that1, ok := that.(*FieldStatistics)
+	if !ok {
+		that2, ok := that.(FieldStatistics)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	i += n7
-	if len(m.Requests) > 0 {
-		for _, msg := range m.Requests {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintApi(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return i, nil
-}
-
-func (m *BulkResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if this.Field != that1.Field {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *BulkResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintApi(dAtA, i, uint64(m.ResponseHeader.Size()))
-	n8, err := m.ResponseHeader.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+	if this.DocCount != that1.DocCount {
+		return false
 	}
-	i += n8
-	if len(m.Responses) > 0 {
-		for _, msg := range m.Responses {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintApi(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	if this.SumLength != that1.SumLength {
+		return false
 	}
-	return i, nil
+	return true
 }
-
-func (m *BulkItemRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (this *TermStatistics) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
 
-func (m *BulkItemRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.OpType != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintApi(dAtA, i, uint64(m.OpType))
-	}
-	if m.Create != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintApi(dAtA, i, uint64(m.Create.Size()))
-		n9, err := m.Create.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	that1, ok := that.(*TermStatistics)
+	if !ok {
+		that2, ok := that.(TermStatistics)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i += n9
 	}
-	if m.Update != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintApi(dAtA, i, uint64(m.Update.Size()))
-		n10, err := m.Update.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n10
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Delete != nil {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintApi(dAtA, i, uint64(m.Delete.Size()))
-		n11, err := m.Delete.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n11
+	if this.Field != that1.Field {
+		return false
 	}
-	return i, nil
+	if !bytes.Equal(this.Term, that1.Term) {
+		return false
+	}
+	if this.DocFreq != that1.DocFreq {
+		return false
+	}
+	return true
 }
+func (this *AnalyzeRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *BulkItemResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*AnalyzeRequest)
+	if !ok {
+		that2, ok := that.(AnalyzeRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ActionRequestHeader.Equal(&that1.ActionRequestHeader) {
+		return false
+	}
+	if this.Analyzer != that1.Analyzer {
+		return false
+	}
+	if this.Field != that1.Field {
+		return false
+	}
+	if this.Text != that1.Text {
+		return false
+	}
+	if this.Explain != that1.Explain {
+		return false
+	}
+	return true
 }
-
-func (m *BulkItemResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.OpType != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintApi(dAtA, i, uint64(m.OpType))
+func (this *AnalyzeResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if m.Create != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintApi(dAtA, i, uint64(m.Create.Size()))
-		n12, err := m.Create.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+
+	that1, ok := that.(*AnalyzeResponse)
+	if !ok {
+		that2, ok := that.(AnalyzeResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i += n12
 	}
-	if m.Update != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintApi(dAtA, i, uint64(m.Update.Size()))
-		n13, err := m.Update.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n13
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Delete != nil {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintApi(dAtA, i, uint64(m.Delete.Size()))
-		n14, err := m.Delete.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	if !this.ResponseHeader.Equal(&that1.ResponseHeader) {
+		return false
+	}
+	if this.Analyzer != that1.Analyzer {
+		return false
+	}
+	if len(this.Tokens) != len(that1.Tokens) {
+		return false
+	}
+	for i := range this.Tokens {
+		if !this.Tokens[i].Equal(&that1.Tokens[i]) {
+			return false
 		}
-		i += n14
 	}
-	if m.Failure != nil {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintApi(dAtA, i, uint64(m.Failure.Size()))
-		n15, err := m.Failure.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	if len(this.Stages) != len(that1.Stages) {
+		return false
+	}
+	for i := range this.Stages {
+		if !this.Stages[i].Equal(&that1.Stages[i]) {
+			return false
 		}
-		i += n15
 	}
-	return i, nil
+	return true
 }
-
-func (m *CreateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (this *AnalyzeToken) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
 
-func (m *CreateRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintApi(dAtA, i, uint64(m.Doc.Size()))
-	n16, err := m.Doc.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+	that1, ok := that.(*AnalyzeToken)
+	if !ok {
+		that2, ok := that.(AnalyzeToken)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	i += n16
-	return i, nil
-}
-
-func (m *CreateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *CreateResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintApi(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
+	if this.Term != that1.Term {
+		return false
 	}
-	if m.Result != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintApi(dAtA, i, uint64(m.Result))
+	if this.Start != that1.Start {
+		return false
 	}
-	return i, nil
-}
-
-func (m *UpdateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if this.End != that1.End {
+		return false
 	}
-	return dAtA[:n], nil
+	if this.Position != that1.Position {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	return true
 }
-
-func (m *UpdateRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintApi(dAtA, i, uint64(m.Doc.Size()))
-	n17, err := m.Doc.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+func (this *AnalyzeStage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	i += n17
-	if m.Upsert {
-		dAtA[i] = 0x10
-		i++
-		if m.Upsert {
-			dAtA[i] = 1
+
+	that1, ok := that.(*AnalyzeStage)
+	if !ok {
+		that2, ok := that.(AnalyzeStage)
+		if ok {
+			that1 = &that2
 		} else {
-			dAtA[i] = 0
+			return false
 		}
-		i++
 	}
-	return i, nil
-}
-
-func (m *UpdateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *UpdateResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintApi(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
+	if this.Name != that1.Name {
+		return false
 	}
-	if m.Result != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintApi(dAtA, i, uint64(m.Result))
+	if this.Text != that1.Text {
+		return false
 	}
-	return i, nil
-}
-
-func (m *DeleteRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if len(this.Tokens) != len(that1.Tokens) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DeleteRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintApi(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
+	for i := range this.Tokens {
+		if !this.Tokens[i].Equal(&that1.Tokens[i]) {
+			return false
+		}
 	}
-	return i, nil
+	return true
 }
-
-func (m *DeleteResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (this *TermVectorsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
 
-func (m *DeleteResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintApi(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
+	that1, ok := that.(*TermVectorsRequest)
+	if !ok {
+		that2, ok := that.(TermVectorsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.Result != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintApi(dAtA, i, uint64(m.Result))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return i, nil
-}
-
-func (m *Failure) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if !this.ActionRequestHeader.Equal(&that1.ActionRequestHeader) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Failure) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintApi(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
+	if !bytes.Equal(this.Id, that1.Id) {
+		return false
 	}
-	if len(m.Cause) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintApi(dAtA, i, uint64(len(m.Cause)))
-		i += copy(dAtA[i:], m.Cause)
+	if len(this.Fields) != len(that1.Fields) {
+		return false
 	}
-	if m.Aborted {
-		dAtA[i] = 0x18
-		i++
-		if m.Aborted {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	for i := range this.Fields {
+		if this.Fields[i] != that1.Fields[i] {
+			return false
 		}
-		i++
 	}
-	return i, nil
-}
-
-func (m *Document) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if this.TermStatistics != that1.TermStatistics {
+		return false
 	}
-	return dAtA[:n], nil
+	return true
 }
-
-func (m *Document) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintApi(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
+func (this *TermVectorsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if len(m.Fields) > 0 {
-		for _, msg := range m.Fields {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintApi(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+
+	that1, ok := that.(*TermVectorsResponse)
+	if !ok {
+		that2, ok := that.(TermVectorsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	return i, nil
-}
-
-func (m *Field) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *Field) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintApi(dAtA, i, uint64(m.FieldValue.Size()))
-	n18, err := m.FieldValue.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	i += n18
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintApi(dAtA, i, uint64(m.Desc.Size()))
-	n19, err := m.Desc.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+	if !this.ResponseHeader.Equal(&that1.ResponseHeader) {
+		return false
 	}
-	i += n19
-	return i, nil
-}
-
-func (m *FieldValue) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if !bytes.Equal(this.Id, that1.Id) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *FieldValue) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintApi(dAtA, i, uint64(m.Id))
+	if this.Found != that1.Found {
+		return false
 	}
-	if m.Type != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintApi(dAtA, i, uint64(m.Type))
+	if len(this.Fields) != len(that1.Fields) {
+		return false
 	}
-	if len(m.Data) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintApi(dAtA, i, uint64(len(m.Data)))
-		i += copy(dAtA[i:], m.Data)
+	for i := range this.Fields {
+		a := this.Fields[i]
+		b := that1.Fields[i]
+		if !(&a).Equal(&b) {
+			return false
+		}
 	}
-	return i, nil
+	return true
 }
-
-func (m *FieldDesc) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (this *FieldTermVectors) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
 
-func (m *FieldDesc) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Stored {
-		dAtA[i] = 0x8
-		i++
-		if m.Stored {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
-	}
-	if m.Tokenized {
-		dAtA[i] = 0x10
-		i++
-		if m.Tokenized {
-			dAtA[i] = 1
+	that1, ok := that.(*FieldTermVectors)
+	if !ok {
+		that2, ok := that.(FieldTermVectors)
+		if ok {
+			that1 = &that2
 		} else {
-			dAtA[i] = 0
+			return false
 		}
-		i++
 	}
-	if m.IndexOption != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintApi(dAtA, i, uint64(m.IndexOption))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.Analyzer) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintApi(dAtA, i, uint64(len(m.Analyzer)))
-		i += copy(dAtA[i:], m.Analyzer)
+	if len(this.Terms) != len(that1.Terms) {
+		return false
 	}
-	return i, nil
-}
-
-func encodeVarintApi(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	for i := range this.Terms {
+		if !this.Terms[i].Equal(&that1.Terms[i]) {
+			return false
+		}
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return true
 }
-func NewPopulatedActionRequestHeader(r randyApi, easy bool) *ActionRequestHeader {
-	this := &ActionRequestHeader{}
-	v1 := meta.NewPopulatedRequestHeader(r, easy)
-	this.RequestHeader = *v1
-	this.Partition = github_com_tiglabs_baudengine_proto_metapb.PartitionID(r.Uint32())
-	if !easy && r.Intn(10) != 0 {
+func (this *TermVector) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return this
-}
 
-func NewPopulatedGetRequest(r randyApi, easy bool) *GetRequest {
-	this := &GetRequest{}
-	v2 := NewPopulatedActionRequestHeader(r, easy)
-	this.ActionRequestHeader = *v2
-	v3 := r.Intn(100)
-	this.Id = make(github_com_tiglabs_baudengine_proto_metapb.Key, v3)
-	for i := 0; i < v3; i++ {
-		this.Id[i] = byte(r.Intn(256))
-	}
-	v4 := r.Intn(10)
-	this.Fields = make([]uint32, v4)
-	for i := 0; i < v4; i++ {
-		this.Fields[i] = uint32(r.Uint32())
+	that1, ok := that.(*TermVector)
+	if !ok {
+		that2, ok := that.(TermVector)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if !easy && r.Intn(10) != 0 {
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return this
-}
-
-func NewPopulatedGetResponse(r randyApi, easy bool) *GetResponse {
-	this := &GetResponse{}
-	v5 := meta.NewPopulatedResponseHeader(r, easy)
-	this.ResponseHeader = *v5
-	v6 := r.Intn(100)
-	this.Id = make(github_com_tiglabs_baudengine_proto_metapb.Key, v6)
-	for i := 0; i < v6; i++ {
-		this.Id[i] = byte(r.Intn(256))
+	if !bytes.Equal(this.Term, that1.Term) {
+		return false
 	}
-	this.Found = bool(bool(r.Intn(2) == 0))
-	if r.Intn(10) != 0 {
-		v7 := r.Intn(10)
-		this.Fields = make(map[uint32]FieldValue)
-		for i := 0; i < v7; i++ {
-			this.Fields[uint32(r.Uint32())] = *NewPopulatedFieldValue(r, easy)
-		}
+	if this.Freq != that1.Freq {
+		return false
 	}
-	if !easy && r.Intn(10) != 0 {
+	if len(this.Positions) != len(that1.Positions) {
+		return false
 	}
-	return this
-}
-
-func NewPopulatedBulkRequest(r randyApi, easy bool) *BulkRequest {
-	this := &BulkRequest{}
-	v8 := NewPopulatedActionRequestHeader(r, easy)
-	this.ActionRequestHeader = *v8
-	if r.Intn(10) != 0 {
-		v9 := r.Intn(5)
-		this.Requests = make([]BulkItemRequest, v9)
-		for i := 0; i < v9; i++ {
-			v10 := NewPopulatedBulkItemRequest(r, easy)
-			this.Requests[i] = *v10
+	for i := range this.Positions {
+		if !this.Positions[i].Equal(&that1.Positions[i]) {
+			return false
 		}
 	}
-	if !easy && r.Intn(10) != 0 {
+	if this.DocFreq != that1.DocFreq {
+		return false
 	}
-	return this
+	if this.TotalTermFreq != that1.TotalTermFreq {
+		return false
+	}
+	return true
 }
+func (this *TermPosition) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func NewPopulatedBulkResponse(r randyApi, easy bool) *BulkResponse {
-	this := &BulkResponse{}
-	v11 := meta.NewPopulatedResponseHeader(r, easy)
-	this.ResponseHeader = *v11
-	if r.Intn(10) != 0 {
-		v12 := r.Intn(5)
-		this.Responses = make([]BulkItemResponse, v12)
-		for i := 0; i < v12; i++ {
-			v13 := NewPopulatedBulkItemResponse(r, easy)
-			this.Responses[i] = *v13
+	that1, ok := that.(*TermPosition)
+	if !ok {
+		that2, ok := that.(TermPosition)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if !easy && r.Intn(10) != 0 {
-	}
-	return this
-}
-
-func NewPopulatedBulkItemRequest(r randyApi, easy bool) *BulkItemRequest {
-	this := &BulkItemRequest{}
-	this.OpType = OpType([]int32{0, 1, 2}[r.Intn(3)])
-	if r.Intn(10) != 0 {
-		this.Create = NewPopulatedCreateRequest(r, easy)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if r.Intn(10) != 0 {
-		this.Update = NewPopulatedUpdateRequest(r, easy)
+	if this.Position != that1.Position {
+		return false
 	}
-	if r.Intn(10) != 0 {
-		this.Delete = NewPopulatedDeleteRequest(r, easy)
+	if this.Start != that1.Start {
+		return false
 	}
-	if !easy && r.Intn(10) != 0 {
+	if this.End != that1.End {
+		return false
 	}
-	return this
+	return true
 }
-
-func NewPopulatedBulkItemResponse(r randyApi, easy bool) *BulkItemResponse {
-	this := &BulkItemResponse{}
-	this.OpType = OpType([]int32{0, 1, 2}[r.Intn(3)])
-	if r.Intn(10) != 0 {
-		this.Create = NewPopulatedCreateResponse(r, easy)
+func (this *ScrollRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if r.Intn(10) != 0 {
-		this.Update = NewPopulatedUpdateResponse(r, easy)
+
+	that1, ok := that.(*ScrollRequest)
+	if !ok {
+		that2, ok := that.(ScrollRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if r.Intn(10) != 0 {
-		this.Delete = NewPopulatedDeleteResponse(r, easy)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if r.Intn(10) != 0 {
-		this.Failure = NewPopulatedFailure(r, easy)
+	if !this.ActionRequestHeader.Equal(&that1.ActionRequestHeader) {
+		return false
 	}
-	if !easy && r.Intn(10) != 0 {
+	if this.ScrollId != that1.ScrollId {
+		return false
 	}
-	return this
-}
-
-func NewPopulatedCreateRequest(r randyApi, easy bool) *CreateRequest {
-	this := &CreateRequest{}
-	v14 := NewPopulatedDocument(r, easy)
-	this.Doc = *v14
-	if !easy && r.Intn(10) != 0 {
+	if this.Size_ != that1.Size_ {
+		return false
 	}
-	return this
-}
-
-func NewPopulatedCreateResponse(r randyApi, easy bool) *CreateResponse {
-	this := &CreateResponse{}
-	v15 := r.Intn(100)
-	this.Id = make(github_com_tiglabs_baudengine_proto_metapb.Key, v15)
-	for i := 0; i < v15; i++ {
-		this.Id[i] = byte(r.Intn(256))
+	if len(this.Fields) != len(that1.Fields) {
+		return false
 	}
-	this.Result = WriteResult([]int32{0, 1, 2, 3, 4}[r.Intn(5)])
-	if !easy && r.Intn(10) != 0 {
+	for i := range this.Fields {
+		if this.Fields[i] != that1.Fields[i] {
+			return false
+		}
 	}
-	return this
-}
-
-func NewPopulatedUpdateRequest(r randyApi, easy bool) *UpdateRequest {
-	this := &UpdateRequest{}
-	v16 := NewPopulatedDocument(r, easy)
-	this.Doc = *v16
-	this.Upsert = bool(bool(r.Intn(2) == 0))
-	if !easy && r.Intn(10) != 0 {
+	if this.KeepAlive != that1.KeepAlive {
+		return false
 	}
-	return this
+	if !bytes.Equal(this.After, that1.After) {
+		return false
+	}
+	return true
 }
+func (this *ScrollResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func NewPopulatedUpdateResponse(r randyApi, easy bool) *UpdateResponse {
-	this := &UpdateResponse{}
-	v17 := r.Intn(100)
-	this.Id = make(github_com_tiglabs_baudengine_proto_metapb.Key, v17)
-	for i := 0; i < v17; i++ {
-		this.Id[i] = byte(r.Intn(256))
+	that1, ok := that.(*ScrollResponse)
+	if !ok {
+		that2, ok := that.(ScrollResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	this.Result = WriteResult([]int32{0, 1, 2, 3, 4}[r.Intn(5)])
-	if !easy && r.Intn(10) != 0 {
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return this
-}
-
-func NewPopulatedDeleteRequest(r randyApi, easy bool) *DeleteRequest {
-	this := &DeleteRequest{}
-	v18 := r.Intn(100)
-	this.Id = make(github_com_tiglabs_baudengine_proto_metapb.Key, v18)
-	for i := 0; i < v18; i++ {
-		this.Id[i] = byte(r.Intn(256))
+	if !this.ResponseHeader.Equal(&that1.ResponseHeader) {
+		return false
 	}
-	if !easy && r.Intn(10) != 0 {
+	if this.ScrollId != that1.ScrollId {
+		return false
 	}
-	return this
-}
-
-func NewPopulatedDeleteResponse(r randyApi, easy bool) *DeleteResponse {
-	this := &DeleteResponse{}
-	v19 := r.Intn(100)
-	this.Id = make(github_com_tiglabs_baudengine_proto_metapb.Key, v19)
-	for i := 0; i < v19; i++ {
-		this.Id[i] = byte(r.Intn(256))
+	if len(this.Docs) != len(that1.Docs) {
+		return false
 	}
-	this.Result = WriteResult([]int32{0, 1, 2, 3, 4}[r.Intn(5)])
-	if !easy && r.Intn(10) != 0 {
+	for i := range this.Docs {
+		if !this.Docs[i].Equal(&that1.Docs[i]) {
+			return false
+		}
 	}
-	return this
+	if this.Done != that1.Done {
+		return false
+	}
+	return true
 }
+func (this *ScrollDocument) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func NewPopulatedFailure(r randyApi, easy bool) *Failure {
-	this := &Failure{}
-	v20 := r.Intn(100)
-	this.Id = make(github_com_tiglabs_baudengine_proto_metapb.Key, v20)
-	for i := 0; i < v20; i++ {
-		this.Id[i] = byte(r.Intn(256))
+	that1, ok := that.(*ScrollDocument)
+	if !ok {
+		that2, ok := that.(ScrollDocument)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	this.Cause = string(randStringApi(r))
-	this.Aborted = bool(bool(r.Intn(2) == 0))
-	if !easy && r.Intn(10) != 0 {
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return this
-}
-
-func NewPopulatedDocument(r randyApi, easy bool) *Document {
-	this := &Document{}
-	v21 := r.Intn(100)
-	this.Id = make(github_com_tiglabs_baudengine_proto_metapb.Key, v21)
-	for i := 0; i < v21; i++ {
-		this.Id[i] = byte(r.Intn(256))
+	if !bytes.Equal(this.Id, that1.Id) {
+		return false
 	}
-	if r.Intn(10) != 0 {
-		v22 := r.Intn(5)
-		this.Fields = make([]Field, v22)
-		for i := 0; i < v22; i++ {
-			v23 := NewPopulatedField(r, easy)
-			this.Fields[i] = *v23
-		}
+	if len(this.Fields) != len(that1.Fields) {
+		return false
 	}
-	if !easy && r.Intn(10) != 0 {
+	for i := range this.Fields {
+		a := this.Fields[i]
+		b := that1.Fields[i]
+		if !(&a).Equal(&b) {
+			return false
+		}
 	}
-	return this
+	return true
 }
-
-func NewPopulatedField(r randyApi, easy bool) *Field {
-	this := &Field{}
-	v24 := NewPopulatedFieldValue(r, easy)
-	this.FieldValue = *v24
-	v25 := NewPopulatedFieldDesc(r, easy)
-	this.Desc = *v25
-	if !easy && r.Intn(10) != 0 {
+func (this *ClearScrollRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return this
-}
 
-func NewPopulatedFieldValue(r randyApi, easy bool) *FieldValue {
-	this := &FieldValue{}
-	this.Id = uint32(r.Uint32())
-	this.Type = ValueType([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8}[r.Intn(9)])
-	v26 := r.Intn(100)
-	this.Data = make(github_com_tiglabs_baudengine_proto_metapb.Value, v26)
-	for i := 0; i < v26; i++ {
-		this.Data[i] = byte(r.Intn(256))
+	that1, ok := that.(*ClearScrollRequest)
+	if !ok {
+		that2, ok := that.(ClearScrollRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if !easy && r.Intn(10) != 0 {
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return this
-}
-
-func NewPopulatedFieldDesc(r randyApi, easy bool) *FieldDesc {
-	this := &FieldDesc{}
-	this.Stored = bool(bool(r.Intn(2) == 0))
-	this.Tokenized = bool(bool(r.Intn(2) == 0))
-	this.IndexOption = IndexOption([]int32{0, 1, 2, 3, 4}[r.Intn(5)])
-	this.Analyzer = string(randStringApi(r))
-	if !easy && r.Intn(10) != 0 {
+	if !this.ActionRequestHeader.Equal(&that1.ActionRequestHeader) {
+		return false
 	}
-	return this
-}
-
-type randyApi interface {
-	Float32() float32
-	Float64() float64
-	Int63() int64
-	Int31() int32
-	Uint32() uint32
-	Intn(n int) int
+	if this.ScrollId != that1.ScrollId {
+		return false
+	}
+	return true
 }
+func (this *ClearScrollResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func randUTF8RuneApi(r randyApi) rune {
-	ru := r.Intn(62)
-	if ru < 10 {
-		return rune(ru + 48)
-	} else if ru < 36 {
-		return rune(ru + 55)
+	that1, ok := that.(*ClearScrollResponse)
+	if !ok {
+		that2, ok := that.(ClearScrollResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return rune(ru + 61)
-}
-func randStringApi(r randyApi) string {
-	v27 := r.Intn(100)
-	tmps := make([]rune, v27)
-	for i := 0; i < v27; i++ {
-		tmps[i] = randUTF8RuneApi(r)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return string(tmps)
+	if !this.ResponseHeader.Equal(&that1.ResponseHeader) {
+		return false
+	}
+	return true
 }
-func randUnrecognizedApi(r randyApi, maxFieldNumber int) (dAtA []byte) {
-	l := r.Intn(5)
-	for i := 0; i < l; i++ {
-		wire := r.Intn(4)
-		if wire == 3 {
-			wire = 5
+func (this *ByQueryRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ByQueryRequest)
+	if !ok {
+		that2, ok := that.(ByQueryRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		fieldNumber := maxFieldNumber + r.Intn(100)
-		dAtA = randFieldApi(dAtA, r, fieldNumber, wire)
 	}
-	return dAtA
-}
-func randFieldApi(dAtA []byte, r randyApi, fieldNumber int, wire int) []byte {
-	key := uint32(fieldNumber)<<3 | uint32(wire)
-	switch wire {
-	case 0:
-		dAtA = encodeVarintPopulateApi(dAtA, uint64(key))
-		v28 := r.Int63()
-		if r.Intn(2) == 0 {
-			v28 *= -1
-		}
-		dAtA = encodeVarintPopulateApi(dAtA, uint64(v28))
-	case 1:
-		dAtA = encodeVarintPopulateApi(dAtA, uint64(key))
-		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
-	case 2:
-		dAtA = encodeVarintPopulateApi(dAtA, uint64(key))
-		ll := r.Intn(100)
-		dAtA = encodeVarintPopulateApi(dAtA, uint64(ll))
-		for j := 0; j < ll; j++ {
-			dAtA = append(dAtA, byte(r.Intn(256)))
-		}
-	default:
-		dAtA = encodeVarintPopulateApi(dAtA, uint64(key))
-		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA
-}
-func encodeVarintPopulateApi(dAtA []byte, v uint64) []byte {
-	for v >= 1<<7 {
-		dAtA = append(dAtA, uint8(uint64(v)&0x7f|0x80))
-		v >>= 7
+	if !this.ActionRequestHeader.Equal(&that1.ActionRequestHeader) {
+		return false
 	}
-	dAtA = append(dAtA, uint8(v))
-	return dAtA
-}
-func (m *ActionRequestHeader) Size() (n int) {
-	var l int
-	_ = l
-	l = m.RequestHeader.Size()
-	n += 1 + l + sovApi(uint64(l))
-	if m.Partition != 0 {
-		n += 1 + sovApi(uint64(m.Partition))
+	if !this.Query.Equal(&that1.Query) {
+		return false
 	}
-	return n
-}
-
-func (m *GetRequest) Size() (n int) {
-	var l int
-	_ = l
-	l = m.ActionRequestHeader.Size()
-	n += 1 + l + sovApi(uint64(l))
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+	if !bytes.Equal(this.Partial, that1.Partial) {
+		return false
 	}
-	if len(m.Fields) > 0 {
-		l = 0
-		for _, e := range m.Fields {
-			l += sovApi(uint64(e))
-		}
-		n += 1 + sovApi(uint64(l)) + l
+	if !this.Script.Equal(that1.Script) {
+		return false
 	}
-	return n
-}
-
-func (m *GetResponse) Size() (n int) {
-	var l int
-	_ = l
-	l = m.ResponseHeader.Size()
-	n += 1 + l + sovApi(uint64(l))
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+	if this.BatchSize != that1.BatchSize {
+		return false
 	}
-	if m.Found {
-		n += 2
+	if this.RequestsPerSecond != that1.RequestsPerSecond {
+		return false
 	}
-	if len(m.Fields) > 0 {
-		for k, v := range m.Fields {
-			_ = k
-			_ = v
-			l = v.Size()
-			mapEntrySize := 1 + sovApi(uint64(k)) + 1 + l + sovApi(uint64(l))
-			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
-		}
+	if this.ProceedOnConflicts != that1.ProceedOnConflicts {
+		return false
 	}
-	return n
+	return true
 }
+func (this *ByQueryResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *BulkRequest) Size() (n int) {
-	var l int
-	_ = l
-	l = m.ActionRequestHeader.Size()
-	n += 1 + l + sovApi(uint64(l))
-	if len(m.Requests) > 0 {
-		for _, e := range m.Requests {
-			l = e.Size()
-			n += 1 + l + sovApi(uint64(l))
+	that1, ok := that.(*ByQueryResponse)
+	if !ok {
+		that2, ok := that.(ByQueryResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	return n
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ResponseHeader.Equal(&that1.ResponseHeader) {
+		return false
+	}
+	if this.TaskId != that1.TaskId {
+		return false
+	}
+	return true
 }
+func (this *ByQueryTaskRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *BulkResponse) Size() (n int) {
-	var l int
-	_ = l
-	l = m.ResponseHeader.Size()
-	n += 1 + l + sovApi(uint64(l))
-	if len(m.Responses) > 0 {
-		for _, e := range m.Responses {
-			l = e.Size()
-			n += 1 + l + sovApi(uint64(l))
+	that1, ok := that.(*ByQueryTaskRequest)
+	if !ok {
+		that2, ok := that.(ByQueryTaskRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	return n
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ActionRequestHeader.Equal(&that1.ActionRequestHeader) {
+		return false
+	}
+	if this.TaskId != that1.TaskId {
+		return false
+	}
+	return true
 }
+func (this *ByQueryTaskResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *BulkItemRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.OpType != 0 {
-		n += 1 + sovApi(uint64(m.OpType))
+	that1, ok := that.(*ByQueryTaskResponse)
+	if !ok {
+		that2, ok := that.(ByQueryTaskResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.Create != nil {
-		l = m.Create.Size()
-		n += 1 + l + sovApi(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Update != nil {
-		l = m.Update.Size()
-		n += 1 + l + sovApi(uint64(l))
+	if !this.ResponseHeader.Equal(&that1.ResponseHeader) {
+		return false
 	}
-	if m.Delete != nil {
-		l = m.Delete.Size()
-		n += 1 + l + sovApi(uint64(l))
+	if !this.Status.Equal(&that1.Status) {
+		return false
 	}
-	return n
+	return true
 }
+func (this *ByQueryStatus) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *BulkItemResponse) Size() (n int) {
-	var l int
-	_ = l
-	if m.OpType != 0 {
-		n += 1 + sovApi(uint64(m.OpType))
+	that1, ok := that.(*ByQueryStatus)
+	if !ok {
+		that2, ok := that.(ByQueryStatus)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.Create != nil {
-		l = m.Create.Size()
-		n += 1 + l + sovApi(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Update != nil {
-		l = m.Update.Size()
-		n += 1 + l + sovApi(uint64(l))
+	if this.TaskId != that1.TaskId {
+		return false
 	}
-	if m.Delete != nil {
-		l = m.Delete.Size()
-		n += 1 + l + sovApi(uint64(l))
+	if this.Update != that1.Update {
+		return false
 	}
-	if m.Failure != nil {
-		l = m.Failure.Size()
-		n += 1 + l + sovApi(uint64(l))
+	if this.Total != that1.Total {
+		return false
 	}
-	return n
-}
-
-func (m *CreateRequest) Size() (n int) {
-	var l int
-	_ = l
-	l = m.Doc.Size()
-	n += 1 + l + sovApi(uint64(l))
-	return n
-}
-
-func (m *CreateResponse) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+	if this.Deleted != that1.Deleted {
+		return false
 	}
-	if m.Result != 0 {
-		n += 1 + sovApi(uint64(m.Result))
+	if this.Updated != that1.Updated {
+		return false
 	}
-	return n
-}
-
-func (m *UpdateRequest) Size() (n int) {
-	var l int
-	_ = l
-	l = m.Doc.Size()
-	n += 1 + l + sovApi(uint64(l))
-	if m.Upsert {
-		n += 2
+	if this.Noops != that1.Noops {
+		return false
 	}
-	return n
-}
-
-func (m *UpdateResponse) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+	if this.VersionConflicts != that1.VersionConflicts {
+		return false
 	}
-	if m.Result != 0 {
-		n += 1 + sovApi(uint64(m.Result))
+	if this.Failures != that1.Failures {
+		return false
 	}
-	return n
-}
-
-func (m *DeleteRequest) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+	if this.Batches != that1.Batches {
+		return false
 	}
-	return n
-}
-
-func (m *DeleteResponse) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+	if this.Done != that1.Done {
+		return false
 	}
-	if m.Result != 0 {
-		n += 1 + sovApi(uint64(m.Result))
+	if this.Canceled != that1.Canceled {
+		return false
 	}
-	return n
-}
-
-func (m *Failure) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+	if this.Error != that1.Error {
+		return false
 	}
-	l = len(m.Cause)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+	if this.StartTime != that1.StartTime {
+		return false
 	}
-	if m.Aborted {
-		n += 2
+	if this.EndTime != that1.EndTime {
+		return false
 	}
-	return n
+	return true
 }
-
-func (m *Document) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+func (this *SortField) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if len(m.Fields) > 0 {
-		for _, e := range m.Fields {
-			l = e.Size()
-			n += 1 + l + sovApi(uint64(l))
+
+	that1, ok := that.(*SortField)
+	if !ok {
+		that2, ok := that.(SortField)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	return n
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Field != that1.Field {
+		return false
+	}
+	if this.Reverse != that1.Reverse {
+		return false
+	}
+	if !this.GeoDistance.Equal(that1.GeoDistance) {
+		return false
+	}
+	return true
 }
+func (this *Query) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Field) Size() (n int) {
-	var l int
-	_ = l
-	l = m.FieldValue.Size()
-	n += 1 + l + sovApi(uint64(l))
-	l = m.Desc.Size()
-	n += 1 + l + sovApi(uint64(l))
-	return n
+	that1, ok := that.(*Query)
+	if !ok {
+		that2, ok := that.(Query)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Term.Equal(that1.Term) {
+		return false
+	}
+	if !this.Terms.Equal(that1.Terms) {
+		return false
+	}
+	if !this.MatchAll.Equal(that1.MatchAll) {
+		return false
+	}
+	if !this.Bool.Equal(that1.Bool) {
+		return false
+	}
+	if !this.Phrase.Equal(that1.Phrase) {
+		return false
+	}
+	if !this.Range.Equal(that1.Range) {
+		return false
+	}
+	if !this.Prefix.Equal(that1.Prefix) {
+		return false
+	}
+	if !this.Wildcard.Equal(that1.Wildcard) {
+		return false
+	}
+	if !this.Regexp.Equal(that1.Regexp) {
+		return false
+	}
+	if !this.Fuzzy.Equal(that1.Fuzzy) {
+		return false
+	}
+	if !this.GeoBoundingBox.Equal(that1.GeoBoundingBox) {
+		return false
+	}
+	if !this.GeoDistance.Equal(that1.GeoDistance) {
+		return false
+	}
+	if !this.GeoPolygon.Equal(that1.GeoPolygon) {
+		return false
+	}
+	if !this.Nested.Equal(that1.Nested) {
+		return false
+	}
+	return true
 }
+func (this *TermQuery) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *FieldValue) Size() (n int) {
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovApi(uint64(m.Id))
+	that1, ok := that.(*TermQuery)
+	if !ok {
+		that2, ok := that.(TermQuery)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.Type != 0 {
-		n += 1 + sovApi(uint64(m.Type))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+	if this.Field != that1.Field {
+		return false
 	}
-	return n
+	if !bytes.Equal(this.Term, that1.Term) {
+		return false
+	}
+	return true
 }
+func (this *TermsQuery) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *FieldDesc) Size() (n int) {
-	var l int
-	_ = l
-	if m.Stored {
-		n += 2
+	that1, ok := that.(*TermsQuery)
+	if !ok {
+		that2, ok := that.(TermsQuery)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.Tokenized {
-		n += 2
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.IndexOption != 0 {
-		n += 1 + sovApi(uint64(m.IndexOption))
+	if this.Field != that1.Field {
+		return false
 	}
-	l = len(m.Analyzer)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+	if len(this.Terms) != len(that1.Terms) {
+		return false
 	}
-	return n
+	for i := range this.Terms {
+		if !bytes.Equal(this.Terms[i], that1.Terms[i]) {
+			return false
+		}
+	}
+	return true
 }
+func (this *PhraseQuery) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func sovApi(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
+	that1, ok := that.(*PhraseQuery)
+	if !ok {
+		that2, ok := that.(PhraseQuery)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	return n
-}
-func sozApi(x uint64) (n int) {
-	return sovApi(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (this *ActionRequestHeader) String() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := strings.Join([]string{`&ActionRequestHeader{`,
-		`RequestHeader:` + strings.Replace(strings.Replace(this.RequestHeader.String(), "RequestHeader", "meta.RequestHeader", 1), `&`, ``, 1) + `,`,
-		`Partition:` + fmt.Sprintf("%v", this.Partition) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *GetRequest) String() string {
-	if this == nil {
-		return "nil"
+	if this.Field != that1.Field {
+		return false
 	}
-	s := strings.Join([]string{`&GetRequest{`,
-		`ActionRequestHeader:` + strings.Replace(strings.Replace(this.ActionRequestHeader.String(), "ActionRequestHeader", "ActionRequestHeader", 1), `&`, ``, 1) + `,`,
-		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
-		`Fields:` + fmt.Sprintf("%v", this.Fields) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *GetResponse) String() string {
-	if this == nil {
-		return "nil"
+	if len(this.Terms) != len(that1.Terms) {
+		return false
 	}
-	keysForFields := make([]uint32, 0, len(this.Fields))
-	for k, _ := range this.Fields {
-		keysForFields = append(keysForFields, k)
+	for i := range this.Terms {
+		if !bytes.Equal(this.Terms[i], that1.Terms[i]) {
+			return false
+		}
 	}
-	sortkeys.Uint32s(keysForFields)
-	mapStringForFields := "map[uint32]FieldValue{"
-	for _, k := range keysForFields {
-		mapStringForFields += fmt.Sprintf("%v: %v,", k, this.Fields[k])
+	if this.Slop != that1.Slop {
+		return false
 	}
-	mapStringForFields += "}"
-	s := strings.Join([]string{`&GetResponse{`,
-		`ResponseHeader:` + strings.Replace(strings.Replace(this.ResponseHeader.String(), "ResponseHeader", "meta.ResponseHeader", 1), `&`, ``, 1) + `,`,
-		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
-		`Found:` + fmt.Sprintf("%v", this.Found) + `,`,
-		`Fields:` + mapStringForFields + `,`,
-		`}`,
-	}, "")
-	return s
+	return true
 }
-func (this *BulkRequest) String() string {
-	if this == nil {
-		return "nil"
+func (this *RangeQuery) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := strings.Join([]string{`&BulkRequest{`,
-		`ActionRequestHeader:` + strings.Replace(strings.Replace(this.ActionRequestHeader.String(), "ActionRequestHeader", "ActionRequestHeader", 1), `&`, ``, 1) + `,`,
-		`Requests:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Requests), "BulkItemRequest", "BulkItemRequest", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *BulkResponse) String() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*RangeQuery)
+	if !ok {
+		that2, ok := that.(RangeQuery)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := strings.Join([]string{`&BulkResponse{`,
-		`ResponseHeader:` + strings.Replace(strings.Replace(this.ResponseHeader.String(), "ResponseHeader", "meta.ResponseHeader", 1), `&`, ``, 1) + `,`,
-		`Responses:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Responses), "BulkItemResponse", "BulkItemResponse", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *BulkItemRequest) String() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := strings.Join([]string{`&BulkItemRequest{`,
-		`OpType:` + fmt.Sprintf("%v", this.OpType) + `,`,
-		`Create:` + strings.Replace(fmt.Sprintf("%v", this.Create), "CreateRequest", "CreateRequest", 1) + `,`,
-		`Update:` + strings.Replace(fmt.Sprintf("%v", this.Update), "UpdateRequest", "UpdateRequest", 1) + `,`,
-		`Delete:` + strings.Replace(fmt.Sprintf("%v", this.Delete), "DeleteRequest", "DeleteRequest", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *BulkItemResponse) String() string {
-	if this == nil {
-		return "nil"
+	if this.Field != that1.Field {
+		return false
 	}
-	s := strings.Join([]string{`&BulkItemResponse{`,
-		`OpType:` + fmt.Sprintf("%v", this.OpType) + `,`,
-		`Create:` + strings.Replace(fmt.Sprintf("%v", this.Create), "CreateResponse", "CreateResponse", 1) + `,`,
-		`Update:` + strings.Replace(fmt.Sprintf("%v", this.Update), "UpdateResponse", "UpdateResponse", 1) + `,`,
-		`Delete:` + strings.Replace(fmt.Sprintf("%v", this.Delete), "DeleteResponse", "DeleteResponse", 1) + `,`,
-		`Failure:` + strings.Replace(fmt.Sprintf("%v", this.Failure), "Failure", "Failure", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *CreateRequest) String() string {
-	if this == nil {
-		return "nil"
+	if !bytes.Equal(this.Gt, that1.Gt) {
+		return false
 	}
-	s := strings.Join([]string{`&CreateRequest{`,
-		`Doc:` + strings.Replace(strings.Replace(this.Doc.String(), "Document", "Document", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *CreateResponse) String() string {
-	if this == nil {
-		return "nil"
+	if !bytes.Equal(this.Gte, that1.Gte) {
+		return false
 	}
-	s := strings.Join([]string{`&CreateResponse{`,
-		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
-		`Result:` + fmt.Sprintf("%v", this.Result) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *UpdateRequest) String() string {
-	if this == nil {
-		return "nil"
+	if !bytes.Equal(this.Lt, that1.Lt) {
+		return false
 	}
-	s := strings.Join([]string{`&UpdateRequest{`,
-		`Doc:` + strings.Replace(strings.Replace(this.Doc.String(), "Document", "Document", 1), `&`, ``, 1) + `,`,
-		`Upsert:` + fmt.Sprintf("%v", this.Upsert) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *UpdateResponse) String() string {
-	if this == nil {
-		return "nil"
+	if !bytes.Equal(this.Lte, that1.Lte) {
+		return false
 	}
-	s := strings.Join([]string{`&UpdateResponse{`,
-		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
-		`Result:` + fmt.Sprintf("%v", this.Result) + `,`,
-		`}`,
-	}, "")
-	return s
+	return true
 }
-func (this *DeleteRequest) String() string {
-	if this == nil {
-		return "nil"
+func (this *PrefixQuery) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := strings.Join([]string{`&DeleteRequest{`,
-		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *DeleteResponse) String() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*PrefixQuery)
+	if !ok {
+		that2, ok := that.(PrefixQuery)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := strings.Join([]string{`&DeleteResponse{`,
-		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
-		`Result:` + fmt.Sprintf("%v", this.Result) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Failure) String() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := strings.Join([]string{`&Failure{`,
-		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
-		`Cause:` + fmt.Sprintf("%v", this.Cause) + `,`,
-		`Aborted:` + fmt.Sprintf("%v", this.Aborted) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Document) String() string {
-	if this == nil {
-		return "nil"
+	if this.Field != that1.Field {
+		return false
 	}
-	s := strings.Join([]string{`&Document{`,
-		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
-		`Fields:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Fields), "Field", "Field", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Field) String() string {
-	if this == nil {
-		return "nil"
+	if !bytes.Equal(this.Prefix, that1.Prefix) {
+		return false
 	}
-	s := strings.Join([]string{`&Field{`,
-		`FieldValue:` + strings.Replace(strings.Replace(this.FieldValue.String(), "FieldValue", "FieldValue", 1), `&`, ``, 1) + `,`,
-		`Desc:` + strings.Replace(strings.Replace(this.Desc.String(), "FieldDesc", "FieldDesc", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *FieldValue) String() string {
-	if this == nil {
-		return "nil"
+	if this.MaxExpansions != that1.MaxExpansions {
+		return false
 	}
-	s := strings.Join([]string{`&FieldValue{`,
-		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
-		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
-		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
-		`}`,
-	}, "")
-	return s
+	return true
 }
-func (this *FieldDesc) String() string {
-	if this == nil {
-		return "nil"
+func (this *WildcardQuery) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := strings.Join([]string{`&FieldDesc{`,
-		`Stored:` + fmt.Sprintf("%v", this.Stored) + `,`,
-		`Tokenized:` + fmt.Sprintf("%v", this.Tokenized) + `,`,
-		`IndexOption:` + fmt.Sprintf("%v", this.IndexOption) + `,`,
-		`Analyzer:` + fmt.Sprintf("%v", this.Analyzer) + `,`,
-		`}`,
-	}, "")
-	return s
+
+	that1, ok := that.(*WildcardQuery)
+	if !ok {
+		that2, ok := that.(WildcardQuery)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Field != that1.Field {
+		return false
+	}
+	if this.Pattern != that1.Pattern {
+		return false
+	}
+	if this.MaxExpansions != that1.MaxExpansions {
+		return false
+	}
+	return true
 }
-func valueToStringApi(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+func (this *RegexpQuery) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+
+	that1, ok := that.(*RegexpQuery)
+	if !ok {
+		that2, ok := that.(RegexpQuery)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Field != that1.Field {
+		return false
+	}
+	if this.Pattern != that1.Pattern {
+		return false
+	}
+	if this.MaxExpansions != that1.MaxExpansions {
+		return false
+	}
+	return true
 }
-func (m *ActionRequestHeader) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApi
+func (this *FuzzyQuery) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FuzzyQuery)
+	if !ok {
+		that2, ok := that.(FuzzyQuery)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Field != that1.Field {
+		return false
+	}
+	if !bytes.Equal(this.Term, that1.Term) {
+		return false
+	}
+	if this.MaxEdits != that1.MaxEdits {
+		return false
+	}
+	if this.PrefixLength != that1.PrefixLength {
+		return false
+	}
+	if this.MaxExpansions != that1.MaxExpansions {
+		return false
+	}
+	return true
+}
+func (this *GeoPoint) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GeoPoint)
+	if !ok {
+		that2, ok := that.(GeoPoint)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Lat != that1.Lat {
+		return false
+	}
+	if this.Lon != that1.Lon {
+		return false
+	}
+	return true
+}
+func (this *GeoBoundingBoxQuery) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GeoBoundingBoxQuery)
+	if !ok {
+		that2, ok := that.(GeoBoundingBoxQuery)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Field != that1.Field {
+		return false
+	}
+	if !this.TopLeft.Equal(that1.TopLeft) {
+		return false
+	}
+	if !this.BottomRight.Equal(that1.BottomRight) {
+		return false
+	}
+	return true
+}
+func (this *GeoDistanceQuery) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GeoDistanceQuery)
+	if !ok {
+		that2, ok := that.(GeoDistanceQuery)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Field != that1.Field {
+		return false
+	}
+	if !this.Origin.Equal(that1.Origin) {
+		return false
+	}
+	if this.Distance != that1.Distance {
+		return false
+	}
+	return true
+}
+func (this *GeoPolygonQuery) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GeoPolygonQuery)
+	if !ok {
+		that2, ok := that.(GeoPolygonQuery)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Field != that1.Field {
+		return false
+	}
+	if len(this.Points) != len(that1.Points) {
+		return false
+	}
+	for i := range this.Points {
+		if !this.Points[i].Equal(that1.Points[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *MatchAllQuery) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MatchAllQuery)
+	if !ok {
+		that2, ok := that.(MatchAllQuery)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *NestedQuery) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*NestedQuery)
+	if !ok {
+		that2, ok := that.(NestedQuery)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Field != that1.Field {
+		return false
+	}
+	if !this.Query.Equal(&that1.Query) {
+		return false
+	}
+	if this.ScoreMode != that1.ScoreMode {
+		return false
+	}
+	if this.InnerHits != that1.InnerHits {
+		return false
+	}
+	if this.InnerHitsSize != that1.InnerHitsSize {
+		return false
+	}
+	return true
+}
+func (this *BoolQuery) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BoolQuery)
+	if !ok {
+		that2, ok := that.(BoolQuery)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Must) != len(that1.Must) {
+		return false
+	}
+	for i := range this.Must {
+		if !this.Must[i].Equal(&that1.Must[i]) {
+			return false
+		}
+	}
+	if len(this.Should) != len(that1.Should) {
+		return false
+	}
+	for i := range this.Should {
+		if !this.Should[i].Equal(&that1.Should[i]) {
+			return false
+		}
+	}
+	if len(this.MustNot) != len(that1.MustNot) {
+		return false
+	}
+	for i := range this.MustNot {
+		if !this.MustNot[i].Equal(&that1.MustNot[i]) {
+			return false
+		}
+	}
+	if this.MinShould != that1.MinShould {
+		return false
+	}
+	return true
+}
+func (this *Document) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Document)
+	if !ok {
+		that2, ok := that.(Document)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Id, that1.Id) {
+		return false
+	}
+	if len(this.Fields) != len(that1.Fields) {
+		return false
+	}
+	for i := range this.Fields {
+		if !this.Fields[i].Equal(&that1.Fields[i]) {
+			return false
+		}
+	}
+	if len(this.Nested) != len(that1.Nested) {
+		return false
+	}
+	for i := range this.Nested {
+		if !this.Nested[i].Equal(&that1.Nested[i]) {
+			return false
+		}
+	}
+	if this.ExpireAt != that1.ExpireAt {
+		return false
+	}
+	return true
+}
+func (this *NestedDocument) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*NestedDocument)
+	if !ok {
+		that2, ok := that.(NestedDocument)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Parent, that1.Parent) {
+		return false
+	}
+	if this.Field != that1.Field {
+		return false
+	}
+	if this.Offset != that1.Offset {
+		return false
+	}
+	if len(this.Fields) != len(that1.Fields) {
+		return false
+	}
+	for i := range this.Fields {
+		if !this.Fields[i].Equal(&that1.Fields[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *Field) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Field)
+	if !ok {
+		that2, ok := that.(Field)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.FieldValue.Equal(&that1.FieldValue) {
+		return false
+	}
+	if !this.Desc.Equal(&that1.Desc) {
+		return false
+	}
+	return true
+}
+func (this *FieldValue) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FieldValue)
+	if !ok {
+		that2, ok := that.(FieldValue)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if !bytes.Equal(this.Data, that1.Data) {
+		return false
+	}
+	return true
+}
+func (this *FieldDesc) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FieldDesc)
+	if !ok {
+		that2, ok := that.(FieldDesc)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Stored != that1.Stored {
+		return false
+	}
+	if this.Tokenized != that1.Tokenized {
+		return false
+	}
+	if this.IndexOption != that1.IndexOption {
+		return false
+	}
+	if this.Analyzer != that1.Analyzer {
+		return false
+	}
+	if this.DocValues != that1.DocValues {
+		return false
+	}
+	return true
+}
+func (this *Aggregation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Aggregation)
+	if !ok {
+		that2, ok := that.(Aggregation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Terms.Equal(that1.Terms) {
+		return false
+	}
+	if !this.Histogram.Equal(that1.Histogram) {
+		return false
+	}
+	if !this.DateHistogram.Equal(that1.DateHistogram) {
+		return false
+	}
+	if !this.Range.Equal(that1.Range) {
+		return false
+	}
+	if !this.Min.Equal(that1.Min) {
+		return false
+	}
+	if !this.Max.Equal(that1.Max) {
+		return false
+	}
+	if !this.Avg.Equal(that1.Avg) {
+		return false
+	}
+	if !this.Sum.Equal(that1.Sum) {
+		return false
+	}
+	if !this.Stats.Equal(that1.Stats) {
+		return false
+	}
+	if !this.Cardinality.Equal(that1.Cardinality) {
+		return false
+	}
+	return true
+}
+func (this *TermsAggregation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TermsAggregation)
+	if !ok {
+		that2, ok := that.(TermsAggregation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Field != that1.Field {
+		return false
+	}
+	if this.Size_ != that1.Size_ {
+		return false
+	}
+	if this.ShardSize != that1.ShardSize {
+		return false
+	}
+	if len(this.Aggregations) != len(that1.Aggregations) {
+		return false
+	}
+	for i := range this.Aggregations {
+		a := this.Aggregations[i]
+		b := that1.Aggregations[i]
+		if !(&a).Equal(&b) {
+			return false
+		}
+	}
+	return true
+}
+func (this *HistogramAggregation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*HistogramAggregation)
+	if !ok {
+		that2, ok := that.(HistogramAggregation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Field != that1.Field {
+		return false
+	}
+	if this.Interval != that1.Interval {
+		return false
+	}
+	if len(this.Aggregations) != len(that1.Aggregations) {
+		return false
+	}
+	for i := range this.Aggregations {
+		a := this.Aggregations[i]
+		b := that1.Aggregations[i]
+		if !(&a).Equal(&b) {
+			return false
+		}
+	}
+	return true
+}
+func (this *DateHistogramAggregation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DateHistogramAggregation)
+	if !ok {
+		that2, ok := that.(DateHistogramAggregation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Field != that1.Field {
+		return false
+	}
+	if this.Interval != that1.Interval {
+		return false
+	}
+	if len(this.Aggregations) != len(that1.Aggregations) {
+		return false
+	}
+	for i := range this.Aggregations {
+		a := this.Aggregations[i]
+		b := that1.Aggregations[i]
+		if !(&a).Equal(&b) {
+			return false
+		}
+	}
+	return true
+}
+func (this *RangeAggregation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RangeAggregation)
+	if !ok {
+		that2, ok := that.(RangeAggregation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Field != that1.Field {
+		return false
+	}
+	if len(this.Ranges) != len(that1.Ranges) {
+		return false
+	}
+	for i := range this.Ranges {
+		if !this.Ranges[i].Equal(&that1.Ranges[i]) {
+			return false
+		}
+	}
+	if len(this.Aggregations) != len(that1.Aggregations) {
+		return false
+	}
+	for i := range this.Aggregations {
+		a := this.Aggregations[i]
+		b := that1.Aggregations[i]
+		if !(&a).Equal(&b) {
+			return false
+		}
+	}
+	return true
+}
+func (this *AggregationRange) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AggregationRange)
+	if !ok {
+		that2, ok := that.(AggregationRange)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Key != that1.Key {
+		return false
+	}
+	if !bytes.Equal(this.From, that1.From) {
+		return false
+	}
+	if !bytes.Equal(this.To, that1.To) {
+		return false
+	}
+	return true
+}
+func (this *MinAggregation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MinAggregation)
+	if !ok {
+		that2, ok := that.(MinAggregation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Field != that1.Field {
+		return false
+	}
+	return true
+}
+func (this *MaxAggregation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MaxAggregation)
+	if !ok {
+		that2, ok := that.(MaxAggregation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Field != that1.Field {
+		return false
+	}
+	return true
+}
+func (this *AvgAggregation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AvgAggregation)
+	if !ok {
+		that2, ok := that.(AvgAggregation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Field != that1.Field {
+		return false
+	}
+	return true
+}
+func (this *SumAggregation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SumAggregation)
+	if !ok {
+		that2, ok := that.(SumAggregation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Field != that1.Field {
+		return false
+	}
+	return true
+}
+func (this *StatsAggregation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*StatsAggregation)
+	if !ok {
+		that2, ok := that.(StatsAggregation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Field != that1.Field {
+		return false
+	}
+	return true
+}
+func (this *CardinalityAggregation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CardinalityAggregation)
+	if !ok {
+		that2, ok := that.(CardinalityAggregation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Field != that1.Field {
+		return false
+	}
+	if this.Precision != that1.Precision {
+		return false
+	}
+	return true
+}
+func (this *AggregationResult) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AggregationResult)
+	if !ok {
+		that2, ok := that.(AggregationResult)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Buckets) != len(that1.Buckets) {
+		return false
+	}
+	for i := range this.Buckets {
+		if !this.Buckets[i].Equal(&that1.Buckets[i]) {
+			return false
+		}
+	}
+	if !this.Stats.Equal(that1.Stats) {
+		return false
+	}
+	if !bytes.Equal(this.Cardinality, that1.Cardinality) {
+		return false
+	}
+	return true
+}
+func (this *AggregationBucket) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AggregationBucket)
+	if !ok {
+		that2, ok := that.(AggregationBucket)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Key, that1.Key) {
+		return false
+	}
+	if this.DocCount != that1.DocCount {
+		return false
+	}
+	if len(this.Aggregations) != len(that1.Aggregations) {
+		return false
+	}
+	for i := range this.Aggregations {
+		a := this.Aggregations[i]
+		b := that1.Aggregations[i]
+		if !(&a).Equal(&b) {
+			return false
+		}
+	}
+	return true
+}
+func (this *StatsResult) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*StatsResult)
+	if !ok {
+		that2, ok := that.(StatsResult)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Count != that1.Count {
+		return false
+	}
+	if this.Sum != that1.Sum {
+		return false
+	}
+	if this.Min != that1.Min {
+		return false
+	}
+	if this.Max != that1.Max {
+		return false
+	}
+	return true
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for ApiGrpc service
+
+type ApiGrpcClient interface {
+	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error)
+	BulkWrite(ctx context.Context, in *BulkRequest, opts ...grpc.CallOption) (*BulkResponse, error)
+	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+	SearchStatistics(ctx context.Context, in *SearchStatisticsRequest, opts ...grpc.CallOption) (*SearchStatisticsResponse, error)
+	Analyze(ctx context.Context, in *AnalyzeRequest, opts ...grpc.CallOption) (*AnalyzeResponse, error)
+	TermVectors(ctx context.Context, in *TermVectorsRequest, opts ...grpc.CallOption) (*TermVectorsResponse, error)
+	Scroll(ctx context.Context, in *ScrollRequest, opts ...grpc.CallOption) (*ScrollResponse, error)
+	ClearScroll(ctx context.Context, in *ClearScrollRequest, opts ...grpc.CallOption) (*ClearScrollResponse, error)
+	DeleteByQuery(ctx context.Context, in *ByQueryRequest, opts ...grpc.CallOption) (*ByQueryResponse, error)
+	UpdateByQuery(ctx context.Context, in *ByQueryRequest, opts ...grpc.CallOption) (*ByQueryResponse, error)
+	GetByQueryTask(ctx context.Context, in *ByQueryTaskRequest, opts ...grpc.CallOption) (*ByQueryTaskResponse, error)
+	CancelByQueryTask(ctx context.Context, in *ByQueryTaskRequest, opts ...grpc.CallOption) (*ByQueryTaskResponse, error)
+}
+
+type apiGrpcClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewApiGrpcClient(cc *grpc.ClientConn) ApiGrpcClient {
+	return &apiGrpcClient{cc}
+}
+
+func (c *apiGrpcClient) Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error) {
+	out := new(GetResponse)
+	err := grpc.Invoke(ctx, "/ApiGrpc/Get", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiGrpcClient) BulkWrite(ctx context.Context, in *BulkRequest, opts ...grpc.CallOption) (*BulkResponse, error) {
+	out := new(BulkResponse)
+	err := grpc.Invoke(ctx, "/ApiGrpc/BulkWrite", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiGrpcClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	out := new(SearchResponse)
+	err := grpc.Invoke(ctx, "/ApiGrpc/Search", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiGrpcClient) SearchStatistics(ctx context.Context, in *SearchStatisticsRequest, opts ...grpc.CallOption) (*SearchStatisticsResponse, error) {
+	out := new(SearchStatisticsResponse)
+	err := grpc.Invoke(ctx, "/ApiGrpc/SearchStatistics", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiGrpcClient) Analyze(ctx context.Context, in *AnalyzeRequest, opts ...grpc.CallOption) (*AnalyzeResponse, error) {
+	out := new(AnalyzeResponse)
+	err := grpc.Invoke(ctx, "/ApiGrpc/Analyze", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiGrpcClient) TermVectors(ctx context.Context, in *TermVectorsRequest, opts ...grpc.CallOption) (*TermVectorsResponse, error) {
+	out := new(TermVectorsResponse)
+	err := grpc.Invoke(ctx, "/ApiGrpc/TermVectors", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiGrpcClient) Scroll(ctx context.Context, in *ScrollRequest, opts ...grpc.CallOption) (*ScrollResponse, error) {
+	out := new(ScrollResponse)
+	err := grpc.Invoke(ctx, "/ApiGrpc/Scroll", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiGrpcClient) ClearScroll(ctx context.Context, in *ClearScrollRequest, opts ...grpc.CallOption) (*ClearScrollResponse, error) {
+	out := new(ClearScrollResponse)
+	err := grpc.Invoke(ctx, "/ApiGrpc/ClearScroll", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiGrpcClient) DeleteByQuery(ctx context.Context, in *ByQueryRequest, opts ...grpc.CallOption) (*ByQueryResponse, error) {
+	out := new(ByQueryResponse)
+	err := grpc.Invoke(ctx, "/ApiGrpc/DeleteByQuery", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiGrpcClient) UpdateByQuery(ctx context.Context, in *ByQueryRequest, opts ...grpc.CallOption) (*ByQueryResponse, error) {
+	out := new(ByQueryResponse)
+	err := grpc.Invoke(ctx, "/ApiGrpc/UpdateByQuery", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiGrpcClient) GetByQueryTask(ctx context.Context, in *ByQueryTaskRequest, opts ...grpc.CallOption) (*ByQueryTaskResponse, error) {
+	out := new(ByQueryTaskResponse)
+	err := grpc.Invoke(ctx, "/ApiGrpc/GetByQueryTask", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiGrpcClient) CancelByQueryTask(ctx context.Context, in *ByQueryTaskRequest, opts ...grpc.CallOption) (*ByQueryTaskResponse, error) {
+	out := new(ByQueryTaskResponse)
+	err := grpc.Invoke(ctx, "/ApiGrpc/CancelByQueryTask", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for ApiGrpc service
+
+type ApiGrpcServer interface {
+	Get(context.Context, *GetRequest) (*GetResponse, error)
+	BulkWrite(context.Context, *BulkRequest) (*BulkResponse, error)
+	Search(context.Context, *SearchRequest) (*SearchResponse, error)
+	SearchStatistics(context.Context, *SearchStatisticsRequest) (*SearchStatisticsResponse, error)
+	Analyze(context.Context, *AnalyzeRequest) (*AnalyzeResponse, error)
+	TermVectors(context.Context, *TermVectorsRequest) (*TermVectorsResponse, error)
+	Scroll(context.Context, *ScrollRequest) (*ScrollResponse, error)
+	ClearScroll(context.Context, *ClearScrollRequest) (*ClearScrollResponse, error)
+	DeleteByQuery(context.Context, *ByQueryRequest) (*ByQueryResponse, error)
+	UpdateByQuery(context.Context, *ByQueryRequest) (*ByQueryResponse, error)
+	GetByQueryTask(context.Context, *ByQueryTaskRequest) (*ByQueryTaskResponse, error)
+	CancelByQueryTask(context.Context, *ByQueryTaskRequest) (*ByQueryTaskResponse, error)
+}
+
+func RegisterApiGrpcServer(s *grpc.Server, srv ApiGrpcServer) {
+	s.RegisterService(&_ApiGrpc_serviceDesc, srv)
+}
+
+func _ApiGrpc_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiGrpcServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ApiGrpc/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiGrpcServer).Get(ctx, req.(*GetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiGrpc_BulkWrite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiGrpcServer).BulkWrite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ApiGrpc/BulkWrite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiGrpcServer).BulkWrite(ctx, req.(*BulkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiGrpc_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiGrpcServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ApiGrpc/Search",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiGrpcServer).Search(ctx, req.(*SearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiGrpc_SearchStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchStatisticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiGrpcServer).SearchStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ApiGrpc/SearchStatistics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiGrpcServer).SearchStatistics(ctx, req.(*SearchStatisticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiGrpc_Analyze_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnalyzeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiGrpcServer).Analyze(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ApiGrpc/Analyze",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiGrpcServer).Analyze(ctx, req.(*AnalyzeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiGrpc_TermVectors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TermVectorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiGrpcServer).TermVectors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ApiGrpc/TermVectors",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiGrpcServer).TermVectors(ctx, req.(*TermVectorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiGrpc_Scroll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScrollRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiGrpcServer).Scroll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ApiGrpc/Scroll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiGrpcServer).Scroll(ctx, req.(*ScrollRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiGrpc_ClearScroll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearScrollRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiGrpcServer).ClearScroll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ApiGrpc/ClearScroll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiGrpcServer).ClearScroll(ctx, req.(*ClearScrollRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiGrpc_DeleteByQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ByQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiGrpcServer).DeleteByQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ApiGrpc/DeleteByQuery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiGrpcServer).DeleteByQuery(ctx, req.(*ByQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiGrpc_UpdateByQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ByQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiGrpcServer).UpdateByQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ApiGrpc/UpdateByQuery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiGrpcServer).UpdateByQuery(ctx, req.(*ByQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiGrpc_GetByQueryTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ByQueryTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiGrpcServer).GetByQueryTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ApiGrpc/GetByQueryTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiGrpcServer).GetByQueryTask(ctx, req.(*ByQueryTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiGrpc_CancelByQueryTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ByQueryTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiGrpcServer).CancelByQueryTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ApiGrpc/CancelByQueryTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiGrpcServer).CancelByQueryTask(ctx, req.(*ByQueryTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ApiGrpc_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ApiGrpc",
+	HandlerType: (*ApiGrpcServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Get",
+			Handler:    _ApiGrpc_Get_Handler,
+		},
+		{
+			MethodName: "BulkWrite",
+			Handler:    _ApiGrpc_BulkWrite_Handler,
+		},
+		{
+			MethodName: "Search",
+			Handler:    _ApiGrpc_Search_Handler,
+		},
+		{
+			MethodName: "SearchStatistics",
+			Handler:    _ApiGrpc_SearchStatistics_Handler,
+		},
+		{
+			MethodName: "Analyze",
+			Handler:    _ApiGrpc_Analyze_Handler,
+		},
+		{
+			MethodName: "TermVectors",
+			Handler:    _ApiGrpc_TermVectors_Handler,
+		},
+		{
+			MethodName: "Scroll",
+			Handler:    _ApiGrpc_Scroll_Handler,
+		},
+		{
+			MethodName: "ClearScroll",
+			Handler:    _ApiGrpc_ClearScroll_Handler,
+		},
+		{
+			MethodName: "DeleteByQuery",
+			Handler:    _ApiGrpc_DeleteByQuery_Handler,
+		},
+		{
+			MethodName: "UpdateByQuery",
+			Handler:    _ApiGrpc_UpdateByQuery_Handler,
+		},
+		{
+			MethodName: "GetByQueryTask",
+			Handler:    _ApiGrpc_GetByQueryTask_Handler,
+		},
+		{
+			MethodName: "CancelByQueryTask",
+			Handler:    _ApiGrpc_CancelByQueryTask_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api.proto",
+}
+
+func (m *ActionRequestHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ActionRequestHeader) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApi(dAtA, i, uint64(m.RequestHeader.Size()))
+	n1, err := m.RequestHeader.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n1
+	if m.Partition != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Partition))
+	}
+	return i, nil
+}
+
+func (m *GetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApi(dAtA, i, uint64(m.ActionRequestHeader.Size()))
+	n2, err := m.ActionRequestHeader.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n2
+	if len(m.Id) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if len(m.Fields) > 0 {
+		dAtA4 := make([]byte, len(m.Fields)*10)
+		var j3 int
+		for _, num := range m.Fields {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(j3))
+		i += copy(dAtA[i:], dAtA4[:j3])
+	}
+	return i, nil
+}
+
+func (m *GetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApi(dAtA, i, uint64(m.ResponseHeader.Size()))
+	n5, err := m.ResponseHeader.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n5
+	if len(m.Id) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if m.Found {
+		dAtA[i] = 0x18
+		i++
+		if m.Found {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Fields) > 0 {
+		for k, _ := range m.Fields {
+			dAtA[i] = 0x22
+			i++
+			v := m.Fields[k]
+			msgSize := 0
+			if (&v) != nil {
+				msgSize = (&v).Size()
+				msgSize += 1 + sovApi(uint64(msgSize))
+			}
+			mapSize := 1 + sovApi(uint64(k)) + msgSize
+			i = encodeVarintApi(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0x8
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(k))
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintApi(dAtA, i, uint64((&v).Size()))
+			n6, err := (&v).MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n6
+		}
+	}
+	if m.Version != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Version))
+	}
+	if m.SeqNo != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.SeqNo))
+	}
+	return i, nil
+}
+
+func (m *BulkRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BulkRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApi(dAtA, i, uint64(m.ActionRequestHeader.Size()))
+	n7, err := m.ActionRequestHeader.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n7
+	if len(m.Requests) > 0 {
+		for _, msg := range m.Requests {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *BulkResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BulkResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApi(dAtA, i, uint64(m.ResponseHeader.Size()))
+	n8, err := m.ResponseHeader.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n8
+	if len(m.Responses) > 0 {
+		for _, msg := range m.Responses {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *BulkItemRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BulkItemRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.OpType != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.OpType))
+	}
+	if m.Create != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Create.Size()))
+		n9, err := m.Create.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n9
+	}
+	if m.Update != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Update.Size()))
+		n10, err := m.Update.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
+	}
+	if m.Delete != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Delete.Size()))
+		n11, err := m.Delete.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n11
+	}
+	return i, nil
+}
+
+func (m *BulkItemResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BulkItemResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.OpType != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.OpType))
+	}
+	if m.Create != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Create.Size()))
+		n12, err := m.Create.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n12
+	}
+	if m.Update != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Update.Size()))
+		n13, err := m.Update.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n13
+	}
+	if m.Delete != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Delete.Size()))
+		n14, err := m.Delete.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n14
+	}
+	if m.Failure != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Failure.Size()))
+		n15, err := m.Failure.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n15
+	}
+	return i, nil
+}
+
+func (m *CreateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApi(dAtA, i, uint64(m.Doc.Size()))
+	n16, err := m.Doc.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n16
+	if len(m.Source) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Source)))
+		i += copy(dAtA[i:], m.Source)
+	}
+	if m.IfVersion != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.IfVersion))
+	}
+	if m.IfSeqNo != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.IfSeqNo))
+	}
+	return i, nil
+}
+
+func (m *CreateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if m.Result != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Result))
+	}
+	if m.Version != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Version))
+	}
+	if m.SeqNo != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.SeqNo))
+	}
+	return i, nil
+}
+
+func (m *UpdateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApi(dAtA, i, uint64(m.Doc.Size()))
+	n17, err := m.Doc.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n17
+	if m.Upsert {
+		dAtA[i] = 0x10
+		i++
+		if m.Upsert {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Source) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Source)))
+		i += copy(dAtA[i:], m.Source)
+	}
+	if len(m.Partial) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Partial)))
+		i += copy(dAtA[i:], m.Partial)
+	}
+	if m.Script != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Script.Size()))
+		n18, err := m.Script.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n18
+	}
+	if m.IfVersion != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.IfVersion))
+	}
+	if m.IfSeqNo != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.IfSeqNo))
+	}
+	if m.MappingVersion != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.MappingVersion))
+	}
+	return i, nil
+}
+
+func (m *Script) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Script) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Source) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Source)))
+		i += copy(dAtA[i:], m.Source)
+	}
+	if len(m.Params) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Params)))
+		i += copy(dAtA[i:], m.Params)
+	}
+	return i, nil
+}
+
+func (m *UpdateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if m.Result != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Result))
+	}
+	if m.Version != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Version))
+	}
+	if m.SeqNo != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.SeqNo))
+	}
+	return i, nil
+}
+
+func (m *DeleteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if m.IfVersion != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.IfVersion))
+	}
+	if m.IfSeqNo != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.IfSeqNo))
+	}
+	if m.IfExpiredAt != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.IfExpiredAt))
+	}
+	return i, nil
+}
+
+func (m *DeleteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if m.Result != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Result))
+	}
+	if m.Version != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Version))
+	}
+	if m.SeqNo != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.SeqNo))
+	}
+	return i, nil
+}
+
+func (m *Failure) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Failure) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if len(m.Cause) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Cause)))
+		i += copy(dAtA[i:], m.Cause)
+	}
+	if m.Aborted {
+		dAtA[i] = 0x18
+		i++
+		if m.Aborted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.VersionConflict {
+		dAtA[i] = 0x20
+		i++
+		if m.VersionConflict {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.CurrentVersion != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.CurrentVersion))
+	}
+	if m.CurrentSeqNo != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.CurrentSeqNo))
+	}
+	return i, nil
+}
+
+func (m *SearchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SearchRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApi(dAtA, i, uint64(m.ActionRequestHeader.Size()))
+	n19, err := m.ActionRequestHeader.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n19
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintApi(dAtA, i, uint64(m.Query.Size()))
+	n20, err := m.Query.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n20
+	if m.From != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.From))
+	}
+	if m.Size_ != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Size_))
+	}
+	if len(m.Sort) > 0 {
+		for _, msg := range m.Sort {
+			dAtA[i] = 0x2a
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Fields) > 0 {
+		dAtA22 := make([]byte, len(m.Fields)*10)
+		var j21 int
+		for _, num := range m.Fields {
+			for num >= 1<<7 {
+				dAtA22[j21] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j21++
+			}
+			dAtA22[j21] = uint8(num)
+			j21++
+		}
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(j21))
+		i += copy(dAtA[i:], dAtA22[:j21])
+	}
+	if len(m.Similarities) > 0 {
+		for k, _ := range m.Similarities {
+			dAtA[i] = 0x3a
+			i++
+			v := m.Similarities[k]
+			mapSize := 1 + sovApi(uint64(k)) + 1 + len(v) + sovApi(uint64(len(v)))
+			i = encodeVarintApi(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0x8
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(k))
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	if m.Statistics != nil {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Statistics.Size()))
+		n23, err := m.Statistics.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n23
+	}
+	if len(m.Aggregations) > 0 {
+		for k, _ := range m.Aggregations {
+			dAtA[i] = 0x4a
+			i++
+			v := m.Aggregations[k]
+			msgSize := 0
+			if (&v) != nil {
+				msgSize = (&v).Size()
+				msgSize += 1 + sovApi(uint64(msgSize))
+			}
+			mapSize := 1 + len(k) + sovApi(uint64(len(k))) + msgSize
+			i = encodeVarintApi(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintApi(dAtA, i, uint64((&v).Size()))
+			n24, err := (&v).MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n24
+		}
+	}
+	if m.Highlight != nil {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Highlight.Size()))
+		n25, err := m.Highlight.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n25
+	}
+	return i, nil
+}
+
+func (m *SearchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SearchResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApi(dAtA, i, uint64(m.ResponseHeader.Size()))
+	n26, err := m.ResponseHeader.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n26
+	if m.Total != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Total))
+	}
+	if len(m.Hits) > 0 {
+		for _, msg := range m.Hits {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Aggregations) > 0 {
+		for k, _ := range m.Aggregations {
+			dAtA[i] = 0x22
+			i++
+			v := m.Aggregations[k]
+			msgSize := 0
+			if (&v) != nil {
+				msgSize = (&v).Size()
+				msgSize += 1 + sovApi(uint64(msgSize))
+			}
+			mapSize := 1 + len(k) + sovApi(uint64(len(k))) + msgSize
+			i = encodeVarintApi(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintApi(dAtA, i, uint64((&v).Size()))
+			n27, err := (&v).MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n27
+		}
+	}
+	return i, nil
+}
+
+func (m *SearchHit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SearchHit) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if m.Score != 0 {
+		dAtA[i] = 0x11
+		i++
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Score))))
+		i += 8
+	}
+	if len(m.Fields) > 0 {
+		for k, _ := range m.Fields {
+			dAtA[i] = 0x1a
+			i++
+			v := m.Fields[k]
+			msgSize := 0
+			if (&v) != nil {
+				msgSize = (&v).Size()
+				msgSize += 1 + sovApi(uint64(msgSize))
+			}
+			mapSize := 1 + sovApi(uint64(k)) + msgSize
+			i = encodeVarintApi(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0x8
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(k))
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintApi(dAtA, i, uint64((&v).Size()))
+			n28, err := (&v).MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n28
+		}
+	}
+	if len(m.Highlights) > 0 {
+		for k, _ := range m.Highlights {
+			dAtA[i] = 0x22
+			i++
+			v := m.Highlights[k]
+			msgSize := 0
+			if (&v) != nil {
+				msgSize = (&v).Size()
+				msgSize += 1 + sovApi(uint64(msgSize))
+			}
+			mapSize := 1 + sovApi(uint64(k)) + msgSize
+			i = encodeVarintApi(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0x8
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(k))
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintApi(dAtA, i, uint64((&v).Size()))
+			n29, err := (&v).MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n29
+		}
+	}
+	if len(m.InnerHits) > 0 {
+		for k, _ := range m.InnerHits {
+			dAtA[i] = 0x2a
+			i++
+			v := m.InnerHits[k]
+			msgSize := 0
+			if (&v) != nil {
+				msgSize = (&v).Size()
+				msgSize += 1 + sovApi(uint64(msgSize))
+			}
+			mapSize := 1 + sovApi(uint64(k)) + msgSize
+			i = encodeVarintApi(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0x8
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(k))
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintApi(dAtA, i, uint64((&v).Size()))
+			n30, err := (&v).MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n30
+		}
+	}
+	return i, nil
+}
+
+func (m *InnerHits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InnerHits) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hits) > 0 {
+		for _, msg := range m.Hits {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *InnerHit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InnerHit) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Offset != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Offset))
+	}
+	if m.Score != 0 {
+		dAtA[i] = 0x11
+		i++
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Score))))
+		i += 8
+	}
+	if len(m.Fields) > 0 {
+		for k, _ := range m.Fields {
+			dAtA[i] = 0x1a
+			i++
+			v := m.Fields[k]
+			msgSize := 0
+			if (&v) != nil {
+				msgSize = (&v).Size()
+				msgSize += 1 + sovApi(uint64(msgSize))
+			}
+			mapSize := 1 + sovApi(uint64(k)) + msgSize
+			i = encodeVarintApi(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0x8
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(k))
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintApi(dAtA, i, uint64((&v).Size()))
+			n31, err := (&v).MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n31
+		}
+	}
+	return i, nil
+}
+
+func (m *Highlight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Highlight) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fields) > 0 {
+		dAtA33 := make([]byte, len(m.Fields)*10)
+		var j32 int
+		for _, num := range m.Fields {
+			for num >= 1<<7 {
+				dAtA33[j32] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j32++
+			}
+			dAtA33[j32] = uint8(num)
+			j32++
+		}
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(j32))
+		i += copy(dAtA[i:], dAtA33[:j32])
+	}
+	if len(m.PreTag) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.PreTag)))
+		i += copy(dAtA[i:], m.PreTag)
+	}
+	if len(m.PostTag) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.PostTag)))
+		i += copy(dAtA[i:], m.PostTag)
+	}
+	if m.FragmentSize != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.FragmentSize))
+	}
+	if m.NumberOfFragments != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.NumberOfFragments))
+	}
+	if len(m.Analyzers) > 0 {
+		for k, _ := range m.Analyzers {
+			dAtA[i] = 0x32
+			i++
+			v := m.Analyzers[k]
+			mapSize := 1 + sovApi(uint64(k)) + 1 + len(v) + sovApi(uint64(len(v)))
+			i = encodeVarintApi(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0x8
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(k))
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	return i, nil
+}
+
+func (m *HighlightFragments) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HighlightFragments) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fragments) > 0 {
+		for _, s := range m.Fragments {
+			dAtA[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func (m *SearchStatisticsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SearchStatisticsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApi(dAtA, i, uint64(m.ActionRequestHeader.Size()))
+	n34, err := m.ActionRequestHeader.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n34
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintApi(dAtA, i, uint64(m.Query.Size()))
+	n35, err := m.Query.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n35
+	return i, nil
+}
+
+func (m *SearchStatisticsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SearchStatisticsResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApi(dAtA, i, uint64(m.ResponseHeader.Size()))
+	n36, err := m.ResponseHeader.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n36
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintApi(dAtA, i, uint64(m.Statistics.Size()))
+	n37, err := m.Statistics.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n37
+	return i, nil
+}
+
+func (m *SearchStatistics) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SearchStatistics) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fields) > 0 {
+		for _, msg := range m.Fields {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Terms) > 0 {
+		for _, msg := range m.Terms {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *FieldStatistics) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FieldStatistics) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Field != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Field))
+	}
+	if m.DocCount != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.DocCount))
+	}
+	if m.SumLength != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.SumLength))
+	}
+	return i, nil
+}
+
+func (m *TermStatistics) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TermStatistics) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Field != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Field))
+	}
+	if len(m.Term) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Term)))
+		i += copy(dAtA[i:], m.Term)
+	}
+	if m.DocFreq != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.DocFreq))
+	}
+	return i, nil
+}
+
+func (m *AnalyzeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AnalyzeRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApi(dAtA, i, uint64(m.ActionRequestHeader.Size()))
+	n38, err := m.ActionRequestHeader.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n38
+	if len(m.Analyzer) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Analyzer)))
+		i += copy(dAtA[i:], m.Analyzer)
+	}
+	if len(m.Field) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Field)))
+		i += copy(dAtA[i:], m.Field)
+	}
+	if len(m.Text) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Text)))
+		i += copy(dAtA[i:], m.Text)
+	}
+	if m.Explain {
+		dAtA[i] = 0x28
+		i++
+		if m.Explain {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *AnalyzeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AnalyzeResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApi(dAtA, i, uint64(m.ResponseHeader.Size()))
+	n39, err := m.ResponseHeader.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n39
+	if len(m.Analyzer) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Analyzer)))
+		i += copy(dAtA[i:], m.Analyzer)
+	}
+	if len(m.Tokens) > 0 {
+		for _, msg := range m.Tokens {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Stages) > 0 {
+		for _, msg := range m.Stages {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *AnalyzeToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AnalyzeToken) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Term) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Term)))
+		i += copy(dAtA[i:], m.Term)
+	}
+	if m.Start != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Start))
+	}
+	if m.End != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.End))
+	}
+	if m.Position != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Position))
+	}
+	if len(m.Type) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Type)))
+		i += copy(dAtA[i:], m.Type)
+	}
+	return i, nil
+}
+
+func (m *AnalyzeStage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AnalyzeStage) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if len(m.Text) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Text)))
+		i += copy(dAtA[i:], m.Text)
+	}
+	if len(m.Tokens) > 0 {
+		for _, msg := range m.Tokens {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *TermVectorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TermVectorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApi(dAtA, i, uint64(m.ActionRequestHeader.Size()))
+	n40, err := m.ActionRequestHeader.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n40
+	if len(m.Id) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if len(m.Fields) > 0 {
+		dAtA42 := make([]byte, len(m.Fields)*10)
+		var j41 int
+		for _, num := range m.Fields {
+			for num >= 1<<7 {
+				dAtA42[j41] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j41++
+			}
+			dAtA42[j41] = uint8(num)
+			j41++
+		}
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(j41))
+		i += copy(dAtA[i:], dAtA42[:j41])
+	}
+	if m.TermStatistics {
+		dAtA[i] = 0x20
+		i++
+		if m.TermStatistics {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *TermVectorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TermVectorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApi(dAtA, i, uint64(m.ResponseHeader.Size()))
+	n43, err := m.ResponseHeader.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n43
+	if len(m.Id) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if m.Found {
+		dAtA[i] = 0x18
+		i++
+		if m.Found {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Fields) > 0 {
+		for k, _ := range m.Fields {
+			dAtA[i] = 0x22
+			i++
+			v := m.Fields[k]
+			msgSize := 0
+			if (&v) != nil {
+				msgSize = (&v).Size()
+				msgSize += 1 + sovApi(uint64(msgSize))
+			}
+			mapSize := 1 + sovApi(uint64(k)) + msgSize
+			i = encodeVarintApi(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0x8
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(k))
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintApi(dAtA, i, uint64((&v).Size()))
+			n44, err := (&v).MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n44
+		}
+	}
+	return i, nil
+}
+
+func (m *FieldTermVectors) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FieldTermVectors) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Terms) > 0 {
+		for _, msg := range m.Terms {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *TermVector) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TermVector) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Term) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Term)))
+		i += copy(dAtA[i:], m.Term)
+	}
+	if m.Freq != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Freq))
+	}
+	if len(m.Positions) > 0 {
+		for _, msg := range m.Positions {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.DocFreq != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.DocFreq))
+	}
+	if m.TotalTermFreq != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.TotalTermFreq))
+	}
+	return i, nil
+}
+
+func (m *TermPosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TermPosition) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Position != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Position))
+	}
+	if m.Start != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Start))
+	}
+	if m.End != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.End))
+	}
+	return i, nil
+}
+
+func (m *ScrollRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScrollRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApi(dAtA, i, uint64(m.ActionRequestHeader.Size()))
+	n45, err := m.ActionRequestHeader.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n45
+	if len(m.ScrollId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ScrollId)))
+		i += copy(dAtA[i:], m.ScrollId)
+	}
+	if m.Size_ != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Size_))
+	}
+	if len(m.Fields) > 0 {
+		dAtA47 := make([]byte, len(m.Fields)*10)
+		var j46 int
+		for _, num := range m.Fields {
+			for num >= 1<<7 {
+				dAtA47[j46] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j46++
+			}
+			dAtA47[j46] = uint8(num)
+			j46++
+		}
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(j46))
+		i += copy(dAtA[i:], dAtA47[:j46])
+	}
+	if len(m.KeepAlive) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.KeepAlive)))
+		i += copy(dAtA[i:], m.KeepAlive)
+	}
+	if len(m.After) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.After)))
+		i += copy(dAtA[i:], m.After)
+	}
+	return i, nil
+}
+
+func (m *ScrollResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScrollResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApi(dAtA, i, uint64(m.ResponseHeader.Size()))
+	n48, err := m.ResponseHeader.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n48
+	if len(m.ScrollId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ScrollId)))
+		i += copy(dAtA[i:], m.ScrollId)
+	}
+	if len(m.Docs) > 0 {
+		for _, msg := range m.Docs {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Done {
+		dAtA[i] = 0x20
+		i++
+		if m.Done {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *ScrollDocument) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScrollDocument) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if len(m.Fields) > 0 {
+		for k, _ := range m.Fields {
+			dAtA[i] = 0x12
+			i++
+			v := m.Fields[k]
+			msgSize := 0
+			if (&v) != nil {
+				msgSize = (&v).Size()
+				msgSize += 1 + sovApi(uint64(msgSize))
+			}
+			mapSize := 1 + sovApi(uint64(k)) + msgSize
+			i = encodeVarintApi(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0x8
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(k))
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintApi(dAtA, i, uint64((&v).Size()))
+			n49, err := (&v).MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n49
+		}
+	}
+	return i, nil
+}
+
+func (m *ClearScrollRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClearScrollRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApi(dAtA, i, uint64(m.ActionRequestHeader.Size()))
+	n50, err := m.ActionRequestHeader.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n50
+	if len(m.ScrollId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ScrollId)))
+		i += copy(dAtA[i:], m.ScrollId)
+	}
+	return i, nil
+}
+
+func (m *ClearScrollResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClearScrollResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApi(dAtA, i, uint64(m.ResponseHeader.Size()))
+	n51, err := m.ResponseHeader.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n51
+	return i, nil
+}
+
+func (m *ByQueryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ByQueryRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApi(dAtA, i, uint64(m.ActionRequestHeader.Size()))
+	n52, err := m.ActionRequestHeader.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n52
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintApi(dAtA, i, uint64(m.Query.Size()))
+	n53, err := m.Query.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n53
+	if len(m.Partial) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Partial)))
+		i += copy(dAtA[i:], m.Partial)
+	}
+	if m.Script != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Script.Size()))
+		n54, err := m.Script.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n54
+	}
+	if m.BatchSize != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.BatchSize))
+	}
+	if m.RequestsPerSecond != 0 {
+		dAtA[i] = 0x35
+		i++
+		binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.RequestsPerSecond))))
+		i += 4
+	}
+	if m.ProceedOnConflicts {
+		dAtA[i] = 0x38
+		i++
+		if m.ProceedOnConflicts {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *ByQueryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ByQueryResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApi(dAtA, i, uint64(m.ResponseHeader.Size()))
+	n55, err := m.ResponseHeader.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n55
+	if len(m.TaskId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.TaskId)))
+		i += copy(dAtA[i:], m.TaskId)
+	}
+	return i, nil
+}
+
+func (m *ByQueryTaskRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ByQueryTaskRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApi(dAtA, i, uint64(m.ActionRequestHeader.Size()))
+	n56, err := m.ActionRequestHeader.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n56
+	if len(m.TaskId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.TaskId)))
+		i += copy(dAtA[i:], m.TaskId)
+	}
+	return i, nil
+}
+
+func (m *ByQueryTaskResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ByQueryTaskResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApi(dAtA, i, uint64(m.ResponseHeader.Size()))
+	n57, err := m.ResponseHeader.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n57
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintApi(dAtA, i, uint64(m.Status.Size()))
+	n58, err := m.Status.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n58
+	return i, nil
+}
+
+func (m *ByQueryStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ByQueryStatus) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.TaskId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.TaskId)))
+		i += copy(dAtA[i:], m.TaskId)
+	}
+	if m.Update {
+		dAtA[i] = 0x10
+		i++
+		if m.Update {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Total != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Total))
+	}
+	if m.Deleted != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Deleted))
+	}
+	if m.Updated != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Updated))
+	}
+	if m.Noops != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Noops))
+	}
+	if m.VersionConflicts != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.VersionConflicts))
+	}
+	if m.Failures != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Failures))
+	}
+	if m.Batches != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Batches))
+	}
+	if m.Done {
+		dAtA[i] = 0x50
+		i++
+		if m.Done {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Canceled {
+		dAtA[i] = 0x58
+		i++
+		if m.Canceled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	if m.StartTime != 0 {
+		dAtA[i] = 0x68
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.StartTime))
+	}
+	if m.EndTime != 0 {
+		dAtA[i] = 0x70
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.EndTime))
+	}
+	return i, nil
+}
+
+func (m *SortField) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SortField) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Field != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Field))
+	}
+	if m.Reverse {
+		dAtA[i] = 0x10
+		i++
+		if m.Reverse {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.GeoDistance != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.GeoDistance.Size()))
+		n59, err := m.GeoDistance.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n59
+	}
+	return i, nil
+}
+
+func (m *Query) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Query) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Term != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Term.Size()))
+		n60, err := m.Term.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n60
+	}
+	if m.Terms != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Terms.Size()))
+		n61, err := m.Terms.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n61
+	}
+	if m.MatchAll != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.MatchAll.Size()))
+		n62, err := m.MatchAll.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n62
+	}
+	if m.Bool != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Bool.Size()))
+		n63, err := m.Bool.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n63
+	}
+	if m.Phrase != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Phrase.Size()))
+		n64, err := m.Phrase.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n64
+	}
+	if m.Range != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Range.Size()))
+		n65, err := m.Range.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n65
+	}
+	if m.Prefix != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Prefix.Size()))
+		n66, err := m.Prefix.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n66
+	}
+	if m.Wildcard != nil {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Wildcard.Size()))
+		n67, err := m.Wildcard.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n67
+	}
+	if m.Regexp != nil {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Regexp.Size()))
+		n68, err := m.Regexp.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n68
+	}
+	if m.Fuzzy != nil {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Fuzzy.Size()))
+		n69, err := m.Fuzzy.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n69
+	}
+	if m.GeoBoundingBox != nil {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.GeoBoundingBox.Size()))
+		n70, err := m.GeoBoundingBox.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n70
+	}
+	if m.GeoDistance != nil {
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.GeoDistance.Size()))
+		n71, err := m.GeoDistance.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n71
+	}
+	if m.GeoPolygon != nil {
+		dAtA[i] = 0x6a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.GeoPolygon.Size()))
+		n72, err := m.GeoPolygon.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n72
+	}
+	if m.Nested != nil {
+		dAtA[i] = 0x72
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Nested.Size()))
+		n73, err := m.Nested.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n73
+	}
+	return i, nil
+}
+
+func (m *TermQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TermQuery) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Field != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Field))
+	}
+	if len(m.Term) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Term)))
+		i += copy(dAtA[i:], m.Term)
+	}
+	return i, nil
+}
+
+func (m *TermsQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TermsQuery) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Field != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Field))
+	}
+	if len(m.Terms) > 0 {
+		for _, b := range m.Terms {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	return i, nil
+}
+
+func (m *PhraseQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PhraseQuery) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Field != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Field))
+	}
+	if len(m.Terms) > 0 {
+		for _, b := range m.Terms {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	if m.Slop != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Slop))
+	}
+	return i, nil
+}
+
+func (m *RangeQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RangeQuery) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Field != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Field))
+	}
+	if len(m.Gt) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Gt)))
+		i += copy(dAtA[i:], m.Gt)
+	}
+	if len(m.Gte) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Gte)))
+		i += copy(dAtA[i:], m.Gte)
+	}
+	if len(m.Lt) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Lt)))
+		i += copy(dAtA[i:], m.Lt)
+	}
+	if len(m.Lte) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Lte)))
+		i += copy(dAtA[i:], m.Lte)
+	}
+	return i, nil
+}
+
+func (m *PrefixQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PrefixQuery) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Field != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Field))
+	}
+	if len(m.Prefix) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Prefix)))
+		i += copy(dAtA[i:], m.Prefix)
+	}
+	if m.MaxExpansions != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.MaxExpansions))
+	}
+	return i, nil
+}
+
+func (m *WildcardQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WildcardQuery) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Field != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Field))
+	}
+	if len(m.Pattern) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Pattern)))
+		i += copy(dAtA[i:], m.Pattern)
+	}
+	if m.MaxExpansions != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.MaxExpansions))
+	}
+	return i, nil
+}
+
+func (m *RegexpQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegexpQuery) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Field != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Field))
+	}
+	if len(m.Pattern) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Pattern)))
+		i += copy(dAtA[i:], m.Pattern)
+	}
+	if m.MaxExpansions != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.MaxExpansions))
+	}
+	return i, nil
+}
+
+func (m *FuzzyQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FuzzyQuery) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Field != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Field))
+	}
+	if len(m.Term) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Term)))
+		i += copy(dAtA[i:], m.Term)
+	}
+	if m.MaxEdits != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.MaxEdits))
+	}
+	if m.PrefixLength != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.PrefixLength))
+	}
+	if m.MaxExpansions != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.MaxExpansions))
+	}
+	return i, nil
+}
+
+func (m *GeoPoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GeoPoint) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Lat != 0 {
+		dAtA[i] = 0x9
+		i++
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Lat))))
+		i += 8
+	}
+	if m.Lon != 0 {
+		dAtA[i] = 0x11
+		i++
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Lon))))
+		i += 8
+	}
+	return i, nil
+}
+
+func (m *GeoBoundingBoxQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GeoBoundingBoxQuery) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Field != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Field))
+	}
+	if m.TopLeft != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.TopLeft.Size()))
+		n74, err := m.TopLeft.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n74
+	}
+	if m.BottomRight != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.BottomRight.Size()))
+		n75, err := m.BottomRight.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n75
+	}
+	return i, nil
+}
+
+func (m *GeoDistanceQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GeoDistanceQuery) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Field != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Field))
+	}
+	if m.Origin != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Origin.Size()))
+		n76, err := m.Origin.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n76
+	}
+	if m.Distance != 0 {
+		dAtA[i] = 0x19
+		i++
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Distance))))
+		i += 8
+	}
+	return i, nil
+}
+
+func (m *GeoPolygonQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GeoPolygonQuery) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Field != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Field))
+	}
+	if len(m.Points) > 0 {
+		for _, msg := range m.Points {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *MatchAllQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MatchAllQuery) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *NestedQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NestedQuery) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Field != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Field))
+	}
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintApi(dAtA, i, uint64(m.Query.Size()))
+	n77, err := m.Query.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n77
+	if len(m.ScoreMode) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ScoreMode)))
+		i += copy(dAtA[i:], m.ScoreMode)
+	}
+	if m.InnerHits {
+		dAtA[i] = 0x20
+		i++
+		if m.InnerHits {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.InnerHitsSize != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.InnerHitsSize))
+	}
+	return i, nil
+}
+
+func (m *BoolQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BoolQuery) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Must) > 0 {
+		for _, msg := range m.Must {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Should) > 0 {
+		for _, msg := range m.Should {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.MustNot) > 0 {
+		for _, msg := range m.MustNot {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.MinShould != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.MinShould))
+	}
+	return i, nil
+}
+
+func (m *Document) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Document) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if len(m.Fields) > 0 {
+		for _, msg := range m.Fields {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Nested) > 0 {
+		for _, msg := range m.Nested {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.ExpireAt != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.ExpireAt))
+	}
+	return i, nil
+}
+
+func (m *NestedDocument) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NestedDocument) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Parent) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Parent)))
+		i += copy(dAtA[i:], m.Parent)
+	}
+	if m.Field != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Field))
+	}
+	if m.Offset != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Offset))
+	}
+	if len(m.Fields) > 0 {
+		for _, msg := range m.Fields {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *Field) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Field) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApi(dAtA, i, uint64(m.FieldValue.Size()))
+	n78, err := m.FieldValue.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n78
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintApi(dAtA, i, uint64(m.Desc.Size()))
+	n79, err := m.Desc.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n79
+	return i, nil
+}
+
+func (m *FieldValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FieldValue) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Id))
+	}
+	if m.Type != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Type))
+	}
+	if len(m.Data) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Data)))
+		i += copy(dAtA[i:], m.Data)
+	}
+	return i, nil
+}
+
+func (m *FieldDesc) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FieldDesc) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Stored {
+		dAtA[i] = 0x8
+		i++
+		if m.Stored {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Tokenized {
+		dAtA[i] = 0x10
+		i++
+		if m.Tokenized {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.IndexOption != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.IndexOption))
+	}
+	if len(m.Analyzer) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Analyzer)))
+		i += copy(dAtA[i:], m.Analyzer)
+	}
+	if m.DocValues {
+		dAtA[i] = 0x28
+		i++
+		if m.DocValues {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *Aggregation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Aggregation) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Terms != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Terms.Size()))
+		n80, err := m.Terms.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n80
+	}
+	if m.Histogram != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Histogram.Size()))
+		n81, err := m.Histogram.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n81
+	}
+	if m.DateHistogram != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.DateHistogram.Size()))
+		n82, err := m.DateHistogram.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n82
+	}
+	if m.Range != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Range.Size()))
+		n83, err := m.Range.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n83
+	}
+	if m.Min != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Min.Size()))
+		n84, err := m.Min.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n84
+	}
+	if m.Max != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Max.Size()))
+		n85, err := m.Max.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n85
+	}
+	if m.Avg != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Avg.Size()))
+		n86, err := m.Avg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n86
+	}
+	if m.Sum != nil {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Sum.Size()))
+		n87, err := m.Sum.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n87
+	}
+	if m.Stats != nil {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Stats.Size()))
+		n88, err := m.Stats.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n88
+	}
+	if m.Cardinality != nil {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Cardinality.Size()))
+		n89, err := m.Cardinality.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n89
+	}
+	return i, nil
+}
+
+func (m *TermsAggregation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TermsAggregation) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Field != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Field))
+	}
+	if m.Size_ != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Size_))
+	}
+	if m.ShardSize != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.ShardSize))
+	}
+	if len(m.Aggregations) > 0 {
+		for k, _ := range m.Aggregations {
+			dAtA[i] = 0x22
+			i++
+			v := m.Aggregations[k]
+			msgSize := 0
+			if (&v) != nil {
+				msgSize = (&v).Size()
+				msgSize += 1 + sovApi(uint64(msgSize))
+			}
+			mapSize := 1 + len(k) + sovApi(uint64(len(k))) + msgSize
+			i = encodeVarintApi(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintApi(dAtA, i, uint64((&v).Size()))
+			n90, err := (&v).MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n90
+		}
+	}
+	return i, nil
+}
+
+func (m *HistogramAggregation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HistogramAggregation) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Field != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Field))
+	}
+	if m.Interval != 0 {
+		dAtA[i] = 0x11
+		i++
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Interval))))
+		i += 8
+	}
+	if len(m.Aggregations) > 0 {
+		for k, _ := range m.Aggregations {
+			dAtA[i] = 0x1a
+			i++
+			v := m.Aggregations[k]
+			msgSize := 0
+			if (&v) != nil {
+				msgSize = (&v).Size()
+				msgSize += 1 + sovApi(uint64(msgSize))
+			}
+			mapSize := 1 + len(k) + sovApi(uint64(len(k))) + msgSize
+			i = encodeVarintApi(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintApi(dAtA, i, uint64((&v).Size()))
+			n91, err := (&v).MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n91
+		}
+	}
+	return i, nil
+}
+
+func (m *DateHistogramAggregation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DateHistogramAggregation) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Field != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Field))
+	}
+	if m.Interval != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Interval))
+	}
+	if len(m.Aggregations) > 0 {
+		for k, _ := range m.Aggregations {
+			dAtA[i] = 0x1a
+			i++
+			v := m.Aggregations[k]
+			msgSize := 0
+			if (&v) != nil {
+				msgSize = (&v).Size()
+				msgSize += 1 + sovApi(uint64(msgSize))
+			}
+			mapSize := 1 + len(k) + sovApi(uint64(len(k))) + msgSize
+			i = encodeVarintApi(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintApi(dAtA, i, uint64((&v).Size()))
+			n92, err := (&v).MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n92
+		}
+	}
+	return i, nil
+}
+
+func (m *RangeAggregation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RangeAggregation) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Field != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Field))
+	}
+	if len(m.Ranges) > 0 {
+		for _, msg := range m.Ranges {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Aggregations) > 0 {
+		for k, _ := range m.Aggregations {
+			dAtA[i] = 0x1a
+			i++
+			v := m.Aggregations[k]
+			msgSize := 0
+			if (&v) != nil {
+				msgSize = (&v).Size()
+				msgSize += 1 + sovApi(uint64(msgSize))
+			}
+			mapSize := 1 + len(k) + sovApi(uint64(len(k))) + msgSize
+			i = encodeVarintApi(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintApi(dAtA, i, uint64((&v).Size()))
+			n93, err := (&v).MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n93
+		}
+	}
+	return i, nil
+}
+
+func (m *AggregationRange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AggregationRange) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
+	}
+	if len(m.From) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.From)))
+		i += copy(dAtA[i:], m.From)
+	}
+	if len(m.To) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.To)))
+		i += copy(dAtA[i:], m.To)
+	}
+	return i, nil
+}
+
+func (m *MinAggregation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MinAggregation) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Field != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Field))
+	}
+	return i, nil
+}
+
+func (m *MaxAggregation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MaxAggregation) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Field != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Field))
+	}
+	return i, nil
+}
+
+func (m *AvgAggregation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AvgAggregation) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Field != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Field))
+	}
+	return i, nil
+}
+
+func (m *SumAggregation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SumAggregation) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Field != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Field))
+	}
+	return i, nil
+}
+
+func (m *StatsAggregation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StatsAggregation) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Field != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Field))
+	}
+	return i, nil
+}
+
+func (m *CardinalityAggregation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CardinalityAggregation) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Field != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Field))
+	}
+	if m.Precision != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Precision))
+	}
+	return i, nil
+}
+
+func (m *AggregationResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AggregationResult) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Buckets) > 0 {
+		for _, msg := range m.Buckets {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Stats != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Stats.Size()))
+		n94, err := m.Stats.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n94
+	}
+	if len(m.Cardinality) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Cardinality)))
+		i += copy(dAtA[i:], m.Cardinality)
+	}
+	return i, nil
+}
+
+func (m *AggregationBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AggregationBucket) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
+	}
+	if m.DocCount != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.DocCount))
+	}
+	if len(m.Aggregations) > 0 {
+		for k, _ := range m.Aggregations {
+			dAtA[i] = 0x1a
+			i++
+			v := m.Aggregations[k]
+			msgSize := 0
+			if (&v) != nil {
+				msgSize = (&v).Size()
+				msgSize += 1 + sovApi(uint64(msgSize))
+			}
+			mapSize := 1 + len(k) + sovApi(uint64(len(k))) + msgSize
+			i = encodeVarintApi(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintApi(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintApi(dAtA, i, uint64((&v).Size()))
+			n95, err := (&v).MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n95
+		}
+	}
+	return i, nil
+}
+
+func (m *StatsResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StatsResult) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Count))
+	}
+	if m.Sum != 0 {
+		dAtA[i] = 0x11
+		i++
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Sum))))
+		i += 8
+	}
+	if m.Min != 0 {
+		dAtA[i] = 0x19
+		i++
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Min))))
+		i += 8
+	}
+	if m.Max != 0 {
+		dAtA[i] = 0x21
+		i++
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Max))))
+		i += 8
+	}
+	return i, nil
+}
+
+func encodeVarintApi(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func NewPopulatedActionRequestHeader(r randyApi, easy bool) *ActionRequestHeader {
+	this := &ActionRequestHeader{}
+	v1 := meta.NewPopulatedRequestHeader(r, easy)
+	this.RequestHeader = *v1
+	this.Partition = github_com_tiglabs_baudengine_proto_metapb.PartitionID(r.Uint32())
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGetRequest(r randyApi, easy bool) *GetRequest {
+	this := &GetRequest{}
+	v2 := NewPopulatedActionRequestHeader(r, easy)
+	this.ActionRequestHeader = *v2
+	v3 := r.Intn(100)
+	this.Id = make(github_com_tiglabs_baudengine_proto_metapb.Key, v3)
+	for i := 0; i < v3; i++ {
+		this.Id[i] = byte(r.Intn(256))
+	}
+	v4 := r.Intn(10)
+	this.Fields = make([]uint32, v4)
+	for i := 0; i < v4; i++ {
+		this.Fields[i] = uint32(r.Uint32())
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGetResponse(r randyApi, easy bool) *GetResponse {
+	this := &GetResponse{}
+	v5 := meta.NewPopulatedResponseHeader(r, easy)
+	this.ResponseHeader = *v5
+	v6 := r.Intn(100)
+	this.Id = make(github_com_tiglabs_baudengine_proto_metapb.Key, v6)
+	for i := 0; i < v6; i++ {
+		this.Id[i] = byte(r.Intn(256))
+	}
+	this.Found = bool(bool(r.Intn(2) == 0))
+	if r.Intn(10) != 0 {
+		v7 := r.Intn(10)
+		this.Fields = make(map[uint32]FieldValue)
+		for i := 0; i < v7; i++ {
+			this.Fields[uint32(r.Uint32())] = *NewPopulatedFieldValue(r, easy)
+		}
+	}
+	this.Version = uint64(uint64(r.Uint32()))
+	this.SeqNo = uint64(uint64(r.Uint32()))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedBulkRequest(r randyApi, easy bool) *BulkRequest {
+	this := &BulkRequest{}
+	v8 := NewPopulatedActionRequestHeader(r, easy)
+	this.ActionRequestHeader = *v8
+	if r.Intn(10) != 0 {
+		v9 := r.Intn(5)
+		this.Requests = make([]BulkItemRequest, v9)
+		for i := 0; i < v9; i++ {
+			v10 := NewPopulatedBulkItemRequest(r, easy)
+			this.Requests[i] = *v10
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedBulkResponse(r randyApi, easy bool) *BulkResponse {
+	this := &BulkResponse{}
+	v11 := meta.NewPopulatedResponseHeader(r, easy)
+	this.ResponseHeader = *v11
+	if r.Intn(10) != 0 {
+		v12 := r.Intn(5)
+		this.Responses = make([]BulkItemResponse, v12)
+		for i := 0; i < v12; i++ {
+			v13 := NewPopulatedBulkItemResponse(r, easy)
+			this.Responses[i] = *v13
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedBulkItemRequest(r randyApi, easy bool) *BulkItemRequest {
+	this := &BulkItemRequest{}
+	this.OpType = OpType([]int32{0, 1, 2}[r.Intn(3)])
+	if r.Intn(10) != 0 {
+		this.Create = NewPopulatedCreateRequest(r, easy)
+	}
+	if r.Intn(10) != 0 {
+		this.Update = NewPopulatedUpdateRequest(r, easy)
+	}
+	if r.Intn(10) != 0 {
+		this.Delete = NewPopulatedDeleteRequest(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedBulkItemResponse(r randyApi, easy bool) *BulkItemResponse {
+	this := &BulkItemResponse{}
+	this.OpType = OpType([]int32{0, 1, 2}[r.Intn(3)])
+	if r.Intn(10) != 0 {
+		this.Create = NewPopulatedCreateResponse(r, easy)
+	}
+	if r.Intn(10) != 0 {
+		this.Update = NewPopulatedUpdateResponse(r, easy)
+	}
+	if r.Intn(10) != 0 {
+		this.Delete = NewPopulatedDeleteResponse(r, easy)
+	}
+	if r.Intn(10) != 0 {
+		this.Failure = NewPopulatedFailure(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedCreateRequest(r randyApi, easy bool) *CreateRequest {
+	this := &CreateRequest{}
+	v14 := NewPopulatedDocument(r, easy)
+	this.Doc = *v14
+	v15 := r.Intn(100)
+	this.Source = make([]byte, v15)
+	for i := 0; i < v15; i++ {
+		this.Source[i] = byte(r.Intn(256))
+	}
+	this.IfVersion = uint64(uint64(r.Uint32()))
+	this.IfSeqNo = uint64(uint64(r.Uint32()))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedCreateResponse(r randyApi, easy bool) *CreateResponse {
+	this := &CreateResponse{}
+	v16 := r.Intn(100)
+	this.Id = make(github_com_tiglabs_baudengine_proto_metapb.Key, v16)
+	for i := 0; i < v16; i++ {
+		this.Id[i] = byte(r.Intn(256))
+	}
+	this.Result = WriteResult([]int32{0, 1, 2, 3, 4}[r.Intn(5)])
+	this.Version = uint64(uint64(r.Uint32()))
+	this.SeqNo = uint64(uint64(r.Uint32()))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedUpdateRequest(r randyApi, easy bool) *UpdateRequest {
+	this := &UpdateRequest{}
+	v17 := NewPopulatedDocument(r, easy)
+	this.Doc = *v17
+	this.Upsert = bool(bool(r.Intn(2) == 0))
+	v18 := r.Intn(100)
+	this.Source = make([]byte, v18)
+	for i := 0; i < v18; i++ {
+		this.Source[i] = byte(r.Intn(256))
+	}
+	v19 := r.Intn(100)
+	this.Partial = make([]byte, v19)
+	for i := 0; i < v19; i++ {
+		this.Partial[i] = byte(r.Intn(256))
+	}
+	if r.Intn(10) != 0 {
+		this.Script = NewPopulatedScript(r, easy)
+	}
+	this.IfVersion = uint64(uint64(r.Uint32()))
+	this.IfSeqNo = uint64(uint64(r.Uint32()))
+	this.MappingVersion = uint64(uint64(r.Uint32()))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedScript(r randyApi, easy bool) *Script {
+	this := &Script{}
+	this.Source = string(randStringApi(r))
+	v20 := r.Intn(100)
+	this.Params = make([]byte, v20)
+	for i := 0; i < v20; i++ {
+		this.Params[i] = byte(r.Intn(256))
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedUpdateResponse(r randyApi, easy bool) *UpdateResponse {
+	this := &UpdateResponse{}
+	v21 := r.Intn(100)
+	this.Id = make(github_com_tiglabs_baudengine_proto_metapb.Key, v21)
+	for i := 0; i < v21; i++ {
+		this.Id[i] = byte(r.Intn(256))
+	}
+	this.Result = WriteResult([]int32{0, 1, 2, 3, 4}[r.Intn(5)])
+	this.Version = uint64(uint64(r.Uint32()))
+	this.SeqNo = uint64(uint64(r.Uint32()))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedDeleteRequest(r randyApi, easy bool) *DeleteRequest {
+	this := &DeleteRequest{}
+	v22 := r.Intn(100)
+	this.Id = make(github_com_tiglabs_baudengine_proto_metapb.Key, v22)
+	for i := 0; i < v22; i++ {
+		this.Id[i] = byte(r.Intn(256))
+	}
+	this.IfVersion = uint64(uint64(r.Uint32()))
+	this.IfSeqNo = uint64(uint64(r.Uint32()))
+	this.IfExpiredAt = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.IfExpiredAt *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedDeleteResponse(r randyApi, easy bool) *DeleteResponse {
+	this := &DeleteResponse{}
+	v23 := r.Intn(100)
+	this.Id = make(github_com_tiglabs_baudengine_proto_metapb.Key, v23)
+	for i := 0; i < v23; i++ {
+		this.Id[i] = byte(r.Intn(256))
+	}
+	this.Result = WriteResult([]int32{0, 1, 2, 3, 4}[r.Intn(5)])
+	this.Version = uint64(uint64(r.Uint32()))
+	this.SeqNo = uint64(uint64(r.Uint32()))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedFailure(r randyApi, easy bool) *Failure {
+	this := &Failure{}
+	v24 := r.Intn(100)
+	this.Id = make(github_com_tiglabs_baudengine_proto_metapb.Key, v24)
+	for i := 0; i < v24; i++ {
+		this.Id[i] = byte(r.Intn(256))
+	}
+	this.Cause = string(randStringApi(r))
+	this.Aborted = bool(bool(r.Intn(2) == 0))
+	this.VersionConflict = bool(bool(r.Intn(2) == 0))
+	this.CurrentVersion = uint64(uint64(r.Uint32()))
+	this.CurrentSeqNo = uint64(uint64(r.Uint32()))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedSearchRequest(r randyApi, easy bool) *SearchRequest {
+	this := &SearchRequest{}
+	v25 := NewPopulatedActionRequestHeader(r, easy)
+	this.ActionRequestHeader = *v25
+	v26 := NewPopulatedQuery(r, easy)
+	this.Query = *v26
+	this.From = uint32(r.Uint32())
+	this.Size_ = uint32(r.Uint32())
+	if r.Intn(10) != 0 {
+		v27 := r.Intn(5)
+		this.Sort = make([]SortField, v27)
+		for i := 0; i < v27; i++ {
+			v28 := NewPopulatedSortField(r, easy)
+			this.Sort[i] = *v28
+		}
+	}
+	v29 := r.Intn(10)
+	this.Fields = make([]uint32, v29)
+	for i := 0; i < v29; i++ {
+		this.Fields[i] = uint32(r.Uint32())
+	}
+	if r.Intn(10) != 0 {
+		v30 := r.Intn(10)
+		this.Similarities = make(map[uint32]string)
+		for i := 0; i < v30; i++ {
+			this.Similarities[uint32(r.Uint32())] = randStringApi(r)
+		}
+	}
+	if r.Intn(10) != 0 {
+		this.Statistics = NewPopulatedSearchStatistics(r, easy)
+	}
+	if r.Intn(10) == 0 {
+		v31 := r.Intn(10)
+		this.Aggregations = make(map[string]Aggregation)
+		for i := 0; i < v31; i++ {
+			this.Aggregations[randStringApi(r)] = *NewPopulatedAggregation(r, easy)
+		}
+	}
+	if r.Intn(10) != 0 {
+		this.Highlight = NewPopulatedHighlight(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedSearchResponse(r randyApi, easy bool) *SearchResponse {
+	this := &SearchResponse{}
+	v32 := meta.NewPopulatedResponseHeader(r, easy)
+	this.ResponseHeader = *v32
+	this.Total = uint32(r.Uint32())
+	if r.Intn(10) != 0 {
+		v33 := r.Intn(5)
+		this.Hits = make([]SearchHit, v33)
+		for i := 0; i < v33; i++ {
+			v34 := NewPopulatedSearchHit(r, easy)
+			this.Hits[i] = *v34
+		}
+	}
+	if r.Intn(10) == 0 {
+		v35 := r.Intn(10)
+		this.Aggregations = make(map[string]AggregationResult)
+		for i := 0; i < v35; i++ {
+			this.Aggregations[randStringApi(r)] = *NewPopulatedAggregationResult(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedSearchHit(r randyApi, easy bool) *SearchHit {
+	this := &SearchHit{}
+	v36 := r.Intn(100)
+	this.Id = make(github_com_tiglabs_baudengine_proto_metapb.Key, v36)
+	for i := 0; i < v36; i++ {
+		this.Id[i] = byte(r.Intn(256))
+	}
+	this.Score = float64(r.Float64())
+	if r.Intn(2) == 0 {
+		this.Score *= -1
+	}
+	if r.Intn(10) != 0 {
+		v37 := r.Intn(10)
+		this.Fields = make(map[uint32]FieldValue)
+		for i := 0; i < v37; i++ {
+			this.Fields[uint32(r.Uint32())] = *NewPopulatedFieldValue(r, easy)
+		}
+	}
+	if r.Intn(10) != 0 {
+		v38 := r.Intn(10)
+		this.Highlights = make(map[uint32]HighlightFragments)
+		for i := 0; i < v38; i++ {
+			this.Highlights[uint32(r.Uint32())] = *NewPopulatedHighlightFragments(r, easy)
+		}
+	}
+	if r.Intn(10) != 0 {
+		v39 := r.Intn(10)
+		this.InnerHits = make(map[uint32]InnerHits)
+		for i := 0; i < v39; i++ {
+			this.InnerHits[uint32(r.Uint32())] = *NewPopulatedInnerHits(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedInnerHits(r randyApi, easy bool) *InnerHits {
+	this := &InnerHits{}
+	if r.Intn(10) != 0 {
+		v40 := r.Intn(5)
+		this.Hits = make([]InnerHit, v40)
+		for i := 0; i < v40; i++ {
+			v41 := NewPopulatedInnerHit(r, easy)
+			this.Hits[i] = *v41
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedInnerHit(r randyApi, easy bool) *InnerHit {
+	this := &InnerHit{}
+	this.Offset = uint32(r.Uint32())
+	this.Score = float64(r.Float64())
+	if r.Intn(2) == 0 {
+		this.Score *= -1
+	}
+	if r.Intn(10) != 0 {
+		v42 := r.Intn(10)
+		this.Fields = make(map[uint32]FieldValue)
+		for i := 0; i < v42; i++ {
+			this.Fields[uint32(r.Uint32())] = *NewPopulatedFieldValue(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedHighlight(r randyApi, easy bool) *Highlight {
+	this := &Highlight{}
+	v43 := r.Intn(10)
+	this.Fields = make([]uint32, v43)
+	for i := 0; i < v43; i++ {
+		this.Fields[i] = uint32(r.Uint32())
+	}
+	this.PreTag = string(randStringApi(r))
+	this.PostTag = string(randStringApi(r))
+	this.FragmentSize = uint32(r.Uint32())
+	this.NumberOfFragments = uint32(r.Uint32())
+	if r.Intn(10) != 0 {
+		v44 := r.Intn(10)
+		this.Analyzers = make(map[uint32]string)
+		for i := 0; i < v44; i++ {
+			this.Analyzers[uint32(r.Uint32())] = randStringApi(r)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedHighlightFragments(r randyApi, easy bool) *HighlightFragments {
+	this := &HighlightFragments{}
+	v45 := r.Intn(10)
+	this.Fragments = make([]string, v45)
+	for i := 0; i < v45; i++ {
+		this.Fragments[i] = string(randStringApi(r))
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedSearchStatisticsRequest(r randyApi, easy bool) *SearchStatisticsRequest {
+	this := &SearchStatisticsRequest{}
+	v46 := NewPopulatedActionRequestHeader(r, easy)
+	this.ActionRequestHeader = *v46
+	v47 := NewPopulatedQuery(r, easy)
+	this.Query = *v47
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedSearchStatisticsResponse(r randyApi, easy bool) *SearchStatisticsResponse {
+	this := &SearchStatisticsResponse{}
+	v48 := meta.NewPopulatedResponseHeader(r, easy)
+	this.ResponseHeader = *v48
+	v49 := NewPopulatedSearchStatistics(r, easy)
+	this.Statistics = *v49
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedSearchStatistics(r randyApi, easy bool) *SearchStatistics {
+	this := &SearchStatistics{}
+	if r.Intn(10) != 0 {
+		v50 := r.Intn(5)
+		this.Fields = make([]FieldStatistics, v50)
+		for i := 0; i < v50; i++ {
+			v51 := NewPopulatedFieldStatistics(r, easy)
+			this.Fields[i] = *v51
+		}
+	}
+	if r.Intn(10) != 0 {
+		v52 := r.Intn(5)
+		this.Terms = make([]TermStatistics, v52)
+		for i := 0; i < v52; i++ {
+			v53 := NewPopulatedTermStatistics(r, easy)
+			this.Terms[i] = *v53
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedFieldStatistics(r randyApi, easy bool) *FieldStatistics {
+	this := &FieldStatistics{}
+	this.Field = uint32(r.Uint32())
+	this.DocCount = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.DocCount *= -1
+	}
+	this.SumLength = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.SumLength *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedTermStatistics(r randyApi, easy bool) *TermStatistics {
+	this := &TermStatistics{}
+	this.Field = uint32(r.Uint32())
+	v54 := r.Intn(100)
+	this.Term = make([]byte, v54)
+	for i := 0; i < v54; i++ {
+		this.Term[i] = byte(r.Intn(256))
+	}
+	this.DocFreq = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.DocFreq *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedAnalyzeRequest(r randyApi, easy bool) *AnalyzeRequest {
+	this := &AnalyzeRequest{}
+	v55 := NewPopulatedActionRequestHeader(r, easy)
+	this.ActionRequestHeader = *v55
+	this.Analyzer = string(randStringApi(r))
+	this.Field = string(randStringApi(r))
+	this.Text = string(randStringApi(r))
+	this.Explain = bool(bool(r.Intn(2) == 0))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedAnalyzeResponse(r randyApi, easy bool) *AnalyzeResponse {
+	this := &AnalyzeResponse{}
+	v56 := meta.NewPopulatedResponseHeader(r, easy)
+	this.ResponseHeader = *v56
+	this.Analyzer = string(randStringApi(r))
+	if r.Intn(10) != 0 {
+		v57 := r.Intn(5)
+		this.Tokens = make([]AnalyzeToken, v57)
+		for i := 0; i < v57; i++ {
+			v58 := NewPopulatedAnalyzeToken(r, easy)
+			this.Tokens[i] = *v58
+		}
+	}
+	if r.Intn(10) != 0 {
+		v59 := r.Intn(5)
+		this.Stages = make([]AnalyzeStage, v59)
+		for i := 0; i < v59; i++ {
+			v60 := NewPopulatedAnalyzeStage(r, easy)
+			this.Stages[i] = *v60
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedAnalyzeToken(r randyApi, easy bool) *AnalyzeToken {
+	this := &AnalyzeToken{}
+	this.Term = string(randStringApi(r))
+	this.Start = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.Start *= -1
+	}
+	this.End = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.End *= -1
+	}
+	this.Position = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.Position *= -1
+	}
+	this.Type = string(randStringApi(r))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedAnalyzeStage(r randyApi, easy bool) *AnalyzeStage {
+	this := &AnalyzeStage{}
+	this.Name = string(randStringApi(r))
+	this.Text = string(randStringApi(r))
+	if r.Intn(10) != 0 {
+		v61 := r.Intn(5)
+		this.Tokens = make([]AnalyzeToken, v61)
+		for i := 0; i < v61; i++ {
+			v62 := NewPopulatedAnalyzeToken(r, easy)
+			this.Tokens[i] = *v62
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedTermVectorsRequest(r randyApi, easy bool) *TermVectorsRequest {
+	this := &TermVectorsRequest{}
+	v63 := NewPopulatedActionRequestHeader(r, easy)
+	this.ActionRequestHeader = *v63
+	v64 := r.Intn(100)
+	this.Id = make(github_com_tiglabs_baudengine_proto_metapb.Key, v64)
+	for i := 0; i < v64; i++ {
+		this.Id[i] = byte(r.Intn(256))
+	}
+	v65 := r.Intn(10)
+	this.Fields = make([]uint32, v65)
+	for i := 0; i < v65; i++ {
+		this.Fields[i] = uint32(r.Uint32())
+	}
+	this.TermStatistics = bool(bool(r.Intn(2) == 0))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedTermVectorsResponse(r randyApi, easy bool) *TermVectorsResponse {
+	this := &TermVectorsResponse{}
+	v66 := meta.NewPopulatedResponseHeader(r, easy)
+	this.ResponseHeader = *v66
+	v67 := r.Intn(100)
+	this.Id = make(github_com_tiglabs_baudengine_proto_metapb.Key, v67)
+	for i := 0; i < v67; i++ {
+		this.Id[i] = byte(r.Intn(256))
+	}
+	this.Found = bool(bool(r.Intn(2) == 0))
+	if r.Intn(10) != 0 {
+		v68 := r.Intn(10)
+		this.Fields = make(map[uint32]FieldTermVectors)
+		for i := 0; i < v68; i++ {
+			this.Fields[uint32(r.Uint32())] = *NewPopulatedFieldTermVectors(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedFieldTermVectors(r randyApi, easy bool) *FieldTermVectors {
+	this := &FieldTermVectors{}
+	if r.Intn(10) != 0 {
+		v69 := r.Intn(5)
+		this.Terms = make([]TermVector, v69)
+		for i := 0; i < v69; i++ {
+			v70 := NewPopulatedTermVector(r, easy)
+			this.Terms[i] = *v70
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedTermVector(r randyApi, easy bool) *TermVector {
+	this := &TermVector{}
+	v71 := r.Intn(100)
+	this.Term = make([]byte, v71)
+	for i := 0; i < v71; i++ {
+		this.Term[i] = byte(r.Intn(256))
+	}
+	this.Freq = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.Freq *= -1
+	}
+	if r.Intn(10) != 0 {
+		v72 := r.Intn(5)
+		this.Positions = make([]TermPosition, v72)
+		for i := 0; i < v72; i++ {
+			v73 := NewPopulatedTermPosition(r, easy)
+			this.Positions[i] = *v73
+		}
+	}
+	this.DocFreq = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.DocFreq *= -1
+	}
+	this.TotalTermFreq = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.TotalTermFreq *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedTermPosition(r randyApi, easy bool) *TermPosition {
+	this := &TermPosition{}
+	this.Position = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.Position *= -1
+	}
+	this.Start = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.Start *= -1
+	}
+	this.End = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.End *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedScrollRequest(r randyApi, easy bool) *ScrollRequest {
+	this := &ScrollRequest{}
+	v74 := NewPopulatedActionRequestHeader(r, easy)
+	this.ActionRequestHeader = *v74
+	this.ScrollId = string(randStringApi(r))
+	this.Size_ = uint32(r.Uint32())
+	v75 := r.Intn(10)
+	this.Fields = make([]uint32, v75)
+	for i := 0; i < v75; i++ {
+		this.Fields[i] = uint32(r.Uint32())
+	}
+	this.KeepAlive = string(randStringApi(r))
+	v76 := r.Intn(100)
+	this.After = make(github_com_tiglabs_baudengine_proto_metapb.Key, v76)
+	for i := 0; i < v76; i++ {
+		this.After[i] = byte(r.Intn(256))
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedScrollResponse(r randyApi, easy bool) *ScrollResponse {
+	this := &ScrollResponse{}
+	v77 := meta.NewPopulatedResponseHeader(r, easy)
+	this.ResponseHeader = *v77
+	this.ScrollId = string(randStringApi(r))
+	if r.Intn(10) != 0 {
+		v78 := r.Intn(5)
+		this.Docs = make([]ScrollDocument, v78)
+		for i := 0; i < v78; i++ {
+			v79 := NewPopulatedScrollDocument(r, easy)
+			this.Docs[i] = *v79
+		}
+	}
+	this.Done = bool(bool(r.Intn(2) == 0))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedScrollDocument(r randyApi, easy bool) *ScrollDocument {
+	this := &ScrollDocument{}
+	v80 := r.Intn(100)
+	this.Id = make(github_com_tiglabs_baudengine_proto_metapb.Key, v80)
+	for i := 0; i < v80; i++ {
+		this.Id[i] = byte(r.Intn(256))
+	}
+	if r.Intn(10) != 0 {
+		v81 := r.Intn(10)
+		this.Fields = make(map[uint32]FieldValue)
+		for i := 0; i < v81; i++ {
+			this.Fields[uint32(r.Uint32())] = *NewPopulatedFieldValue(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedClearScrollRequest(r randyApi, easy bool) *ClearScrollRequest {
+	this := &ClearScrollRequest{}
+	v82 := NewPopulatedActionRequestHeader(r, easy)
+	this.ActionRequestHeader = *v82
+	this.ScrollId = string(randStringApi(r))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedClearScrollResponse(r randyApi, easy bool) *ClearScrollResponse {
+	this := &ClearScrollResponse{}
+	v83 := meta.NewPopulatedResponseHeader(r, easy)
+	this.ResponseHeader = *v83
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedByQueryRequest(r randyApi, easy bool) *ByQueryRequest {
+	this := &ByQueryRequest{}
+	v84 := NewPopulatedActionRequestHeader(r, easy)
+	this.ActionRequestHeader = *v84
+	v85 := NewPopulatedQuery(r, easy)
+	this.Query = *v85
+	v86 := r.Intn(100)
+	this.Partial = make([]byte, v86)
+	for i := 0; i < v86; i++ {
+		this.Partial[i] = byte(r.Intn(256))
+	}
+	if r.Intn(10) != 0 {
+		this.Script = NewPopulatedScript(r, easy)
+	}
+	this.BatchSize = uint32(r.Uint32())
+	this.RequestsPerSecond = float32(r.Float32())
+	if r.Intn(2) == 0 {
+		this.RequestsPerSecond *= -1
+	}
+	this.ProceedOnConflicts = bool(bool(r.Intn(2) == 0))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedByQueryResponse(r randyApi, easy bool) *ByQueryResponse {
+	this := &ByQueryResponse{}
+	v87 := meta.NewPopulatedResponseHeader(r, easy)
+	this.ResponseHeader = *v87
+	this.TaskId = string(randStringApi(r))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedByQueryTaskRequest(r randyApi, easy bool) *ByQueryTaskRequest {
+	this := &ByQueryTaskRequest{}
+	v88 := NewPopulatedActionRequestHeader(r, easy)
+	this.ActionRequestHeader = *v88
+	this.TaskId = string(randStringApi(r))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedByQueryTaskResponse(r randyApi, easy bool) *ByQueryTaskResponse {
+	this := &ByQueryTaskResponse{}
+	v89 := meta.NewPopulatedResponseHeader(r, easy)
+	this.ResponseHeader = *v89
+	v90 := NewPopulatedByQueryStatus(r, easy)
+	this.Status = *v90
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedByQueryStatus(r randyApi, easy bool) *ByQueryStatus {
+	this := &ByQueryStatus{}
+	this.TaskId = string(randStringApi(r))
+	this.Update = bool(bool(r.Intn(2) == 0))
+	this.Total = uint64(uint64(r.Uint32()))
+	this.Deleted = uint64(uint64(r.Uint32()))
+	this.Updated = uint64(uint64(r.Uint32()))
+	this.Noops = uint64(uint64(r.Uint32()))
+	this.VersionConflicts = uint64(uint64(r.Uint32()))
+	this.Failures = uint64(uint64(r.Uint32()))
+	this.Batches = uint64(uint64(r.Uint32()))
+	this.Done = bool(bool(r.Intn(2) == 0))
+	this.Canceled = bool(bool(r.Intn(2) == 0))
+	this.Error = string(randStringApi(r))
+	this.StartTime = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.StartTime *= -1
+	}
+	this.EndTime = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.EndTime *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedSortField(r randyApi, easy bool) *SortField {
+	this := &SortField{}
+	this.Field = uint32(r.Uint32())
+	this.Reverse = bool(bool(r.Intn(2) == 0))
+	if r.Intn(10) != 0 {
+		this.GeoDistance = NewPopulatedGeoPoint(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedQuery(r randyApi, easy bool) *Query {
+	this := &Query{}
+	fieldNum := r.Intn(1202)
+	switch fieldNum {
+	case 0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55, 56, 57, 58, 59, 60, 61, 62, 63, 64, 65, 66, 67, 68, 69, 70, 71, 72, 73, 74, 75, 76, 77, 78, 79, 80, 81, 82, 83, 84, 85, 86, 87, 88, 89, 90, 91, 92, 93, 94, 95, 96, 97, 98, 99:
+		this.Term = NewPopulatedTermQuery(r, easy)
+	case 100, 101, 102, 103, 104, 105, 106, 107, 108, 109, 110, 111, 112, 113, 114, 115, 116, 117, 118, 119, 120, 121, 122, 123, 124, 125, 126, 127, 128, 129, 130, 131, 132, 133, 134, 135, 136, 137, 138, 139, 140, 141, 142, 143, 144, 145, 146, 147, 148, 149, 150, 151, 152, 153, 154, 155, 156, 157, 158, 159, 160, 161, 162, 163, 164, 165, 166, 167, 168, 169, 170, 171, 172, 173, 174, 175, 176, 177, 178, 179, 180, 181, 182, 183, 184, 185, 186, 187, 188, 189, 190, 191, 192, 193, 194, 195, 196, 197, 198, 199:
+		this.Terms = NewPopulatedTermsQuery(r, easy)
+	case 200, 201, 202, 203, 204, 205, 206, 207, 208, 209, 210, 211, 212, 213, 214, 215, 216, 217, 218, 219, 220, 221, 222, 223, 224, 225, 226, 227, 228, 229, 230, 231, 232, 233, 234, 235, 236, 237, 238, 239, 240, 241, 242, 243, 244, 245, 246, 247, 248, 249, 250, 251, 252, 253, 254, 255, 256, 257, 258, 259, 260, 261, 262, 263, 264, 265, 266, 267, 268, 269, 270, 271, 272, 273, 274, 275, 276, 277, 278, 279, 280, 281, 282, 283, 284, 285, 286, 287, 288, 289, 290, 291, 292, 293, 294, 295, 296, 297, 298, 299:
+		this.MatchAll = NewPopulatedMatchAllQuery(r, easy)
+	case 300:
+		this.Bool = NewPopulatedBoolQuery(r, easy)
+	case 301, 302, 303, 304, 305, 306, 307, 308, 309, 310, 311, 312, 313, 314, 315, 316, 317, 318, 319, 320, 321, 322, 323, 324, 325, 326, 327, 328, 329, 330, 331, 332, 333, 334, 335, 336, 337, 338, 339, 340, 341, 342, 343, 344, 345, 346, 347, 348, 349, 350, 351, 352, 353, 354, 355, 356, 357, 358, 359, 360, 361, 362, 363, 364, 365, 366, 367, 368, 369, 370, 371, 372, 373, 374, 375, 376, 377, 378, 379, 380, 381, 382, 383, 384, 385, 386, 387, 388, 389, 390, 391, 392, 393, 394, 395, 396, 397, 398, 399, 400:
+		this.Phrase = NewPopulatedPhraseQuery(r, easy)
+	case 401, 402, 403, 404, 405, 406, 407, 408, 409, 410, 411, 412, 413, 414, 415, 416, 417, 418, 419, 420, 421, 422, 423, 424, 425, 426, 427, 428, 429, 430, 431, 432, 433, 434, 435, 436, 437, 438, 439, 440, 441, 442, 443, 444, 445, 446, 447, 448, 449, 450, 451, 452, 453, 454, 455, 456, 457, 458, 459, 460, 461, 462, 463, 464, 465, 466, 467, 468, 469, 470, 471, 472, 473, 474, 475, 476, 477, 478, 479, 480, 481, 482, 483, 484, 485, 486, 487, 488, 489, 490, 491, 492, 493, 494, 495, 496, 497, 498, 499, 500:
+		this.Range = NewPopulatedRangeQuery(r, easy)
+	case 501, 502, 503, 504, 505, 506, 507, 508, 509, 510, 511, 512, 513, 514, 515, 516, 517, 518, 519, 520, 521, 522, 523, 524, 525, 526, 527, 528, 529, 530, 531, 532, 533, 534, 535, 536, 537, 538, 539, 540, 541, 542, 543, 544, 545, 546, 547, 548, 549, 550, 551, 552, 553, 554, 555, 556, 557, 558, 559, 560, 561, 562, 563, 564, 565, 566, 567, 568, 569, 570, 571, 572, 573, 574, 575, 576, 577, 578, 579, 580, 581, 582, 583, 584, 585, 586, 587, 588, 589, 590, 591, 592, 593, 594, 595, 596, 597, 598, 599, 600:
+		this.Prefix = NewPopulatedPrefixQuery(r, easy)
+	case 601, 602, 603, 604, 605, 606, 607, 608, 609, 610, 611, 612, 613, 614, 615, 616, 617, 618, 619, 620, 621, 622, 623, 624, 625, 626, 627, 628, 629, 630, 631, 632, 633, 634, 635, 636, 637, 638, 639, 640, 641, 642, 643, 644, 645, 646, 647, 648, 649, 650, 651, 652, 653, 654, 655, 656, 657, 658, 659, 660, 661, 662, 663, 664, 665, 666, 667, 668, 669, 670, 671, 672, 673, 674, 675, 676, 677, 678, 679, 680, 681, 682, 683, 684, 685, 686, 687, 688, 689, 690, 691, 692, 693, 694, 695, 696, 697, 698, 699, 700:
+		this.Wildcard = NewPopulatedWildcardQuery(r, easy)
+	case 701, 702, 703, 704, 705, 706, 707, 708, 709, 710, 711, 712, 713, 714, 715, 716, 717, 718, 719, 720, 721, 722, 723, 724, 725, 726, 727, 728, 729, 730, 731, 732, 733, 734, 735, 736, 737, 738, 739, 740, 741, 742, 743, 744, 745, 746, 747, 748, 749, 750, 751, 752, 753, 754, 755, 756, 757, 758, 759, 760, 761, 762, 763, 764, 765, 766, 767, 768, 769, 770, 771, 772, 773, 774, 775, 776, 777, 778, 779, 780, 781, 782, 783, 784, 785, 786, 787, 788, 789, 790, 791, 792, 793, 794, 795, 796, 797, 798, 799, 800:
+		this.Regexp = NewPopulatedRegexpQuery(r, easy)
+	case 801, 802, 803, 804, 805, 806, 807, 808, 809, 810, 811, 812, 813, 814, 815, 816, 817, 818, 819, 820, 821, 822, 823, 824, 825, 826, 827, 828, 829, 830, 831, 832, 833, 834, 835, 836, 837, 838, 839, 840, 841, 842, 843, 844, 845, 846, 847, 848, 849, 850, 851, 852, 853, 854, 855, 856, 857, 858, 859, 860, 861, 862, 863, 864, 865, 866, 867, 868, 869, 870, 871, 872, 873, 874, 875, 876, 877, 878, 879, 880, 881, 882, 883, 884, 885, 886, 887, 888, 889, 890, 891, 892, 893, 894, 895, 896, 897, 898, 899, 900:
+		this.Fuzzy = NewPopulatedFuzzyQuery(r, easy)
+	case 901, 902, 903, 904, 905, 906, 907, 908, 909, 910, 911, 912, 913, 914, 915, 916, 917, 918, 919, 920, 921, 922, 923, 924, 925, 926, 927, 928, 929, 930, 931, 932, 933, 934, 935, 936, 937, 938, 939, 940, 941, 942, 943, 944, 945, 946, 947, 948, 949, 950, 951, 952, 953, 954, 955, 956, 957, 958, 959, 960, 961, 962, 963, 964, 965, 966, 967, 968, 969, 970, 971, 972, 973, 974, 975, 976, 977, 978, 979, 980, 981, 982, 983, 984, 985, 986, 987, 988, 989, 990, 991, 992, 993, 994, 995, 996, 997, 998, 999, 1000:
+		this.GeoBoundingBox = NewPopulatedGeoBoundingBoxQuery(r, easy)
+	case 1001, 1002, 1003, 1004, 1005, 1006, 1007, 1008, 1009, 1010, 1011, 1012, 1013, 1014, 1015, 1016, 1017, 1018, 1019, 1020, 1021, 1022, 1023, 1024, 1025, 1026, 1027, 1028, 1029, 1030, 1031, 1032, 1033, 1034, 1035, 1036, 1037, 1038, 1039, 1040, 1041, 1042, 1043, 1044, 1045, 1046, 1047, 1048, 1049, 1050, 1051, 1052, 1053, 1054, 1055, 1056, 1057, 1058, 1059, 1060, 1061, 1062, 1063, 1064, 1065, 1066, 1067, 1068, 1069, 1070, 1071, 1072, 1073, 1074, 1075, 1076, 1077, 1078, 1079, 1080, 1081, 1082, 1083, 1084, 1085, 1086, 1087, 1088, 1089, 1090, 1091, 1092, 1093, 1094, 1095, 1096, 1097, 1098, 1099, 1100:
+		this.GeoDistance = NewPopulatedGeoDistanceQuery(r, easy)
+	case 1101, 1102, 1103, 1104, 1105, 1106, 1107, 1108, 1109, 1110, 1111, 1112, 1113, 1114, 1115, 1116, 1117, 1118, 1119, 1120, 1121, 1122, 1123, 1124, 1125, 1126, 1127, 1128, 1129, 1130, 1131, 1132, 1133, 1134, 1135, 1136, 1137, 1138, 1139, 1140, 1141, 1142, 1143, 1144, 1145, 1146, 1147, 1148, 1149, 1150, 1151, 1152, 1153, 1154, 1155, 1156, 1157, 1158, 1159, 1160, 1161, 1162, 1163, 1164, 1165, 1166, 1167, 1168, 1169, 1170, 1171, 1172, 1173, 1174, 1175, 1176, 1177, 1178, 1179, 1180, 1181, 1182, 1183, 1184, 1185, 1186, 1187, 1188, 1189, 1190, 1191, 1192, 1193, 1194, 1195, 1196, 1197, 1198, 1199, 1200:
+		this.GeoPolygon = NewPopulatedGeoPolygonQuery(r, easy)
+	case 1201:
+		this.Nested = NewPopulatedNestedQuery(r, easy)
+	}
+	return this
+}
+
+func NewPopulatedTermQuery(r randyApi, easy bool) *TermQuery {
+	this := &TermQuery{}
+	this.Field = uint32(r.Uint32())
+	v91 := r.Intn(100)
+	this.Term = make([]byte, v91)
+	for i := 0; i < v91; i++ {
+		this.Term[i] = byte(r.Intn(256))
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedTermsQuery(r randyApi, easy bool) *TermsQuery {
+	this := &TermsQuery{}
+	this.Field = uint32(r.Uint32())
+	v92 := r.Intn(10)
+	this.Terms = make([][]byte, v92)
+	for i := 0; i < v92; i++ {
+		v93 := r.Intn(100)
+		this.Terms[i] = make([]byte, v93)
+		for j := 0; j < v93; j++ {
+			this.Terms[i][j] = byte(r.Intn(256))
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedPhraseQuery(r randyApi, easy bool) *PhraseQuery {
+	this := &PhraseQuery{}
+	this.Field = uint32(r.Uint32())
+	v94 := r.Intn(10)
+	this.Terms = make([][]byte, v94)
+	for i := 0; i < v94; i++ {
+		v95 := r.Intn(100)
+		this.Terms[i] = make([]byte, v95)
+		for j := 0; j < v95; j++ {
+			this.Terms[i][j] = byte(r.Intn(256))
+		}
+	}
+	this.Slop = uint32(r.Uint32())
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedRangeQuery(r randyApi, easy bool) *RangeQuery {
+	this := &RangeQuery{}
+	this.Field = uint32(r.Uint32())
+	v96 := r.Intn(100)
+	this.Gt = make([]byte, v96)
+	for i := 0; i < v96; i++ {
+		this.Gt[i] = byte(r.Intn(256))
+	}
+	v97 := r.Intn(100)
+	this.Gte = make([]byte, v97)
+	for i := 0; i < v97; i++ {
+		this.Gte[i] = byte(r.Intn(256))
+	}
+	v98 := r.Intn(100)
+	this.Lt = make([]byte, v98)
+	for i := 0; i < v98; i++ {
+		this.Lt[i] = byte(r.Intn(256))
+	}
+	v99 := r.Intn(100)
+	this.Lte = make([]byte, v99)
+	for i := 0; i < v99; i++ {
+		this.Lte[i] = byte(r.Intn(256))
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedPrefixQuery(r randyApi, easy bool) *PrefixQuery {
+	this := &PrefixQuery{}
+	this.Field = uint32(r.Uint32())
+	v100 := r.Intn(100)
+	this.Prefix = make([]byte, v100)
+	for i := 0; i < v100; i++ {
+		this.Prefix[i] = byte(r.Intn(256))
+	}
+	this.MaxExpansions = uint32(r.Uint32())
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedWildcardQuery(r randyApi, easy bool) *WildcardQuery {
+	this := &WildcardQuery{}
+	this.Field = uint32(r.Uint32())
+	this.Pattern = string(randStringApi(r))
+	this.MaxExpansions = uint32(r.Uint32())
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedRegexpQuery(r randyApi, easy bool) *RegexpQuery {
+	this := &RegexpQuery{}
+	this.Field = uint32(r.Uint32())
+	this.Pattern = string(randStringApi(r))
+	this.MaxExpansions = uint32(r.Uint32())
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedFuzzyQuery(r randyApi, easy bool) *FuzzyQuery {
+	this := &FuzzyQuery{}
+	this.Field = uint32(r.Uint32())
+	v101 := r.Intn(100)
+	this.Term = make([]byte, v101)
+	for i := 0; i < v101; i++ {
+		this.Term[i] = byte(r.Intn(256))
+	}
+	this.MaxEdits = uint32(r.Uint32())
+	this.PrefixLength = uint32(r.Uint32())
+	this.MaxExpansions = uint32(r.Uint32())
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGeoPoint(r randyApi, easy bool) *GeoPoint {
+	this := &GeoPoint{}
+	this.Lat = float64(r.Float64())
+	if r.Intn(2) == 0 {
+		this.Lat *= -1
+	}
+	this.Lon = float64(r.Float64())
+	if r.Intn(2) == 0 {
+		this.Lon *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGeoBoundingBoxQuery(r randyApi, easy bool) *GeoBoundingBoxQuery {
+	this := &GeoBoundingBoxQuery{}
+	this.Field = uint32(r.Uint32())
+	if r.Intn(10) != 0 {
+		this.TopLeft = NewPopulatedGeoPoint(r, easy)
+	}
+	if r.Intn(10) != 0 {
+		this.BottomRight = NewPopulatedGeoPoint(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGeoDistanceQuery(r randyApi, easy bool) *GeoDistanceQuery {
+	this := &GeoDistanceQuery{}
+	this.Field = uint32(r.Uint32())
+	if r.Intn(10) != 0 {
+		this.Origin = NewPopulatedGeoPoint(r, easy)
+	}
+	this.Distance = float64(r.Float64())
+	if r.Intn(2) == 0 {
+		this.Distance *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGeoPolygonQuery(r randyApi, easy bool) *GeoPolygonQuery {
+	this := &GeoPolygonQuery{}
+	this.Field = uint32(r.Uint32())
+	if r.Intn(10) != 0 {
+		v102 := r.Intn(5)
+		this.Points = make([]*GeoPoint, v102)
+		for i := 0; i < v102; i++ {
+			this.Points[i] = NewPopulatedGeoPoint(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedMatchAllQuery(r randyApi, easy bool) *MatchAllQuery {
+	this := &MatchAllQuery{}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedNestedQuery(r randyApi, easy bool) *NestedQuery {
+	this := &NestedQuery{}
+	this.Field = uint32(r.Uint32())
+	v103 := NewPopulatedQuery(r, easy)
+	this.Query = *v103
+	this.ScoreMode = string(randStringApi(r))
+	this.InnerHits = bool(bool(r.Intn(2) == 0))
+	this.InnerHitsSize = uint32(r.Uint32())
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedBoolQuery(r randyApi, easy bool) *BoolQuery {
+	this := &BoolQuery{}
+	if r.Intn(10) == 0 {
+		v104 := r.Intn(5)
+		this.Must = make([]Query, v104)
+		for i := 0; i < v104; i++ {
+			v105 := NewPopulatedQuery(r, easy)
+			this.Must[i] = *v105
+		}
+	}
+	if r.Intn(10) == 0 {
+		v106 := r.Intn(5)
+		this.Should = make([]Query, v106)
+		for i := 0; i < v106; i++ {
+			v107 := NewPopulatedQuery(r, easy)
+			this.Should[i] = *v107
+		}
+	}
+	if r.Intn(10) == 0 {
+		v108 := r.Intn(5)
+		this.MustNot = make([]Query, v108)
+		for i := 0; i < v108; i++ {
+			v109 := NewPopulatedQuery(r, easy)
+			this.MustNot[i] = *v109
+		}
+	}
+	this.MinShould = uint32(r.Uint32())
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedDocument(r randyApi, easy bool) *Document {
+	this := &Document{}
+	v110 := r.Intn(100)
+	this.Id = make(github_com_tiglabs_baudengine_proto_metapb.Key, v110)
+	for i := 0; i < v110; i++ {
+		this.Id[i] = byte(r.Intn(256))
+	}
+	if r.Intn(10) != 0 {
+		v111 := r.Intn(5)
+		this.Fields = make([]Field, v111)
+		for i := 0; i < v111; i++ {
+			v112 := NewPopulatedField(r, easy)
+			this.Fields[i] = *v112
+		}
+	}
+	if r.Intn(10) != 0 {
+		v113 := r.Intn(5)
+		this.Nested = make([]NestedDocument, v113)
+		for i := 0; i < v113; i++ {
+			v114 := NewPopulatedNestedDocument(r, easy)
+			this.Nested[i] = *v114
+		}
+	}
+	this.ExpireAt = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.ExpireAt *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedNestedDocument(r randyApi, easy bool) *NestedDocument {
+	this := &NestedDocument{}
+	v115 := r.Intn(100)
+	this.Parent = make(github_com_tiglabs_baudengine_proto_metapb.Key, v115)
+	for i := 0; i < v115; i++ {
+		this.Parent[i] = byte(r.Intn(256))
+	}
+	this.Field = uint32(r.Uint32())
+	this.Offset = uint32(r.Uint32())
+	if r.Intn(10) != 0 {
+		v116 := r.Intn(5)
+		this.Fields = make([]Field, v116)
+		for i := 0; i < v116; i++ {
+			v117 := NewPopulatedField(r, easy)
+			this.Fields[i] = *v117
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedField(r randyApi, easy bool) *Field {
+	this := &Field{}
+	v118 := NewPopulatedFieldValue(r, easy)
+	this.FieldValue = *v118
+	v119 := NewPopulatedFieldDesc(r, easy)
+	this.Desc = *v119
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedFieldValue(r randyApi, easy bool) *FieldValue {
+	this := &FieldValue{}
+	this.Id = uint32(r.Uint32())
+	this.Type = ValueType([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}[r.Intn(10)])
+	v120 := r.Intn(100)
+	this.Data = make(github_com_tiglabs_baudengine_proto_metapb.Value, v120)
+	for i := 0; i < v120; i++ {
+		this.Data[i] = byte(r.Intn(256))
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedFieldDesc(r randyApi, easy bool) *FieldDesc {
+	this := &FieldDesc{}
+	this.Stored = bool(bool(r.Intn(2) == 0))
+	this.Tokenized = bool(bool(r.Intn(2) == 0))
+	this.IndexOption = IndexOption([]int32{0, 1, 2, 3, 4}[r.Intn(5)])
+	this.Analyzer = string(randStringApi(r))
+	this.DocValues = bool(bool(r.Intn(2) == 0))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedAggregation(r randyApi, easy bool) *Aggregation {
+	this := &Aggregation{}
+	fieldNum := r.Intn(604)
+	switch fieldNum {
+	case 0:
+		this.Terms = NewPopulatedTermsAggregation(r, easy)
+	case 1:
+		this.Histogram = NewPopulatedHistogramAggregation(r, easy)
+	case 2:
+		this.DateHistogram = NewPopulatedDateHistogramAggregation(r, easy)
+	case 3:
+		this.Range = NewPopulatedRangeAggregation(r, easy)
+	case 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55, 56, 57, 58, 59, 60, 61, 62, 63, 64, 65, 66, 67, 68, 69, 70, 71, 72, 73, 74, 75, 76, 77, 78, 79, 80, 81, 82, 83, 84, 85, 86, 87, 88, 89, 90, 91, 92, 93, 94, 95, 96, 97, 98, 99, 100, 101, 102, 103:
+		this.Min = NewPopulatedMinAggregation(r, easy)
+	case 104, 105, 106, 107, 108, 109, 110, 111, 112, 113, 114, 115, 116, 117, 118, 119, 120, 121, 122, 123, 124, 125, 126, 127, 128, 129, 130, 131, 132, 133, 134, 135, 136, 137, 138, 139, 140, 141, 142, 143, 144, 145, 146, 147, 148, 149, 150, 151, 152, 153, 154, 155, 156, 157, 158, 159, 160, 161, 162, 163, 164, 165, 166, 167, 168, 169, 170, 171, 172, 173, 174, 175, 176, 177, 178, 179, 180, 181, 182, 183, 184, 185, 186, 187, 188, 189, 190, 191, 192, 193, 194, 195, 196, 197, 198, 199, 200, 201, 202, 203:
+		this.Max = NewPopulatedMaxAggregation(r, easy)
+	case 204, 205, 206, 207, 208, 209, 210, 211, 212, 213, 214, 215, 216, 217, 218, 219, 220, 221, 222, 223, 224, 225, 226, 227, 228, 229, 230, 231, 232, 233, 234, 235, 236, 237, 238, 239, 240, 241, 242, 243, 244, 245, 246, 247, 248, 249, 250, 251, 252, 253, 254, 255, 256, 257, 258, 259, 260, 261, 262, 263, 264, 265, 266, 267, 268, 269, 270, 271, 272, 273, 274, 275, 276, 277, 278, 279, 280, 281, 282, 283, 284, 285, 286, 287, 288, 289, 290, 291, 292, 293, 294, 295, 296, 297, 298, 299, 300, 301, 302, 303:
+		this.Avg = NewPopulatedAvgAggregation(r, easy)
+	case 304, 305, 306, 307, 308, 309, 310, 311, 312, 313, 314, 315, 316, 317, 318, 319, 320, 321, 322, 323, 324, 325, 326, 327, 328, 329, 330, 331, 332, 333, 334, 335, 336, 337, 338, 339, 340, 341, 342, 343, 344, 345, 346, 347, 348, 349, 350, 351, 352, 353, 354, 355, 356, 357, 358, 359, 360, 361, 362, 363, 364, 365, 366, 367, 368, 369, 370, 371, 372, 373, 374, 375, 376, 377, 378, 379, 380, 381, 382, 383, 384, 385, 386, 387, 388, 389, 390, 391, 392, 393, 394, 395, 396, 397, 398, 399, 400, 401, 402, 403:
+		this.Sum = NewPopulatedSumAggregation(r, easy)
+	case 404, 405, 406, 407, 408, 409, 410, 411, 412, 413, 414, 415, 416, 417, 418, 419, 420, 421, 422, 423, 424, 425, 426, 427, 428, 429, 430, 431, 432, 433, 434, 435, 436, 437, 438, 439, 440, 441, 442, 443, 444, 445, 446, 447, 448, 449, 450, 451, 452, 453, 454, 455, 456, 457, 458, 459, 460, 461, 462, 463, 464, 465, 466, 467, 468, 469, 470, 471, 472, 473, 474, 475, 476, 477, 478, 479, 480, 481, 482, 483, 484, 485, 486, 487, 488, 489, 490, 491, 492, 493, 494, 495, 496, 497, 498, 499, 500, 501, 502, 503:
+		this.Stats = NewPopulatedStatsAggregation(r, easy)
+	case 504, 505, 506, 507, 508, 509, 510, 511, 512, 513, 514, 515, 516, 517, 518, 519, 520, 521, 522, 523, 524, 525, 526, 527, 528, 529, 530, 531, 532, 533, 534, 535, 536, 537, 538, 539, 540, 541, 542, 543, 544, 545, 546, 547, 548, 549, 550, 551, 552, 553, 554, 555, 556, 557, 558, 559, 560, 561, 562, 563, 564, 565, 566, 567, 568, 569, 570, 571, 572, 573, 574, 575, 576, 577, 578, 579, 580, 581, 582, 583, 584, 585, 586, 587, 588, 589, 590, 591, 592, 593, 594, 595, 596, 597, 598, 599, 600, 601, 602, 603:
+		this.Cardinality = NewPopulatedCardinalityAggregation(r, easy)
+	}
+	return this
+}
+
+func NewPopulatedTermsAggregation(r randyApi, easy bool) *TermsAggregation {
+	this := &TermsAggregation{}
+	this.Field = uint32(r.Uint32())
+	this.Size_ = uint32(r.Uint32())
+	this.ShardSize = uint32(r.Uint32())
+	if r.Intn(10) == 0 {
+		v121 := r.Intn(10)
+		this.Aggregations = make(map[string]Aggregation)
+		for i := 0; i < v121; i++ {
+			this.Aggregations[randStringApi(r)] = *NewPopulatedAggregation(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedHistogramAggregation(r randyApi, easy bool) *HistogramAggregation {
+	this := &HistogramAggregation{}
+	this.Field = uint32(r.Uint32())
+	this.Interval = float64(r.Float64())
+	if r.Intn(2) == 0 {
+		this.Interval *= -1
+	}
+	if r.Intn(10) == 0 {
+		v122 := r.Intn(10)
+		this.Aggregations = make(map[string]Aggregation)
+		for i := 0; i < v122; i++ {
+			this.Aggregations[randStringApi(r)] = *NewPopulatedAggregation(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedDateHistogramAggregation(r randyApi, easy bool) *DateHistogramAggregation {
+	this := &DateHistogramAggregation{}
+	this.Field = uint32(r.Uint32())
+	this.Interval = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Interval *= -1
+	}
+	if r.Intn(10) == 0 {
+		v123 := r.Intn(10)
+		this.Aggregations = make(map[string]Aggregation)
+		for i := 0; i < v123; i++ {
+			this.Aggregations[randStringApi(r)] = *NewPopulatedAggregation(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedRangeAggregation(r randyApi, easy bool) *RangeAggregation {
+	this := &RangeAggregation{}
+	this.Field = uint32(r.Uint32())
+	if r.Intn(10) != 0 {
+		v124 := r.Intn(5)
+		this.Ranges = make([]AggregationRange, v124)
+		for i := 0; i < v124; i++ {
+			v125 := NewPopulatedAggregationRange(r, easy)
+			this.Ranges[i] = *v125
+		}
+	}
+	if r.Intn(10) == 0 {
+		v126 := r.Intn(10)
+		this.Aggregations = make(map[string]Aggregation)
+		for i := 0; i < v126; i++ {
+			this.Aggregations[randStringApi(r)] = *NewPopulatedAggregation(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedAggregationRange(r randyApi, easy bool) *AggregationRange {
+	this := &AggregationRange{}
+	this.Key = string(randStringApi(r))
+	v127 := r.Intn(100)
+	this.From = make([]byte, v127)
+	for i := 0; i < v127; i++ {
+		this.From[i] = byte(r.Intn(256))
+	}
+	v128 := r.Intn(100)
+	this.To = make([]byte, v128)
+	for i := 0; i < v128; i++ {
+		this.To[i] = byte(r.Intn(256))
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedMinAggregation(r randyApi, easy bool) *MinAggregation {
+	this := &MinAggregation{}
+	this.Field = uint32(r.Uint32())
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedMaxAggregation(r randyApi, easy bool) *MaxAggregation {
+	this := &MaxAggregation{}
+	this.Field = uint32(r.Uint32())
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedAvgAggregation(r randyApi, easy bool) *AvgAggregation {
+	this := &AvgAggregation{}
+	this.Field = uint32(r.Uint32())
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedSumAggregation(r randyApi, easy bool) *SumAggregation {
+	this := &SumAggregation{}
+	this.Field = uint32(r.Uint32())
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedStatsAggregation(r randyApi, easy bool) *StatsAggregation {
+	this := &StatsAggregation{}
+	this.Field = uint32(r.Uint32())
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedCardinalityAggregation(r randyApi, easy bool) *CardinalityAggregation {
+	this := &CardinalityAggregation{}
+	this.Field = uint32(r.Uint32())
+	this.Precision = uint32(r.Uint32())
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedAggregationResult(r randyApi, easy bool) *AggregationResult {
+	this := &AggregationResult{}
+	if r.Intn(10) == 0 {
+		v129 := r.Intn(5)
+		this.Buckets = make([]AggregationBucket, v129)
+		for i := 0; i < v129; i++ {
+			v130 := NewPopulatedAggregationBucket(r, easy)
+			this.Buckets[i] = *v130
+		}
+	}
+	if r.Intn(10) != 0 {
+		this.Stats = NewPopulatedStatsResult(r, easy)
+	}
+	v131 := r.Intn(100)
+	this.Cardinality = make([]byte, v131)
+	for i := 0; i < v131; i++ {
+		this.Cardinality[i] = byte(r.Intn(256))
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedAggregationBucket(r randyApi, easy bool) *AggregationBucket {
+	this := &AggregationBucket{}
+	v132 := r.Intn(100)
+	this.Key = make([]byte, v132)
+	for i := 0; i < v132; i++ {
+		this.Key[i] = byte(r.Intn(256))
+	}
+	this.DocCount = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.DocCount *= -1
+	}
+	if r.Intn(10) == 0 {
+		v133 := r.Intn(10)
+		this.Aggregations = make(map[string]AggregationResult)
+		for i := 0; i < v133; i++ {
+			this.Aggregations[randStringApi(r)] = *NewPopulatedAggregationResult(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedStatsResult(r randyApi, easy bool) *StatsResult {
+	this := &StatsResult{}
+	this.Count = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Count *= -1
+	}
+	this.Sum = float64(r.Float64())
+	if r.Intn(2) == 0 {
+		this.Sum *= -1
+	}
+	this.Min = float64(r.Float64())
+	if r.Intn(2) == 0 {
+		this.Min *= -1
+	}
+	this.Max = float64(r.Float64())
+	if r.Intn(2) == 0 {
+		this.Max *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+type randyApi interface {
+	Float32() float32
+	Float64() float64
+	Int63() int64
+	Int31() int32
+	Uint32() uint32
+	Intn(n int) int
+}
+
+func randUTF8RuneApi(r randyApi) rune {
+	ru := r.Intn(62)
+	if ru < 10 {
+		return rune(ru + 48)
+	} else if ru < 36 {
+		return rune(ru + 55)
+	}
+	return rune(ru + 61)
+}
+func randStringApi(r randyApi) string {
+	v134 := r.Intn(100)
+	tmps := make([]rune, v134)
+	for i := 0; i < v134; i++ {
+		tmps[i] = randUTF8RuneApi(r)
+	}
+	return string(tmps)
+}
+func randUnrecognizedApi(r randyApi, maxFieldNumber int) (dAtA []byte) {
+	l := r.Intn(5)
+	for i := 0; i < l; i++ {
+		wire := r.Intn(4)
+		if wire == 3 {
+			wire = 5
+		}
+		fieldNumber := maxFieldNumber + r.Intn(100)
+		dAtA = randFieldApi(dAtA, r, fieldNumber, wire)
+	}
+	return dAtA
+}
+func randFieldApi(dAtA []byte, r randyApi, fieldNumber int, wire int) []byte {
+	key := uint32(fieldNumber)<<3 | uint32(wire)
+	switch wire {
+	case 0:
+		dAtA = encodeVarintPopulateApi(dAtA, uint64(key))
+		v135 := r.Int63()
+		if r.Intn(2) == 0 {
+			v135 *= -1
+		}
+		dAtA = encodeVarintPopulateApi(dAtA, uint64(v135))
+	case 1:
+		dAtA = encodeVarintPopulateApi(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	case 2:
+		dAtA = encodeVarintPopulateApi(dAtA, uint64(key))
+		ll := r.Intn(100)
+		dAtA = encodeVarintPopulateApi(dAtA, uint64(ll))
+		for j := 0; j < ll; j++ {
+			dAtA = append(dAtA, byte(r.Intn(256)))
+		}
+	default:
+		dAtA = encodeVarintPopulateApi(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	}
+	return dAtA
+}
+func encodeVarintPopulateApi(dAtA []byte, v uint64) []byte {
+	for v >= 1<<7 {
+		dAtA = append(dAtA, uint8(uint64(v)&0x7f|0x80))
+		v >>= 7
+	}
+	dAtA = append(dAtA, uint8(v))
+	return dAtA
+}
+func (m *ActionRequestHeader) Size() (n int) {
+	var l int
+	_ = l
+	l = m.RequestHeader.Size()
+	n += 1 + l + sovApi(uint64(l))
+	if m.Partition != 0 {
+		n += 1 + sovApi(uint64(m.Partition))
+	}
+	return n
+}
+
+func (m *GetRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = m.ActionRequestHeader.Size()
+	n += 1 + l + sovApi(uint64(l))
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if len(m.Fields) > 0 {
+		l = 0
+		for _, e := range m.Fields {
+			l += sovApi(uint64(e))
+		}
+		n += 1 + sovApi(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *GetResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = m.ResponseHeader.Size()
+	n += 1 + l + sovApi(uint64(l))
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Found {
+		n += 2
+	}
+	if len(m.Fields) > 0 {
+		for k, v := range m.Fields {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + sovApi(uint64(k)) + 1 + l + sovApi(uint64(l))
+			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
+		}
+	}
+	if m.Version != 0 {
+		n += 1 + sovApi(uint64(m.Version))
+	}
+	if m.SeqNo != 0 {
+		n += 1 + sovApi(uint64(m.SeqNo))
+	}
+	return n
+}
+
+func (m *BulkRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = m.ActionRequestHeader.Size()
+	n += 1 + l + sovApi(uint64(l))
+	if len(m.Requests) > 0 {
+		for _, e := range m.Requests {
+			l = e.Size()
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BulkResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = m.ResponseHeader.Size()
+	n += 1 + l + sovApi(uint64(l))
+	if len(m.Responses) > 0 {
+		for _, e := range m.Responses {
+			l = e.Size()
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BulkItemRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.OpType != 0 {
+		n += 1 + sovApi(uint64(m.OpType))
+	}
+	if m.Create != nil {
+		l = m.Create.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Update != nil {
+		l = m.Update.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Delete != nil {
+		l = m.Delete.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *BulkItemResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.OpType != 0 {
+		n += 1 + sovApi(uint64(m.OpType))
+	}
+	if m.Create != nil {
+		l = m.Create.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Update != nil {
+		l = m.Update.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Delete != nil {
+		l = m.Delete.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Failure != nil {
+		l = m.Failure.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *CreateRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = m.Doc.Size()
+	n += 1 + l + sovApi(uint64(l))
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.IfVersion != 0 {
+		n += 1 + sovApi(uint64(m.IfVersion))
+	}
+	if m.IfSeqNo != 0 {
+		n += 1 + sovApi(uint64(m.IfSeqNo))
+	}
+	return n
+}
+
+func (m *CreateResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Result != 0 {
+		n += 1 + sovApi(uint64(m.Result))
+	}
+	if m.Version != 0 {
+		n += 1 + sovApi(uint64(m.Version))
+	}
+	if m.SeqNo != 0 {
+		n += 1 + sovApi(uint64(m.SeqNo))
+	}
+	return n
+}
+
+func (m *UpdateRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = m.Doc.Size()
+	n += 1 + l + sovApi(uint64(l))
+	if m.Upsert {
+		n += 2
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.Partial)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Script != nil {
+		l = m.Script.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.IfVersion != 0 {
+		n += 1 + sovApi(uint64(m.IfVersion))
+	}
+	if m.IfSeqNo != 0 {
+		n += 1 + sovApi(uint64(m.IfSeqNo))
+	}
+	if m.MappingVersion != 0 {
+		n += 1 + sovApi(uint64(m.MappingVersion))
+	}
+	return n
+}
+
+func (m *Script) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.Params)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdateResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Result != 0 {
+		n += 1 + sovApi(uint64(m.Result))
+	}
+	if m.Version != 0 {
+		n += 1 + sovApi(uint64(m.Version))
+	}
+	if m.SeqNo != 0 {
+		n += 1 + sovApi(uint64(m.SeqNo))
+	}
+	return n
+}
+
+func (m *DeleteRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.IfVersion != 0 {
+		n += 1 + sovApi(uint64(m.IfVersion))
+	}
+	if m.IfSeqNo != 0 {
+		n += 1 + sovApi(uint64(m.IfSeqNo))
+	}
+	if m.IfExpiredAt != 0 {
+		n += 1 + sovApi(uint64(m.IfExpiredAt))
+	}
+	return n
+}
+
+func (m *DeleteResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Result != 0 {
+		n += 1 + sovApi(uint64(m.Result))
+	}
+	if m.Version != 0 {
+		n += 1 + sovApi(uint64(m.Version))
+	}
+	if m.SeqNo != 0 {
+		n += 1 + sovApi(uint64(m.SeqNo))
+	}
+	return n
+}
+
+func (m *Failure) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.Cause)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Aborted {
+		n += 2
+	}
+	if m.VersionConflict {
+		n += 2
+	}
+	if m.CurrentVersion != 0 {
+		n += 1 + sovApi(uint64(m.CurrentVersion))
+	}
+	if m.CurrentSeqNo != 0 {
+		n += 1 + sovApi(uint64(m.CurrentSeqNo))
+	}
+	return n
+}
+
+func (m *SearchRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = m.ActionRequestHeader.Size()
+	n += 1 + l + sovApi(uint64(l))
+	l = m.Query.Size()
+	n += 1 + l + sovApi(uint64(l))
+	if m.From != 0 {
+		n += 1 + sovApi(uint64(m.From))
+	}
+	if m.Size_ != 0 {
+		n += 1 + sovApi(uint64(m.Size_))
+	}
+	if len(m.Sort) > 0 {
+		for _, e := range m.Sort {
+			l = e.Size()
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	if len(m.Fields) > 0 {
+		l = 0
+		for _, e := range m.Fields {
+			l += sovApi(uint64(e))
+		}
+		n += 1 + sovApi(uint64(l)) + l
+	}
+	if len(m.Similarities) > 0 {
+		for k, v := range m.Similarities {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovApi(uint64(k)) + 1 + len(v) + sovApi(uint64(len(v)))
+			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
+		}
+	}
+	if m.Statistics != nil {
+		l = m.Statistics.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if len(m.Aggregations) > 0 {
+		for k, v := range m.Aggregations {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + l + sovApi(uint64(l))
+			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
+		}
+	}
+	if m.Highlight != nil {
+		l = m.Highlight.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *SearchResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = m.ResponseHeader.Size()
+	n += 1 + l + sovApi(uint64(l))
+	if m.Total != 0 {
+		n += 1 + sovApi(uint64(m.Total))
+	}
+	if len(m.Hits) > 0 {
+		for _, e := range m.Hits {
+			l = e.Size()
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	if len(m.Aggregations) > 0 {
+		for k, v := range m.Aggregations {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + l + sovApi(uint64(l))
+			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *SearchHit) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Score != 0 {
+		n += 9
+	}
+	if len(m.Fields) > 0 {
+		for k, v := range m.Fields {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + sovApi(uint64(k)) + 1 + l + sovApi(uint64(l))
+			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Highlights) > 0 {
+		for k, v := range m.Highlights {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + sovApi(uint64(k)) + 1 + l + sovApi(uint64(l))
+			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
+		}
+	}
+	if len(m.InnerHits) > 0 {
+		for k, v := range m.InnerHits {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + sovApi(uint64(k)) + 1 + l + sovApi(uint64(l))
+			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *InnerHits) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Hits) > 0 {
+		for _, e := range m.Hits {
+			l = e.Size()
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *InnerHit) Size() (n int) {
+	var l int
+	_ = l
+	if m.Offset != 0 {
+		n += 1 + sovApi(uint64(m.Offset))
+	}
+	if m.Score != 0 {
+		n += 9
+	}
+	if len(m.Fields) > 0 {
+		for k, v := range m.Fields {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + sovApi(uint64(k)) + 1 + l + sovApi(uint64(l))
+			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *Highlight) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Fields) > 0 {
+		l = 0
+		for _, e := range m.Fields {
+			l += sovApi(uint64(e))
+		}
+		n += 1 + sovApi(uint64(l)) + l
+	}
+	l = len(m.PreTag)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.PostTag)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.FragmentSize != 0 {
+		n += 1 + sovApi(uint64(m.FragmentSize))
+	}
+	if m.NumberOfFragments != 0 {
+		n += 1 + sovApi(uint64(m.NumberOfFragments))
+	}
+	if len(m.Analyzers) > 0 {
+		for k, v := range m.Analyzers {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovApi(uint64(k)) + 1 + len(v) + sovApi(uint64(len(v)))
+			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *HighlightFragments) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Fragments) > 0 {
+		for _, s := range m.Fragments {
+			l = len(s)
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SearchStatisticsRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = m.ActionRequestHeader.Size()
+	n += 1 + l + sovApi(uint64(l))
+	l = m.Query.Size()
+	n += 1 + l + sovApi(uint64(l))
+	return n
+}
+
+func (m *SearchStatisticsResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = m.ResponseHeader.Size()
+	n += 1 + l + sovApi(uint64(l))
+	l = m.Statistics.Size()
+	n += 1 + l + sovApi(uint64(l))
+	return n
+}
+
+func (m *SearchStatistics) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Fields) > 0 {
+		for _, e := range m.Fields {
+			l = e.Size()
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	if len(m.Terms) > 0 {
+		for _, e := range m.Terms {
+			l = e.Size()
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FieldStatistics) Size() (n int) {
+	var l int
+	_ = l
+	if m.Field != 0 {
+		n += 1 + sovApi(uint64(m.Field))
+	}
+	if m.DocCount != 0 {
+		n += 1 + sovApi(uint64(m.DocCount))
+	}
+	if m.SumLength != 0 {
+		n += 1 + sovApi(uint64(m.SumLength))
+	}
+	return n
+}
+
+func (m *TermStatistics) Size() (n int) {
+	var l int
+	_ = l
+	if m.Field != 0 {
+		n += 1 + sovApi(uint64(m.Field))
+	}
+	l = len(m.Term)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.DocFreq != 0 {
+		n += 1 + sovApi(uint64(m.DocFreq))
+	}
+	return n
+}
+
+func (m *AnalyzeRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = m.ActionRequestHeader.Size()
+	n += 1 + l + sovApi(uint64(l))
+	l = len(m.Analyzer)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.Text)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Explain {
+		n += 2
+	}
+	return n
+}
+
+func (m *AnalyzeResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = m.ResponseHeader.Size()
+	n += 1 + l + sovApi(uint64(l))
+	l = len(m.Analyzer)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if len(m.Tokens) > 0 {
+		for _, e := range m.Tokens {
+			l = e.Size()
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	if len(m.Stages) > 0 {
+		for _, e := range m.Stages {
+			l = e.Size()
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AnalyzeToken) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Term)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Start != 0 {
+		n += 1 + sovApi(uint64(m.Start))
+	}
+	if m.End != 0 {
+		n += 1 + sovApi(uint64(m.End))
+	}
+	if m.Position != 0 {
+		n += 1 + sovApi(uint64(m.Position))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *AnalyzeStage) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.Text)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if len(m.Tokens) > 0 {
+		for _, e := range m.Tokens {
+			l = e.Size()
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TermVectorsRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = m.ActionRequestHeader.Size()
+	n += 1 + l + sovApi(uint64(l))
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if len(m.Fields) > 0 {
+		l = 0
+		for _, e := range m.Fields {
+			l += sovApi(uint64(e))
+		}
+		n += 1 + sovApi(uint64(l)) + l
+	}
+	if m.TermStatistics {
+		n += 2
+	}
+	return n
+}
+
+func (m *TermVectorsResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = m.ResponseHeader.Size()
+	n += 1 + l + sovApi(uint64(l))
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Found {
+		n += 2
+	}
+	if len(m.Fields) > 0 {
+		for k, v := range m.Fields {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + sovApi(uint64(k)) + 1 + l + sovApi(uint64(l))
+			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *FieldTermVectors) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Terms) > 0 {
+		for _, e := range m.Terms {
+			l = e.Size()
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TermVector) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Term)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Freq != 0 {
+		n += 1 + sovApi(uint64(m.Freq))
+	}
+	if len(m.Positions) > 0 {
+		for _, e := range m.Positions {
+			l = e.Size()
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	if m.DocFreq != 0 {
+		n += 1 + sovApi(uint64(m.DocFreq))
+	}
+	if m.TotalTermFreq != 0 {
+		n += 1 + sovApi(uint64(m.TotalTermFreq))
+	}
+	return n
+}
+
+func (m *TermPosition) Size() (n int) {
+	var l int
+	_ = l
+	if m.Position != 0 {
+		n += 1 + sovApi(uint64(m.Position))
+	}
+	if m.Start != 0 {
+		n += 1 + sovApi(uint64(m.Start))
+	}
+	if m.End != 0 {
+		n += 1 + sovApi(uint64(m.End))
+	}
+	return n
+}
+
+func (m *ScrollRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = m.ActionRequestHeader.Size()
+	n += 1 + l + sovApi(uint64(l))
+	l = len(m.ScrollId)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Size_ != 0 {
+		n += 1 + sovApi(uint64(m.Size_))
+	}
+	if len(m.Fields) > 0 {
+		l = 0
+		for _, e := range m.Fields {
+			l += sovApi(uint64(e))
+		}
+		n += 1 + sovApi(uint64(l)) + l
+	}
+	l = len(m.KeepAlive)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.After)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *ScrollResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = m.ResponseHeader.Size()
+	n += 1 + l + sovApi(uint64(l))
+	l = len(m.ScrollId)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if len(m.Docs) > 0 {
+		for _, e := range m.Docs {
+			l = e.Size()
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	if m.Done {
+		n += 2
+	}
+	return n
+}
+
+func (m *ScrollDocument) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if len(m.Fields) > 0 {
+		for k, v := range m.Fields {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + sovApi(uint64(k)) + 1 + l + sovApi(uint64(l))
+			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *ClearScrollRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = m.ActionRequestHeader.Size()
+	n += 1 + l + sovApi(uint64(l))
+	l = len(m.ScrollId)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *ClearScrollResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = m.ResponseHeader.Size()
+	n += 1 + l + sovApi(uint64(l))
+	return n
+}
+
+func (m *ByQueryRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = m.ActionRequestHeader.Size()
+	n += 1 + l + sovApi(uint64(l))
+	l = m.Query.Size()
+	n += 1 + l + sovApi(uint64(l))
+	l = len(m.Partial)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Script != nil {
+		l = m.Script.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.BatchSize != 0 {
+		n += 1 + sovApi(uint64(m.BatchSize))
+	}
+	if m.RequestsPerSecond != 0 {
+		n += 5
+	}
+	if m.ProceedOnConflicts {
+		n += 2
+	}
+	return n
+}
+
+func (m *ByQueryResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = m.ResponseHeader.Size()
+	n += 1 + l + sovApi(uint64(l))
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *ByQueryTaskRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = m.ActionRequestHeader.Size()
+	n += 1 + l + sovApi(uint64(l))
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *ByQueryTaskResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = m.ResponseHeader.Size()
+	n += 1 + l + sovApi(uint64(l))
+	l = m.Status.Size()
+	n += 1 + l + sovApi(uint64(l))
+	return n
+}
+
+func (m *ByQueryStatus) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Update {
+		n += 2
+	}
+	if m.Total != 0 {
+		n += 1 + sovApi(uint64(m.Total))
+	}
+	if m.Deleted != 0 {
+		n += 1 + sovApi(uint64(m.Deleted))
+	}
+	if m.Updated != 0 {
+		n += 1 + sovApi(uint64(m.Updated))
+	}
+	if m.Noops != 0 {
+		n += 1 + sovApi(uint64(m.Noops))
+	}
+	if m.VersionConflicts != 0 {
+		n += 1 + sovApi(uint64(m.VersionConflicts))
+	}
+	if m.Failures != 0 {
+		n += 1 + sovApi(uint64(m.Failures))
+	}
+	if m.Batches != 0 {
+		n += 1 + sovApi(uint64(m.Batches))
+	}
+	if m.Done {
+		n += 2
+	}
+	if m.Canceled {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovApi(uint64(m.StartTime))
+	}
+	if m.EndTime != 0 {
+		n += 1 + sovApi(uint64(m.EndTime))
+	}
+	return n
+}
+
+func (m *SortField) Size() (n int) {
+	var l int
+	_ = l
+	if m.Field != 0 {
+		n += 1 + sovApi(uint64(m.Field))
+	}
+	if m.Reverse {
+		n += 2
+	}
+	if m.GeoDistance != nil {
+		l = m.GeoDistance.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *Query) Size() (n int) {
+	var l int
+	_ = l
+	if m.Term != nil {
+		l = m.Term.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Terms != nil {
+		l = m.Terms.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.MatchAll != nil {
+		l = m.MatchAll.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Bool != nil {
+		l = m.Bool.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Phrase != nil {
+		l = m.Phrase.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Range != nil {
+		l = m.Range.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Prefix != nil {
+		l = m.Prefix.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Wildcard != nil {
+		l = m.Wildcard.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Regexp != nil {
+		l = m.Regexp.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Fuzzy != nil {
+		l = m.Fuzzy.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.GeoBoundingBox != nil {
+		l = m.GeoBoundingBox.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.GeoDistance != nil {
+		l = m.GeoDistance.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.GeoPolygon != nil {
+		l = m.GeoPolygon.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Nested != nil {
+		l = m.Nested.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *TermQuery) Size() (n int) {
+	var l int
+	_ = l
+	if m.Field != 0 {
+		n += 1 + sovApi(uint64(m.Field))
+	}
+	l = len(m.Term)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *TermsQuery) Size() (n int) {
+	var l int
+	_ = l
+	if m.Field != 0 {
+		n += 1 + sovApi(uint64(m.Field))
+	}
+	if len(m.Terms) > 0 {
+		for _, b := range m.Terms {
+			l = len(b)
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PhraseQuery) Size() (n int) {
+	var l int
+	_ = l
+	if m.Field != 0 {
+		n += 1 + sovApi(uint64(m.Field))
+	}
+	if len(m.Terms) > 0 {
+		for _, b := range m.Terms {
+			l = len(b)
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	if m.Slop != 0 {
+		n += 1 + sovApi(uint64(m.Slop))
+	}
+	return n
+}
+
+func (m *RangeQuery) Size() (n int) {
+	var l int
+	_ = l
+	if m.Field != 0 {
+		n += 1 + sovApi(uint64(m.Field))
+	}
+	l = len(m.Gt)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.Gte)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.Lt)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.Lte)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *PrefixQuery) Size() (n int) {
+	var l int
+	_ = l
+	if m.Field != 0 {
+		n += 1 + sovApi(uint64(m.Field))
+	}
+	l = len(m.Prefix)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.MaxExpansions != 0 {
+		n += 1 + sovApi(uint64(m.MaxExpansions))
+	}
+	return n
+}
+
+func (m *WildcardQuery) Size() (n int) {
+	var l int
+	_ = l
+	if m.Field != 0 {
+		n += 1 + sovApi(uint64(m.Field))
+	}
+	l = len(m.Pattern)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.MaxExpansions != 0 {
+		n += 1 + sovApi(uint64(m.MaxExpansions))
+	}
+	return n
+}
+
+func (m *RegexpQuery) Size() (n int) {
+	var l int
+	_ = l
+	if m.Field != 0 {
+		n += 1 + sovApi(uint64(m.Field))
+	}
+	l = len(m.Pattern)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.MaxExpansions != 0 {
+		n += 1 + sovApi(uint64(m.MaxExpansions))
+	}
+	return n
+}
+
+func (m *FuzzyQuery) Size() (n int) {
+	var l int
+	_ = l
+	if m.Field != 0 {
+		n += 1 + sovApi(uint64(m.Field))
+	}
+	l = len(m.Term)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.MaxEdits != 0 {
+		n += 1 + sovApi(uint64(m.MaxEdits))
+	}
+	if m.PrefixLength != 0 {
+		n += 1 + sovApi(uint64(m.PrefixLength))
+	}
+	if m.MaxExpansions != 0 {
+		n += 1 + sovApi(uint64(m.MaxExpansions))
+	}
+	return n
+}
+
+func (m *GeoPoint) Size() (n int) {
+	var l int
+	_ = l
+	if m.Lat != 0 {
+		n += 9
+	}
+	if m.Lon != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *GeoBoundingBoxQuery) Size() (n int) {
+	var l int
+	_ = l
+	if m.Field != 0 {
+		n += 1 + sovApi(uint64(m.Field))
+	}
+	if m.TopLeft != nil {
+		l = m.TopLeft.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.BottomRight != nil {
+		l = m.BottomRight.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *GeoDistanceQuery) Size() (n int) {
+	var l int
+	_ = l
+	if m.Field != 0 {
+		n += 1 + sovApi(uint64(m.Field))
+	}
+	if m.Origin != nil {
+		l = m.Origin.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Distance != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *GeoPolygonQuery) Size() (n int) {
+	var l int
+	_ = l
+	if m.Field != 0 {
+		n += 1 + sovApi(uint64(m.Field))
+	}
+	if len(m.Points) > 0 {
+		for _, e := range m.Points {
+			l = e.Size()
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MatchAllQuery) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *NestedQuery) Size() (n int) {
+	var l int
+	_ = l
+	if m.Field != 0 {
+		n += 1 + sovApi(uint64(m.Field))
+	}
+	l = m.Query.Size()
+	n += 1 + l + sovApi(uint64(l))
+	l = len(m.ScoreMode)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.InnerHits {
+		n += 2
+	}
+	if m.InnerHitsSize != 0 {
+		n += 1 + sovApi(uint64(m.InnerHitsSize))
+	}
+	return n
+}
+
+func (m *BoolQuery) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Must) > 0 {
+		for _, e := range m.Must {
+			l = e.Size()
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	if len(m.Should) > 0 {
+		for _, e := range m.Should {
+			l = e.Size()
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	if len(m.MustNot) > 0 {
+		for _, e := range m.MustNot {
+			l = e.Size()
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	if m.MinShould != 0 {
+		n += 1 + sovApi(uint64(m.MinShould))
+	}
+	return n
+}
+
+func (m *Document) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if len(m.Fields) > 0 {
+		for _, e := range m.Fields {
+			l = e.Size()
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	if len(m.Nested) > 0 {
+		for _, e := range m.Nested {
+			l = e.Size()
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	if m.ExpireAt != 0 {
+		n += 1 + sovApi(uint64(m.ExpireAt))
+	}
+	return n
+}
+
+func (m *NestedDocument) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Parent)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Field != 0 {
+		n += 1 + sovApi(uint64(m.Field))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovApi(uint64(m.Offset))
+	}
+	if len(m.Fields) > 0 {
+		for _, e := range m.Fields {
+			l = e.Size()
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Field) Size() (n int) {
+	var l int
+	_ = l
+	l = m.FieldValue.Size()
+	n += 1 + l + sovApi(uint64(l))
+	l = m.Desc.Size()
+	n += 1 + l + sovApi(uint64(l))
+	return n
+}
+
+func (m *FieldValue) Size() (n int) {
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovApi(uint64(m.Id))
+	}
+	if m.Type != 0 {
+		n += 1 + sovApi(uint64(m.Type))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *FieldDesc) Size() (n int) {
+	var l int
+	_ = l
+	if m.Stored {
+		n += 2
+	}
+	if m.Tokenized {
+		n += 2
+	}
+	if m.IndexOption != 0 {
+		n += 1 + sovApi(uint64(m.IndexOption))
+	}
+	l = len(m.Analyzer)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.DocValues {
+		n += 2
+	}
+	return n
+}
+
+func (m *Aggregation) Size() (n int) {
+	var l int
+	_ = l
+	if m.Terms != nil {
+		l = m.Terms.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Histogram != nil {
+		l = m.Histogram.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.DateHistogram != nil {
+		l = m.DateHistogram.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Range != nil {
+		l = m.Range.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Min != nil {
+		l = m.Min.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Max != nil {
+		l = m.Max.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Avg != nil {
+		l = m.Avg.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Sum != nil {
+		l = m.Sum.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Stats != nil {
+		l = m.Stats.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Cardinality != nil {
+		l = m.Cardinality.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *TermsAggregation) Size() (n int) {
+	var l int
+	_ = l
+	if m.Field != 0 {
+		n += 1 + sovApi(uint64(m.Field))
+	}
+	if m.Size_ != 0 {
+		n += 1 + sovApi(uint64(m.Size_))
+	}
+	if m.ShardSize != 0 {
+		n += 1 + sovApi(uint64(m.ShardSize))
+	}
+	if len(m.Aggregations) > 0 {
+		for k, v := range m.Aggregations {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + l + sovApi(uint64(l))
+			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *HistogramAggregation) Size() (n int) {
+	var l int
+	_ = l
+	if m.Field != 0 {
+		n += 1 + sovApi(uint64(m.Field))
+	}
+	if m.Interval != 0 {
+		n += 9
+	}
+	if len(m.Aggregations) > 0 {
+		for k, v := range m.Aggregations {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + l + sovApi(uint64(l))
+			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *DateHistogramAggregation) Size() (n int) {
+	var l int
+	_ = l
+	if m.Field != 0 {
+		n += 1 + sovApi(uint64(m.Field))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovApi(uint64(m.Interval))
+	}
+	if len(m.Aggregations) > 0 {
+		for k, v := range m.Aggregations {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + l + sovApi(uint64(l))
+			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *RangeAggregation) Size() (n int) {
+	var l int
+	_ = l
+	if m.Field != 0 {
+		n += 1 + sovApi(uint64(m.Field))
+	}
+	if len(m.Ranges) > 0 {
+		for _, e := range m.Ranges {
+			l = e.Size()
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	if len(m.Aggregations) > 0 {
+		for k, v := range m.Aggregations {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + l + sovApi(uint64(l))
+			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *AggregationRange) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *MinAggregation) Size() (n int) {
+	var l int
+	_ = l
+	if m.Field != 0 {
+		n += 1 + sovApi(uint64(m.Field))
+	}
+	return n
+}
+
+func (m *MaxAggregation) Size() (n int) {
+	var l int
+	_ = l
+	if m.Field != 0 {
+		n += 1 + sovApi(uint64(m.Field))
+	}
+	return n
+}
+
+func (m *AvgAggregation) Size() (n int) {
+	var l int
+	_ = l
+	if m.Field != 0 {
+		n += 1 + sovApi(uint64(m.Field))
+	}
+	return n
+}
+
+func (m *SumAggregation) Size() (n int) {
+	var l int
+	_ = l
+	if m.Field != 0 {
+		n += 1 + sovApi(uint64(m.Field))
+	}
+	return n
+}
+
+func (m *StatsAggregation) Size() (n int) {
+	var l int
+	_ = l
+	if m.Field != 0 {
+		n += 1 + sovApi(uint64(m.Field))
+	}
+	return n
+}
+
+func (m *CardinalityAggregation) Size() (n int) {
+	var l int
+	_ = l
+	if m.Field != 0 {
+		n += 1 + sovApi(uint64(m.Field))
+	}
+	if m.Precision != 0 {
+		n += 1 + sovApi(uint64(m.Precision))
+	}
+	return n
+}
+
+func (m *AggregationResult) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Buckets) > 0 {
+		for _, e := range m.Buckets {
+			l = e.Size()
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	if m.Stats != nil {
+		l = m.Stats.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.Cardinality)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *AggregationBucket) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.DocCount != 0 {
+		n += 1 + sovApi(uint64(m.DocCount))
+	}
+	if len(m.Aggregations) > 0 {
+		for k, v := range m.Aggregations {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + l + sovApi(uint64(l))
+			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *StatsResult) Size() (n int) {
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovApi(uint64(m.Count))
+	}
+	if m.Sum != 0 {
+		n += 9
+	}
+	if m.Min != 0 {
+		n += 9
+	}
+	if m.Max != 0 {
+		n += 9
+	}
+	return n
+}
+
+func sovApi(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozApi(x uint64) (n int) {
+	return sovApi(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *ActionRequestHeader) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ActionRequestHeader{`,
+		`RequestHeader:` + strings.Replace(strings.Replace(this.RequestHeader.String(), "RequestHeader", "meta.RequestHeader", 1), `&`, ``, 1) + `,`,
+		`Partition:` + fmt.Sprintf("%v", this.Partition) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetRequest{`,
+		`ActionRequestHeader:` + strings.Replace(strings.Replace(this.ActionRequestHeader.String(), "ActionRequestHeader", "ActionRequestHeader", 1), `&`, ``, 1) + `,`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`Fields:` + fmt.Sprintf("%v", this.Fields) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForFields := make([]uint32, 0, len(this.Fields))
+	for k, _ := range this.Fields {
+		keysForFields = append(keysForFields, k)
+	}
+	sortkeys.Uint32s(keysForFields)
+	mapStringForFields := "map[uint32]FieldValue{"
+	for _, k := range keysForFields {
+		mapStringForFields += fmt.Sprintf("%v: %v,", k, this.Fields[k])
+	}
+	mapStringForFields += "}"
+	s := strings.Join([]string{`&GetResponse{`,
+		`ResponseHeader:` + strings.Replace(strings.Replace(this.ResponseHeader.String(), "ResponseHeader", "meta.ResponseHeader", 1), `&`, ``, 1) + `,`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`Found:` + fmt.Sprintf("%v", this.Found) + `,`,
+		`Fields:` + mapStringForFields + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`SeqNo:` + fmt.Sprintf("%v", this.SeqNo) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BulkRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BulkRequest{`,
+		`ActionRequestHeader:` + strings.Replace(strings.Replace(this.ActionRequestHeader.String(), "ActionRequestHeader", "ActionRequestHeader", 1), `&`, ``, 1) + `,`,
+		`Requests:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Requests), "BulkItemRequest", "BulkItemRequest", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BulkResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BulkResponse{`,
+		`ResponseHeader:` + strings.Replace(strings.Replace(this.ResponseHeader.String(), "ResponseHeader", "meta.ResponseHeader", 1), `&`, ``, 1) + `,`,
+		`Responses:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Responses), "BulkItemResponse", "BulkItemResponse", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BulkItemRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BulkItemRequest{`,
+		`OpType:` + fmt.Sprintf("%v", this.OpType) + `,`,
+		`Create:` + strings.Replace(fmt.Sprintf("%v", this.Create), "CreateRequest", "CreateRequest", 1) + `,`,
+		`Update:` + strings.Replace(fmt.Sprintf("%v", this.Update), "UpdateRequest", "UpdateRequest", 1) + `,`,
+		`Delete:` + strings.Replace(fmt.Sprintf("%v", this.Delete), "DeleteRequest", "DeleteRequest", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BulkItemResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BulkItemResponse{`,
+		`OpType:` + fmt.Sprintf("%v", this.OpType) + `,`,
+		`Create:` + strings.Replace(fmt.Sprintf("%v", this.Create), "CreateResponse", "CreateResponse", 1) + `,`,
+		`Update:` + strings.Replace(fmt.Sprintf("%v", this.Update), "UpdateResponse", "UpdateResponse", 1) + `,`,
+		`Delete:` + strings.Replace(fmt.Sprintf("%v", this.Delete), "DeleteResponse", "DeleteResponse", 1) + `,`,
+		`Failure:` + strings.Replace(fmt.Sprintf("%v", this.Failure), "Failure", "Failure", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CreateRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CreateRequest{`,
+		`Doc:` + strings.Replace(strings.Replace(this.Doc.String(), "Document", "Document", 1), `&`, ``, 1) + `,`,
+		`Source:` + fmt.Sprintf("%v", this.Source) + `,`,
+		`IfVersion:` + fmt.Sprintf("%v", this.IfVersion) + `,`,
+		`IfSeqNo:` + fmt.Sprintf("%v", this.IfSeqNo) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CreateResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CreateResponse{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`Result:` + fmt.Sprintf("%v", this.Result) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`SeqNo:` + fmt.Sprintf("%v", this.SeqNo) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpdateRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateRequest{`,
+		`Doc:` + strings.Replace(strings.Replace(this.Doc.String(), "Document", "Document", 1), `&`, ``, 1) + `,`,
+		`Upsert:` + fmt.Sprintf("%v", this.Upsert) + `,`,
+		`Source:` + fmt.Sprintf("%v", this.Source) + `,`,
+		`Partial:` + fmt.Sprintf("%v", this.Partial) + `,`,
+		`Script:` + strings.Replace(fmt.Sprintf("%v", this.Script), "Script", "Script", 1) + `,`,
+		`IfVersion:` + fmt.Sprintf("%v", this.IfVersion) + `,`,
+		`IfSeqNo:` + fmt.Sprintf("%v", this.IfSeqNo) + `,`,
+		`MappingVersion:` + fmt.Sprintf("%v", this.MappingVersion) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Script) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Script{`,
+		`Source:` + fmt.Sprintf("%v", this.Source) + `,`,
+		`Params:` + fmt.Sprintf("%v", this.Params) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpdateResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateResponse{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`Result:` + fmt.Sprintf("%v", this.Result) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`SeqNo:` + fmt.Sprintf("%v", this.SeqNo) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeleteRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeleteRequest{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`IfVersion:` + fmt.Sprintf("%v", this.IfVersion) + `,`,
+		`IfSeqNo:` + fmt.Sprintf("%v", this.IfSeqNo) + `,`,
+		`IfExpiredAt:` + fmt.Sprintf("%v", this.IfExpiredAt) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeleteResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeleteResponse{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`Result:` + fmt.Sprintf("%v", this.Result) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`SeqNo:` + fmt.Sprintf("%v", this.SeqNo) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Failure) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Failure{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`Cause:` + fmt.Sprintf("%v", this.Cause) + `,`,
+		`Aborted:` + fmt.Sprintf("%v", this.Aborted) + `,`,
+		`VersionConflict:` + fmt.Sprintf("%v", this.VersionConflict) + `,`,
+		`CurrentVersion:` + fmt.Sprintf("%v", this.CurrentVersion) + `,`,
+		`CurrentSeqNo:` + fmt.Sprintf("%v", this.CurrentSeqNo) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SearchRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForSimilarities := make([]uint32, 0, len(this.Similarities))
+	for k, _ := range this.Similarities {
+		keysForSimilarities = append(keysForSimilarities, k)
+	}
+	sortkeys.Uint32s(keysForSimilarities)
+	mapStringForSimilarities := "map[uint32]string{"
+	for _, k := range keysForSimilarities {
+		mapStringForSimilarities += fmt.Sprintf("%v: %v,", k, this.Similarities[k])
+	}
+	mapStringForSimilarities += "}"
+	keysForAggregations := make([]string, 0, len(this.Aggregations))
+	for k, _ := range this.Aggregations {
+		keysForAggregations = append(keysForAggregations, k)
+	}
+	sortkeys.Strings(keysForAggregations)
+	mapStringForAggregations := "map[string]Aggregation{"
+	for _, k := range keysForAggregations {
+		mapStringForAggregations += fmt.Sprintf("%v: %v,", k, this.Aggregations[k])
+	}
+	mapStringForAggregations += "}"
+	s := strings.Join([]string{`&SearchRequest{`,
+		`ActionRequestHeader:` + strings.Replace(strings.Replace(this.ActionRequestHeader.String(), "ActionRequestHeader", "ActionRequestHeader", 1), `&`, ``, 1) + `,`,
+		`Query:` + strings.Replace(strings.Replace(this.Query.String(), "Query", "Query", 1), `&`, ``, 1) + `,`,
+		`From:` + fmt.Sprintf("%v", this.From) + `,`,
+		`Size_:` + fmt.Sprintf("%v", this.Size_) + `,`,
+		`Sort:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Sort), "SortField", "SortField", 1), `&`, ``, 1) + `,`,
+		`Fields:` + fmt.Sprintf("%v", this.Fields) + `,`,
+		`Similarities:` + mapStringForSimilarities + `,`,
+		`Statistics:` + strings.Replace(fmt.Sprintf("%v", this.Statistics), "SearchStatistics", "SearchStatistics", 1) + `,`,
+		`Aggregations:` + mapStringForAggregations + `,`,
+		`Highlight:` + strings.Replace(fmt.Sprintf("%v", this.Highlight), "Highlight", "Highlight", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SearchResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForAggregations := make([]string, 0, len(this.Aggregations))
+	for k, _ := range this.Aggregations {
+		keysForAggregations = append(keysForAggregations, k)
+	}
+	sortkeys.Strings(keysForAggregations)
+	mapStringForAggregations := "map[string]AggregationResult{"
+	for _, k := range keysForAggregations {
+		mapStringForAggregations += fmt.Sprintf("%v: %v,", k, this.Aggregations[k])
+	}
+	mapStringForAggregations += "}"
+	s := strings.Join([]string{`&SearchResponse{`,
+		`ResponseHeader:` + strings.Replace(strings.Replace(this.ResponseHeader.String(), "ResponseHeader", "meta.ResponseHeader", 1), `&`, ``, 1) + `,`,
+		`Total:` + fmt.Sprintf("%v", this.Total) + `,`,
+		`Hits:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Hits), "SearchHit", "SearchHit", 1), `&`, ``, 1) + `,`,
+		`Aggregations:` + mapStringForAggregations + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SearchHit) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForFields := make([]uint32, 0, len(this.Fields))
+	for k, _ := range this.Fields {
+		keysForFields = append(keysForFields, k)
+	}
+	sortkeys.Uint32s(keysForFields)
+	mapStringForFields := "map[uint32]FieldValue{"
+	for _, k := range keysForFields {
+		mapStringForFields += fmt.Sprintf("%v: %v,", k, this.Fields[k])
+	}
+	mapStringForFields += "}"
+	keysForHighlights := make([]uint32, 0, len(this.Highlights))
+	for k, _ := range this.Highlights {
+		keysForHighlights = append(keysForHighlights, k)
+	}
+	sortkeys.Uint32s(keysForHighlights)
+	mapStringForHighlights := "map[uint32]HighlightFragments{"
+	for _, k := range keysForHighlights {
+		mapStringForHighlights += fmt.Sprintf("%v: %v,", k, this.Highlights[k])
+	}
+	mapStringForHighlights += "}"
+	keysForInnerHits := make([]uint32, 0, len(this.InnerHits))
+	for k, _ := range this.InnerHits {
+		keysForInnerHits = append(keysForInnerHits, k)
+	}
+	sortkeys.Uint32s(keysForInnerHits)
+	mapStringForInnerHits := "map[uint32]InnerHits{"
+	for _, k := range keysForInnerHits {
+		mapStringForInnerHits += fmt.Sprintf("%v: %v,", k, this.InnerHits[k])
+	}
+	mapStringForInnerHits += "}"
+	s := strings.Join([]string{`&SearchHit{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`Score:` + fmt.Sprintf("%v", this.Score) + `,`,
+		`Fields:` + mapStringForFields + `,`,
+		`Highlights:` + mapStringForHighlights + `,`,
+		`InnerHits:` + mapStringForInnerHits + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *InnerHits) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&InnerHits{`,
+		`Hits:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Hits), "InnerHit", "InnerHit", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *InnerHit) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForFields := make([]uint32, 0, len(this.Fields))
+	for k, _ := range this.Fields {
+		keysForFields = append(keysForFields, k)
+	}
+	sortkeys.Uint32s(keysForFields)
+	mapStringForFields := "map[uint32]FieldValue{"
+	for _, k := range keysForFields {
+		mapStringForFields += fmt.Sprintf("%v: %v,", k, this.Fields[k])
+	}
+	mapStringForFields += "}"
+	s := strings.Join([]string{`&InnerHit{`,
+		`Offset:` + fmt.Sprintf("%v", this.Offset) + `,`,
+		`Score:` + fmt.Sprintf("%v", this.Score) + `,`,
+		`Fields:` + mapStringForFields + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Highlight) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForAnalyzers := make([]uint32, 0, len(this.Analyzers))
+	for k, _ := range this.Analyzers {
+		keysForAnalyzers = append(keysForAnalyzers, k)
+	}
+	sortkeys.Uint32s(keysForAnalyzers)
+	mapStringForAnalyzers := "map[uint32]string{"
+	for _, k := range keysForAnalyzers {
+		mapStringForAnalyzers += fmt.Sprintf("%v: %v,", k, this.Analyzers[k])
+	}
+	mapStringForAnalyzers += "}"
+	s := strings.Join([]string{`&Highlight{`,
+		`Fields:` + fmt.Sprintf("%v", this.Fields) + `,`,
+		`PreTag:` + fmt.Sprintf("%v", this.PreTag) + `,`,
+		`PostTag:` + fmt.Sprintf("%v", this.PostTag) + `,`,
+		`FragmentSize:` + fmt.Sprintf("%v", this.FragmentSize) + `,`,
+		`NumberOfFragments:` + fmt.Sprintf("%v", this.NumberOfFragments) + `,`,
+		`Analyzers:` + mapStringForAnalyzers + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HighlightFragments) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HighlightFragments{`,
+		`Fragments:` + fmt.Sprintf("%v", this.Fragments) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SearchStatisticsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SearchStatisticsRequest{`,
+		`ActionRequestHeader:` + strings.Replace(strings.Replace(this.ActionRequestHeader.String(), "ActionRequestHeader", "ActionRequestHeader", 1), `&`, ``, 1) + `,`,
+		`Query:` + strings.Replace(strings.Replace(this.Query.String(), "Query", "Query", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SearchStatisticsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SearchStatisticsResponse{`,
+		`ResponseHeader:` + strings.Replace(strings.Replace(this.ResponseHeader.String(), "ResponseHeader", "meta.ResponseHeader", 1), `&`, ``, 1) + `,`,
+		`Statistics:` + strings.Replace(strings.Replace(this.Statistics.String(), "SearchStatistics", "SearchStatistics", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SearchStatistics) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SearchStatistics{`,
+		`Fields:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Fields), "FieldStatistics", "FieldStatistics", 1), `&`, ``, 1) + `,`,
+		`Terms:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Terms), "TermStatistics", "TermStatistics", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FieldStatistics) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FieldStatistics{`,
+		`Field:` + fmt.Sprintf("%v", this.Field) + `,`,
+		`DocCount:` + fmt.Sprintf("%v", this.DocCount) + `,`,
+		`SumLength:` + fmt.Sprintf("%v", this.SumLength) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TermStatistics) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TermStatistics{`,
+		`Field:` + fmt.Sprintf("%v", this.Field) + `,`,
+		`Term:` + fmt.Sprintf("%v", this.Term) + `,`,
+		`DocFreq:` + fmt.Sprintf("%v", this.DocFreq) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AnalyzeRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AnalyzeRequest{`,
+		`ActionRequestHeader:` + strings.Replace(strings.Replace(this.ActionRequestHeader.String(), "ActionRequestHeader", "ActionRequestHeader", 1), `&`, ``, 1) + `,`,
+		`Analyzer:` + fmt.Sprintf("%v", this.Analyzer) + `,`,
+		`Field:` + fmt.Sprintf("%v", this.Field) + `,`,
+		`Text:` + fmt.Sprintf("%v", this.Text) + `,`,
+		`Explain:` + fmt.Sprintf("%v", this.Explain) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AnalyzeResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AnalyzeResponse{`,
+		`ResponseHeader:` + strings.Replace(strings.Replace(this.ResponseHeader.String(), "ResponseHeader", "meta.ResponseHeader", 1), `&`, ``, 1) + `,`,
+		`Analyzer:` + fmt.Sprintf("%v", this.Analyzer) + `,`,
+		`Tokens:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Tokens), "AnalyzeToken", "AnalyzeToken", 1), `&`, ``, 1) + `,`,
+		`Stages:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Stages), "AnalyzeStage", "AnalyzeStage", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AnalyzeToken) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AnalyzeToken{`,
+		`Term:` + fmt.Sprintf("%v", this.Term) + `,`,
+		`Start:` + fmt.Sprintf("%v", this.Start) + `,`,
+		`End:` + fmt.Sprintf("%v", this.End) + `,`,
+		`Position:` + fmt.Sprintf("%v", this.Position) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AnalyzeStage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AnalyzeStage{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Text:` + fmt.Sprintf("%v", this.Text) + `,`,
+		`Tokens:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Tokens), "AnalyzeToken", "AnalyzeToken", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TermVectorsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TermVectorsRequest{`,
+		`ActionRequestHeader:` + strings.Replace(strings.Replace(this.ActionRequestHeader.String(), "ActionRequestHeader", "ActionRequestHeader", 1), `&`, ``, 1) + `,`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`Fields:` + fmt.Sprintf("%v", this.Fields) + `,`,
+		`TermStatistics:` + fmt.Sprintf("%v", this.TermStatistics) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TermVectorsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForFields := make([]uint32, 0, len(this.Fields))
+	for k, _ := range this.Fields {
+		keysForFields = append(keysForFields, k)
+	}
+	sortkeys.Uint32s(keysForFields)
+	mapStringForFields := "map[uint32]FieldTermVectors{"
+	for _, k := range keysForFields {
+		mapStringForFields += fmt.Sprintf("%v: %v,", k, this.Fields[k])
+	}
+	mapStringForFields += "}"
+	s := strings.Join([]string{`&TermVectorsResponse{`,
+		`ResponseHeader:` + strings.Replace(strings.Replace(this.ResponseHeader.String(), "ResponseHeader", "meta.ResponseHeader", 1), `&`, ``, 1) + `,`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`Found:` + fmt.Sprintf("%v", this.Found) + `,`,
+		`Fields:` + mapStringForFields + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FieldTermVectors) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FieldTermVectors{`,
+		`Terms:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Terms), "TermVector", "TermVector", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TermVector) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TermVector{`,
+		`Term:` + fmt.Sprintf("%v", this.Term) + `,`,
+		`Freq:` + fmt.Sprintf("%v", this.Freq) + `,`,
+		`Positions:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Positions), "TermPosition", "TermPosition", 1), `&`, ``, 1) + `,`,
+		`DocFreq:` + fmt.Sprintf("%v", this.DocFreq) + `,`,
+		`TotalTermFreq:` + fmt.Sprintf("%v", this.TotalTermFreq) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TermPosition) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TermPosition{`,
+		`Position:` + fmt.Sprintf("%v", this.Position) + `,`,
+		`Start:` + fmt.Sprintf("%v", this.Start) + `,`,
+		`End:` + fmt.Sprintf("%v", this.End) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ScrollRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ScrollRequest{`,
+		`ActionRequestHeader:` + strings.Replace(strings.Replace(this.ActionRequestHeader.String(), "ActionRequestHeader", "ActionRequestHeader", 1), `&`, ``, 1) + `,`,
+		`ScrollId:` + fmt.Sprintf("%v", this.ScrollId) + `,`,
+		`Size_:` + fmt.Sprintf("%v", this.Size_) + `,`,
+		`Fields:` + fmt.Sprintf("%v", this.Fields) + `,`,
+		`KeepAlive:` + fmt.Sprintf("%v", this.KeepAlive) + `,`,
+		`After:` + fmt.Sprintf("%v", this.After) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ScrollResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ScrollResponse{`,
+		`ResponseHeader:` + strings.Replace(strings.Replace(this.ResponseHeader.String(), "ResponseHeader", "meta.ResponseHeader", 1), `&`, ``, 1) + `,`,
+		`ScrollId:` + fmt.Sprintf("%v", this.ScrollId) + `,`,
+		`Docs:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Docs), "ScrollDocument", "ScrollDocument", 1), `&`, ``, 1) + `,`,
+		`Done:` + fmt.Sprintf("%v", this.Done) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ScrollDocument) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForFields := make([]uint32, 0, len(this.Fields))
+	for k, _ := range this.Fields {
+		keysForFields = append(keysForFields, k)
+	}
+	sortkeys.Uint32s(keysForFields)
+	mapStringForFields := "map[uint32]FieldValue{"
+	for _, k := range keysForFields {
+		mapStringForFields += fmt.Sprintf("%v: %v,", k, this.Fields[k])
+	}
+	mapStringForFields += "}"
+	s := strings.Join([]string{`&ScrollDocument{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`Fields:` + mapStringForFields + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ClearScrollRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ClearScrollRequest{`,
+		`ActionRequestHeader:` + strings.Replace(strings.Replace(this.ActionRequestHeader.String(), "ActionRequestHeader", "ActionRequestHeader", 1), `&`, ``, 1) + `,`,
+		`ScrollId:` + fmt.Sprintf("%v", this.ScrollId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ClearScrollResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ClearScrollResponse{`,
+		`ResponseHeader:` + strings.Replace(strings.Replace(this.ResponseHeader.String(), "ResponseHeader", "meta.ResponseHeader", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ByQueryRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ByQueryRequest{`,
+		`ActionRequestHeader:` + strings.Replace(strings.Replace(this.ActionRequestHeader.String(), "ActionRequestHeader", "ActionRequestHeader", 1), `&`, ``, 1) + `,`,
+		`Query:` + strings.Replace(strings.Replace(this.Query.String(), "Query", "Query", 1), `&`, ``, 1) + `,`,
+		`Partial:` + fmt.Sprintf("%v", this.Partial) + `,`,
+		`Script:` + strings.Replace(fmt.Sprintf("%v", this.Script), "Script", "Script", 1) + `,`,
+		`BatchSize:` + fmt.Sprintf("%v", this.BatchSize) + `,`,
+		`RequestsPerSecond:` + fmt.Sprintf("%v", this.RequestsPerSecond) + `,`,
+		`ProceedOnConflicts:` + fmt.Sprintf("%v", this.ProceedOnConflicts) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ByQueryResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ByQueryResponse{`,
+		`ResponseHeader:` + strings.Replace(strings.Replace(this.ResponseHeader.String(), "ResponseHeader", "meta.ResponseHeader", 1), `&`, ``, 1) + `,`,
+		`TaskId:` + fmt.Sprintf("%v", this.TaskId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ByQueryTaskRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ByQueryTaskRequest{`,
+		`ActionRequestHeader:` + strings.Replace(strings.Replace(this.ActionRequestHeader.String(), "ActionRequestHeader", "ActionRequestHeader", 1), `&`, ``, 1) + `,`,
+		`TaskId:` + fmt.Sprintf("%v", this.TaskId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ByQueryTaskResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ByQueryTaskResponse{`,
+		`ResponseHeader:` + strings.Replace(strings.Replace(this.ResponseHeader.String(), "ResponseHeader", "meta.ResponseHeader", 1), `&`, ``, 1) + `,`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "ByQueryStatus", "ByQueryStatus", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ByQueryStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ByQueryStatus{`,
+		`TaskId:` + fmt.Sprintf("%v", this.TaskId) + `,`,
+		`Update:` + fmt.Sprintf("%v", this.Update) + `,`,
+		`Total:` + fmt.Sprintf("%v", this.Total) + `,`,
+		`Deleted:` + fmt.Sprintf("%v", this.Deleted) + `,`,
+		`Updated:` + fmt.Sprintf("%v", this.Updated) + `,`,
+		`Noops:` + fmt.Sprintf("%v", this.Noops) + `,`,
+		`VersionConflicts:` + fmt.Sprintf("%v", this.VersionConflicts) + `,`,
+		`Failures:` + fmt.Sprintf("%v", this.Failures) + `,`,
+		`Batches:` + fmt.Sprintf("%v", this.Batches) + `,`,
+		`Done:` + fmt.Sprintf("%v", this.Done) + `,`,
+		`Canceled:` + fmt.Sprintf("%v", this.Canceled) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`StartTime:` + fmt.Sprintf("%v", this.StartTime) + `,`,
+		`EndTime:` + fmt.Sprintf("%v", this.EndTime) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SortField) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SortField{`,
+		`Field:` + fmt.Sprintf("%v", this.Field) + `,`,
+		`Reverse:` + fmt.Sprintf("%v", this.Reverse) + `,`,
+		`GeoDistance:` + strings.Replace(fmt.Sprintf("%v", this.GeoDistance), "GeoPoint", "GeoPoint", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Query) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Query{`,
+		`Term:` + strings.Replace(fmt.Sprintf("%v", this.Term), "TermQuery", "TermQuery", 1) + `,`,
+		`Terms:` + strings.Replace(fmt.Sprintf("%v", this.Terms), "TermsQuery", "TermsQuery", 1) + `,`,
+		`MatchAll:` + strings.Replace(fmt.Sprintf("%v", this.MatchAll), "MatchAllQuery", "MatchAllQuery", 1) + `,`,
+		`Bool:` + strings.Replace(fmt.Sprintf("%v", this.Bool), "BoolQuery", "BoolQuery", 1) + `,`,
+		`Phrase:` + strings.Replace(fmt.Sprintf("%v", this.Phrase), "PhraseQuery", "PhraseQuery", 1) + `,`,
+		`Range:` + strings.Replace(fmt.Sprintf("%v", this.Range), "RangeQuery", "RangeQuery", 1) + `,`,
+		`Prefix:` + strings.Replace(fmt.Sprintf("%v", this.Prefix), "PrefixQuery", "PrefixQuery", 1) + `,`,
+		`Wildcard:` + strings.Replace(fmt.Sprintf("%v", this.Wildcard), "WildcardQuery", "WildcardQuery", 1) + `,`,
+		`Regexp:` + strings.Replace(fmt.Sprintf("%v", this.Regexp), "RegexpQuery", "RegexpQuery", 1) + `,`,
+		`Fuzzy:` + strings.Replace(fmt.Sprintf("%v", this.Fuzzy), "FuzzyQuery", "FuzzyQuery", 1) + `,`,
+		`GeoBoundingBox:` + strings.Replace(fmt.Sprintf("%v", this.GeoBoundingBox), "GeoBoundingBoxQuery", "GeoBoundingBoxQuery", 1) + `,`,
+		`GeoDistance:` + strings.Replace(fmt.Sprintf("%v", this.GeoDistance), "GeoDistanceQuery", "GeoDistanceQuery", 1) + `,`,
+		`GeoPolygon:` + strings.Replace(fmt.Sprintf("%v", this.GeoPolygon), "GeoPolygonQuery", "GeoPolygonQuery", 1) + `,`,
+		`Nested:` + strings.Replace(fmt.Sprintf("%v", this.Nested), "NestedQuery", "NestedQuery", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TermQuery) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TermQuery{`,
+		`Field:` + fmt.Sprintf("%v", this.Field) + `,`,
+		`Term:` + fmt.Sprintf("%v", this.Term) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TermsQuery) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TermsQuery{`,
+		`Field:` + fmt.Sprintf("%v", this.Field) + `,`,
+		`Terms:` + fmt.Sprintf("%v", this.Terms) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PhraseQuery) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PhraseQuery{`,
+		`Field:` + fmt.Sprintf("%v", this.Field) + `,`,
+		`Terms:` + fmt.Sprintf("%v", this.Terms) + `,`,
+		`Slop:` + fmt.Sprintf("%v", this.Slop) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RangeQuery) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RangeQuery{`,
+		`Field:` + fmt.Sprintf("%v", this.Field) + `,`,
+		`Gt:` + fmt.Sprintf("%v", this.Gt) + `,`,
+		`Gte:` + fmt.Sprintf("%v", this.Gte) + `,`,
+		`Lt:` + fmt.Sprintf("%v", this.Lt) + `,`,
+		`Lte:` + fmt.Sprintf("%v", this.Lte) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PrefixQuery) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PrefixQuery{`,
+		`Field:` + fmt.Sprintf("%v", this.Field) + `,`,
+		`Prefix:` + fmt.Sprintf("%v", this.Prefix) + `,`,
+		`MaxExpansions:` + fmt.Sprintf("%v", this.MaxExpansions) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WildcardQuery) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WildcardQuery{`,
+		`Field:` + fmt.Sprintf("%v", this.Field) + `,`,
+		`Pattern:` + fmt.Sprintf("%v", this.Pattern) + `,`,
+		`MaxExpansions:` + fmt.Sprintf("%v", this.MaxExpansions) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RegexpQuery) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RegexpQuery{`,
+		`Field:` + fmt.Sprintf("%v", this.Field) + `,`,
+		`Pattern:` + fmt.Sprintf("%v", this.Pattern) + `,`,
+		`MaxExpansions:` + fmt.Sprintf("%v", this.MaxExpansions) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FuzzyQuery) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FuzzyQuery{`,
+		`Field:` + fmt.Sprintf("%v", this.Field) + `,`,
+		`Term:` + fmt.Sprintf("%v", this.Term) + `,`,
+		`MaxEdits:` + fmt.Sprintf("%v", this.MaxEdits) + `,`,
+		`PrefixLength:` + fmt.Sprintf("%v", this.PrefixLength) + `,`,
+		`MaxExpansions:` + fmt.Sprintf("%v", this.MaxExpansions) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GeoPoint) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GeoPoint{`,
+		`Lat:` + fmt.Sprintf("%v", this.Lat) + `,`,
+		`Lon:` + fmt.Sprintf("%v", this.Lon) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GeoBoundingBoxQuery) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GeoBoundingBoxQuery{`,
+		`Field:` + fmt.Sprintf("%v", this.Field) + `,`,
+		`TopLeft:` + strings.Replace(fmt.Sprintf("%v", this.TopLeft), "GeoPoint", "GeoPoint", 1) + `,`,
+		`BottomRight:` + strings.Replace(fmt.Sprintf("%v", this.BottomRight), "GeoPoint", "GeoPoint", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GeoDistanceQuery) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GeoDistanceQuery{`,
+		`Field:` + fmt.Sprintf("%v", this.Field) + `,`,
+		`Origin:` + strings.Replace(fmt.Sprintf("%v", this.Origin), "GeoPoint", "GeoPoint", 1) + `,`,
+		`Distance:` + fmt.Sprintf("%v", this.Distance) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GeoPolygonQuery) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GeoPolygonQuery{`,
+		`Field:` + fmt.Sprintf("%v", this.Field) + `,`,
+		`Points:` + strings.Replace(fmt.Sprintf("%v", this.Points), "GeoPoint", "GeoPoint", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MatchAllQuery) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MatchAllQuery{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NestedQuery) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NestedQuery{`,
+		`Field:` + fmt.Sprintf("%v", this.Field) + `,`,
+		`Query:` + strings.Replace(strings.Replace(this.Query.String(), "Query", "Query", 1), `&`, ``, 1) + `,`,
+		`ScoreMode:` + fmt.Sprintf("%v", this.ScoreMode) + `,`,
+		`InnerHits:` + fmt.Sprintf("%v", this.InnerHits) + `,`,
+		`InnerHitsSize:` + fmt.Sprintf("%v", this.InnerHitsSize) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BoolQuery) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BoolQuery{`,
+		`Must:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Must), "Query", "Query", 1), `&`, ``, 1) + `,`,
+		`Should:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Should), "Query", "Query", 1), `&`, ``, 1) + `,`,
+		`MustNot:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.MustNot), "Query", "Query", 1), `&`, ``, 1) + `,`,
+		`MinShould:` + fmt.Sprintf("%v", this.MinShould) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Document) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Document{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`Fields:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Fields), "Field", "Field", 1), `&`, ``, 1) + `,`,
+		`Nested:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Nested), "NestedDocument", "NestedDocument", 1), `&`, ``, 1) + `,`,
+		`ExpireAt:` + fmt.Sprintf("%v", this.ExpireAt) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NestedDocument) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NestedDocument{`,
+		`Parent:` + fmt.Sprintf("%v", this.Parent) + `,`,
+		`Field:` + fmt.Sprintf("%v", this.Field) + `,`,
+		`Offset:` + fmt.Sprintf("%v", this.Offset) + `,`,
+		`Fields:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Fields), "Field", "Field", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Field) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Field{`,
+		`FieldValue:` + strings.Replace(strings.Replace(this.FieldValue.String(), "FieldValue", "FieldValue", 1), `&`, ``, 1) + `,`,
+		`Desc:` + strings.Replace(strings.Replace(this.Desc.String(), "FieldDesc", "FieldDesc", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FieldValue) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FieldValue{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FieldDesc) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FieldDesc{`,
+		`Stored:` + fmt.Sprintf("%v", this.Stored) + `,`,
+		`Tokenized:` + fmt.Sprintf("%v", this.Tokenized) + `,`,
+		`IndexOption:` + fmt.Sprintf("%v", this.IndexOption) + `,`,
+		`Analyzer:` + fmt.Sprintf("%v", this.Analyzer) + `,`,
+		`DocValues:` + fmt.Sprintf("%v", this.DocValues) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Aggregation) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Aggregation{`,
+		`Terms:` + strings.Replace(fmt.Sprintf("%v", this.Terms), "TermsAggregation", "TermsAggregation", 1) + `,`,
+		`Histogram:` + strings.Replace(fmt.Sprintf("%v", this.Histogram), "HistogramAggregation", "HistogramAggregation", 1) + `,`,
+		`DateHistogram:` + strings.Replace(fmt.Sprintf("%v", this.DateHistogram), "DateHistogramAggregation", "DateHistogramAggregation", 1) + `,`,
+		`Range:` + strings.Replace(fmt.Sprintf("%v", this.Range), "RangeAggregation", "RangeAggregation", 1) + `,`,
+		`Min:` + strings.Replace(fmt.Sprintf("%v", this.Min), "MinAggregation", "MinAggregation", 1) + `,`,
+		`Max:` + strings.Replace(fmt.Sprintf("%v", this.Max), "MaxAggregation", "MaxAggregation", 1) + `,`,
+		`Avg:` + strings.Replace(fmt.Sprintf("%v", this.Avg), "AvgAggregation", "AvgAggregation", 1) + `,`,
+		`Sum:` + strings.Replace(fmt.Sprintf("%v", this.Sum), "SumAggregation", "SumAggregation", 1) + `,`,
+		`Stats:` + strings.Replace(fmt.Sprintf("%v", this.Stats), "StatsAggregation", "StatsAggregation", 1) + `,`,
+		`Cardinality:` + strings.Replace(fmt.Sprintf("%v", this.Cardinality), "CardinalityAggregation", "CardinalityAggregation", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TermsAggregation) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForAggregations := make([]string, 0, len(this.Aggregations))
+	for k, _ := range this.Aggregations {
+		keysForAggregations = append(keysForAggregations, k)
+	}
+	sortkeys.Strings(keysForAggregations)
+	mapStringForAggregations := "map[string]Aggregation{"
+	for _, k := range keysForAggregations {
+		mapStringForAggregations += fmt.Sprintf("%v: %v,", k, this.Aggregations[k])
+	}
+	mapStringForAggregations += "}"
+	s := strings.Join([]string{`&TermsAggregation{`,
+		`Field:` + fmt.Sprintf("%v", this.Field) + `,`,
+		`Size_:` + fmt.Sprintf("%v", this.Size_) + `,`,
+		`ShardSize:` + fmt.Sprintf("%v", this.ShardSize) + `,`,
+		`Aggregations:` + mapStringForAggregations + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HistogramAggregation) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForAggregations := make([]string, 0, len(this.Aggregations))
+	for k, _ := range this.Aggregations {
+		keysForAggregations = append(keysForAggregations, k)
+	}
+	sortkeys.Strings(keysForAggregations)
+	mapStringForAggregations := "map[string]Aggregation{"
+	for _, k := range keysForAggregations {
+		mapStringForAggregations += fmt.Sprintf("%v: %v,", k, this.Aggregations[k])
+	}
+	mapStringForAggregations += "}"
+	s := strings.Join([]string{`&HistogramAggregation{`,
+		`Field:` + fmt.Sprintf("%v", this.Field) + `,`,
+		`Interval:` + fmt.Sprintf("%v", this.Interval) + `,`,
+		`Aggregations:` + mapStringForAggregations + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DateHistogramAggregation) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForAggregations := make([]string, 0, len(this.Aggregations))
+	for k, _ := range this.Aggregations {
+		keysForAggregations = append(keysForAggregations, k)
+	}
+	sortkeys.Strings(keysForAggregations)
+	mapStringForAggregations := "map[string]Aggregation{"
+	for _, k := range keysForAggregations {
+		mapStringForAggregations += fmt.Sprintf("%v: %v,", k, this.Aggregations[k])
+	}
+	mapStringForAggregations += "}"
+	s := strings.Join([]string{`&DateHistogramAggregation{`,
+		`Field:` + fmt.Sprintf("%v", this.Field) + `,`,
+		`Interval:` + fmt.Sprintf("%v", this.Interval) + `,`,
+		`Aggregations:` + mapStringForAggregations + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RangeAggregation) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForAggregations := make([]string, 0, len(this.Aggregations))
+	for k, _ := range this.Aggregations {
+		keysForAggregations = append(keysForAggregations, k)
+	}
+	sortkeys.Strings(keysForAggregations)
+	mapStringForAggregations := "map[string]Aggregation{"
+	for _, k := range keysForAggregations {
+		mapStringForAggregations += fmt.Sprintf("%v: %v,", k, this.Aggregations[k])
+	}
+	mapStringForAggregations += "}"
+	s := strings.Join([]string{`&RangeAggregation{`,
+		`Field:` + fmt.Sprintf("%v", this.Field) + `,`,
+		`Ranges:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Ranges), "AggregationRange", "AggregationRange", 1), `&`, ``, 1) + `,`,
+		`Aggregations:` + mapStringForAggregations + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AggregationRange) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AggregationRange{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`From:` + fmt.Sprintf("%v", this.From) + `,`,
+		`To:` + fmt.Sprintf("%v", this.To) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MinAggregation) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MinAggregation{`,
+		`Field:` + fmt.Sprintf("%v", this.Field) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MaxAggregation) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MaxAggregation{`,
+		`Field:` + fmt.Sprintf("%v", this.Field) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AvgAggregation) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AvgAggregation{`,
+		`Field:` + fmt.Sprintf("%v", this.Field) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SumAggregation) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SumAggregation{`,
+		`Field:` + fmt.Sprintf("%v", this.Field) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StatsAggregation) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StatsAggregation{`,
+		`Field:` + fmt.Sprintf("%v", this.Field) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CardinalityAggregation) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CardinalityAggregation{`,
+		`Field:` + fmt.Sprintf("%v", this.Field) + `,`,
+		`Precision:` + fmt.Sprintf("%v", this.Precision) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AggregationResult) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AggregationResult{`,
+		`Buckets:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Buckets), "AggregationBucket", "AggregationBucket", 1), `&`, ``, 1) + `,`,
+		`Stats:` + strings.Replace(fmt.Sprintf("%v", this.Stats), "StatsResult", "StatsResult", 1) + `,`,
+		`Cardinality:` + fmt.Sprintf("%v", this.Cardinality) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AggregationBucket) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForAggregations := make([]string, 0, len(this.Aggregations))
+	for k, _ := range this.Aggregations {
+		keysForAggregations = append(keysForAggregations, k)
+	}
+	sortkeys.Strings(keysForAggregations)
+	mapStringForAggregations := "map[string]AggregationResult{"
+	for _, k := range keysForAggregations {
+		mapStringForAggregations += fmt.Sprintf("%v: %v,", k, this.Aggregations[k])
+	}
+	mapStringForAggregations += "}"
+	s := strings.Join([]string{`&AggregationBucket{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`DocCount:` + fmt.Sprintf("%v", this.DocCount) + `,`,
+		`Aggregations:` + mapStringForAggregations + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StatsResult) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StatsResult{`,
+		`Count:` + fmt.Sprintf("%v", this.Count) + `,`,
+		`Sum:` + fmt.Sprintf("%v", this.Sum) + `,`,
+		`Min:` + fmt.Sprintf("%v", this.Min) + `,`,
+		`Max:` + fmt.Sprintf("%v", this.Max) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringApi(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (this *Query) GetValue() interface{} {
+	if this.Term != nil {
+		return this.Term
+	}
+	if this.Terms != nil {
+		return this.Terms
+	}
+	if this.MatchAll != nil {
+		return this.MatchAll
+	}
+	if this.Bool != nil {
+		return this.Bool
+	}
+	if this.Phrase != nil {
+		return this.Phrase
+	}
+	if this.Range != nil {
+		return this.Range
+	}
+	if this.Prefix != nil {
+		return this.Prefix
+	}
+	if this.Wildcard != nil {
+		return this.Wildcard
+	}
+	if this.Regexp != nil {
+		return this.Regexp
+	}
+	if this.Fuzzy != nil {
+		return this.Fuzzy
+	}
+	if this.GeoBoundingBox != nil {
+		return this.GeoBoundingBox
+	}
+	if this.GeoDistance != nil {
+		return this.GeoDistance
+	}
+	if this.GeoPolygon != nil {
+		return this.GeoPolygon
+	}
+	if this.Nested != nil {
+		return this.Nested
+	}
+	return nil
+}
+
+func (this *Query) SetValue(value interface{}) bool {
+	switch vt := value.(type) {
+	case *TermQuery:
+		this.Term = vt
+	case *TermsQuery:
+		this.Terms = vt
+	case *MatchAllQuery:
+		this.MatchAll = vt
+	case *BoolQuery:
+		this.Bool = vt
+	case *PhraseQuery:
+		this.Phrase = vt
+	case *RangeQuery:
+		this.Range = vt
+	case *PrefixQuery:
+		this.Prefix = vt
+	case *WildcardQuery:
+		this.Wildcard = vt
+	case *RegexpQuery:
+		this.Regexp = vt
+	case *FuzzyQuery:
+		this.Fuzzy = vt
+	case *GeoBoundingBoxQuery:
+		this.GeoBoundingBox = vt
+	case *GeoDistanceQuery:
+		this.GeoDistance = vt
+	case *GeoPolygonQuery:
+		this.GeoPolygon = vt
+	case *NestedQuery:
+		this.Nested = vt
+	default:
+		return false
+	}
+	return true
+}
+func (this *Aggregation) GetValue() interface{} {
+	if this.Terms != nil {
+		return this.Terms
+	}
+	if this.Histogram != nil {
+		return this.Histogram
+	}
+	if this.DateHistogram != nil {
+		return this.DateHistogram
+	}
+	if this.Range != nil {
+		return this.Range
+	}
+	if this.Min != nil {
+		return this.Min
+	}
+	if this.Max != nil {
+		return this.Max
+	}
+	if this.Avg != nil {
+		return this.Avg
+	}
+	if this.Sum != nil {
+		return this.Sum
+	}
+	if this.Stats != nil {
+		return this.Stats
+	}
+	if this.Cardinality != nil {
+		return this.Cardinality
+	}
+	return nil
+}
+
+func (this *Aggregation) SetValue(value interface{}) bool {
+	switch vt := value.(type) {
+	case *TermsAggregation:
+		this.Terms = vt
+	case *HistogramAggregation:
+		this.Histogram = vt
+	case *DateHistogramAggregation:
+		this.DateHistogram = vt
+	case *RangeAggregation:
+		this.Range = vt
+	case *MinAggregation:
+		this.Min = vt
+	case *MaxAggregation:
+		this.Max = vt
+	case *AvgAggregation:
+		this.Avg = vt
+	case *SumAggregation:
+		this.Sum = vt
+	case *StatsAggregation:
+		this.Stats = vt
+	case *CardinalityAggregation:
+		this.Cardinality = vt
+	default:
+		return false
+	}
+	return true
+}
+func (m *ActionRequestHeader) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActionRequestHeader: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActionRequestHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RequestHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
+			}
+			m.Partition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Partition |= (github_com_tiglabs_baudengine_proto_metapb.PartitionID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionRequestHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ActionRequestHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = append(m.Id[:0], dAtA[iNdEx:postIndex]...)
+			if m.Id == nil {
+				m.Id = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint32(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Fields = append(m.Fields, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthApi
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Fields = append(m.Fields, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ResponseHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = append(m.Id[:0], dAtA[iNdEx:postIndex]...)
+			if m.Id == nil {
+				m.Id = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Found", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Found = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Fields == nil {
+				m.Fields = make(map[uint32]FieldValue)
+			}
+			var mapkey uint32
+			mapvalue := &FieldValue{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= (uint32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= (int(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthApi
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if mapmsglen < 0 {
+						return ErrInvalidLengthApi
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &FieldValue{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipApi(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthApi
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Fields[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeqNo", wireType)
+			}
+			m.SeqNo = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SeqNo |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BulkRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BulkRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BulkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionRequestHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ActionRequestHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requests = append(m.Requests, BulkItemRequest{})
+			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BulkResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BulkResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BulkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ResponseHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Responses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Responses = append(m.Responses, BulkItemResponse{})
+			if err := m.Responses[len(m.Responses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BulkItemRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BulkItemRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BulkItemRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpType", wireType)
+			}
+			m.OpType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OpType |= (OpType(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Create", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Create == nil {
+				m.Create = &CreateRequest{}
+			}
+			if err := m.Create.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Update == nil {
+				m.Update = &UpdateRequest{}
+			}
+			if err := m.Update.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delete", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Delete == nil {
+				m.Delete = &DeleteRequest{}
+			}
+			if err := m.Delete.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BulkItemResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BulkItemResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BulkItemResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpType", wireType)
+			}
+			m.OpType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OpType |= (OpType(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Create", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Create == nil {
+				m.Create = &CreateResponse{}
+			}
+			if err := m.Create.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Update == nil {
+				m.Update = &UpdateResponse{}
+			}
+			if err := m.Update.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delete", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Delete == nil {
+				m.Delete = &DeleteResponse{}
+			}
+			if err := m.Delete.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failure", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Failure == nil {
+				m.Failure = &Failure{}
+			}
+			if err := m.Failure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Doc", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Doc.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = append(m.Source[:0], dAtA[iNdEx:postIndex]...)
+			if m.Source == nil {
+				m.Source = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IfVersion", wireType)
+			}
+			m.IfVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IfVersion |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IfSeqNo", wireType)
+			}
+			m.IfSeqNo = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IfSeqNo |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = append(m.Id[:0], dAtA[iNdEx:postIndex]...)
+			if m.Id == nil {
+				m.Id = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			m.Result = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Result |= (WriteResult(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeqNo", wireType)
+			}
+			m.SeqNo = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SeqNo |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Doc", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Doc.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upsert", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Upsert = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = append(m.Source[:0], dAtA[iNdEx:postIndex]...)
+			if m.Source == nil {
+				m.Source = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partial", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Partial = append(m.Partial[:0], dAtA[iNdEx:postIndex]...)
+			if m.Partial == nil {
+				m.Partial = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Script", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Script == nil {
+				m.Script = &Script{}
+			}
+			if err := m.Script.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IfVersion", wireType)
+			}
+			m.IfVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IfVersion |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IfSeqNo", wireType)
+			}
+			m.IfSeqNo = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IfSeqNo |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MappingVersion", wireType)
+			}
+			m.MappingVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MappingVersion |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Script) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Script: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Script: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Params = append(m.Params[:0], dAtA[iNdEx:postIndex]...)
+			if m.Params == nil {
+				m.Params = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = append(m.Id[:0], dAtA[iNdEx:postIndex]...)
+			if m.Id == nil {
+				m.Id = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			m.Result = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Result |= (WriteResult(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeqNo", wireType)
+			}
+			m.SeqNo = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SeqNo |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = append(m.Id[:0], dAtA[iNdEx:postIndex]...)
+			if m.Id == nil {
+				m.Id = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IfVersion", wireType)
+			}
+			m.IfVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IfVersion |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IfSeqNo", wireType)
+			}
+			m.IfSeqNo = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IfSeqNo |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IfExpiredAt", wireType)
+			}
+			m.IfExpiredAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IfExpiredAt |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = append(m.Id[:0], dAtA[iNdEx:postIndex]...)
+			if m.Id == nil {
+				m.Id = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			m.Result = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Result |= (WriteResult(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeqNo", wireType)
+			}
+			m.SeqNo = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SeqNo |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Failure) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Failure: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Failure: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = append(m.Id[:0], dAtA[iNdEx:postIndex]...)
+			if m.Id == nil {
+				m.Id = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cause", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cause = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aborted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Aborted = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionConflict", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.VersionConflict = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentVersion", wireType)
+			}
+			m.CurrentVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentVersion |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentSeqNo", wireType)
+			}
+			m.CurrentSeqNo = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentSeqNo |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SearchRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SearchRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SearchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionRequestHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ActionRequestHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Query.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			m.From = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.From |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			m.Size_ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Size_ |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sort", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sort = append(m.Sort, SortField{})
+			if err := m.Sort[len(m.Sort)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint32(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Fields = append(m.Fields, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthApi
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Fields = append(m.Fields, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Similarities", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Similarities == nil {
+				m.Similarities = make(map[uint32]string)
+			}
+			var mapkey uint32
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= (uint32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthApi
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipApi(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthApi
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Similarities[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Statistics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Statistics == nil {
+				m.Statistics = &SearchStatistics{}
+			}
+			if err := m.Statistics.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aggregations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Aggregations == nil {
+				m.Aggregations = make(map[string]Aggregation)
+			}
+			var mapkey string
+			mapvalue := &Aggregation{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthApi
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= (int(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthApi
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if mapmsglen < 0 {
+						return ErrInvalidLengthApi
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &Aggregation{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipApi(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthApi
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Aggregations[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Highlight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Highlight == nil {
+				m.Highlight = &Highlight{}
+			}
+			if err := m.Highlight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SearchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SearchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SearchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ResponseHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hits = append(m.Hits, SearchHit{})
+			if err := m.Hits[len(m.Hits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aggregations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Aggregations == nil {
+				m.Aggregations = make(map[string]AggregationResult)
+			}
+			var mapkey string
+			mapvalue := &AggregationResult{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthApi
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= (int(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthApi
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if mapmsglen < 0 {
+						return ErrInvalidLengthApi
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &AggregationResult{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipApi(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthApi
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Aggregations[mapkey] = *mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SearchHit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SearchHit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SearchHit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = append(m.Id[:0], dAtA[iNdEx:postIndex]...)
+			if m.Id == nil {
+				m.Id = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Score = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Fields == nil {
+				m.Fields = make(map[uint32]FieldValue)
+			}
+			var mapkey uint32
+			mapvalue := &FieldValue{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= (uint32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= (int(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthApi
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if mapmsglen < 0 {
+						return ErrInvalidLengthApi
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &FieldValue{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipApi(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthApi
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Fields[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Highlights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Highlights == nil {
+				m.Highlights = make(map[uint32]HighlightFragments)
+			}
+			var mapkey uint32
+			mapvalue := &HighlightFragments{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= (uint32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= (int(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthApi
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if mapmsglen < 0 {
+						return ErrInvalidLengthApi
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &HighlightFragments{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipApi(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthApi
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Highlights[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InnerHits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InnerHits == nil {
+				m.InnerHits = make(map[uint32]InnerHits)
+			}
+			var mapkey uint32
+			mapvalue := &InnerHits{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= (uint32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= (int(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthApi
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if mapmsglen < 0 {
+						return ErrInvalidLengthApi
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &InnerHits{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipApi(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthApi
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.InnerHits[mapkey] = *mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InnerHits) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InnerHits: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InnerHits: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hits = append(m.Hits, InnerHit{})
+			if err := m.Hits[len(m.Hits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InnerHit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InnerHit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InnerHit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Score = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Fields == nil {
+				m.Fields = make(map[uint32]FieldValue)
+			}
+			var mapkey uint32
+			mapvalue := &FieldValue{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= (uint32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= (int(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthApi
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if mapmsglen < 0 {
+						return ErrInvalidLengthApi
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &FieldValue{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipApi(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthApi
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Fields[mapkey] = *mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Highlight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Highlight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Highlight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint32(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Fields = append(m.Fields, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthApi
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Fields = append(m.Fields, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreTag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreTag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostTag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PostTag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FragmentSize", wireType)
+			}
+			m.FragmentSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FragmentSize |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumberOfFragments", wireType)
+			}
+			m.NumberOfFragments = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumberOfFragments |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Analyzers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Analyzers == nil {
+				m.Analyzers = make(map[uint32]string)
+			}
+			var mapkey uint32
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= (uint32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthApi
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipApi(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthApi
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Analyzers[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HighlightFragments) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HighlightFragments: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HighlightFragments: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fragments", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fragments = append(m.Fragments, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SearchStatisticsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SearchStatisticsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SearchStatisticsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionRequestHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ActionRequestHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Query.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SearchStatisticsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SearchStatisticsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SearchStatisticsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ResponseHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Statistics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Statistics.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SearchStatistics) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SearchStatistics: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SearchStatistics: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fields = append(m.Fields, FieldStatistics{})
+			if err := m.Fields[len(m.Fields)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Terms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Terms = append(m.Terms, TermStatistics{})
+			if err := m.Terms[len(m.Terms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FieldStatistics) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FieldStatistics: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FieldStatistics: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			m.Field = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Field |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocCount", wireType)
+			}
+			m.DocCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DocCount |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SumLength", wireType)
+			}
+			m.SumLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SumLength |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TermStatistics) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TermStatistics: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TermStatistics: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			m.Field = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Field |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Term", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Term = append(m.Term[:0], dAtA[iNdEx:postIndex]...)
+			if m.Term == nil {
+				m.Term = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocFreq", wireType)
+			}
+			m.DocFreq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DocFreq |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AnalyzeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AnalyzeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AnalyzeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionRequestHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ActionRequestHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Analyzer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Analyzer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Field = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Text = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Explain", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Explain = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AnalyzeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AnalyzeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AnalyzeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ResponseHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Analyzer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Analyzer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tokens = append(m.Tokens, AnalyzeToken{})
+			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stages = append(m.Stages, AnalyzeStage{})
+			if err := m.Stages[len(m.Stages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AnalyzeToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AnalyzeToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AnalyzeToken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Term", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Term = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			m.Start = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Start |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			m.End = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.End |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+			}
+			m.Position = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Position |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AnalyzeStage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AnalyzeStage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AnalyzeStage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Text = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tokens = append(m.Tokens, AnalyzeToken{})
+			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TermVectorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TermVectorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TermVectorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionRequestHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ActionRequestHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = append(m.Id[:0], dAtA[iNdEx:postIndex]...)
+			if m.Id == nil {
+				m.Id = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint32(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Fields = append(m.Fields, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthApi
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Fields = append(m.Fields, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TermStatistics", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TermStatistics = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TermVectorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TermVectorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TermVectorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ResponseHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = append(m.Id[:0], dAtA[iNdEx:postIndex]...)
+			if m.Id == nil {
+				m.Id = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Found", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Found = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Fields == nil {
+				m.Fields = make(map[uint32]FieldTermVectors)
+			}
+			var mapkey uint32
+			mapvalue := &FieldTermVectors{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= (uint32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= (int(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthApi
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if mapmsglen < 0 {
+						return ErrInvalidLengthApi
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &FieldTermVectors{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipApi(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthApi
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Fields[mapkey] = *mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FieldTermVectors) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FieldTermVectors: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FieldTermVectors: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Terms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Terms = append(m.Terms, TermVector{})
+			if err := m.Terms[len(m.Terms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TermVector) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TermVector: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TermVector: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Term", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Term = append(m.Term[:0], dAtA[iNdEx:postIndex]...)
+			if m.Term == nil {
+				m.Term = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Freq", wireType)
+			}
+			m.Freq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Freq |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Positions = append(m.Positions, TermPosition{})
+			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocFreq", wireType)
+			}
+			m.DocFreq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DocFreq |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalTermFreq", wireType)
+			}
+			m.TotalTermFreq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalTermFreq |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TermPosition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TermPosition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TermPosition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+			}
+			m.Position = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Position |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			m.Start = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Start |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			m.End = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.End |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScrollRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScrollRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScrollRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionRequestHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ActionRequestHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScrollId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScrollId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			m.Size_ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Size_ |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint32(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Fields = append(m.Fields, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthApi
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Fields = append(m.Fields, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeepAlive", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeepAlive = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field After", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.After = append(m.After[:0], dAtA[iNdEx:postIndex]...)
+			if m.After == nil {
+				m.After = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScrollResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScrollResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScrollResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ResponseHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScrollId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScrollId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Docs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Docs = append(m.Docs, ScrollDocument{})
+			if err := m.Docs[len(m.Docs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Done", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Done = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScrollDocument) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScrollDocument: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScrollDocument: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = append(m.Id[:0], dAtA[iNdEx:postIndex]...)
+			if m.Id == nil {
+				m.Id = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Fields == nil {
+				m.Fields = make(map[uint32]FieldValue)
+			}
+			var mapkey uint32
+			mapvalue := &FieldValue{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= (uint32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= (int(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthApi
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if mapmsglen < 0 {
+						return ErrInvalidLengthApi
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &FieldValue{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipApi(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthApi
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Fields[mapkey] = *mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClearScrollRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClearScrollRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClearScrollRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionRequestHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ActionRequestHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScrollId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScrollId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClearScrollResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClearScrollResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClearScrollResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ResponseHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ByQueryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ByQueryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ByQueryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionRequestHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ActionRequestHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Query.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partial", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Partial = append(m.Partial[:0], dAtA[iNdEx:postIndex]...)
+			if m.Partial == nil {
+				m.Partial = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Script", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Script == nil {
+				m.Script = &Script{}
+			}
+			if err := m.Script.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchSize", wireType)
+			}
+			m.BatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchSize |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestsPerSecond", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.RequestsPerSecond = float32(math.Float32frombits(v))
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProceedOnConflicts", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ProceedOnConflicts = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ByQueryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ByQueryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ByQueryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ResponseHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ByQueryTaskRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ByQueryTaskRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ByQueryTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionRequestHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ActionRequestHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ByQueryTaskResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ByQueryTaskResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ByQueryTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ResponseHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ByQueryStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ByQueryStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ByQueryStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Update = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deleted", wireType)
+			}
+			m.Deleted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deleted |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Updated", wireType)
+			}
+			m.Updated = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Updated |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Noops", wireType)
+			}
+			m.Noops = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Noops |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionConflicts", wireType)
+			}
+			m.VersionConflicts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VersionConflicts |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failures", wireType)
+			}
+			m.Failures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Failures |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batches", wireType)
+			}
+			m.Batches = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Batches |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Done", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Done = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Canceled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Canceled = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			m.EndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTime |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SortField) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SortField: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SortField: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			m.Field = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Field |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reverse", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Reverse = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GeoDistance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GeoDistance == nil {
+				m.GeoDistance = &GeoPoint{}
+			}
+			if err := m.GeoDistance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Query) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Query: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Query: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Term", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Term == nil {
+				m.Term = &TermQuery{}
+			}
+			if err := m.Term.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Terms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Terms == nil {
+				m.Terms = &TermsQuery{}
+			}
+			if err := m.Terms.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchAll", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MatchAll == nil {
+				m.MatchAll = &MatchAllQuery{}
+			}
+			if err := m.MatchAll.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Bool == nil {
+				m.Bool = &BoolQuery{}
+			}
+			if err := m.Bool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phrase", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Phrase == nil {
+				m.Phrase = &PhraseQuery{}
+			}
+			if err := m.Phrase.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Range", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Range == nil {
+				m.Range = &RangeQuery{}
+			}
+			if err := m.Range.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Prefix == nil {
+				m.Prefix = &PrefixQuery{}
+			}
+			if err := m.Prefix.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wildcard", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Wildcard == nil {
+				m.Wildcard = &WildcardQuery{}
+			}
+			if err := m.Wildcard.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Regexp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Regexp == nil {
+				m.Regexp = &RegexpQuery{}
+			}
+			if err := m.Regexp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fuzzy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Fuzzy == nil {
+				m.Fuzzy = &FuzzyQuery{}
+			}
+			if err := m.Fuzzy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GeoBoundingBox", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GeoBoundingBox == nil {
+				m.GeoBoundingBox = &GeoBoundingBoxQuery{}
+			}
+			if err := m.GeoBoundingBox.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GeoDistance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GeoDistance == nil {
+				m.GeoDistance = &GeoDistanceQuery{}
+			}
+			if err := m.GeoDistance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GeoPolygon", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GeoPolygon == nil {
+				m.GeoPolygon = &GeoPolygonQuery{}
+			}
+			if err := m.GeoPolygon.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nested", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Nested == nil {
+				m.Nested = &NestedQuery{}
+			}
+			if err := m.Nested.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TermQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TermQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TermQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			m.Field = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Field |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Term", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Term = append(m.Term[:0], dAtA[iNdEx:postIndex]...)
+			if m.Term == nil {
+				m.Term = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TermsQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TermsQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TermsQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			m.Field = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Field |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Terms", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Terms = append(m.Terms, make([]byte, postIndex-iNdEx))
+			copy(m.Terms[len(m.Terms)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PhraseQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PhraseQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PhraseQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			m.Field = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Field |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Terms", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Terms = append(m.Terms, make([]byte, postIndex-iNdEx))
+			copy(m.Terms[len(m.Terms)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slop", wireType)
+			}
+			m.Slop = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slop |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RangeQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RangeQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RangeQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			m.Field = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Field |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gt", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Gt = append(m.Gt[:0], dAtA[iNdEx:postIndex]...)
+			if m.Gt == nil {
+				m.Gt = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gte", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Gte = append(m.Gte[:0], dAtA[iNdEx:postIndex]...)
+			if m.Gte == nil {
+				m.Gte = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lt", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lt = append(m.Lt[:0], dAtA[iNdEx:postIndex]...)
+			if m.Lt == nil {
+				m.Lt = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lte", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lte = append(m.Lte[:0], dAtA[iNdEx:postIndex]...)
+			if m.Lte == nil {
+				m.Lte = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PrefixQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PrefixQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PrefixQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			m.Field = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Field |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prefix = append(m.Prefix[:0], dAtA[iNdEx:postIndex]...)
+			if m.Prefix == nil {
+				m.Prefix = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxExpansions", wireType)
+			}
+			m.MaxExpansions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxExpansions |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WildcardQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WildcardQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WildcardQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			m.Field = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Field |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pattern", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pattern = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxExpansions", wireType)
+			}
+			m.MaxExpansions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxExpansions |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegexpQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegexpQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegexpQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			m.Field = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Field |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pattern", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pattern = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxExpansions", wireType)
+			}
+			m.MaxExpansions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxExpansions |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FuzzyQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FuzzyQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FuzzyQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			m.Field = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Field |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Term", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Term = append(m.Term[:0], dAtA[iNdEx:postIndex]...)
+			if m.Term == nil {
+				m.Term = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEdits", wireType)
+			}
+			m.MaxEdits = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxEdits |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrefixLength", wireType)
+			}
+			m.PrefixLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrefixLength |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxExpansions", wireType)
+			}
+			m.MaxExpansions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxExpansions |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GeoPoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GeoPoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GeoPoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lat", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Lat = float64(math.Float64frombits(v))
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lon", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Lon = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GeoBoundingBoxQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GeoBoundingBoxQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GeoBoundingBoxQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			m.Field = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Field |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopLeft", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TopLeft == nil {
+				m.TopLeft = &GeoPoint{}
+			}
+			if err := m.TopLeft.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BottomRight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BottomRight == nil {
+				m.BottomRight = &GeoPoint{}
+			}
+			if err := m.BottomRight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GeoDistanceQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GeoDistanceQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GeoDistanceQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			m.Field = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Field |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Origin == nil {
+				m.Origin = &GeoPoint{}
+			}
+			if err := m.Origin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distance", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Distance = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GeoPolygonQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GeoPolygonQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GeoPolygonQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			m.Field = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Field |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Points", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Points = append(m.Points, &GeoPoint{})
+			if err := m.Points[len(m.Points)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MatchAllQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MatchAllQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MatchAllQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NestedQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NestedQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NestedQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			m.Field = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Field |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Query.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScoreMode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScoreMode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InnerHits", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InnerHits = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InnerHitsSize", wireType)
+			}
+			m.InnerHitsSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InnerHitsSize |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BoolQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BoolQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BoolQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Must", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Must = append(m.Must, Query{})
+			if err := m.Must[len(m.Must)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Should", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Should = append(m.Should, Query{})
+			if err := m.Should[len(m.Should)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MustNot", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MustNot = append(m.MustNot, Query{})
+			if err := m.MustNot[len(m.MustNot)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinShould", wireType)
+			}
+			m.MinShould = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinShould |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Document) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Document: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Document: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = append(m.Id[:0], dAtA[iNdEx:postIndex]...)
+			if m.Id == nil {
+				m.Id = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fields = append(m.Fields, Field{})
+			if err := m.Fields[len(m.Fields)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nested", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nested = append(m.Nested, NestedDocument{})
+			if err := m.Nested[len(m.Nested)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpireAt", wireType)
+			}
+			m.ExpireAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpireAt |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NestedDocument) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NestedDocument: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NestedDocument: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parent = append(m.Parent[:0], dAtA[iNdEx:postIndex]...)
+			if m.Parent == nil {
+				m.Parent = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			m.Field = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Field |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fields = append(m.Fields, Field{})
+			if err := m.Fields[len(m.Fields)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Field) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Field: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Field: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldValue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FieldValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Desc", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Desc.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FieldValue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FieldValue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FieldValue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= (ValueType(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FieldDesc) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FieldDesc: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FieldDesc: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stored", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Stored = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokenized", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.Tokenized = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexOption", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			m.IndexOption = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IndexOption |= (IndexOption(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ActionRequestHeader: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ActionRequestHeader: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestHeader", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Analyzer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -2648,27 +24320,26 @@ func (m *ActionRequestHeader) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RequestHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Analyzer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DocValues", wireType)
 			}
-			m.Partition = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -2678,11 +24349,12 @@ func (m *ActionRequestHeader) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Partition |= (github_com_tiglabs_baudengine_proto_metapb.PartitionID(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.DocValues = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -2704,7 +24376,7 @@ func (m *ActionRequestHeader) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetRequest) Unmarshal(dAtA []byte) error {
+func (m *Aggregation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2727,15 +24399,15 @@ func (m *GetRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: Aggregation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Aggregation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActionRequestHeader", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Terms", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2759,15 +24431,18 @@ func (m *GetRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ActionRequestHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Terms == nil {
+				m.Terms = &TermsAggregation{}
+			}
+			if err := m.Terms.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Histogram", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -2777,138 +24452,94 @@ func (m *GetRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = append(m.Id[:0], dAtA[iNdEx:postIndex]...)
-			if m.Id == nil {
-				m.Id = []byte{}
+			if m.Histogram == nil {
+				m.Histogram = &HistogramAggregation{}
+			}
+			if err := m.Histogram.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType == 0 {
-				var v uint32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowApi
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= (uint32(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Fields = append(m.Fields, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowApi
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthApi
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DateHistogram", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				for iNdEx < postIndex {
-					var v uint32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowApi
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= (uint32(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Fields = append(m.Fields, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApi(dAtA[iNdEx:])
-			if err != nil {
-				return err
 			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApi
+			if m.DateHistogram == nil {
+				m.DateHistogram = &DateHistogramAggregation{}
 			}
-			if iNdEx >= l {
+			if err := m.DateHistogram.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Range", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Range == nil {
+				m.Range = &RangeAggregation{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.Range.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResponseHeader", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2932,15 +24563,18 @@ func (m *GetResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ResponseHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Min == nil {
+				m.Min = &MinAggregation{}
+			}
+			if err := m.Min.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -2950,28 +24584,30 @@ func (m *GetResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = append(m.Id[:0], dAtA[iNdEx:postIndex]...)
-			if m.Id == nil {
-				m.Id = []byte{}
+			if m.Max == nil {
+				m.Max = &MaxAggregation{}
+			}
+			if err := m.Max.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Found", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Avg", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -2981,15 +24617,28 @@ func (m *GetResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Found = bool(v != 0)
-		case 4:
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Avg == nil {
+				m.Avg = &AvgAggregation{}
+			}
+			if err := m.Avg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sum", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3013,145 +24662,16 @@ func (m *GetResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Fields == nil {
-				m.Fields = make(map[uint32]FieldValue)
-			}
-			var mapkey uint32
-			mapvalue := &FieldValue{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowApi
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowApi
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapkey |= (uint32(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowApi
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= (int(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthApi
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if mapmsglen < 0 {
-						return ErrInvalidLengthApi
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &FieldValue{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipApi(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthApi
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if m.Sum == nil {
+				m.Sum = &SumAggregation{}
 			}
-			m.Fields[mapkey] = *mapvalue
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApi(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Sum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthApi
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BulkRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApi
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BulkRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BulkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActionRequestHeader", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3175,13 +24695,16 @@ func (m *BulkRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ActionRequestHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Stats == nil {
+				m.Stats = &StatsAggregation{}
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cardinality", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3205,8 +24728,10 @@ func (m *BulkRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Requests = append(m.Requests, BulkItemRequest{})
-			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Cardinality == nil {
+				m.Cardinality = &CardinalityAggregation{}
+			}
+			if err := m.Cardinality.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3231,7 +24756,7 @@ func (m *BulkRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BulkResponse) Unmarshal(dAtA []byte) error {
+func (m *TermsAggregation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3254,17 +24779,17 @@ func (m *BulkResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BulkResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TermsAggregation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BulkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TermsAggregation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResponseHeader", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
 			}
-			var msglen int
+			m.Field = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -3274,25 +24799,52 @@ func (m *BulkResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Field |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthApi
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Size_ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Size_ |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if err := m.ResponseHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardSize", wireType)
 			}
-			iNdEx = postIndex
-		case 2:
+			m.ShardSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardSize |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Responses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Aggregations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3309,17 +24861,109 @@ func (m *BulkResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Responses = append(m.Responses, BulkItemResponse{})
-			if err := m.Responses[len(m.Responses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Aggregations == nil {
+				m.Aggregations = make(map[string]Aggregation)
+			}
+			var mapkey string
+			mapvalue := &Aggregation{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthApi
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= (int(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthApi
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if mapmsglen < 0 {
+						return ErrInvalidLengthApi
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &Aggregation{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipApi(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthApi
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Aggregations[mapkey] = *mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3342,7 +24986,7 @@ func (m *BulkResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BulkItemRequest) Unmarshal(dAtA []byte) error {
+func (m *HistogramAggregation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3365,17 +25009,17 @@ func (m *BulkItemRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BulkItemRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: HistogramAggregation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BulkItemRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HistogramAggregation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OpType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
 			}
-			m.OpType = 0
+			m.Field = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -3385,47 +25029,25 @@ func (m *BulkItemRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OpType |= (OpType(b) & 0x7F) << shift
+				m.Field |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Create", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthApi
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			var v uint64
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Create == nil {
-				m.Create = &CreateRequest{}
-			}
-			if err := m.Create.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Interval = float64(math.Float64frombits(v))
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Aggregations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3449,45 +25071,102 @@ func (m *BulkItemRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Update == nil {
-				m.Update = &UpdateRequest{}
-			}
-			if err := m.Update.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delete", wireType)
+			if m.Aggregations == nil {
+				m.Aggregations = make(map[string]Aggregation)
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			mapvalue := &Aggregation{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthApi
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= (int(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthApi
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if mapmsglen < 0 {
+						return ErrInvalidLengthApi
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &Aggregation{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipApi(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthApi
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Delete == nil {
-				m.Delete = &DeleteRequest{}
-			}
-			if err := m.Delete.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Aggregations[mapkey] = *mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3510,7 +25189,7 @@ func (m *BulkItemRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BulkItemResponse) Unmarshal(dAtA []byte) error {
+func (m *DateHistogramAggregation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3531,71 +25210,19 @@ func (m *BulkItemResponse) Unmarshal(dAtA []byte) error {
 			}
 		}
 		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BulkItemResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BulkItemResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OpType", wireType)
-			}
-			m.OpType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.OpType |= (OpType(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Create", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Create == nil {
-				m.Create = &CreateResponse{}
-			}
-			if err := m.Create.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DateHistogramAggregation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DateHistogramAggregation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
 			}
-			var msglen int
+			m.Field = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -3605,30 +25232,16 @@ func (m *BulkItemResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Field |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Update == nil {
-				m.Update = &UpdateResponse{}
-			}
-			if err := m.Update.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delete", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
 			}
-			var msglen int
+			m.Interval = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -3638,28 +25251,14 @@ func (m *BulkItemResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Interval |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Delete == nil {
-				m.Delete = &DeleteResponse{}
-			}
-			if err := m.Delete.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Failure", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Aggregations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3683,12 +25282,102 @@ func (m *BulkItemResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Failure == nil {
-				m.Failure = &Failure{}
+			if m.Aggregations == nil {
+				m.Aggregations = make(map[string]Aggregation)
 			}
-			if err := m.Failure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var mapkey string
+			mapvalue := &Aggregation{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthApi
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= (int(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthApi
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if mapmsglen < 0 {
+						return ErrInvalidLengthApi
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &Aggregation{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipApi(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthApi
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Aggregations[mapkey] = *mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3711,7 +25400,7 @@ func (m *BulkItemResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateRequest) Unmarshal(dAtA []byte) error {
+func (m *RangeAggregation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3734,15 +25423,34 @@ func (m *CreateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RangeAggregation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RangeAggregation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			m.Field = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Field |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Doc", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ranges", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3766,10 +25474,134 @@ func (m *CreateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Doc.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Ranges = append(m.Ranges, AggregationRange{})
+			if err := m.Ranges[len(m.Ranges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aggregations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Aggregations == nil {
+				m.Aggregations = make(map[string]Aggregation)
+			}
+			var mapkey string
+			mapvalue := &Aggregation{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthApi
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= (int(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthApi
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if mapmsglen < 0 {
+						return ErrInvalidLengthApi
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &Aggregation{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipApi(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthApi
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Aggregations[mapkey] = *mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -3791,7 +25623,7 @@ func (m *CreateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateResponse) Unmarshal(dAtA []byte) error {
+func (m *AggregationRange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3814,15 +25646,44 @@ func (m *CreateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AggregationRange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AggregationRange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -3846,16 +25707,16 @@ func (m *CreateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = append(m.Id[:0], dAtA[iNdEx:postIndex]...)
-			if m.Id == nil {
-				m.Id = []byte{}
+			m.From = append(m.From[:0], dAtA[iNdEx:postIndex]...)
+			if m.From == nil {
+				m.From = []byte{}
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
-			m.Result = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -3865,11 +25726,23 @@ func (m *CreateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Result |= (WriteResult(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = append(m.To[:0], dAtA[iNdEx:postIndex]...)
+			if m.To == nil {
+				m.To = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -3891,7 +25764,7 @@ func (m *CreateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateRequest) Unmarshal(dAtA []byte) error {
+func (m *MinAggregation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3914,47 +25787,17 @@ func (m *UpdateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MinAggregation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MinAggregation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Doc", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Doc.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Upsert", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
 			}
-			var v int
+			m.Field = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -3964,12 +25807,11 @@ func (m *UpdateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.Field |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Upsert = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -3991,7 +25833,7 @@ func (m *UpdateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateResponse) Unmarshal(dAtA []byte) error {
+func (m *MaxAggregation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4014,48 +25856,17 @@ func (m *UpdateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MaxAggregation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MaxAggregation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Id = append(m.Id[:0], dAtA[iNdEx:postIndex]...)
-			if m.Id == nil {
-				m.Id = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
 			}
-			m.Result = 0
+			m.Field = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -4065,7 +25876,7 @@ func (m *UpdateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Result |= (WriteResult(b) & 0x7F) << shift
+				m.Field |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4091,7 +25902,7 @@ func (m *UpdateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteRequest) Unmarshal(dAtA []byte) error {
+func (m *AvgAggregation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4114,17 +25925,17 @@ func (m *DeleteRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AvgAggregation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AvgAggregation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
 			}
-			var byteLen int
+			m.Field = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -4134,23 +25945,11 @@ func (m *DeleteRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				m.Field |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Id = append(m.Id[:0], dAtA[iNdEx:postIndex]...)
-			if m.Id == nil {
-				m.Id = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -4172,7 +25971,7 @@ func (m *DeleteRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteResponse) Unmarshal(dAtA []byte) error {
+func (m *SumAggregation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4195,48 +25994,17 @@ func (m *DeleteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SumAggregation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SumAggregation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Id = append(m.Id[:0], dAtA[iNdEx:postIndex]...)
-			if m.Id == nil {
-				m.Id = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
 			}
-			m.Result = 0
+			m.Field = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -4246,7 +26014,7 @@ func (m *DeleteResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Result |= (WriteResult(b) & 0x7F) << shift
+				m.Field |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4272,7 +26040,7 @@ func (m *DeleteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Failure) Unmarshal(dAtA []byte) error {
+func (m *StatsAggregation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4295,77 +26063,17 @@ func (m *Failure) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Failure: wiretype end group for non-group")
+			return fmt.Errorf("proto: StatsAggregation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Failure: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StatsAggregation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Id = append(m.Id[:0], dAtA[iNdEx:postIndex]...)
-			if m.Id == nil {
-				m.Id = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cause", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Cause = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Aborted", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
 			}
-			var v int
+			m.Field = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -4375,12 +26083,11 @@ func (m *Failure) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.Field |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Aborted = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -4402,7 +26109,7 @@ func (m *Failure) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Document) Unmarshal(dAtA []byte) error {
+func (m *CardinalityAggregation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4425,17 +26132,17 @@ func (m *Document) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Document: wiretype end group for non-group")
+			return fmt.Errorf("proto: CardinalityAggregation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Document: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CardinalityAggregation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
 			}
-			var byteLen int
+			m.Field = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -4445,28 +26152,16 @@ func (m *Document) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				m.Field |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Id = append(m.Id[:0], dAtA[iNdEx:postIndex]...)
-			if m.Id == nil {
-				m.Id = []byte{}
-			}
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Precision", wireType)
 			}
-			var msglen int
+			m.Precision = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -4476,23 +26171,11 @@ func (m *Document) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Precision |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Fields = append(m.Fields, Field{})
-			if err := m.Fields[len(m.Fields)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -4514,7 +26197,7 @@ func (m *Document) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Field) Unmarshal(dAtA []byte) error {
+func (m *AggregationResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4537,15 +26220,15 @@ func (m *Field) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Field: wiretype end group for non-group")
+			return fmt.Errorf("proto: AggregationResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Field: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AggregationResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FieldValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4569,13 +26252,14 @@ func (m *Field) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FieldValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Buckets = append(m.Buckets, AggregationBucket{})
+			if err := m.Buckets[len(m.Buckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Desc", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4599,10 +26283,44 @@ func (m *Field) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Desc.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Stats == nil {
+				m.Stats = &StatsResult{}
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cardinality", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cardinality = append(m.Cardinality[:0], dAtA[iNdEx:postIndex]...)
+			if m.Cardinality == nil {
+				m.Cardinality = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -4624,7 +26342,7 @@ func (m *Field) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FieldValue) Unmarshal(dAtA []byte) error {
+func (m *AggregationBucket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4647,17 +26365,17 @@ func (m *FieldValue) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FieldValue: wiretype end group for non-group")
+			return fmt.Errorf("proto: AggregationBucket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FieldValue: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AggregationBucket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			m.Id = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -4667,16 +26385,28 @@ func (m *FieldValue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= (uint32(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DocCount", wireType)
 			}
-			m.Type = 0
+			m.DocCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -4686,16 +26416,16 @@ func (m *FieldValue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= (ValueType(b) & 0x7F) << shift
+				m.DocCount |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Aggregations", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -4705,22 +26435,114 @@ func (m *FieldValue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			if m.Aggregations == nil {
+				m.Aggregations = make(map[string]AggregationResult)
+			}
+			var mapkey string
+			mapvalue := &AggregationResult{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthApi
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= (int(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthApi
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if mapmsglen < 0 {
+						return ErrInvalidLengthApi
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &AggregationResult{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipApi(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthApi
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Aggregations[mapkey] = *mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4743,7 +26565,7 @@ func (m *FieldValue) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FieldDesc) Unmarshal(dAtA []byte) error {
+func (m *StatsResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4766,17 +26588,17 @@ func (m *FieldDesc) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FieldDesc: wiretype end group for non-group")
+			return fmt.Errorf("proto: StatsResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FieldDesc: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StatsResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stored", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			var v int
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -4786,80 +26608,44 @@ func (m *FieldDesc) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.Count |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Stored = bool(v != 0)
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tokenized", wireType)
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sum", wireType)
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Tokenized = bool(v != 0)
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Sum = float64(math.Float64frombits(v))
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IndexOption", wireType)
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
 			}
-			m.IndexOption = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.IndexOption |= (IndexOption(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
 			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Min = float64(math.Float64frombits(v))
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Analyzer", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApi
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			var v uint64
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Analyzer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Max = float64(math.Float64frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -4989,81 +26775,281 @@ var (
 func init() { proto.RegisterFile("api.proto", fileDescriptorApi) }
 
 var fileDescriptorApi = []byte{
-	// 1209 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcd, 0x6f, 0x1b, 0x55,
-	0x10, 0xdf, 0xe7, 0x5d, 0x7f, 0xec, 0x6c, 0x9c, 0x2c, 0x8f, 0xaa, 0x32, 0x11, 0x6c, 0x52, 0xab,
-	0xa2, 0x51, 0x80, 0x4d, 0x31, 0xb4, 0x42, 0x15, 0x97, 0x38, 0xb6, 0x53, 0x53, 0xd7, 0x1b, 0x36,
-	0x0e, 0x05, 0x2e, 0x61, 0xed, 0x7d, 0x49, 0x57, 0x75, 0xbc, 0xcb, 0x7e, 0x20, 0x92, 0x03, 0x20,
-	0x71, 0xe5, 0x80, 0xc4, 0x85, 0x63, 0xb9, 0x20, 0x24, 0xfe, 0x01, 0x8e, 0x1c, 0x7b, 0xac, 0xc4,
-	0x85, 0x53, 0xd4, 0x98, 0x7f, 0x80, 0x23, 0xea, 0x09, 0xbd, 0x8f, 0x5d, 0x7f, 0xb4, 0x87, 0x96,
-	0x96, 0x9e, 0xf6, 0xcd, 0x9b, 0xdf, 0xcc, 0xfc, 0x66, 0x76, 0xde, 0xbc, 0x07, 0xaa, 0x13, 0x78,
-	0x66, 0x10, 0xfa, 0xb1, 0xbf, 0xfc, 0xd6, 0xa1, 0x17, 0xdf, 0x4e, 0xfa, 0xe6, 0xc0, 0x3f, 0xda,
-	0x38, 0xf4, 0x0f, 0xfd, 0x0d, 0xb6, 0xdd, 0x4f, 0x0e, 0x98, 0xc4, 0x04, 0xb6, 0x12, 0xf0, 0x2b,
-	0x53, 0xf0, 0xd8, 0x3b, 0x1c, 0x3a, 0xfd, 0x68, 0xa3, 0xef, 0x24, 0x2e, 0x19, 0x1d, 0x7a, 0x23,
-	0xc2, 0x8d, 0x37, 0x8e, 0x48, 0xec, 0x04, 0x7d, 0xf6, 0xe1, 0x66, 0xd5, 0x9f, 0x10, 0xbc, 0xbc,
-	0x39, 0x88, 0x3d, 0x7f, 0x64, 0x93, 0xcf, 0x13, 0x12, 0xc5, 0xd7, 0x89, 0xe3, 0x92, 0x10, 0x5f,
-	0x86, 0xc2, 0x6d, 0xb6, 0xaa, 0xa0, 0x55, 0xb4, 0xa6, 0xd5, 0x16, 0xcd, 0x19, 0x7d, 0xbd, 0x74,
-	0xef, 0x74, 0x45, 0xba, 0x7f, 0xba, 0x82, 0x6c, 0x81, 0xc3, 0x1f, 0x83, 0x1a, 0x38, 0x61, 0xec,
-	0x51, 0x5f, 0x95, 0xdc, 0x2a, 0x5a, 0x2b, 0xd7, 0xaf, 0x3d, 0x3c, 0x5d, 0xb9, 0xfa, 0xe4, 0xbc,
-	0xcc, 0x9d, 0xd4, 0xbe, 0xdd, 0xb0, 0x27, 0xce, 0xaa, 0x3f, 0x23, 0x80, 0x6d, 0x12, 0x0b, 0x02,
-	0xf8, 0xea, 0x1c, 0xb5, 0x73, 0xe6, 0x63, 0x12, 0x78, 0x0c, 0xc1, 0x3a, 0xe4, 0x3c, 0x97, 0x31,
-	0x5b, 0xa8, 0xd7, 0x1e, 0x9e, 0xae, 0x98, 0x4f, 0xc1, 0xec, 0x06, 0x39, 0xb6, 0x73, 0x9e, 0x8b,
-	0xcf, 0x43, 0xe1, 0xc0, 0x23, 0x43, 0x37, 0xaa, 0xc8, 0xab, 0xf2, 0x5a, 0xd9, 0x16, 0xd2, 0x35,
-	0xe5, 0xc7, 0xbb, 0x2b, 0x52, 0xf5, 0x6e, 0x0e, 0x34, 0x46, 0x34, 0x0a, 0xfc, 0x51, 0x44, 0xf0,
-	0xdb, 0x73, 0x4c, 0x97, 0xcc, 0x54, 0xf5, 0xbf, 0x92, 0x3c, 0x07, 0xf9, 0x03, 0x3f, 0x19, 0xb9,
-	0x15, 0x79, 0x15, 0xad, 0x95, 0x6c, 0x2e, 0xd0, 0xb2, 0x09, 0xea, 0xca, 0xaa, 0xbc, 0xa6, 0xd5,
-	0x2a, 0xe6, 0x14, 0x55, 0xb3, 0xc5, 0x54, 0xcd, 0x51, 0x1c, 0x1e, 0xd7, 0x15, 0xca, 0x2a, 0x4d,
-	0x6d, 0xb9, 0x05, 0xda, 0x94, 0x12, 0xeb, 0x20, 0xdf, 0x21, 0xc7, 0x2c, 0xa1, 0xb2, 0x4d, 0x97,
-	0xf8, 0x02, 0xe4, 0xbf, 0x70, 0x86, 0x09, 0x61, 0xac, 0xb5, 0x9a, 0xc6, 0x7d, 0x7d, 0x44, 0xb7,
-	0x6c, 0xae, 0xb9, 0x96, 0x7b, 0x0f, 0x89, 0x12, 0x7d, 0x0d, 0x5a, 0x3d, 0x19, 0xde, 0x79, 0xd6,
-	0x7f, 0x59, 0x83, 0x52, 0xc8, 0x21, 0x51, 0x25, 0xc7, 0xd2, 0xd1, 0x4d, 0xea, 0xb7, 0x1d, 0x93,
-	0x23, 0x61, 0x2b, 0xd2, 0xc8, 0x70, 0x82, 0xc0, 0x57, 0xb0, 0xc0, 0x09, 0xfc, 0xf7, 0x7f, 0x74,
-	0x05, 0xd4, 0x50, 0x60, 0xd2, 0xe8, 0x2f, 0x4d, 0x45, 0xe7, 0x1a, 0x11, 0x7e, 0x82, 0x14, 0xf1,
-	0x7f, 0x45, 0xb0, 0x34, 0xc7, 0x14, 0xaf, 0x42, 0xd1, 0x0f, 0xf6, 0xe3, 0xe3, 0x80, 0x30, 0x12,
-	0x8b, 0xb5, 0xa2, 0x69, 0x05, 0xbd, 0xe3, 0x80, 0xd8, 0x05, 0x9f, 0x7d, 0xf1, 0xeb, 0x50, 0x18,
-	0x84, 0xc4, 0x89, 0xd3, 0x22, 0x2f, 0x9a, 0x5b, 0x4c, 0x14, 0x1e, 0x6c, 0xa1, 0xa5, 0xb8, 0x24,
-	0x70, 0x29, 0x4e, 0x16, 0xb8, 0x3d, 0x26, 0x66, 0x38, 0xae, 0xa5, 0x38, 0x97, 0x0c, 0x49, 0x4c,
-	0x2a, 0x8a, 0xc0, 0x35, 0x98, 0x98, 0xe1, 0xb8, 0xb6, 0xfa, 0x07, 0x02, 0x7d, 0x3e, 0xb3, 0x27,
-	0xa0, 0x7b, 0x69, 0x8e, 0xee, 0x52, 0x46, 0x97, 0xbb, 0xc8, 0xf8, 0x5e, 0x9a, 0xe3, 0xbb, 0x94,
-	0xf1, 0x4d, 0x81, 0x82, 0xf0, 0xa5, 0x39, 0xc2, 0x4b, 0x19, 0xe1, 0x14, 0xc8, 0xd5, 0xb8, 0x0a,
-	0xc5, 0x03, 0xc7, 0x1b, 0x26, 0x21, 0xa9, 0xe4, 0x19, 0xb2, 0x64, 0xb6, 0xb8, 0x6c, 0xa7, 0x8a,
-	0x6a, 0x0d, 0xca, 0x33, 0xe5, 0xc3, 0x17, 0x40, 0x76, 0xfd, 0x81, 0xe8, 0x00, 0xd5, 0x6c, 0xf8,
-	0x83, 0xe4, 0x88, 0x8c, 0xd2, 0x16, 0xa2, 0xba, 0xea, 0x09, 0x2c, 0xce, 0xe6, 0x20, 0x8e, 0x2a,
-	0x7a, 0xa6, 0xa3, 0x7a, 0x11, 0x0a, 0x21, 0x89, 0x92, 0x61, 0xcc, 0x0a, 0xb5, 0x58, 0x5b, 0x30,
-	0x6f, 0x85, 0x1e, 0x8b, 0x91, 0x0c, 0x63, 0x5b, 0xe8, 0xaa, 0x1f, 0x40, 0x79, 0xe6, 0x37, 0x3e,
-	0x01, 0x5f, 0x3a, 0xa9, 0x92, 0x20, 0x22, 0x21, 0xf7, 0x5c, 0xb2, 0x85, 0x44, 0xf3, 0x98, 0x2d,
-	0xf1, 0x0b, 0xcc, 0x63, 0x17, 0xca, 0x33, 0x6d, 0xf6, 0x3c, 0x42, 0xd3, 0x84, 0x66, 0x5b, 0xe1,
-	0x05, 0x26, 0xf4, 0x2d, 0x82, 0xa2, 0xe8, 0xae, 0xe7, 0x12, 0xf5, 0x1c, 0xe4, 0x07, 0x4e, 0x12,
-	0xf1, 0x63, 0xa3, 0xda, 0x5c, 0xc0, 0x15, 0x28, 0x3a, 0x7d, 0x3f, 0x8c, 0x49, 0x3a, 0xd1, 0x53,
-	0x51, 0x8c, 0x94, 0x13, 0x28, 0xa5, 0x1d, 0xf0, 0xbc, 0x72, 0x17, 0x37, 0x05, 0x1f, 0x6e, 0x05,
-	0x3e, 0xd1, 0x67, 0xef, 0x05, 0x11, 0xfb, 0x33, 0xc8, 0x33, 0x25, 0x7e, 0x23, 0xbd, 0x05, 0xd0,
-	0x23, 0xb7, 0xc0, 0xd4, 0x08, 0xe5, 0x18, 0x7c, 0x11, 0x14, 0x97, 0x44, 0x03, 0x31, 0x1d, 0x80,
-	0x63, 0x1b, 0x24, 0x1a, 0x88, 0x18, 0x4c, 0x2b, 0x22, 0x7c, 0x87, 0x00, 0x26, 0xbe, 0xf0, 0x62,
-	0x96, 0x60, 0x99, 0x91, 0x35, 0x40, 0x61, 0x93, 0x88, 0xff, 0x26, 0x30, 0x19, 0x8a, 0x0d, 0x23,
-	0xb6, 0x8f, 0xaf, 0x83, 0xe2, 0x3a, 0xb1, 0xc3, 0x2a, 0xb7, 0x50, 0x7f, 0xf7, 0xe1, 0xe9, 0xca,
-	0xe5, 0xa7, 0x28, 0x09, 0xbf, 0xc5, 0x98, 0x07, 0x41, 0xe7, 0x07, 0x04, 0x6a, 0x46, 0x97, 0x9e,
-	0xb2, 0x28, 0xf6, 0x43, 0xc2, 0x19, 0x95, 0x6c, 0x21, 0xe1, 0x57, 0x41, 0x8d, 0xfd, 0x3b, 0x64,
-	0xe4, 0x9d, 0x10, 0x57, 0x1c, 0xc0, 0xc9, 0x06, 0x36, 0x41, 0xf3, 0x46, 0x2e, 0xf9, 0xd2, 0x0a,
-	0xd8, 0x63, 0x49, 0x16, 0x1d, 0xd6, 0x9e, 0xec, 0xd9, 0xd3, 0x00, 0xbc, 0x0c, 0x25, 0x67, 0xe4,
-	0x0c, 0x8f, 0x4f, 0x48, 0xc8, 0xc6, 0x9f, 0x6a, 0x67, 0x32, 0x67, 0xb5, 0xfe, 0x26, 0x14, 0xf8,
-	0x08, 0xc6, 0x00, 0x85, 0x2d, 0xbb, 0xb9, 0xd9, 0x6b, 0xea, 0x12, 0x5d, 0xef, 0xed, 0x34, 0xe8,
-	0x1a, 0xd1, 0x75, 0xa3, 0xd9, 0x69, 0xf6, 0x9a, 0x7a, 0x6e, 0xfd, 0x26, 0x68, 0x53, 0xdd, 0x8c,
-	0x35, 0x28, 0x72, 0x93, 0x86, 0x2e, 0x51, 0x81, 0xdb, 0x34, 0x74, 0x44, 0x05, 0x6e, 0xd4, 0xd0,
-	0x73, 0xb8, 0x0c, 0x6a, 0xd7, 0xea, 0xed, 0xb7, 0xac, 0xbd, 0x6e, 0x43, 0x97, 0x71, 0x09, 0x94,
-	0xae, 0x65, 0xed, 0xe8, 0xca, 0xfa, 0x11, 0xa8, 0x59, 0xd5, 0x99, 0x7d, 0xf7, 0x46, 0xd7, 0xba,
-	0xd5, 0xd5, 0x25, 0x86, 0xd9, 0xeb, 0x74, 0x74, 0x84, 0x8b, 0x20, 0xb7, 0xbb, 0x3d, 0x3d, 0x87,
-	0x55, 0xc8, 0xb7, 0x3a, 0xd6, 0x66, 0x4f, 0x97, 0xb9, 0xf7, 0xad, 0xf6, 0xcd, 0xcd, 0x8e, 0xae,
-	0x50, 0x68, 0xdd, 0xb2, 0x3a, 0x7a, 0x9e, 0x32, 0xdd, 0xed, 0xd9, 0xed, 0xee, 0xb6, 0x5e, 0xa0,
-	0xbb, 0xbd, 0xf6, 0xcd, 0xa6, 0x5e, 0x64, 0xfa, 0x8e, 0x55, 0xd7, 0x4b, 0xeb, 0x87, 0xa0, 0x4d,
-	0x55, 0x8a, 0xf3, 0xe8, 0x36, 0x79, 0xb4, 0x86, 0xb5, 0xb5, 0xab, 0x23, 0x4a, 0x95, 0xae, 0xf6,
-	0x5b, 0x76, 0xf3, 0x43, 0x3d, 0x87, 0xcf, 0x03, 0xce, 0xc4, 0xfd, 0x1d, 0x6b, 0xb7, 0xdd, 0x6b,
-	0x5b, 0x5d, 0x5d, 0xc6, 0xaf, 0xc1, 0x2b, 0x8f, 0xee, 0xef, 0x5b, 0xad, 0xd6, 0x6e, 0xb3, 0xa7,
-	0x2b, 0xb5, 0x4f, 0xa0, 0xb8, 0x19, 0x78, 0xdb, 0x61, 0x30, 0xc0, 0x55, 0x90, 0xb7, 0x49, 0x8c,
-	0x35, 0x73, 0xf2, 0x0e, 0x5d, 0x5e, 0x98, 0x7e, 0x40, 0x55, 0x25, 0xbc, 0x0e, 0x2a, 0xbd, 0x2a,
-	0x59, 0x65, 0xf1, 0x82, 0x39, 0xf5, 0xcc, 0x59, 0x2e, 0x9b, 0xd3, 0x6f, 0x8e, 0xaa, 0x54, 0x7f,
-	0xff, 0xde, 0x99, 0x21, 0xfd, 0x79, 0x66, 0x48, 0x0f, 0xce, 0x0c, 0xe9, 0xef, 0x33, 0x43, 0xfa,
-	0xe7, 0xcc, 0x40, 0xdf, 0x8c, 0x0d, 0xf4, 0xcb, 0xd8, 0x40, 0xbf, 0x8d, 0x0d, 0xe9, 0xf7, 0xb1,
-	0x21, 0xdd, 0x1b, 0x1b, 0xe8, 0xfe, 0xd8, 0x40, 0x0f, 0xc6, 0x06, 0xfa, 0xfe, 0x2f, 0x43, 0xba,
-	0x8e, 0x3e, 0x55, 0x82, 0x28, 0xe8, 0xf7, 0x0b, 0xac, 0x47, 0xdf, 0xf9, 0x37, 0x00, 0x00, 0xff,
-	0xff, 0x9f, 0xe1, 0xd1, 0xd4, 0x2e, 0x0c, 0x00, 0x00,
+	// 4412 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x3b, 0x4b, 0x8c, 0x1c, 0x49,
+	0x56, 0x9d, 0x55, 0xd5, 0x55, 0x95, 0xaf, 0x7e, 0xd9, 0xd1, 0x3d, 0x9e, 0x72, 0xcd, 0x4e, 0xbb,
+	0x9d, 0x63, 0xc6, 0x1e, 0x7b, 0x36, 0x3d, 0xd3, 0x33, 0xb3, 0x3b, 0x6b, 0x60, 0x98, 0x6e, 0x77,
+	0xb7, 0xbb, 0x67, 0xfa, 0xe3, 0xc9, 0x6e, 0xcf, 0x00, 0x97, 0x24, 0xbb, 0x32, 0xaa, 0x3a, 0xe5,
+	0xaa, 0xcc, 0x74, 0x66, 0x96, 0xe9, 0x36, 0x88, 0x05, 0x21, 0x0e, 0x48, 0x70, 0xe2, 0x82, 0xc4,
+	0x81, 0x45, 0x88, 0x8f, 0x40, 0xac, 0xd0, 0x4a, 0x20, 0x8e, 0x7b, 0x42, 0x73, 0xe0, 0x30, 0x08,
+	0x89, 0xe5, 0x64, 0xad, 0x7d, 0x41, 0xe2, 0x84, 0xb8, 0x00, 0x23, 0x21, 0x56, 0x2f, 0x3e, 0x59,
+	0x99, 0xf5, 0xf1, 0xb6, 0xdb, 0x9e, 0xcf, 0xa9, 0xf2, 0x7d, 0xe2, 0xc5, 0x8b, 0x88, 0x17, 0x2f,
+	0x5e, 0xbc, 0x17, 0x05, 0xaa, 0x1d, 0xb8, 0x46, 0x10, 0xfa, 0xb1, 0xdf, 0xfa, 0x66, 0xd7, 0x8d,
+	0x8f, 0x06, 0x87, 0x46, 0xdb, 0xef, 0x5f, 0xef, 0xfa, 0x5d, 0xff, 0x3a, 0x43, 0x1f, 0x0e, 0x3a,
+	0x0c, 0x62, 0x00, 0xfb, 0x12, 0xec, 0xef, 0xa4, 0xd8, 0x63, 0xb7, 0xdb, 0xb3, 0x0f, 0xa3, 0xeb,
+	0x87, 0xf6, 0xc0, 0xa1, 0x5e, 0xd7, 0xf5, 0x28, 0x6f, 0x7c, 0xbd, 0x4f, 0x63, 0x3b, 0x38, 0x64,
+	0x3f, 0xbc, 0x99, 0xfe, 0x27, 0x0a, 0xcc, 0xaf, 0xb4, 0x63, 0xd7, 0xf7, 0x4c, 0x7a, 0x6f, 0x40,
+	0xa3, 0x78, 0x93, 0xda, 0x0e, 0x0d, 0xc9, 0x1b, 0x50, 0x3c, 0x62, 0x5f, 0x4d, 0x65, 0x49, 0xb9,
+	0x52, 0x59, 0xae, 0x1b, 0x19, 0xfa, 0x6a, 0xf9, 0xd3, 0x87, 0x17, 0x66, 0x3e, 0x7b, 0x78, 0x41,
+	0x31, 0x05, 0x1f, 0xf9, 0x45, 0x50, 0x03, 0x3b, 0x8c, 0x5d, 0x94, 0xd5, 0xcc, 0x2d, 0x29, 0x57,
+	0x6a, 0xab, 0x37, 0x3e, 0x7f, 0x78, 0xe1, 0x5b, 0xa7, 0xd7, 0xcb, 0xb8, 0x2d, 0xdb, 0x6f, 0xad,
+	0x99, 0x43, 0x61, 0xfa, 0x9f, 0x2b, 0x00, 0xb7, 0x68, 0x2c, 0x14, 0x20, 0xdf, 0x1a, 0x51, 0x6d,
+	0xc1, 0x98, 0x30, 0x80, 0x09, 0x0a, 0xae, 0x42, 0xce, 0x75, 0x98, 0x66, 0xd5, 0xd5, 0xe5, 0xcf,
+	0x1f, 0x5e, 0x30, 0x9e, 0x42, 0xb3, 0x0f, 0xe9, 0x89, 0x99, 0x73, 0x1d, 0x72, 0x0e, 0x8a, 0x1d,
+	0x97, 0xf6, 0x9c, 0xa8, 0x99, 0x5f, 0xca, 0x5f, 0xa9, 0x99, 0x02, 0xba, 0x51, 0xf8, 0xc3, 0xef,
+	0x5d, 0x98, 0xd1, 0xff, 0x39, 0x07, 0x15, 0xa6, 0x68, 0x14, 0xf8, 0x5e, 0x44, 0xc9, 0x9b, 0x23,
+	0x9a, 0x36, 0x0c, 0x49, 0xfa, 0x42, 0x95, 0x5c, 0x80, 0xd9, 0x8e, 0x3f, 0xf0, 0x9c, 0x66, 0x7e,
+	0x49, 0xb9, 0x52, 0x36, 0x39, 0x80, 0xd3, 0x26, 0x54, 0x2f, 0x2c, 0xe5, 0xaf, 0x54, 0x96, 0x9b,
+	0x46, 0x4a, 0x55, 0x63, 0x83, 0x91, 0xd6, 0xbd, 0x38, 0x3c, 0x59, 0x2d, 0xa0, 0x56, 0x72, 0x68,
+	0xa4, 0x09, 0xa5, 0xfb, 0x34, 0x8c, 0x70, 0x55, 0x67, 0x97, 0x94, 0x2b, 0x05, 0x53, 0x82, 0xe4,
+	0x05, 0x28, 0x46, 0xf4, 0x9e, 0xe5, 0xf9, 0xcd, 0x22, 0x23, 0xcc, 0x46, 0xf4, 0xde, 0xae, 0xdf,
+	0xda, 0x80, 0x4a, 0x4a, 0x1a, 0xd1, 0x20, 0x7f, 0x97, 0x9e, 0xb0, 0x19, 0xa8, 0x99, 0xf8, 0x49,
+	0x2e, 0xc2, 0xec, 0x7d, 0xbb, 0x37, 0xa0, 0x6c, 0x98, 0x95, 0xe5, 0x0a, 0xef, 0xfc, 0x63, 0x44,
+	0x99, 0x9c, 0x72, 0x23, 0xf7, 0xae, 0x22, 0xe6, 0xf4, 0xbb, 0x50, 0x59, 0x1d, 0xf4, 0xee, 0x3e,
+	0xeb, 0xe2, 0x2f, 0x43, 0x39, 0xe4, 0x2c, 0x51, 0x33, 0xc7, 0xc6, 0xaf, 0x19, 0x28, 0x77, 0x2b,
+	0xa6, 0x7d, 0xd1, 0x56, 0x8c, 0x3b, 0xe1, 0x13, 0x0a, 0xfc, 0x06, 0x54, 0xb9, 0x02, 0x67, 0x5f,
+	0xd4, 0x77, 0x40, 0x0d, 0x05, 0x8f, 0xec, 0x7d, 0x2e, 0xd5, 0x3b, 0xa7, 0x88, 0xee, 0x87, 0x9c,
+	0xa2, 0xff, 0xbf, 0x56, 0xa0, 0x31, 0xa2, 0x29, 0x59, 0x82, 0x92, 0x1f, 0x58, 0xf1, 0x49, 0x40,
+	0x99, 0x12, 0xf5, 0xe5, 0x92, 0xb1, 0x17, 0x1c, 0x9c, 0x04, 0xd4, 0x2c, 0xfa, 0xec, 0x97, 0xbc,
+	0x0a, 0xc5, 0x76, 0x48, 0xed, 0x58, 0x4e, 0x72, 0xdd, 0xb8, 0xc9, 0x40, 0x21, 0xc1, 0x14, 0x54,
+	0xe4, 0x1b, 0x04, 0x0e, 0xf2, 0xe5, 0x05, 0xdf, 0x9d, 0xc0, 0x49, 0xf3, 0x71, 0x2a, 0xf2, 0x39,
+	0xb4, 0x47, 0x63, 0xda, 0x2c, 0x08, 0xbe, 0x35, 0x06, 0x26, 0x7c, 0x9c, 0xaa, 0xff, 0x8b, 0x02,
+	0xda, 0xe8, 0xc8, 0x4e, 0xa1, 0xee, 0xe5, 0x11, 0x75, 0x1b, 0x89, 0xba, 0x5c, 0x44, 0xa2, 0xef,
+	0xe5, 0x11, 0x7d, 0x1b, 0x89, 0xbe, 0x92, 0x51, 0x28, 0x7c, 0x79, 0x44, 0xe1, 0x46, 0xa2, 0xb0,
+	0x64, 0xe4, 0x64, 0xa2, 0x43, 0xa9, 0x63, 0xbb, 0xbd, 0x41, 0x48, 0x99, 0x7d, 0x57, 0x96, 0xcb,
+	0xc6, 0x06, 0x87, 0x4d, 0x49, 0xd0, 0x7f, 0x47, 0x81, 0x5a, 0x66, 0xfe, 0xc8, 0x45, 0xc8, 0x3b,
+	0x7e, 0x5b, 0x98, 0x80, 0x6a, 0xac, 0xf9, 0xed, 0x41, 0x9f, 0x7a, 0xd2, 0x86, 0x90, 0x86, 0xbe,
+	0x22, 0xf2, 0x07, 0x61, 0x9b, 0x8f, 0xa9, 0x6a, 0x0a, 0x88, 0xbc, 0x0c, 0xe0, 0x76, 0x2c, 0xb9,
+	0xa7, 0xf2, 0x6c, 0xeb, 0xa8, 0x6e, 0xe7, 0x63, 0x8e, 0x20, 0x2d, 0x50, 0xdd, 0x8e, 0x25, 0x36,
+	0x56, 0x81, 0xef, 0x38, 0xb7, 0xb3, 0x8f, 0x5b, 0x0b, 0x6d, 0xa1, 0x9e, 0x9d, 0x18, 0xe1, 0x30,
+	0x94, 0x67, 0x72, 0x18, 0x97, 0xa0, 0x18, 0xd2, 0x68, 0xd0, 0x8b, 0x99, 0xa6, 0xf5, 0xe5, 0xaa,
+	0xf1, 0x49, 0xe8, 0xb2, 0x3e, 0x06, 0xbd, 0xd8, 0x14, 0xb4, 0xb4, 0x23, 0xc8, 0x4f, 0x73, 0x04,
+	0x85, 0x94, 0x23, 0xd0, 0x7f, 0x2b, 0x07, 0xb5, 0x8c, 0x35, 0x9d, 0x72, 0xd6, 0x06, 0x41, 0x44,
+	0x43, 0xae, 0x4b, 0xd9, 0x14, 0x50, 0x6a, 0x36, 0xf3, 0x99, 0xd9, 0x6c, 0x42, 0x89, 0x9d, 0x14,
+	0x76, 0x8f, 0x75, 0x5e, 0x35, 0x25, 0x48, 0x2e, 0x40, 0x31, 0x6a, 0x87, 0x6e, 0x10, 0x8b, 0x75,
+	0x2d, 0x19, 0xfb, 0x0c, 0x34, 0x05, 0x7a, 0x64, 0x21, 0x8a, 0x4f, 0x5c, 0x88, 0x52, 0x66, 0x21,
+	0xc8, 0x65, 0x68, 0xf4, 0xed, 0x20, 0x70, 0xbd, 0x6e, 0xd2, 0xbe, 0xcc, 0x38, 0xea, 0x02, 0x2d,
+	0x84, 0xe8, 0xef, 0x42, 0x91, 0xf7, 0x9a, 0x1a, 0x00, 0x0e, 0x5f, 0x4d, 0x06, 0x70, 0x0e, 0x8a,
+	0x81, 0x1d, 0xda, 0xfd, 0x48, 0x9a, 0x09, 0x87, 0xd8, 0x5a, 0x67, 0x6d, 0xfb, 0xeb, 0xbc, 0xd6,
+	0x3f, 0x50, 0xa0, 0x96, 0xf1, 0x08, 0xcf, 0x45, 0xd9, 0xec, 0x0a, 0xe5, 0x9e, 0xb8, 0x42, 0xf9,
+	0xec, 0x0a, 0xe9, 0x50, 0x73, 0x3b, 0x16, 0x3d, 0x0e, 0xdc, 0x90, 0x3a, 0x96, 0x1d, 0x33, 0x75,
+	0xf3, 0x66, 0xc5, 0xed, 0xac, 0x73, 0xdc, 0x4a, 0xcc, 0xa6, 0x38, 0xeb, 0x15, 0xbe, 0xce, 0x53,
+	0xfc, 0x7f, 0x0a, 0x94, 0x84, 0x67, 0x7a, 0x2e, 0x6a, 0x2e, 0xc0, 0x6c, 0xdb, 0x1e, 0x44, 0xdc,
+	0x3d, 0xa9, 0x26, 0x07, 0x50, 0x2d, 0xfb, 0xd0, 0x0f, 0x63, 0x2a, 0xc3, 0x07, 0x09, 0x92, 0xd7,
+	0x40, 0x13, 0x1a, 0x5a, 0x6d, 0xdf, 0xeb, 0xf4, 0xdc, 0x36, 0x9f, 0xd4, 0xb2, 0xd9, 0x10, 0xf8,
+	0x9b, 0x02, 0x8d, 0xdb, 0xa3, 0x3d, 0x08, 0x43, 0xea, 0xc5, 0x56, 0x36, 0x76, 0xa8, 0x0b, 0xb4,
+	0x5c, 0xc1, 0x4b, 0x20, 0x31, 0x56, 0x26, 0x94, 0xa8, 0x0a, 0x2c, 0x5b, 0x4b, 0x71, 0x10, 0xfe,
+	0x6b, 0x01, 0x6a, 0xfb, 0xd4, 0x0e, 0xdb, 0x47, 0xcf, 0x1a, 0x0c, 0xe8, 0x30, 0x7b, 0x6f, 0x40,
+	0xc3, 0x13, 0x71, 0xd8, 0x14, 0x8d, 0x8f, 0x10, 0x12, 0x5e, 0x88, 0x93, 0x08, 0x81, 0x42, 0x27,
+	0xf4, 0xfb, 0x6c, 0x12, 0x6a, 0x26, 0xfb, 0x46, 0x5c, 0xe4, 0x3e, 0xe0, 0x27, 0x4a, 0xcd, 0x64,
+	0xdf, 0xe4, 0x12, 0x14, 0x22, 0x3f, 0x44, 0x1f, 0x83, 0xc7, 0x3a, 0x18, 0xfb, 0x7e, 0x18, 0xb3,
+	0x78, 0x46, 0x88, 0x63, 0xd4, 0x54, 0xdc, 0x58, 0x4c, 0xc7, 0x8d, 0x64, 0x0d, 0xaa, 0x91, 0xdb,
+	0x77, 0x7b, 0x76, 0xe8, 0xc6, 0x2e, 0x8d, 0x9a, 0x25, 0x26, 0x65, 0xc9, 0xc8, 0x8c, 0xd3, 0xd8,
+	0x4f, 0xb1, 0xb0, 0xa0, 0xca, 0xcc, 0xb4, 0x22, 0x6f, 0x02, 0x44, 0xb1, 0x1d, 0xbb, 0x51, 0xec,
+	0xb6, 0x23, 0xe6, 0x88, 0x30, 0xc0, 0xe0, 0x32, 0xf6, 0x13, 0x82, 0x99, 0x62, 0x22, 0x1f, 0x40,
+	0xd5, 0xee, 0x76, 0x43, 0xda, 0xb5, 0x71, 0xc2, 0xa2, 0xa6, 0x3a, 0xb1, 0xe3, 0x95, 0x14, 0x4b,
+	0x3a, 0x36, 0xcc, 0xb4, 0x25, 0x57, 0x40, 0x3d, 0x72, 0xbb, 0x47, 0x3d, 0xb7, 0x7b, 0x14, 0x37,
+	0x81, 0xf5, 0x0e, 0xc6, 0xa6, 0xc4, 0x98, 0x43, 0x62, 0xeb, 0x17, 0x60, 0x6e, 0x6c, 0x2c, 0x13,
+	0x02, 0xc4, 0x85, 0x74, 0x80, 0xa8, 0xa6, 0x62, 0xc2, 0xd6, 0x0e, 0xcc, 0x8d, 0xe9, 0x94, 0x16,
+	0xa0, 0x72, 0x01, 0x7a, 0x36, 0xc2, 0xac, 0xa6, 0x07, 0x32, 0x1e, 0x62, 0xfe, 0x45, 0x0e, 0xea,
+	0x72, 0xdc, 0x67, 0x0f, 0xf2, 0x16, 0x60, 0x36, 0xf6, 0x63, 0xbb, 0xc7, 0xef, 0x3e, 0x26, 0x07,
+	0xd0, 0x3c, 0x8e, 0xdc, 0x98, 0x5f, 0x17, 0x98, 0x79, 0xb0, 0x7e, 0x36, 0x5d, 0x79, 0xe6, 0x31,
+	0x2a, 0xf9, 0x70, 0x64, 0x35, 0x78, 0x84, 0x7e, 0xd1, 0xc8, 0x6a, 0x75, 0xba, 0xe5, 0x68, 0xed,
+	0x9f, 0x6e, 0x8e, 0xae, 0x64, 0xe7, 0x88, 0x64, 0xe6, 0x88, 0xbb, 0xaa, 0xb1, 0x99, 0xfa, 0xdd,
+	0x02, 0xa8, 0xc9, 0x08, 0x9e, 0x97, 0x13, 0x8a, 0xda, 0x7e, 0xc8, 0xb5, 0x50, 0x4c, 0x0e, 0x90,
+	0xb7, 0x33, 0xd7, 0xac, 0xca, 0xf2, 0xb9, 0xe1, 0xbc, 0x3d, 0xe1, 0xa6, 0xf2, 0x3e, 0x40, 0x62,
+	0x6a, 0x72, 0x0e, 0x5b, 0xa9, 0x96, 0x89, 0x49, 0x66, 0x5a, 0xa7, 0xda, 0x90, 0xf7, 0x00, 0x5c,
+	0xcf, 0xa3, 0xa1, 0xc5, 0xd6, 0x8c, 0x6f, 0xe9, 0xf3, 0x29, 0x09, 0x5b, 0x48, 0xdc, 0x74, 0xb3,
+	0x02, 0x54, 0x57, 0x62, 0x9f, 0xd7, 0xd5, 0xa7, 0x65, 0x42, 0x63, 0x44, 0xd9, 0x09, 0xb2, 0x5e,
+	0xcb, 0xca, 0x9a, 0x1f, 0x8e, 0x6f, 0x23, 0xb4, 0xbb, 0x18, 0x57, 0x45, 0x69, 0x99, 0x9b, 0x50,
+	0xcf, 0xaa, 0x3f, 0x41, 0xe4, 0x52, 0x56, 0x24, 0x0c, 0x07, 0x3c, 0x6e, 0x0b, 0x6f, 0x80, 0x9a,
+	0x50, 0xc9, 0x2b, 0xc2, 0xcc, 0x15, 0x36, 0x65, 0x6a, 0xd2, 0x2e, 0x6d, 0xe5, 0xfa, 0xdf, 0x29,
+	0x50, 0x96, 0x04, 0xf4, 0x88, 0x7e, 0xa7, 0x13, 0xd1, 0x58, 0xf4, 0x2f, 0xa0, 0x29, 0x06, 0xf1,
+	0xd6, 0x88, 0x41, 0xbc, 0x90, 0xf4, 0x30, 0xdd, 0x1e, 0x9e, 0xd7, 0x6a, 0xe8, 0x7f, 0x9a, 0x03,
+	0x35, 0x99, 0xdb, 0x94, 0x2b, 0x57, 0x32, 0xae, 0xfc, 0x45, 0x28, 0x05, 0x21, 0xb5, 0x62, 0xbb,
+	0x2b, 0xdc, 0x56, 0x31, 0x08, 0xe9, 0x81, 0xdd, 0x25, 0xe7, 0xa1, 0x1c, 0xf8, 0x51, 0xcc, 0x28,
+	0x79, 0x46, 0x29, 0x21, 0x8c, 0xa4, 0x57, 0xa0, 0xd6, 0x11, 0x6b, 0x65, 0xa5, 0x4e, 0x96, 0xaa,
+	0x44, 0xee, 0xe3, 0x09, 0x63, 0xc0, 0xbc, 0x37, 0xe8, 0x1f, 0xd2, 0xd0, 0xf2, 0x3b, 0x96, 0xa4,
+	0x44, 0xec, 0x40, 0xad, 0x99, 0x73, 0x9c, 0xb4, 0xd7, 0x49, 0xd6, 0x9c, 0x7c, 0x1b, 0x54, 0xdb,
+	0xb3, 0x7b, 0x27, 0x0f, 0x68, 0xc8, 0x8f, 0x1b, 0xb4, 0xe1, 0x44, 0x7f, 0x63, 0x45, 0xd2, 0xf8,
+	0x49, 0x32, 0xe4, 0x6d, 0xfd, 0x1c, 0xd4, 0xb3, 0xc4, 0xa7, 0x71, 0xcd, 0xfa, 0x32, 0x90, 0x71,
+	0x03, 0x24, 0xdf, 0x00, 0x75, 0xa8, 0x32, 0x4e, 0x98, 0x6a, 0x0e, 0x11, 0xfa, 0xaf, 0xc1, 0x8b,
+	0x63, 0xa7, 0xd4, 0x17, 0x7f, 0xb6, 0x0b, 0x03, 0xfe, 0x3d, 0x05, 0x9a, 0xe3, 0xbd, 0x9f, 0xfd,
+	0x00, 0xf8, 0x76, 0xe6, 0x14, 0xce, 0x4d, 0x39, 0x85, 0xa5, 0xd7, 0x19, 0xb2, 0x0a, 0x75, 0x7c,
+	0xd0, 0x46, 0x79, 0x89, 0x91, 0xb1, 0x35, 0xcc, 0x59, 0x30, 0x0b, 0x1d, 0x93, 0x26, 0x6d, 0xf0,
+	0x1a, 0xcc, 0xc6, 0x34, 0xec, 0xcb, 0x24, 0x43, 0xc3, 0x38, 0xa0, 0x61, 0x7f, 0x8c, 0x9b, 0xf3,
+	0xe8, 0x6d, 0x68, 0x8c, 0x48, 0x63, 0x99, 0x23, 0x44, 0x89, 0x15, 0xe7, 0x00, 0x79, 0x09, 0x54,
+	0xc7, 0x6f, 0x5b, 0x6d, 0x7f, 0xe0, 0xf1, 0x90, 0x36, 0x6f, 0x96, 0x1d, 0xbf, 0x7d, 0x13, 0x61,
+	0x0c, 0xd1, 0xa3, 0x41, 0xdf, 0xea, 0x51, 0xaf, 0x1b, 0x1f, 0x31, 0xfb, 0xce, 0x9b, 0x6a, 0x34,
+	0xe8, 0x6f, 0x33, 0x84, 0x7e, 0x07, 0xea, 0x59, 0x1d, 0xa6, 0xf4, 0x41, 0xa0, 0x80, 0x5a, 0x89,
+	0x3b, 0x10, 0xfb, 0xc6, 0x8d, 0x83, 0xfd, 0x76, 0x42, 0x7a, 0x4f, 0x08, 0x2e, 0x39, 0x7e, 0x7b,
+	0x23, 0xa4, 0xf7, 0xf4, 0xef, 0x2b, 0x89, 0xad, 0x3e, 0xab, 0xc1, 0xb4, 0xa0, 0x2c, 0xb7, 0x80,
+	0x30, 0xea, 0x04, 0x1e, 0xea, 0xca, 0xf7, 0x6d, 0x5a, 0xd7, 0x63, 0x1e, 0xfc, 0xaa, 0x26, 0xfb,
+	0xc6, 0xb0, 0x99, 0x1e, 0x07, 0x3d, 0xdb, 0xe5, 0x91, 0x6e, 0xd9, 0x94, 0xa0, 0x58, 0xdd, 0x1f,
+	0x2a, 0xd0, 0x48, 0x14, 0x3e, 0xbb, 0x8d, 0x3d, 0x49, 0xd9, 0x6b, 0x50, 0x8c, 0xfd, 0xbb, 0xd4,
+	0x93, 0x3e, 0xb2, 0x26, 0xb7, 0xfa, 0x01, 0x62, 0xa5, 0xa5, 0x70, 0x16, 0x64, 0x8e, 0x62, 0xbb,
+	0x4b, 0xe5, 0x39, 0x99, 0x30, 0xef, 0x23, 0x56, 0x32, 0x73, 0x16, 0x31, 0x84, 0x07, 0x50, 0x4d,
+	0x0b, 0x4c, 0x96, 0x4c, 0x91, 0xd3, 0x10, 0xf6, 0x99, 0xf7, 0x8e, 0x6d, 0x71, 0x79, 0x9f, 0x35,
+	0x39, 0x80, 0x6e, 0x84, 0x8a, 0x74, 0xe4, 0xac, 0x89, 0x9f, 0x38, 0x8e, 0xc0, 0x8f, 0x78, 0xae,
+	0xb8, 0xc0, 0xd0, 0x09, 0xcc, 0xe4, 0x62, 0xaa, 0x68, 0x56, 0xc8, 0x3d, 0x09, 0xa8, 0xde, 0x86,
+	0x6a, 0x5a, 0x3f, 0xe4, 0xf1, 0xec, 0xbe, 0xbc, 0x4a, 0xb3, 0xef, 0x64, 0x59, 0x72, 0xa9, 0x65,
+	0x79, 0x9a, 0x39, 0xd1, 0x7f, 0xa4, 0x00, 0x41, 0x63, 0xfd, 0x98, 0xb6, 0x63, 0x3f, 0x8c, 0xbe,
+	0xc6, 0xf9, 0x66, 0xbc, 0x60, 0xe1, 0x7c, 0x5b, 0x29, 0x87, 0xc3, 0xaf, 0x62, 0xf5, 0x38, 0xb3,
+	0xdb, 0xc4, 0xd2, 0xfd, 0x7d, 0x0e, 0xe6, 0x33, 0x23, 0xfb, 0x3a, 0x26, 0xa8, 0xdf, 0x1b, 0x49,
+	0x50, 0x2f, 0x19, 0x13, 0x54, 0x7e, 0xc2, 0x71, 0xbf, 0xfd, 0xd3, 0x8e, 0xfb, 0xcb, 0xd9, 0xe3,
+	0x7e, 0x8e, 0xcb, 0x4a, 0x77, 0x32, 0x16, 0xe4, 0xfc, 0x2c, 0x68, 0xa3, 0x4c, 0x28, 0x86, 0x3b,
+	0x59, 0xee, 0x93, 0x2b, 0x29, 0x35, 0xb3, 0x0e, 0xf6, 0xaf, 0x14, 0x80, 0x21, 0x2d, 0xb3, 0x5f,
+	0xa4, 0x8b, 0x63, 0xb7, 0x4c, 0x7a, 0x4f, 0x6c, 0x17, 0xf6, 0x4d, 0xde, 0x04, 0x55, 0xee, 0x85,
+	0xa1, 0xd9, 0xa2, 0x9c, 0xdb, 0x02, 0x2b, 0xe3, 0xce, 0x84, 0x2b, 0xe3, 0x29, 0x0b, 0x19, 0x4f,
+	0x49, 0x5e, 0x85, 0x06, 0xbb, 0x89, 0x58, 0xcc, 0x5e, 0x18, 0xc7, 0x2c, 0xe3, 0xa8, 0x31, 0x34,
+	0xca, 0x65, 0x1e, 0xd5, 0x84, 0x6a, 0xba, 0x8f, 0xcc, 0x0e, 0x55, 0x46, 0x76, 0xe8, 0x29, 0x77,
+	0xb9, 0xfe, 0xff, 0x0a, 0xd4, 0xf6, 0xdb, 0xa1, 0xdf, 0xeb, 0x3d, 0xeb, 0x5e, 0x7a, 0x09, 0xd4,
+	0x88, 0x09, 0xb2, 0x84, 0xf1, 0xa9, 0x66, 0x99, 0x23, 0xb6, 0x9c, 0xe4, 0x5a, 0x9e, 0x4f, 0x5d,
+	0xcb, 0xcf, 0x65, 0x8c, 0x69, 0xb8, 0x71, 0x5e, 0x06, 0xb8, 0x4b, 0x69, 0x60, 0xd9, 0x3d, 0xf7,
+	0xbe, 0x74, 0x31, 0x2a, 0x62, 0x56, 0x10, 0x41, 0x36, 0x61, 0xd6, 0xee, 0xc4, 0x34, 0x6c, 0x16,
+	0xcf, 0x6c, 0xe0, 0x5c, 0x80, 0xb0, 0x9f, 0x3f, 0x53, 0xa0, 0x2e, 0x67, 0xe0, 0xec, 0x7b, 0xee,
+	0x89, 0xa3, 0x7f, 0x0d, 0x0a, 0x8e, 0xdf, 0x96, 0x96, 0xd2, 0x30, 0x78, 0x77, 0x23, 0xa9, 0x55,
+	0xc6, 0x82, 0x13, 0xe5, 0xf8, 0x1e, 0x15, 0xae, 0x82, 0x7d, 0x0b, 0x3d, 0x7f, 0x94, 0xe8, 0x29,
+	0x1b, 0x3e, 0x97, 0xdb, 0xdd, 0x77, 0x92, 0x55, 0xe0, 0x01, 0xc9, 0x4b, 0x23, 0xda, 0x7d, 0x09,
+	0xc1, 0xbb, 0x0f, 0xe4, 0x66, 0x8f, 0xda, 0xe1, 0x17, 0x6f, 0x87, 0x62, 0x2a, 0x77, 0x61, 0x3e,
+	0xd3, 0xe1, 0x99, 0x97, 0x5d, 0xc8, 0xfb, 0x7e, 0x0e, 0xea, 0xab, 0x27, 0x2c, 0x8a, 0xfd, 0x32,
+	0xf2, 0x5e, 0xa9, 0x7c, 0x7a, 0x7e, 0x5a, 0x3e, 0xbd, 0x30, 0x35, 0x9f, 0x7e, 0x68, 0xc7, 0xed,
+	0x23, 0x7e, 0x95, 0xe1, 0xf7, 0x13, 0x95, 0x61, 0xe4, 0x3d, 0x46, 0x96, 0xd6, 0xac, 0x80, 0x86,
+	0x56, 0x44, 0xdb, 0xbe, 0xe7, 0xb0, 0x9d, 0x96, 0x33, 0xe7, 0x24, 0xe9, 0x36, 0x0d, 0xf7, 0x19,
+	0x81, 0xbc, 0x01, 0x0b, 0x41, 0xe8, 0xb7, 0x29, 0x75, 0xac, 0x54, 0xca, 0x31, 0x62, 0xa9, 0xf8,
+	0xb2, 0x49, 0x04, 0x6d, 0x2f, 0xc9, 0x3a, 0xca, 0xc3, 0xae, 0x0d, 0x8d, 0x64, 0xbe, 0xce, 0xbe,
+	0xe7, 0x5e, 0x84, 0x52, 0x6c, 0x47, 0x77, 0x87, 0xeb, 0x5c, 0x44, 0x30, 0x59, 0xe5, 0xbb, 0x40,
+	0x44, 0x27, 0x07, 0x76, 0xf4, 0xcc, 0xd5, 0xc9, 0x9f, 0xd2, 0xd9, 0xaf, 0xc3, 0x7c, 0xa6, 0xb3,
+	0xb3, 0x8f, 0xea, 0x75, 0x16, 0xf6, 0xc5, 0x83, 0x28, 0x29, 0x0b, 0x0a, 0xc1, 0xfb, 0x0c, 0x9b,
+	0x8a, 0xfb, 0xe2, 0x81, 0x9c, 0xcf, 0xdf, 0xce, 0x43, 0x2d, 0xc3, 0x95, 0x56, 0x57, 0x49, 0xab,
+	0xcb, 0x8b, 0x37, 0x8e, 0x2c, 0xe3, 0x95, 0x93, 0x62, 0x5c, 0x92, 0x1b, 0xe3, 0x99, 0x6e, 0x0e,
+	0xa0, 0xa9, 0xf1, 0x1a, 0x9c, 0x23, 0xeb, 0x5c, 0x02, 0x44, 0x0a, 0x6f, 0xe9, 0xc8, 0x9a, 0xb3,
+	0x00, 0x51, 0x92, 0xe7, 0xfb, 0x41, 0x24, 0x4b, 0xce, 0x0c, 0x20, 0xd7, 0x60, 0x6e, 0x34, 0x35,
+	0x1d, 0x89, 0x92, 0x8d, 0x36, 0x92, 0x9b, 0x8e, 0xf0, 0x64, 0x13, 0x75, 0xbd, 0x48, 0x14, 0x6d,
+	0x12, 0x18, 0x3b, 0x66, 0x06, 0x4b, 0x31, 0x23, 0xca, 0x3a, 0x16, 0x60, 0xe2, 0x3b, 0x61, 0xe8,
+	0x3b, 0x51, 0x52, 0xdb, 0xf6, 0xda, 0xb4, 0x47, 0x9d, 0x66, 0x85, 0xe1, 0x13, 0x18, 0x15, 0xa5,
+	0x61, 0xe8, 0x87, 0xcd, 0x2a, 0xbf, 0x3a, 0x30, 0x80, 0xdd, 0x96, 0xf0, 0xb0, 0xb4, 0x62, 0xb7,
+	0x4f, 0x9b, 0x35, 0x71, 0x5b, 0x42, 0xcc, 0x81, 0xdb, 0xa7, 0x78, 0x8e, 0x53, 0xcf, 0xe1, 0xc4,
+	0x3a, 0x3f, 0xc7, 0xa9, 0xe7, 0x20, 0x49, 0x77, 0x41, 0x4d, 0x52, 0xcb, 0x53, 0xee, 0x50, 0x4d,
+	0x28, 0x85, 0x14, 0x87, 0x2b, 0xa7, 0x5f, 0x82, 0xe4, 0x75, 0xa8, 0x76, 0xa9, 0x6f, 0x39, 0x6e,
+	0x14, 0xa3, 0x7e, 0xa2, 0x76, 0xaa, 0x1a, 0xb7, 0xa8, 0x7f, 0xdb, 0x77, 0xbd, 0xd8, 0xac, 0x74,
+	0xa9, 0xbf, 0x26, 0xa8, 0xfa, 0x67, 0x05, 0x98, 0x65, 0xcb, 0x4d, 0x16, 0x53, 0x21, 0x0b, 0xa6,
+	0x83, 0x30, 0x42, 0x60, 0x14, 0x11, 0xbe, 0x5c, 0x1c, 0xde, 0x37, 0x95, 0x24, 0x14, 0x8a, 0x38,
+	0x07, 0xa7, 0x90, 0x6b, 0xa0, 0xf6, 0x99, 0x53, 0xb0, 0x7b, 0xbd, 0xa4, 0xc6, 0xbc, 0x83, 0x98,
+	0x95, 0x5e, 0x8f, 0x73, 0x96, 0xfb, 0x02, 0xc4, 0xfe, 0x0e, 0x7d, 0xbf, 0x27, 0x1c, 0x0c, 0x18,
+	0xab, 0xbe, 0x2f, 0x78, 0x18, 0x1e, 0x2b, 0x2b, 0xc1, 0x51, 0x68, 0x47, 0xb2, 0x54, 0x5b, 0x35,
+	0x6e, 0x33, 0x90, 0xf3, 0x08, 0x1a, 0x6a, 0x15, 0xda, 0x5e, 0x97, 0x36, 0x8b, 0x42, 0x2b, 0x13,
+	0x21, 0xa1, 0x15, 0xa3, 0x30, 0x41, 0x21, 0xed, 0xb8, 0xc7, 0xcd, 0x92, 0x14, 0xc4, 0x40, 0x29,
+	0x88, 0x01, 0xe4, 0x2a, 0x94, 0x7f, 0xd5, 0xed, 0x39, 0x6d, 0x3b, 0x74, 0x44, 0x56, 0xbd, 0x6e,
+	0x7c, 0x22, 0x10, 0x42, 0x75, 0x49, 0xe7, 0x45, 0x9f, 0x2e, 0x3d, 0x0e, 0x9a, 0xaa, 0x90, 0x68,
+	0x32, 0x50, 0x48, 0xe4, 0x34, 0x54, 0xad, 0x33, 0x78, 0xf0, 0xe0, 0x44, 0xa4, 0xc9, 0x2b, 0xc6,
+	0x06, 0x42, 0x42, 0x35, 0x46, 0x21, 0xef, 0x81, 0x86, 0x6b, 0x75, 0x88, 0x31, 0x31, 0xd6, 0x17,
+	0x0f, 0xfd, 0xe3, 0x66, 0x45, 0x78, 0x93, 0x5b, 0xd4, 0x5f, 0x15, 0xf8, 0x55, 0x5f, 0x28, 0x5b,
+	0xef, 0x66, 0x90, 0xe4, 0xed, 0x91, 0xb5, 0xae, 0x8a, 0x60, 0xf7, 0xd6, 0x70, 0x85, 0x79, 0xc3,
+	0xf4, 0x9a, 0x93, 0x37, 0x01, 0x41, 0x2b, 0xf0, 0x7b, 0x27, 0x5d, 0xdf, 0x63, 0x96, 0x89, 0xe9,
+	0x06, 0x66, 0x20, 0x0c, 0xc5, 0xdb, 0x40, 0x37, 0x41, 0xe0, 0x88, 0x3d, 0x1a, 0xe1, 0x1e, 0xad,
+	0x8b, 0x11, 0xef, 0x32, 0x50, 0x8c, 0x98, 0xd3, 0x6e, 0x14, 0x3e, 0xfd, 0xde, 0x05, 0x45, 0x7f,
+	0x07, 0xd4, 0xc4, 0x76, 0x4e, 0x9f, 0x01, 0xd0, 0xdf, 0x05, 0x18, 0x5a, 0xd4, 0x94, 0x76, 0x0b,
+	0xe9, 0x9c, 0x47, 0x55, 0xc6, 0xde, 0x3b, 0x50, 0x49, 0x99, 0xc6, 0xd3, 0x34, 0x65, 0xe1, 0x64,
+	0xcf, 0x0f, 0x92, 0x70, 0xb2, 0xe7, 0x07, 0x7a, 0x07, 0x60, 0x68, 0x44, 0x53, 0xa4, 0xd5, 0x21,
+	0xd7, 0x8d, 0x85, 0xfa, 0xb9, 0x2e, 0x8b, 0x87, 0xbb, 0xb1, 0x2c, 0x57, 0xe3, 0x27, 0x72, 0xf4,
+	0x62, 0x51, 0xa6, 0xce, 0xf5, 0x18, 0x47, 0x2f, 0xe6, 0xb6, 0x5c, 0x35, 0xf1, 0x53, 0x3f, 0x84,
+	0x4a, 0xca, 0x10, 0xa7, 0x74, 0x74, 0x2e, 0x31, 0x5e, 0x59, 0x31, 0x66, 0x10, 0xf9, 0x19, 0xa8,
+	0xf7, 0xed, 0x63, 0xac, 0x79, 0xda, 0x5e, 0x24, 0x6e, 0x0f, 0xd8, 0xac, 0xd6, 0xb7, 0x8f, 0xd7,
+	0x13, 0xa4, 0xde, 0x81, 0x5a, 0xc6, 0x88, 0xa7, 0x7b, 0x93, 0xc0, 0x8e, 0x63, 0x1a, 0x7a, 0xe2,
+	0x4c, 0x92, 0xe0, 0x69, 0xfb, 0x71, 0xa0, 0x92, 0xda, 0x02, 0x5f, 0x54, 0x2f, 0x7f, 0xac, 0x00,
+	0x0c, 0x37, 0xd1, 0x53, 0x64, 0x97, 0x5e, 0x42, 0xc7, 0x74, 0x6c, 0x51, 0x87, 0x97, 0x67, 0x90,
+	0xbb, 0x8c, 0xa2, 0x1d, 0x9e, 0xcf, 0xae, 0xf1, 0x49, 0x95, 0x89, 0x2d, 0x91, 0x98, 0xe5, 0x48,
+	0x9e, 0xdb, 0x9a, 0xa0, 0xe1, 0xec, 0x24, 0x0d, 0x0d, 0x28, 0x4b, 0x3f, 0xcb, 0x56, 0xdc, 0xe6,
+	0x29, 0x6f, 0xc5, 0xc4, 0x4f, 0x86, 0x11, 0xb5, 0x6d, 0xc4, 0xf8, 0x9e, 0xfe, 0x5d, 0x98, 0x9f,
+	0xb0, 0xcf, 0xa7, 0x8c, 0xec, 0x12, 0x94, 0x63, 0x3f, 0xb0, 0x7a, 0xb4, 0x13, 0x37, 0x73, 0xa3,
+	0x5e, 0xbd, 0x14, 0xfb, 0xc1, 0x36, 0xed, 0xc4, 0xe8, 0xff, 0x0f, 0xfd, 0x38, 0xf6, 0xfb, 0x56,
+	0xc8, 0x8a, 0x74, 0xe3, 0xfe, 0x9f, 0x93, 0x4d, 0xa4, 0xea, 0x5d, 0xd0, 0x46, 0x9d, 0xc5, 0x94,
+	0xde, 0x2f, 0x42, 0xd1, 0x0f, 0xdd, 0xae, 0xeb, 0x8d, 0xf7, 0x2d, 0x08, 0x78, 0x46, 0x66, 0x8e,
+	0x1d, 0xc5, 0x4c, 0x60, 0xfd, 0x03, 0x68, 0x8c, 0x38, 0x98, 0xe9, 0xfd, 0x04, 0x28, 0x55, 0xde,
+	0x23, 0xd2, 0xfd, 0x70, 0x82, 0xde, 0x80, 0x5a, 0xe6, 0x54, 0xd1, 0xff, 0x46, 0x81, 0x4a, 0xca,
+	0x21, 0x4d, 0x91, 0x7c, 0x9a, 0x90, 0x18, 0x0f, 0x6d, 0xac, 0x42, 0x58, 0x7d, 0xdf, 0xa1, 0x22,
+	0x15, 0xa8, 0x32, 0xcc, 0x8e, 0xef, 0xf0, 0xf7, 0x3c, 0xc3, 0xa2, 0x11, 0xbf, 0x5b, 0x0d, 0x6b,
+	0x42, 0x78, 0x01, 0x1f, 0x92, 0xd3, 0xa1, 0x71, 0x2d, 0xe1, 0xc1, 0xf0, 0x58, 0xff, 0x23, 0x05,
+	0xd4, 0xe4, 0xbc, 0x23, 0x4b, 0x50, 0xe8, 0x0f, 0xa2, 0x58, 0xe4, 0x18, 0xb2, 0x6a, 0x31, 0x0a,
+	0xba, 0xdf, 0xe8, 0xc8, 0x1f, 0xf4, 0x9c, 0x66, 0x6e, 0x02, 0x8f, 0xa0, 0x91, 0xcb, 0x50, 0x46,
+	0x6e, 0xcb, 0xf3, 0xe3, 0x66, 0x7e, 0x02, 0x5f, 0x09, 0xa9, 0xbb, 0x3e, 0x0b, 0xde, 0xfb, 0xae,
+	0x67, 0x09, 0x91, 0xdc, 0xdc, 0xd5, 0xbe, 0xeb, 0xed, 0x33, 0x84, 0xfe, 0x8f, 0x0a, 0x94, 0x9f,
+	0xeb, 0xd5, 0xf0, 0xd2, 0xc8, 0xd5, 0xb0, 0x68, 0xa4, 0xab, 0xe6, 0x82, 0x46, 0xbe, 0x99, 0x9c,
+	0x31, 0xf2, 0x7a, 0xcb, 0x97, 0x74, 0xe4, 0x7a, 0x2b, 0x98, 0x70, 0x4f, 0xf3, 0x17, 0x1f, 0xc3,
+	0x07, 0x1f, 0x65, 0x8e, 0x58, 0x89, 0x45, 0x34, 0xfb, 0xb7, 0x0a, 0xd4, 0xb3, 0x32, 0xc8, 0x07,
+	0xec, 0x05, 0x0e, 0xf5, 0xe2, 0x67, 0x18, 0x92, 0x90, 0x30, 0xb4, 0xb2, 0xdc, 0x88, 0xc7, 0x16,
+	0xc5, 0xae, 0x7c, 0xa6, 0xd8, 0x75, 0x69, 0x24, 0xe5, 0x35, 0x71, 0x12, 0xf4, 0x5f, 0x81, 0x59,
+	0x86, 0xc6, 0xf4, 0x3e, 0xbf, 0xf2, 0x2a, 0x63, 0x57, 0xde, 0x54, 0xac, 0xcf, 0x79, 0xb0, 0xf2,
+	0xec, 0xd0, 0xa8, 0x9d, 0x94, 0xf2, 0x18, 0xef, 0x1a, 0x8d, 0xda, 0x49, 0x4a, 0x80, 0x46, 0xed,
+	0x61, 0x29, 0x04, 0x86, 0xb2, 0x48, 0x3d, 0x59, 0xdf, 0x1a, 0x5b, 0xab, 0x45, 0x91, 0x91, 0xe5,
+	0xcf, 0x59, 0xc0, 0x60, 0x5c, 0xec, 0xfd, 0x1e, 0xc3, 0x93, 0x4d, 0x28, 0x38, 0x76, 0x6c, 0xf3,
+	0xa3, 0x6e, 0xf5, 0xed, 0xcf, 0x1f, 0x5e, 0x78, 0xe3, 0x29, 0xa6, 0x8f, 0x49, 0x33, 0x99, 0x04,
+	0xa1, 0xce, 0x0f, 0x14, 0x50, 0x13, 0x75, 0x71, 0xf2, 0xa2, 0xd8, 0x0f, 0x29, 0xd7, 0xa8, 0x6c,
+	0x0a, 0x08, 0x4b, 0x4b, 0x2c, 0x71, 0xeb, 0x3e, 0xa0, 0x8e, 0x08, 0x78, 0x87, 0x08, 0x62, 0x40,
+	0xc5, 0xf5, 0x1c, 0x7a, 0xbc, 0x17, 0xc4, 0xf2, 0x89, 0x0d, 0xbe, 0xc4, 0xd9, 0x1a, 0xe2, 0xcc,
+	0x34, 0x43, 0x26, 0xb3, 0x5e, 0x18, 0xc9, 0xac, 0xbf, 0x0c, 0x80, 0xe9, 0x35, 0x36, 0xaf, 0x91,
+	0xc8, 0xef, 0x63, 0x49, 0x84, 0x69, 0x2e, 0xaf, 0x49, 0xff, 0x94, 0x87, 0x4a, 0xaa, 0x84, 0x9e,
+	0x4e, 0x13, 0xf2, 0x00, 0x8c, 0x45, 0x32, 0x99, 0x87, 0x08, 0x8c, 0x4e, 0xde, 0xc2, 0xe7, 0x13,
+	0x51, 0xec, 0x77, 0x43, 0xbb, 0x2f, 0x56, 0xeb, 0x05, 0x63, 0x53, 0x62, 0xd2, 0x0d, 0x86, 0x7c,
+	0xe4, 0x7d, 0xa8, 0xe3, 0x85, 0xc8, 0x1a, 0xb6, 0xe4, 0x3e, 0xfd, 0xbc, 0xb1, 0x66, 0xc7, 0x74,
+	0x62, 0xeb, 0x9a, 0x93, 0xa6, 0xa0, 0x7e, 0x3c, 0x4a, 0x2e, 0x08, 0xfd, 0x58, 0x80, 0x93, 0xd1,
+	0x8f, 0xd1, 0xf1, 0xd1, 0x5e, 0x5f, 0x94, 0x35, 0x70, 0x03, 0xee, 0xb8, 0x5e, 0x9a, 0x09, 0x69,
+	0x8c, 0xc5, 0x3e, 0x16, 0xf1, 0x76, 0xc3, 0xd8, 0xb1, 0x8f, 0xb3, 0x2c, 0xf6, 0x31, 0xb2, 0xd8,
+	0xf7, 0xbb, 0x22, 0xdc, 0x6e, 0x18, 0x2b, 0xf7, 0xbb, 0x19, 0x16, 0xfb, 0x7e, 0x17, 0x59, 0xa2,
+	0x41, 0x5f, 0x44, 0xda, 0x0d, 0x63, 0x7f, 0x90, 0x51, 0x1f, 0x69, 0xa8, 0x34, 0xde, 0x4d, 0x23,
+	0x11, 0x64, 0xcf, 0x19, 0x78, 0x23, 0xcd, 0x4e, 0x2a, 0xa3, 0x93, 0xef, 0x40, 0x05, 0x03, 0x1c,
+	0xd7, 0xb3, 0x7b, 0x6e, 0x2c, 0xc3, 0xed, 0x17, 0x8d, 0x9b, 0x43, 0x5c, 0xba, 0x51, 0x9a, 0x57,
+	0x44, 0xac, 0xff, 0xab, 0x80, 0x36, 0xba, 0x62, 0xd3, 0xa3, 0x0b, 0xe6, 0xd6, 0x73, 0xa9, 0xfc,
+	0x23, 0x9e, 0x19, 0x47, 0x76, 0xe8, 0x58, 0xa9, 0xcc, 0xa4, 0xca, 0x30, 0x2c, 0x17, 0xb2, 0x33,
+	0xf1, 0xc1, 0xc7, 0x2b, 0x63, 0x36, 0x72, 0xca, 0x27, 0x1f, 0xcf, 0xf7, 0x59, 0x8c, 0xfe, 0x1f,
+	0x0a, 0x2c, 0x4c, 0x32, 0xa1, 0x29, 0xe3, 0x6f, 0x41, 0xd9, 0xf5, 0x62, 0x1a, 0xde, 0x17, 0x8f,
+	0x5f, 0x14, 0x33, 0x81, 0xc9, 0x47, 0x23, 0x03, 0xe5, 0x6e, 0xfc, 0xf2, 0x44, 0xfb, 0xfe, 0x6a,
+	0x06, 0xfb, 0x5f, 0x0a, 0x34, 0xa7, 0xed, 0x99, 0x53, 0x0e, 0x38, 0x9f, 0x1a, 0xf0, 0x9d, 0x89,
+	0x03, 0xbe, 0x36, 0x75, 0x5b, 0x7e, 0x35, 0x83, 0xfe, 0x6f, 0x05, 0xb4, 0xd1, 0xfd, 0x3e, 0x65,
+	0xb0, 0xd7, 0xa1, 0xc8, 0xfc, 0xc0, 0xf0, 0xe5, 0x7a, 0x5a, 0x26, 0x52, 0xe4, 0x71, 0xc5, 0xd9,
+	0xc8, 0xce, 0xc4, 0x19, 0x78, 0x65, 0xcc, 0xbf, 0x7c, 0x35, 0x23, 0xdf, 0x04, 0x6d, 0x54, 0xff,
+	0x09, 0xd2, 0xe4, 0xeb, 0x3f, 0x71, 0x61, 0xc0, 0x6f, 0x3c, 0x15, 0x63, 0x5f, 0x5c, 0xe7, 0x72,
+	0xb1, 0xaf, 0xbf, 0x0a, 0xf5, 0xac, 0x2f, 0x9c, 0x3c, 0x81, 0x8c, 0xcf, 0x3e, 0x3e, 0x15, 0x5f,
+	0xd6, 0x2b, 0x4e, 0xe7, 0xcb, 0xba, 0xc6, 0x29, 0x7c, 0x57, 0x40, 0x1b, 0xf5, 0x8e, 0x53, 0x38,
+	0xb7, 0xe1, 0xdc, 0x64, 0xc7, 0x38, 0xc5, 0x24, 0xbe, 0x01, 0x6a, 0x10, 0xd2, 0xb6, 0x9b, 0xbc,
+	0xca, 0xad, 0x99, 0x43, 0x84, 0xfe, 0xfb, 0x0a, 0xcc, 0x8d, 0xbd, 0x25, 0x23, 0xcb, 0x50, 0x3a,
+	0x1c, 0xb4, 0xef, 0xd2, 0xe4, 0x91, 0x50, 0xe6, 0xc1, 0xd9, 0x2a, 0x23, 0xc9, 0x98, 0x54, 0x30,
+	0xe2, 0x9a, 0x72, 0x6f, 0x2f, 0xd7, 0x94, 0x8d, 0x47, 0x3e, 0x4e, 0x63, 0x24, 0xb2, 0x94, 0x75,
+	0xf4, 0x7c, 0x79, 0xd2, 0x28, 0xfd, 0xdf, 0xb3, 0xfa, 0xf0, 0xae, 0xd2, 0x6b, 0x5e, 0xe5, 0x6b,
+	0xfe, 0xc4, 0x67, 0x0e, 0xbb, 0x13, 0x8d, 0xfa, 0xd2, 0xf8, 0x18, 0xbe, 0xc2, 0x47, 0x7a, 0xfa,
+	0x2f, 0x41, 0x25, 0x35, 0x43, 0xec, 0x81, 0x2f, 0x1b, 0x8c, 0xc2, 0x06, 0xc3, 0x01, 0xa2, 0xf1,
+	0x53, 0x56, 0x5c, 0x38, 0xf1, 0x50, 0xd5, 0xf8, 0x01, 0xcf, 0x6f, 0x67, 0xf8, 0xc9, 0x30, 0xf6,
+	0x71, 0xb3, 0x20, 0x30, 0xf6, 0xf1, 0xd5, 0xd7, 0xa1, 0xc8, 0xff, 0xb2, 0x41, 0x00, 0x8a, 0x37,
+	0xcd, 0xf5, 0x95, 0x83, 0x75, 0x6d, 0x06, 0xbf, 0xef, 0xdc, 0x5e, 0xc3, 0x6f, 0x05, 0xbf, 0xd7,
+	0xd6, 0xb7, 0xd7, 0x0f, 0xd6, 0xb5, 0xdc, 0xd5, 0x1d, 0xa8, 0xa4, 0x9e, 0x3c, 0x93, 0x0a, 0x94,
+	0x78, 0x93, 0x35, 0x6d, 0x06, 0x01, 0xde, 0x66, 0x4d, 0x53, 0x10, 0xe0, 0x8d, 0xd6, 0xb4, 0x1c,
+	0xa9, 0x81, 0xba, 0xbb, 0x77, 0x60, 0x6d, 0xec, 0xdd, 0xd9, 0x5d, 0xd3, 0xf2, 0xa4, 0x0c, 0x85,
+	0xdd, 0xbd, 0xbd, 0xdb, 0x5a, 0xe1, 0xea, 0x7d, 0x50, 0x93, 0x90, 0x93, 0xb5, 0xdf, 0xfd, 0x70,
+	0x77, 0xef, 0x93, 0x5d, 0x6d, 0x86, 0xf1, 0xdc, 0xd9, 0xde, 0xd6, 0x14, 0x52, 0x82, 0xfc, 0xd6,
+	0xee, 0x81, 0x96, 0x23, 0x2a, 0xcc, 0x6e, 0x6c, 0xef, 0xad, 0x1c, 0x68, 0x79, 0x2e, 0xfd, 0xe6,
+	0xd6, 0xce, 0xca, 0xb6, 0x56, 0x40, 0xd6, 0xd5, 0xbd, 0xbd, 0x6d, 0x6d, 0x16, 0x35, 0xdd, 0x3f,
+	0x30, 0xb7, 0x76, 0x6f, 0x69, 0x45, 0xc4, 0x1e, 0x6c, 0xed, 0xac, 0x6b, 0x25, 0x46, 0xdf, 0xde,
+	0x5b, 0xd5, 0xca, 0x28, 0xea, 0xd6, 0xfa, 0x9e, 0xa6, 0x5e, 0xed, 0x42, 0x25, 0x15, 0x2f, 0x72,
+	0x85, 0x76, 0xd7, 0x79, 0xb7, 0x6b, 0x7b, 0x37, 0xf7, 0x35, 0x05, 0x75, 0xc6, 0x2f, 0x6b, 0xc3,
+	0x5c, 0xff, 0x48, 0xcb, 0x91, 0x73, 0x40, 0x12, 0xd0, 0xba, 0xbd, 0xb7, 0xbf, 0x75, 0xb0, 0xb5,
+	0xb7, 0xab, 0xe5, 0xc9, 0xcb, 0x70, 0x7e, 0x1c, 0x6f, 0xed, 0x6d, 0x6c, 0xec, 0xaf, 0x1f, 0x68,
+	0x85, 0xe5, 0x3f, 0x98, 0x85, 0xd2, 0x4a, 0xe0, 0xde, 0x0a, 0x83, 0x36, 0xd1, 0x21, 0x7f, 0x8b,
+	0xc6, 0xa4, 0x62, 0x0c, 0xff, 0xf2, 0xd6, 0xaa, 0xa6, 0xff, 0xab, 0xa5, 0xcf, 0x90, 0xab, 0xa0,
+	0xe2, 0x9f, 0x6c, 0xd8, 0x1c, 0x93, 0xaa, 0x91, 0xfa, 0x83, 0x54, 0xab, 0x66, 0xa4, 0xff, 0xad,
+	0xa4, 0xcf, 0xe0, 0x13, 0x08, 0xfe, 0xae, 0x88, 0xd4, 0xb3, 0xaf, 0x7b, 0x5b, 0x8d, 0x91, 0xf7,
+	0xa5, 0xfa, 0x0c, 0xd9, 0x9a, 0xf0, 0x08, 0xa9, 0x69, 0x4c, 0x79, 0xa3, 0xd5, 0x3a, 0x6f, 0x4c,
+	0x7b, 0x3f, 0xa5, 0xcf, 0x10, 0x03, 0x4a, 0xe2, 0xad, 0x05, 0x69, 0x18, 0xd9, 0xb7, 0x3a, 0x2d,
+	0xcd, 0x18, 0x79, 0x0b, 0xa3, 0xcf, 0x90, 0x1b, 0x50, 0x49, 0x57, 0xd9, 0xe7, 0x8d, 0xf1, 0xa7,
+	0x18, 0xad, 0x85, 0x49, 0x4f, 0x02, 0xc4, 0x18, 0x59, 0xb9, 0x0d, 0xc7, 0x98, 0x2e, 0xf4, 0xb5,
+	0x1a, 0x46, 0xb6, 0x0e, 0xc7, 0x3b, 0x4a, 0x15, 0xe8, 0xc8, 0xbc, 0x31, 0x5e, 0x1f, 0x6c, 0x2d,
+	0x18, 0x13, 0x6a, 0x78, 0xfa, 0x0c, 0x79, 0x5b, 0xfe, 0xcb, 0x41, 0x14, 0x44, 0x48, 0xc3, 0xc8,
+	0xd6, 0xe6, 0x5a, 0x9a, 0x31, 0x52, 0x7c, 0xe2, 0xad, 0xf8, 0x3f, 0x39, 0x9e, 0xaa, 0xd5, 0xcf,
+	0x43, 0xfd, 0x16, 0x8d, 0x53, 0x85, 0x1f, 0x32, 0x6f, 0x8c, 0xd7, 0x9c, 0x5a, 0x0b, 0xc6, 0x84,
+	0xda, 0x90, 0x3e, 0x43, 0xde, 0x87, 0xb9, 0x9b, 0xac, 0x18, 0x71, 0x56, 0x09, 0xab, 0xef, 0x7e,
+	0xfa, 0x68, 0x71, 0xe6, 0xdf, 0x1e, 0x2d, 0xce, 0xfc, 0xf8, 0xd1, 0xe2, 0xcc, 0x7f, 0x3e, 0x5a,
+	0x9c, 0xf9, 0x9f, 0x47, 0x8b, 0xca, 0x6f, 0x3e, 0x5e, 0x54, 0xfe, 0xf2, 0xf1, 0xa2, 0xf2, 0x0f,
+	0x8f, 0x17, 0x67, 0x7e, 0xf8, 0x78, 0x71, 0xe6, 0xd3, 0xc7, 0x8b, 0xca, 0x67, 0x8f, 0x17, 0x95,
+	0x1f, 0x3f, 0x5e, 0x54, 0x36, 0x95, 0x5f, 0x2e, 0x04, 0x51, 0x70, 0x78, 0x58, 0x64, 0x17, 0xbc,
+	0xb7, 0x7e, 0x32, 0x00, 0xf1, 0x2d, 0x8c, 0x81, 0xcf, 0x3a, 0x00, 0x00,
 }
@@ -17,7 +17,10 @@ func CreateRaftCommand() *RaftCommand {
 
 // Close reset and put to pool
 func (c *RaftCommand) Close() error {
+	c.Type = CmdType_WRITE
 	c.WriteCommands = nil
+	c.Mapping = nil
+	c.MappingVersion = 0
 	raftCmdPool.Put(c)
 	return nil
 }
@@ -19,6 +19,8 @@ import _ "github.com/gogo/protobuf/gogoproto"
 import _ "github.com/tiglabs/baudengine/proto/metapb"
 import api "github.com/tiglabs/baudengine/proto/pspb"
 
+import bytes "bytes"
+
 import strings "strings"
 import reflect "reflect"
 
@@ -40,15 +42,19 @@ type CmdType int32
 const (
 	CmdType_WRITE CmdType = 0
 	CmdType_ADMIN CmdType = 1
+	// sets the newer version of the space mapping at the same position of the writes on all the replicas
+	CmdType_MAPPING CmdType = 2
 )
 
 var CmdType_name = map[int32]string{
 	0: "WRITE",
 	1: "ADMIN",
+	2: "MAPPING",
 }
 var CmdType_value = map[string]int32{
-	"WRITE": 0,
-	"ADMIN": 1,
+	"WRITE":   0,
+	"ADMIN":   1,
+	"MAPPING": 2,
 }
 
 func (x CmdType) String() string {
@@ -57,8 +63,10 @@ func (x CmdType) String() string {
 func (CmdType) EnumDescriptor() ([]byte, []int) { return fileDescriptorRaftcmd, []int{0} }
 
 type RaftCommand struct {
-	Type          CmdType               `protobuf:"varint,1,opt,name=type,proto3,enum=CmdType" json:"type,omitempty"`
-	WriteCommands []api.BulkItemRequest `protobuf:"bytes,2,rep,name=write_commands,json=writeCommands" json:"write_commands"`
+	Type           CmdType               `protobuf:"varint,1,opt,name=type,proto3,enum=CmdType" json:"type,omitempty"`
+	WriteCommands  []api.BulkItemRequest `protobuf:"bytes,2,rep,name=write_commands,json=writeCommands" json:"write_commands"`
+	Mapping        []byte                `protobuf:"bytes,3,opt,name=mapping,proto3" json:"mapping,omitempty"`
+	MappingVersion uint64                `protobuf:"varint,4,opt,name=mapping_version,json=mappingVersion,proto3" json:"mapping_version,omitempty"`
 }
 
 func (m *RaftCommand) Reset()                    { *m = RaftCommand{} }
@@ -99,6 +107,12 @@ func (this *RaftCommand) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if !bytes.Equal(this.Mapping, that1.Mapping) {
+		return false
+	}
+	if this.MappingVersion != that1.MappingVersion {
+		return false
+	}
 	return true
 }
 func (m *RaftCommand) Marshal() (dAtA []byte, err error) {
@@ -133,6 +147,17 @@ func (m *RaftCommand) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if len(m.Mapping) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintRaftcmd(dAtA, i, uint64(len(m.Mapping)))
+		i += copy(dAtA[i:], m.Mapping)
+	}
+	if m.MappingVersion != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintRaftcmd(dAtA, i, uint64(m.MappingVersion))
+	}
 	return i, nil
 }
 
@@ -147,7 +172,7 @@ func encodeVarintRaftcmd(dAtA []byte, offset int, v uint64) int {
 }
 func NewPopulatedRaftCommand(r randyRaftcmd, easy bool) *RaftCommand {
 	this := &RaftCommand{}
-	this.Type = CmdType([]int32{0, 1}[r.Intn(2)])
+	this.Type = CmdType([]int32{0, 1, 2}[r.Intn(3)])
 	if r.Intn(10) != 0 {
 		v1 := r.Intn(5)
 		this.WriteCommands = make([]api.BulkItemRequest, v1)
@@ -156,6 +181,12 @@ func NewPopulatedRaftCommand(r randyRaftcmd, easy bool) *RaftCommand {
 			this.WriteCommands[i] = *v2
 		}
 	}
+	v3 := r.Intn(100)
+	this.Mapping = make([]byte, v3)
+	for i := 0; i < v3; i++ {
+		this.Mapping[i] = byte(r.Intn(256))
+	}
+	this.MappingVersion = uint64(uint64(r.Uint32()))
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -180,9 +211,9 @@ func randUTF8RuneRaftcmd(r randyRaftcmd) rune {
 	return rune(ru + 61)
 }
 func randStringRaftcmd(r randyRaftcmd) string {
-	v3 := r.Intn(100)
-	tmps := make([]rune, v3)
-	for i := 0; i < v3; i++ {
+	v4 := r.Intn(100)
+	tmps := make([]rune, v4)
+	for i := 0; i < v4; i++ {
 		tmps[i] = randUTF8RuneRaftcmd(r)
 	}
 	return string(tmps)
@@ -204,11 +235,11 @@ func randFieldRaftcmd(dAtA []byte, r randyRaftcmd, fieldNumber int, wire int) []
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateRaftcmd(dAtA, uint64(key))
-		v4 := r.Int63()
+		v5 := r.Int63()
 		if r.Intn(2) == 0 {
-			v4 *= -1
+			v5 *= -1
 		}
-		dAtA = encodeVarintPopulateRaftcmd(dAtA, uint64(v4))
+		dAtA = encodeVarintPopulateRaftcmd(dAtA, uint64(v5))
 	case 1:
 		dAtA = encodeVarintPopulateRaftcmd(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -245,6 +276,13 @@ func (m *RaftCommand) Size() (n int) {
 			n += 1 + l + sovRaftcmd(uint64(l))
 		}
 	}
+	l = len(m.Mapping)
+	if l > 0 {
+		n += 1 + l + sovRaftcmd(uint64(l))
+	}
+	if m.MappingVersion != 0 {
+		n += 1 + sovRaftcmd(uint64(m.MappingVersion))
+	}
 	return n
 }
 
@@ -268,6 +306,8 @@ func (this *RaftCommand) String() string {
 	s := strings.Join([]string{`&RaftCommand{`,
 		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
 		`WriteCommands:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.WriteCommands), "BulkItemRequest", "api.BulkItemRequest", 1), `&`, ``, 1) + `,`,
+		`Mapping:` + fmt.Sprintf("%v", this.Mapping) + `,`,
+		`MappingVersion:` + fmt.Sprintf("%v", this.MappingVersion) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -359,6 +399,56 @@ func (m *RaftCommand) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mapping", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftcmd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRaftcmd
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mapping = append(m.Mapping[:0], dAtA[iNdEx:postIndex]...)
+			if m.Mapping == nil {
+				m.Mapping = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MappingVersion", wireType)
+			}
+			m.MappingVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftcmd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MappingVersion |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftcmd(dAtA[iNdEx:])
@@ -488,25 +578,28 @@ var (
 func init() { proto.RegisterFile("raftcmd.proto", fileDescriptorRaftcmd) }
 
 var fileDescriptorRaftcmd = []byte{
-	// 306 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x2d, 0x4a, 0x4c, 0x2b,
-	0x49, 0xce, 0x4d, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x97, 0xd2, 0x4d, 0xcf, 0x2c, 0xc9, 0x28,
-	0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x4f, 0xcf, 0x4f, 0xcf, 0xd7, 0x07, 0x0b, 0x27, 0x95, 0xa6,
-	0x81, 0x79, 0x60, 0x0e, 0x98, 0x05, 0x55, 0x6e, 0x8a, 0xa4, 0xbc, 0x24, 0x33, 0x3d, 0x27, 0x31,
-	0xa9, 0x58, 0x3f, 0x29, 0xb1, 0x34, 0x25, 0x35, 0x2f, 0x3d, 0x33, 0x2f, 0x15, 0xa2, 0x59, 0x3f,
-	0x37, 0xb5, 0x24, 0xb1, 0x20, 0x09, 0x4c, 0x41, 0xb5, 0x19, 0x11, 0xa3, 0xad, 0xa0, 0xb8, 0x20,
-	0x49, 0x3f, 0xb1, 0x20, 0x13, 0xa2, 0x47, 0x29, 0x8b, 0x8b, 0x3b, 0x28, 0x31, 0xad, 0xc4, 0x39,
-	0x3f, 0x37, 0x37, 0x31, 0x2f, 0x45, 0x48, 0x86, 0x8b, 0xa5, 0xa4, 0xb2, 0x20, 0x55, 0x82, 0x51,
-	0x81, 0x51, 0x83, 0xcf, 0x88, 0x43, 0xcf, 0x39, 0x37, 0x25, 0xa4, 0xb2, 0x20, 0x35, 0x08, 0x2c,
-	0x2a, 0x64, 0xcb, 0xc5, 0x57, 0x5e, 0x94, 0x59, 0x92, 0x1a, 0x9f, 0x0c, 0x51, 0x5e, 0x2c, 0xc1,
-	0xa4, 0xc0, 0xac, 0xc1, 0x6d, 0x24, 0xa0, 0xe7, 0x54, 0x9a, 0x93, 0xed, 0x59, 0x92, 0x9a, 0x1b,
-	0x94, 0x5a, 0x58, 0x9a, 0x5a, 0x5c, 0xe2, 0xc4, 0x72, 0xe2, 0x9e, 0x3c, 0x43, 0x10, 0x2f, 0x58,
-	0x35, 0xd4, 0xec, 0x62, 0x2d, 0x79, 0x2e, 0x76, 0xa8, 0x79, 0x42, 0x9c, 0x5c, 0xac, 0xe1, 0x41,
-	0x9e, 0x21, 0xae, 0x02, 0x0c, 0x20, 0xa6, 0xa3, 0x8b, 0xaf, 0xa7, 0x9f, 0x00, 0xa3, 0x93, 0xdd,
-	0x89, 0x87, 0x72, 0x0c, 0x37, 0x1e, 0xca, 0x31, 0x3c, 0x78, 0x28, 0xc7, 0xf0, 0xe1, 0xa1, 0x1c,
-	0xc3, 0x8f, 0x87, 0x72, 0x8c, 0x0d, 0x8f, 0xe4, 0x18, 0x57, 0x3c, 0x92, 0x63, 0xdc, 0xf1, 0x48,
-	0x8e, 0xe1, 0xc0, 0x23, 0x39, 0x86, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0,
-	0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x06, 0x0f, 0xc6, 0x28, 0x36, 0x50, 0x68, 0x17, 0x24, 0x25,
-	0xb1, 0x81, 0xfd, 0x64, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x23, 0x33, 0x43, 0x2e, 0x7e, 0x01,
-	0x00, 0x00,
+	// 359 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0xb1, 0x6b, 0xdb, 0x40,
+	0x18, 0xc5, 0xef, 0x6c, 0xd5, 0x6e, 0xcf, 0xb5, 0x2b, 0x34, 0x89, 0x52, 0xae, 0xa2, 0x4b, 0x45,
+	0x69, 0x25, 0x70, 0xe9, 0x52, 0xe8, 0x60, 0xbb, 0x21, 0xd1, 0x60, 0x63, 0x84, 0x49, 0x20, 0x8b,
+	0xb9, 0xb3, 0xce, 0x8a, 0x88, 0x4f, 0xba, 0x48, 0xa7, 0x04, 0x6f, 0xf9, 0x73, 0xb2, 0x64, 0xcf,
+	0x98, 0xd1, 0x63, 0xc6, 0x4c, 0xc1, 0xd2, 0x5f, 0x90, 0x31, 0x63, 0xf0, 0x49, 0x81, 0x8c, 0x99,
+	0xee, 0xbd, 0xc7, 0xfb, 0x1d, 0x1f, 0xdf, 0x87, 0xba, 0x29, 0x59, 0xca, 0x05, 0x0f, 0x1c, 0x91,
+	0x26, 0x32, 0xf9, 0xfc, 0x2b, 0x8c, 0xe4, 0x49, 0x4e, 0x9d, 0x45, 0xc2, 0xdd, 0x30, 0x09, 0x13,
+	0x57, 0xc5, 0x34, 0x5f, 0x2a, 0xa7, 0x8c, 0x52, 0x75, 0xfd, 0xcf, 0xab, 0xba, 0x8c, 0xc2, 0x15,
+	0xa1, 0x99, 0x4b, 0x49, 0x1e, 0xb0, 0x38, 0x8c, 0x62, 0x56, 0xc1, 0x2e, 0x67, 0x92, 0x08, 0xaa,
+	0x9e, 0x1a, 0xeb, 0xbf, 0x05, 0x13, 0x99, 0xa0, 0x2e, 0x11, 0x51, 0xc5, 0x7c, 0xbb, 0x86, 0xa8,
+	0xe3, 0x93, 0xa5, 0x1c, 0x25, 0x9c, 0x93, 0x38, 0x30, 0xbe, 0x20, 0x4d, 0xae, 0x05, 0x33, 0xa1,
+	0x05, 0xed, 0x5e, 0xff, 0xbd, 0x33, 0xe2, 0xc1, 0x6c, 0x2d, 0x98, 0xaf, 0x52, 0xe3, 0x1f, 0xea,
+	0x5d, 0xa4, 0x91, 0x64, 0xf3, 0x45, 0x55, 0xcf, 0xcc, 0x86, 0xd5, 0xb4, 0x3b, 0x7d, 0xdd, 0x19,
+	0xe6, 0xab, 0x53, 0x4f, 0x32, 0xee, 0xb3, 0xb3, 0x9c, 0x65, 0x72, 0xa8, 0x6d, 0x1e, 0xbe, 0x02,
+	0xbf, 0xab, 0xda, 0xf5, 0xdf, 0x99, 0x61, 0xa2, 0x36, 0x27, 0x42, 0x44, 0x71, 0x68, 0x36, 0x2d,
+	0x68, 0x7f, 0xf4, 0x5f, 0xac, 0xf1, 0x1d, 0x7d, 0xaa, 0xe5, 0xfc, 0x9c, 0xa5, 0x59, 0x94, 0xc4,
+	0xa6, 0x66, 0x41, 0x5b, 0xf3, 0x7b, 0x75, 0x7c, 0x58, 0xa5, 0x3f, 0x7e, 0xa2, 0x76, 0x3d, 0x92,
+	0xf1, 0x01, 0xbd, 0x3b, 0xf2, 0xbd, 0xd9, 0x9e, 0x0e, 0x76, 0x72, 0xf0, 0x7f, 0xec, 0x4d, 0x74,
+	0x68, 0x74, 0x50, 0x7b, 0x3c, 0x98, 0x4e, 0xbd, 0xc9, 0xbe, 0xde, 0x18, 0xfe, 0xdd, 0x14, 0x18,
+	0xdc, 0x17, 0x18, 0x6c, 0x0b, 0x0c, 0x1e, 0x0b, 0x0c, 0x9e, 0x0a, 0x0c, 0x2f, 0x4b, 0x0c, 0xaf,
+	0x4a, 0x0c, 0x6f, 0x4a, 0x0c, 0x6e, 0x4b, 0x0c, 0x36, 0x25, 0x86, 0x77, 0x25, 0x86, 0xdb, 0x12,
+	0xc3, 0x03, 0x78, 0xdc, 0xda, 0x9d, 0x4e, 0x50, 0xda, 0x52, 0x0b, 0xfa, 0xfd, 0x3c, 0x00, 0xd6,
+	0x8c, 0x16, 0xeb, 0xcb, 0x01, 0x00, 0x00,
 }
@@ -21,6 +21,10 @@ type Config struct {
 	RPCPort           int           `json:"rpc-port,omitempty"`
 	AdminPort         int           `json:"admin-port,omitempty"`
 	HeartbeatInterval int           `json:"heartbeat-interval,omitempty"`
+	// the dir of the word dicts of the analysis components, the dicts are reloaded from it
+	DictPath string `json:"dict-path,omitempty"`
+	// the interval of the sweeper deleting the expired documents in milliseconds
+	ExpireSweepInterval int `json:"expire-sweep-interval,omitempty"`
 
 	RaftHeartbeatPort      int    `json:"raft-heartbeat-port,omitempty"`
 	RaftReplicatePort      int    `json:"raft-replicate-port,omitempty"`
@@ -44,6 +48,7 @@ func LoadConfig(conf *config.Config) *Config {
 	c.ClusterID = conf.GetString("cluster.id")
 	c.MasterServer = conf.GetString("master.server")
 	c.DataPath = conf.GetString("data.path")
+	c.DictPath = conf.GetString("dict.path")
 	c.LogDir = conf.GetString("log.dir")
 	c.LogModule = conf.GetString("log.module")
 	c.LogLevel = conf.GetString("log.level")
@@ -64,6 +69,9 @@ func LoadConfig(conf *config.Config) *Config {
 	if heartbeat := conf.GetString("heartbeat.interval"); heartbeat != "" {
 		c.HeartbeatInterval, _ = strconv.Atoi(heartbeat)
 	}
+	if expireSweep := conf.GetString("expire.sweep.interval"); expireSweep != "" {
+		c.ExpireSweepInterval, _ = strconv.Atoi(expireSweep)
+	}
 
 	if raftHbPort := conf.GetString("raft.heartbeat.port"); raftHbPort != "" {
 		c.RaftHeartbeatPort, _ = strconv.Atoi(raftHbPort)
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/tiglabs/baudengine/kernel/registry"
 	"github.com/tiglabs/baudengine/proto/masterpb"
 	"github.com/tiglabs/baudengine/proto/metapb"
 	"github.com/tiglabs/baudengine/util"
@@ -105,10 +106,7 @@ func (h *heartbeatWork) doHeartbeat() {
 	retryOpt.Context = h.server.ctx
 
 	err := util.RetryMaxAttempt(&retryOpt, func() error {
-		masterAddr := h.server.MasterServer
-		if h.server.masterLeader != "" {
-			masterAddr = h.server.masterLeader
-		}
+		masterAddr := h.server.getMasterAddr()
 		masterClient, err := h.server.masterClient.GetGrpcClient(masterAddr)
 		if err != nil {
 			return fmt.Errorf("get master heartbeat rpc client[%s] error: %s", masterAddr, err)
@@ -127,6 +125,8 @@ func (h *heartbeatWork) doHeartbeat() {
 			return true
 		})
 		req.SysStats = *stats
+		req.DictVersions = registry.Versions()
+		req.DictErrors = registry.LoadErrors()
 
 		log.Debug("heartbeat to master request is: %s", req)
 		goCtx, cancel := context.WithTimeout(h.server.ctx, heartbeatTimeout)
@@ -138,6 +138,11 @@ func (h *heartbeatWork) doHeartbeat() {
 		}
 
 		if resp.Code == metapb.RESP_CODE_OK {
+			for _, m := range resp.Mappings {
+				if p, ok := h.server.partitions.Load(m.ID); ok {
+					p.(*partition).updateMapping(m.Mapping, m.MappingVersion)
+				}
+			}
 			return nil
 		}
 
@@ -149,11 +154,7 @@ func (h *heartbeatWork) doHeartbeat() {
 			return nil
 		}
 
-		if resp.Error.NoLeader != nil {
-			h.server.masterLeader = ""
-		} else if resp.Error.NotLeader != nil {
-			h.server.masterLeader = resp.Error.NotLeader.LeaderAddr
-		}
+		h.server.updateMasterLeader(&resp.Error)
 		return fmt.Errorf("master heartbeat requeset[%s] ack code not ok, response is: %s", req.ReqId, resp.String())
 	})
 
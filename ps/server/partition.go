@@ -3,20 +3,32 @@ package server
 import (
 	"context"
 	"errors"
+	"fmt"
 	"sync"
 	"time"
 
 	"github.com/tiglabs/baudengine/kernel"
+	// the analyzers of the mappings
+	_ "github.com/tiglabs/baudengine/kernel/analysis/analyzer/fast"
+	_ "github.com/tiglabs/baudengine/kernel/analysis/analyzer/keyword"
+	_ "github.com/tiglabs/baudengine/kernel/analysis/analyzer/multilingual"
+	_ "github.com/tiglabs/baudengine/kernel/analysis/analyzer/simple"
+	_ "github.com/tiglabs/baudengine/kernel/analysis/analyzer/standard"
+	_ "github.com/tiglabs/baudengine/kernel/analysis/analyzer/whitspace"
 	"github.com/tiglabs/baudengine/kernel/index"
 	"github.com/tiglabs/baudengine/kernel/store/kvstore/badgerdb"
 	"github.com/tiglabs/baudengine/proto/masterpb"
 	"github.com/tiglabs/baudengine/proto/metapb"
+	"github.com/tiglabs/baudengine/proto/pspb/raftpb"
 	"github.com/tiglabs/baudengine/util/log"
+	"github.com/tiglabs/baudengine/util/routine"
 	"github.com/tiglabs/raft"
 	"github.com/tiglabs/raft/proto"
 	"github.com/tiglabs/raft/storage/wal"
 )
 
+const proposeMappingTimeout = 10 * time.Second
+
 var (
 	errorPartitonCommand = errors.New("unsupported command")
 )
@@ -34,12 +46,23 @@ type partition struct {
 	leaderAddr string
 	meta       metapb.Partition
 	statistics masterpb.PartitionStats
+
+	// the open scrolls by the scroll IDs
+	scrollMutex sync.Mutex
+	scrolls     map[string]*scrollContext
+
+	// the delete and update by query tasks by the task IDs, the store is closed after the running tasks
+	taskMutex sync.Mutex
+	tasks     map[string]*byQueryTask
+	taskGroup sync.WaitGroup
 }
 
 func newPartition(server *Server, meta metapb.Partition) *partition {
 	p := &partition{
-		meta:   meta,
-		server: server,
+		meta:    meta,
+		server:  server,
+		scrolls: make(map[string]*scrollContext),
+		tasks:   make(map[string]*byQueryTask),
 	}
 	p.meta.Status = metapb.PA_NOTREAD
 	p.ctx, p.ctxCancel = context.WithCancel(server.ctx)
@@ -72,6 +95,16 @@ func (p *partition) start() {
 		return
 	}
 	p.store = index.NewIndexDriver(kvStore)
+	if len(p.meta.Mapping) > 0 {
+		if err := p.store.SetMapping(p.meta.Mapping); err != nil {
+			p.rwMutex.Lock()
+			p.meta.Status = metapb.PA_INVALID
+			p.rwMutex.Unlock()
+			p.store.Close()
+			log.Error("start partition[%d] set mapping error: %s", p.meta.ID, err)
+			return
+		}
+	}
 	apply, err := p.store.GetApplyID()
 	if err != nil {
 		p.rwMutex.Lock()
@@ -116,6 +149,8 @@ func (p *partition) start() {
 	p.rwMutex.Lock()
 	p.meta.Status = metapb.PA_READONLY
 	p.rwMutex.Unlock()
+	p.startExpireSweeper()
+	p.startScrollReaper()
 	log.Info("start partition[%d] success", p.meta.ID)
 	return
 }
@@ -128,6 +163,8 @@ func (p *partition) Close() error {
 
 		p.ctxCancel()
 		p.server.raftServer.RemoveRaft(p.meta.ID)
+		p.closeScrolls()
+		p.taskGroup.Wait()
 		if p.store != nil {
 			p.store.Close()
 		}
@@ -136,6 +173,74 @@ func (p *partition) Close() error {
 	return nil
 }
 
+// updateMapping proposes the newer version of the space mapping learned from master, only the leader proposes it
+// and all the replicas set it in the apply, so the writes before and after it are mapped by the same version
+func (p *partition) updateMapping(mapping []byte, version uint64) {
+	p.rwMutex.RLock()
+	leader := p.meta.Status == metapb.PA_READWRITE
+	curVersion := p.meta.MappingVersion
+	p.rwMutex.RUnlock()
+	if !leader || version <= curVersion {
+		return
+	}
+
+	routine.RunWorkAsync(fmt.Sprintf("PARTITION-MAPPING-%d", p.meta.ID), func() {
+		ctx, cancel := context.WithTimeout(p.ctx, proposeMappingTimeout)
+		defer cancel()
+		if err := p.proposeMapping(ctx, mapping, version); err != nil {
+			log.Error("partition[%d] propose mapping version[%d] error: %s", p.meta.ID, version, err)
+		}
+	}, routine.LogPanic(false))
+}
+
+// proposeMapping proposes the version of the space mapping by raft and waits for the apply
+func (p *partition) proposeMapping(ctx context.Context, mapping []byte, version uint64) error {
+	raftCmd := raftpb.CreateRaftCommand()
+	raftCmd.Type = raftpb.CmdType_MAPPING
+	raftCmd.Mapping = mapping
+	raftCmd.MappingVersion = version
+	data, err := raftCmd.Marshal()
+	raftCmd.Close()
+	if err != nil {
+		return err
+	}
+	future := p.server.raftServer.Submit(p.meta.ID, data)
+	respCh, errCh := future.AsyncResponse()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case err = <-errCh:
+		return err
+	case <-respCh:
+		return nil
+	}
+}
+
+// applyMapping sets the version of the space mapping proposed by the leader, the older versions are skipped
+func (p *partition) applyMapping(index uint64, mapping []byte, version uint64) error {
+	if version > p.mappingVersion() {
+		if err := p.store.SetMapping(mapping); err != nil {
+			p.store.SetApplyID(index)
+			log.Error("partition[%d] set mapping version[%d] error: %s", p.meta.ID, version, err)
+			return err
+		}
+		p.rwMutex.Lock()
+		p.meta.Mapping = mapping
+		p.meta.MappingVersion = version
+		p.rwMutex.Unlock()
+		log.Info("partition[%d] update mapping to version[%d]", p.meta.ID, version)
+	}
+	return p.store.SetApplyID(index)
+}
+
+// mappingVersion returns the version of the space mapping of the partition
+func (p *partition) mappingVersion() uint64 {
+	p.rwMutex.RLock()
+	defer p.rwMutex.RUnlock()
+	return p.meta.MappingVersion
+}
+
 func (p *partition) getPartitionInfo() *masterpb.PartitionInfo {
 	p.rwMutex.RLock()
 	info := new(masterpb.PartitionInfo)
@@ -144,6 +249,7 @@ func (p *partition) getPartitionInfo() *masterpb.PartitionInfo {
 	info.Status = p.meta.Status
 	info.Epoch = p.meta.Epoch
 	info.Statistics = p.statistics
+	info.MappingVersion = p.meta.MappingVersion
 	replicas := p.meta.Replicas
 	p.rwMutex.RUnlock()
 
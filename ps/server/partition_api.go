@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/tiglabs/baudengine/kernel"
+	"github.com/tiglabs/baudengine/kernel/mapping"
 	"github.com/tiglabs/baudengine/proto/metapb"
 	"github.com/tiglabs/baudengine/proto/pspb"
 	"github.com/tiglabs/baudengine/proto/pspb/raftpb"
@@ -44,6 +45,11 @@ func (p *partition) getInternal(request *pspb.GetRequest, response *pspb.GetResp
 		}
 	}
 	fields, response.Found = p.store.GetDocument(timeCtx, request.Id, request.Fields)
+	if response.Found {
+		if version, found, err := p.store.DocVersion(request.Id); err == nil && found {
+			response.Version, response.SeqNo = version.Version, version.SeqNo
+		}
+	}
 	select {
 	case <-timeCtx.Done():
 		err = timeCtx.Err()
@@ -68,6 +74,252 @@ func (p *partition) getInternal(request *pspb.GetRequest, response *pspb.GetResp
 	return
 }
 
+func (p *partition) searchInternal(request *pspb.SearchRequest, response *pspb.SearchResponse) {
+	if err := p.checkReadable(true); err != nil {
+		response.Error = *err
+		if err.NotLeader != nil {
+			response.Code = metapb.PS_RESP_CODE_NOT_LEADER
+			response.Message = fmt.Sprintf("node[%d] of partition[%d] is not leader", p.server.NodeID, request.Partition)
+		} else if err.NoLeader != nil {
+			response.Code = metapb.PS_RESP_CODE_NO_LEADER
+			response.Message = fmt.Sprintf("node[%d] of partition[%d] has no leader", p.server.NodeID, request.Partition)
+		} else if err.PartitionNotFound != nil {
+			response.Code = metapb.PS_RESP_CODE_NO_PARTITION
+			response.Message = fmt.Sprintf("node[%d] of partition[%d] has closed", p.server.NodeID, request.Partition)
+		}
+
+		log.Error("search document error:[%s],\n search request is:[%s]", response.Message, request)
+		return
+	}
+
+	query, err := toKernelQuery(&request.Query)
+	if err != nil {
+		response.Code = metapb.RESP_CODE_SERVER_ERROR
+		response.Message = err.Error()
+		log.Error("search document error:[%s],\n search request is:[%s]", err, request)
+		return
+	}
+	aggs, err := kernel.AggregationsFromPB(request.Aggregations)
+	if err != nil {
+		response.Code = metapb.RESP_CODE_SERVER_ERROR
+		response.Message = err.Error()
+		log.Error("search document error:[%s],\n search request is:[%s]", err, request)
+		return
+	}
+
+	var (
+		result  *kernel.Result
+		cancel  context.CancelFunc
+		timeCtx = p.ctx
+	)
+	if request.Timeout != "" {
+		if timeout, err := time.ParseDuration(request.Timeout); err == nil {
+			timeCtx, cancel = context.WithTimeout(timeCtx, timeout)
+		}
+	}
+	searchReq := &kernel.Request{
+		Query:        query,
+		From:         int(request.From),
+		Size:         int(request.Size_),
+		Fields:       request.Fields,
+		Similarities: request.Similarities,
+		Statistics:   toKernelStatistics(request.Statistics),
+		Aggregations: aggs,
+		Highlight:    toKernelHighlight(request.Highlight),
+	}
+	for _, sf := range request.Sort {
+		sortField := kernel.SortField{FieldId: sf.Field, Reverse: sf.Reverse}
+		if sf.GeoDistance != nil {
+			origin := toKernelGeoPoint(sf.GeoDistance)
+			sortField.GeoDistance = &origin
+		}
+		searchReq.Sort = append(searchReq.Sort, sortField)
+	}
+	result, err = p.store.Search(timeCtx, searchReq)
+	select {
+	case <-timeCtx.Done():
+		err = timeCtx.Err()
+	default:
+	}
+	if cancel != nil {
+		cancel()
+	}
+
+	if err != nil {
+		if err == context.DeadlineExceeded {
+			response.Code = metapb.RESP_CODE_TIMEOUT
+			response.Message = "request timeout"
+		} else if err == context.Canceled {
+			response.Code = metapb.RESP_CODE_SERVER_STOP
+			response.Message = "during request processing, the server is shut down"
+		} else {
+			response.Code = metapb.RESP_CODE_SERVER_ERROR
+			response.Message = err.Error()
+		}
+		log.Error("search document error:[%s],\n search request is:[%s]", err, request)
+		return
+	}
+
+	response.Total = uint32(result.Total)
+	response.Aggregations = kernel.AggregationResultsToPB(result.Aggregations)
+	response.Hits = make([]pspb.SearchHit, 0, len(result.Hits))
+	for _, hit := range result.Hits {
+		response.Hits = append(response.Hits, pspb.SearchHit{Id: hit.DocID, Score: hit.Score, Fields: hit.Fields,
+			Highlights: fromKernelHighlights(hit.Highlights), InnerHits: fromKernelInnerHits(hit.InnerHits)})
+	}
+	return
+}
+
+func (p *partition) searchStatisticsInternal(request *pspb.SearchStatisticsRequest, response *pspb.SearchStatisticsResponse) {
+	if err := p.checkReadable(true); err != nil {
+		response.Error = *err
+		if err.NotLeader != nil {
+			response.Code = metapb.PS_RESP_CODE_NOT_LEADER
+			response.Message = fmt.Sprintf("node[%d] of partition[%d] is not leader", p.server.NodeID, request.Partition)
+		} else if err.NoLeader != nil {
+			response.Code = metapb.PS_RESP_CODE_NO_LEADER
+			response.Message = fmt.Sprintf("node[%d] of partition[%d] has no leader", p.server.NodeID, request.Partition)
+		} else if err.PartitionNotFound != nil {
+			response.Code = metapb.PS_RESP_CODE_NO_PARTITION
+			response.Message = fmt.Sprintf("node[%d] of partition[%d] has closed", p.server.NodeID, request.Partition)
+		}
+
+		log.Error("search statistics error:[%s],\n search statistics request is:[%s]", response.Message, request)
+		return
+	}
+
+	query, err := toKernelQuery(&request.Query)
+	if err != nil {
+		response.Code = metapb.RESP_CODE_SERVER_ERROR
+		response.Message = err.Error()
+		log.Error("search statistics error:[%s],\n search statistics request is:[%s]", err, request)
+		return
+	}
+
+	var (
+		stats   *kernel.Statistics
+		cancel  context.CancelFunc
+		timeCtx = p.ctx
+	)
+	if request.Timeout != "" {
+		if timeout, err := time.ParseDuration(request.Timeout); err == nil {
+			timeCtx, cancel = context.WithTimeout(timeCtx, timeout)
+		}
+	}
+	stats, err = p.store.Statistics(timeCtx, query)
+	select {
+	case <-timeCtx.Done():
+		err = timeCtx.Err()
+	default:
+	}
+	if cancel != nil {
+		cancel()
+	}
+
+	if err != nil {
+		if err == context.DeadlineExceeded {
+			response.Code = metapb.RESP_CODE_TIMEOUT
+			response.Message = "request timeout"
+		} else if err == context.Canceled {
+			response.Code = metapb.RESP_CODE_SERVER_STOP
+			response.Message = "during request processing, the server is shut down"
+		} else {
+			response.Code = metapb.RESP_CODE_SERVER_ERROR
+			response.Message = err.Error()
+		}
+		log.Error("search statistics error:[%s],\n search statistics request is:[%s]", err, request)
+		return
+	}
+	response.Statistics = fromKernelStatistics(stats)
+}
+
+func (p *partition) analyzeInternal(request *pspb.AnalyzeRequest, response *pspb.AnalyzeResponse) {
+	// the analysis reads the mapping only, so the followers serve it too
+	if err := p.checkReadable(false); err != nil {
+		response.Error = *err
+		if err.NoLeader != nil {
+			response.Code = metapb.PS_RESP_CODE_NO_LEADER
+			response.Message = fmt.Sprintf("node[%d] of partition[%d] has no leader", p.server.NodeID, request.Partition)
+		} else if err.PartitionNotFound != nil {
+			response.Code = metapb.PS_RESP_CODE_NO_PARTITION
+			response.Message = fmt.Sprintf("node[%d] of partition[%d] has closed", p.server.NodeID, request.Partition)
+		}
+
+		log.Error("analyze error:[%s],\n analyze request is:[%s]", response.Message, request)
+		return
+	}
+
+	result, err := p.store.Analyze(&kernel.AnalyzeRequest{
+		Analyzer: request.Analyzer,
+		Field:    request.Field,
+		Text:     []byte(request.Text),
+		Explain:  request.Explain,
+	})
+	if err != nil {
+		response.Code = metapb.RESP_CODE_SERVER_ERROR
+		response.Message = err.Error()
+		log.Error("analyze error:[%s],\n analyze request is:[%s]", err, request)
+		return
+	}
+	response.Analyzer = result.Analyzer
+	response.Tokens = fromAnalysisTokens(result.Tokens)
+	response.Stages = fromAnalysisStages(result.Stages)
+}
+
+func (p *partition) termVectorsInternal(request *pspb.TermVectorsRequest, response *pspb.TermVectorsResponse) {
+	if err := p.checkReadable(true); err != nil {
+		response.Error = *err
+		if err.NotLeader != nil {
+			response.Code = metapb.PS_RESP_CODE_NOT_LEADER
+			response.Message = fmt.Sprintf("node[%d] of partition[%d] is not leader", p.server.NodeID, request.Partition)
+		} else if err.NoLeader != nil {
+			response.Code = metapb.PS_RESP_CODE_NO_LEADER
+			response.Message = fmt.Sprintf("node[%d] of partition[%d] has no leader", p.server.NodeID, request.Partition)
+		} else if err.PartitionNotFound != nil {
+			response.Code = metapb.PS_RESP_CODE_NO_PARTITION
+			response.Message = fmt.Sprintf("node[%d] of partition[%d] has closed", p.server.NodeID, request.Partition)
+		}
+
+		log.Error("term vectors error:[%s],\n term vectors request is:[%s]", response.Message, request)
+		return
+	}
+
+	var (
+		cancel  context.CancelFunc
+		timeCtx = p.ctx
+	)
+	if request.Timeout != "" {
+		if timeout, err := time.ParseDuration(request.Timeout); err == nil {
+			timeCtx, cancel = context.WithTimeout(timeCtx, timeout)
+		}
+	}
+	result, err := p.store.TermVectors(timeCtx, &kernel.TermVectorsRequest{
+		DocID:          request.Id,
+		Fields:         request.Fields,
+		TermStatistics: request.TermStatistics,
+	})
+	if cancel != nil {
+		cancel()
+	}
+
+	if err != nil {
+		if err == context.DeadlineExceeded {
+			response.Code = metapb.RESP_CODE_TIMEOUT
+			response.Message = "request timeout"
+		} else if err == context.Canceled {
+			response.Code = metapb.RESP_CODE_SERVER_STOP
+			response.Message = "during request processing, the server is shut down"
+		} else {
+			response.Code = metapb.RESP_CODE_SERVER_ERROR
+			response.Message = err.Error()
+		}
+		log.Error("term vectors error:[%s],\n term vectors request is:[%s]", err, request)
+		return
+	}
+	response.Found = result.Found
+	response.Fields = fromKernelTermVectors(result.Fields)
+}
+
 func (p *partition) bulkInternal(request *pspb.BulkRequest, response *pspb.BulkResponse) {
 	p.rwMutex.RLock()
 	pstatus := p.meta.Status
@@ -82,10 +334,6 @@ func (p *partition) bulkInternal(request *pspb.BulkRequest, response *pspb.BulkR
 	var (
 		timeCtx = p.ctx
 		cancel  context.CancelFunc
-
-		err    error
-		done   bool
-		result interface{}
 	)
 	if request.Timeout != "" {
 		if timeout, e := time.ParseDuration(request.Timeout); e == nil {
@@ -93,32 +341,142 @@ func (p *partition) bulkInternal(request *pspb.BulkRequest, response *pspb.BulkR
 		}
 	}
 
+	result, err, done := p.submitMapped(timeCtx, request.Requests)
+
+	if cancel != nil {
+		cancel()
+	}
+	p.fillBulkResponse(request, response, result, err, done)
+}
+
+// submitWrite proposes the write commands by raft and waits for the responses of the apply,
+// done is true if the context is done before the apply
+func (p *partition) submitWrite(ctx context.Context, requests []pspb.BulkItemRequest) (result interface{}, err error, done bool) {
 	raftCmd := raftpb.CreateRaftCommand()
 	raftCmd.Type = raftpb.CmdType_WRITE
-	raftCmd.WriteCommands = request.Requests
-	if data, e := raftCmd.Marshal(); e != nil {
-		err = e
-		log.Error("marshal raftCommand error:[%s],\n request is:[%s]", err, request)
-	} else {
-		future := p.server.raftServer.Submit(request.Partition, data)
-		respCh, errCh := future.AsyncResponse()
-		raftCmd.Close()
-
-		select {
-		case <-timeCtx.Done():
-			err = timeCtx.Err()
-			done = true
-
-		case err = <-errCh:
-
-		case result = <-respCh:
+	raftCmd.WriteCommands = requests
+	data, err := raftCmd.Marshal()
+	if err != nil {
+		return nil, err, false
+	}
+	future := p.server.raftServer.Submit(p.meta.ID, data)
+	respCh, errCh := future.AsyncResponse()
+	raftCmd.Close()
+
+	select {
+	case <-ctx.Done():
+		err = ctx.Err()
+		done = true
+
+	case err = <-errCh:
+
+	case result = <-respCh:
+	}
+	return
+}
+
+// submitMapped maps the source documents of the requests on the leader and proposes the mapped requests,
+// the requests failed to map are not proposed and have their failures in the responses.
+// A follower rejects the requests before the mapping, or the new dynamic fields are sent to master for a write failing later.
+func (p *partition) submitMapped(ctx context.Context, requests []pspb.BulkItemRequest) (result interface{}, err error, done bool) {
+	p.rwMutex.RLock()
+	leader := p.meta.Status == metapb.PA_READWRITE
+	p.rwMutex.RUnlock()
+	if !leader {
+		return nil, raft.ErrNotLeader, false
+	}
+
+	failures := p.mapRequests(requests)
+	proposed := make([]pspb.BulkItemRequest, 0, len(requests))
+	for i := range requests {
+		if failures[i] == nil {
+			proposed = append(proposed, requests[i])
 		}
 	}
+	var responses []pspb.BulkItemResponse
+	if len(proposed) > 0 {
+		p.fillExpireAt(proposed)
+		if result, err, done = p.submitWrite(ctx, proposed); err != nil {
+			return
+		}
+		responses = result.([]pspb.BulkItemResponse)
+	}
 
-	if cancel != nil {
-		cancel()
+	resp := make([]pspb.BulkItemResponse, len(requests))
+	for i := range requests {
+		if failures[i] != nil {
+			resp[i] = pspb.BulkItemResponse{OpType: requests[i].OpType, Failure: failures[i]}
+		} else {
+			resp[i], responses = responses[0], responses[1:]
+		}
+	}
+	return resp, nil, false
+}
+
+// mapRequests maps the source documents of the create and the update requests into their documents by the space
+// mapping and resolves the new fields of the merges, it runs on the leader before the proposal, so all the replicas
+// apply the same fields
+func (p *partition) mapRequests(requests []pspb.BulkItemRequest) []*pspb.Failure {
+	failures := make([]*pspb.Failure, len(requests))
+	for i := range requests {
+		var doc *pspb.Document
+		var err error
+		switch {
+		case requests[i].OpType == pspb.OpType_CREATE && len(requests[i].Create.Source) > 0:
+			doc, err = p.mapDocument(requests[i].Create.Doc.Id, requests[i].Create.Source)
+			if err == nil {
+				doc.ExpireAt = requests[i].Create.Doc.ExpireAt
+				requests[i].Create.Doc, requests[i].Create.Source = *doc, nil
+			}
+		case requests[i].OpType == pspb.OpType_UPDATE && len(requests[i].Update.Source) > 0:
+			doc, err = p.mapDocument(requests[i].Update.Doc.Id, requests[i].Update.Source)
+			if err == nil {
+				doc.ExpireAt = requests[i].Update.Doc.ExpireAt
+				requests[i].Update.Doc, requests[i].Update.Source = *doc, nil
+			}
+		case requests[i].OpType == pspb.OpType_UPDATE && (len(requests[i].Update.Partial) > 0 || requests[i].Update.Script != nil):
+			err = p.resolveMerge(requests[i].Update)
+		}
+		if err != nil {
+			log.Error("map document error:[%s],\n write request is:[%s]", err, &requests[i])
+			failures[i] = writeFailure(requestDocID(&requests[i]), err)
+		}
+	}
+	return failures
+}
+
+func requestDocID(request *pspb.BulkItemRequest) metapb.Key {
+	switch request.OpType {
+	case pspb.OpType_CREATE:
+		return request.Create.Doc.Id
+	case pspb.OpType_UPDATE:
+		return request.Update.Doc.Id
+	case pspb.OpType_DELETE:
+		return request.Delete.Id
+	}
+	return nil
+}
+
+// fillExpireAt sets the expiration time of the written documents by the default ttl of the space,
+// it is set by the leader before the proposal, so all the replicas have the same expiration time
+func (p *partition) fillExpireAt(requests []pspb.BulkItemRequest) {
+	ttl := p.store.DefaultTTL()
+	if ttl <= 0 {
+		return
+	}
+	expireAt := time.Now().Add(ttl).UnixNano() / int64(time.Millisecond)
+	for i := range requests {
+		switch requests[i].OpType {
+		case pspb.OpType_CREATE:
+			if requests[i].Create.Doc.ExpireAt == 0 {
+				requests[i].Create.Doc.ExpireAt = expireAt
+			}
+		case pspb.OpType_UPDATE:
+			if requests[i].Update.Doc.ExpireAt == 0 {
+				requests[i].Update.Doc.ExpireAt = expireAt
+			}
+		}
 	}
-	p.fillBulkResponse(request, response, result, err, done)
 }
 
 func (p *partition) fillBulkResponse(request *pspb.BulkRequest, response *pspb.BulkResponse, result interface{}, err error, done bool) {
@@ -174,6 +532,8 @@ func (p *partition) fillBulkResponse(request *pspb.BulkRequest, response *pspb.B
 
 func (p *partition) execWriteCommand(index uint64, cmds []pspb.BulkItemRequest) ([]pspb.BulkItemResponse, error) {
 	batch := p.store.NewWriteBatch()
+	// the versions of the documents written by the batch are derived from the raft index
+	batch.SetApplyID(index)
 	resp := make([]pspb.BulkItemResponse, len(cmds))
 
 	for i, cmd := range cmds {
@@ -185,7 +545,7 @@ func (p *partition) execWriteCommand(index uint64, cmds []pspb.BulkItemRequest)
 				resp[i].Create = createResp
 			} else {
 				log.Error("create document error:[%s],\n create request is:[%s]", err, cmd.Create)
-				resp[i].Failure = &pspb.Failure{Id: cmd.Create.Doc.Id, Cause: err.Error()}
+				resp[i].Failure = writeFailure(cmd.Create.Doc.Id, err)
 			}
 
 		case pspb.OpType_UPDATE:
@@ -193,7 +553,7 @@ func (p *partition) execWriteCommand(index uint64, cmds []pspb.BulkItemRequest)
 				resp[i].Update = updateResp
 			} else {
 				log.Error("update document error:[%s],\n update request is:[%s]", err, cmd.Update)
-				resp[i].Failure = &pspb.Failure{Id: cmd.Update.Doc.Id, Cause: err.Error()}
+				resp[i].Failure = writeFailure(cmd.Update.Doc.Id, err)
 			}
 
 		case pspb.OpType_DELETE:
@@ -201,7 +561,7 @@ func (p *partition) execWriteCommand(index uint64, cmds []pspb.BulkItemRequest)
 				resp[i].Delete = delResp
 			} else {
 				log.Error("delete document error:[%s],\n delete request is:[%s]", err, cmd.Delete)
-				resp[i].Failure = &pspb.Failure{Id: cmd.Delete.Id, Cause: err.Error()}
+				resp[i].Failure = writeFailure(cmd.Delete.Id, err)
 			}
 
 		default:
@@ -210,7 +570,6 @@ func (p *partition) execWriteCommand(index uint64, cmds []pspb.BulkItemRequest)
 		}
 	}
 
-	batch.SetApplyID(index)
 	if err := batch.Commit(); err != nil {
 		p.store.SetApplyID(index)
 		log.Error("could not commit batch,error is:[%s]", err)
@@ -220,15 +579,82 @@ func (p *partition) execWriteCommand(index uint64, cmds []pspb.BulkItemRequest)
 	return resp, nil
 }
 
+// errUnmappedSource fails the source document proposed without the mapped fields, the source is mapped by the leader
+// before the proposal and never in the apply, the replicas could map it by different versions of the space mapping
+var errUnmappedSource = errors.New("the source document is not mapped by the leader")
+
+// versionConflictError is the failed precondition of the version of the document
+type versionConflictError struct {
+	current kernel.DocVersion
+}
+
+func (e *versionConflictError) Error() string {
+	return fmt.Sprintf("version conflict, current version [%d] sequence number [%d]", e.current.Version, e.current.SeqNo)
+}
+
+func writeFailure(docID metapb.Key, err error) *pspb.Failure {
+	failure := &pspb.Failure{Id: docID, Cause: err.Error()}
+	if conflict, ok := err.(*versionConflictError); ok {
+		failure.VersionConflict = true
+		failure.CurrentVersion = conflict.current.Version
+		failure.CurrentSeqNo = conflict.current.SeqNo
+	}
+	return failure
+}
+
+// checkVersion checks the preconditions of the version and the sequence number of the document
+// with the writes before in the batch, 0 is no precondition
+func checkVersion(batch kernel.Batch, docID metapb.Key, ifVersion, ifSeqNo uint64) error {
+	if ifVersion == 0 && ifSeqNo == 0 {
+		return nil
+	}
+	current, found, err := batch.DocVersion(docID)
+	if err != nil {
+		return err
+	}
+	if !found {
+		current = kernel.DocVersion{}
+	}
+	if (ifVersion > 0 && current.Version != ifVersion) || (ifSeqNo > 0 && current.SeqNo != ifSeqNo) {
+		return &versionConflictError{current: current}
+	}
+	return nil
+}
+
+// docVersion returns the version of the document written by the batch
+func docVersion(batch kernel.Batch, docID metapb.Key) (kernel.DocVersion, error) {
+	version, _, err := batch.DocVersion(docID)
+	return version, err
+}
+
 func (p *partition) createInternal(request *pspb.CreateRequest, batch kernel.Batch) (*pspb.CreateResponse, error) {
+	if err := checkVersion(batch, request.Doc.Id, request.IfVersion, request.IfSeqNo); err != nil {
+		return nil, err
+	}
+	if len(request.Source) > 0 {
+		return nil, errUnmappedSource
+	}
 	if err := batch.AddDocument(p.ctx, &request.Doc); err != nil {
 		return nil, err
 	}
+	version, err := docVersion(batch, request.Doc.Id)
+	if err != nil {
+		return nil, err
+	}
 
-	return &pspb.CreateResponse{Id: request.Doc.Id, Result: pspb.WriteResult_CREATED}, nil
+	return &pspb.CreateResponse{Id: request.Doc.Id, Result: pspb.WriteResult_CREATED, Version: version.Version, SeqNo: version.SeqNo}, nil
 }
 
 func (p *partition) updateInternal(request *pspb.UpdateRequest, batch kernel.Batch) (*pspb.UpdateResponse, error) {
+	if err := checkVersion(batch, request.Doc.Id, request.IfVersion, request.IfSeqNo); err != nil {
+		return nil, err
+	}
+	if len(request.Partial) > 0 || request.Script != nil {
+		return p.mergeInternal(request, batch)
+	}
+	if len(request.Source) > 0 {
+		return nil, errUnmappedSource
+	}
 	found, err := batch.UpdateDocument(p.ctx, &request.Doc, request.Upsert)
 	if err != nil {
 		return nil, err
@@ -240,10 +666,111 @@ func (p *partition) updateInternal(request *pspb.UpdateRequest, batch kernel.Bat
 	} else if request.Upsert {
 		result = pspb.WriteResult_CREATED
 	}
-	return &pspb.UpdateResponse{Id: request.Doc.Id, Result: result}, nil
+	return updateResponse(batch, request.Doc.Id, result)
+}
+
+// mergeInternal merges the partial document or the fields set by the script into the document, the script runs
+// on the fields read in the apply of the raft log. The new fields are added to the space mapping by the leader
+// before the proposal, the replicas which have applied the mapping version of the request merge by the same fields.
+func (p *partition) mergeInternal(request *pspb.UpdateRequest, batch kernel.Batch) (*pspb.UpdateResponse, error) {
+	if version := p.mappingVersion(); version < request.MappingVersion {
+		return nil, fmt.Errorf("mapping version [%d] of the partition is older than the version [%d] of the update", version, request.MappingVersion)
+	}
+	result, err := batch.MergeDocument(p.ctx, mergeRequest(request))
+	if err != nil {
+		return nil, err
+	}
+	return updateResponse(batch, request.Doc.Id, result)
+}
+
+func mergeRequest(request *pspb.UpdateRequest) *kernel.MergeRequest {
+	req := &kernel.MergeRequest{DocID: request.Doc.Id, Partial: request.Partial, Upsert: request.Upsert, ExpireAt: request.Doc.ExpireAt}
+	if request.Script != nil {
+		req.Script = request.Script.Source
+		req.Params = request.Script.Params
+	}
+	return req
+}
+
+// resolveMerge merges the partial document or runs the script in a batch rolled back on the leader, the new fields
+// of the dynamic objects are added to the space mapping, and the mapping version is set in the request for the apply.
+// The other errors are left to the apply, the document could be written by the requests before it.
+func (p *partition) resolveMerge(request *pspb.UpdateRequest) error {
+	batch := p.store.NewWriteBatch()
+	_, err := batch.MergeDocument(p.ctx, mergeRequest(request))
+	batch.Rollback()
+	if dynamicErr, ok := err.(*mapping.DynamicMappingError); ok {
+		if err := p.updateDynamicMapping(dynamicErr); err != nil {
+			return err
+		}
+	}
+	request.MappingVersion = p.mappingVersion()
+	return nil
+}
+
+func updateResponse(batch kernel.Batch, docID metapb.Key, result pspb.WriteResult) (*pspb.UpdateResponse, error) {
+	resp := &pspb.UpdateResponse{Id: docID, Result: result}
+	if result != pspb.WriteResult_NOT_FOUND {
+		version, err := docVersion(batch, docID)
+		if err != nil {
+			return nil, err
+		}
+		resp.Version, resp.SeqNo = version.Version, version.SeqNo
+	}
+	return resp, nil
+}
+
+// mapDocument maps the source document by the space mapping on the leader, it never runs in the apply. The new
+// fields of the dynamic objects are added to the space mapping by master and applied by raft first,
+// so all the partitions and their replicas have the same field IDs
+func (p *partition) mapDocument(docID metapb.Key, source []byte) (*pspb.Document, error) {
+	doc, err := p.store.MapDocument(docID, source)
+	dynamicErr, ok := err.(*mapping.DynamicMappingError)
+	if !ok {
+		return doc, err
+	}
+	if err := p.updateDynamicMapping(dynamicErr); err != nil {
+		return nil, err
+	}
+	return p.store.MapDocument(docID, source)
+}
+
+// updateDynamicMapping merges the new fields into the space mapping by master, and proposes the merged mapping
+// by raft, the requests mapped by the new fields are proposed after it is applied
+func (p *partition) updateDynamicMapping(dynamicErr *mapping.DynamicMappingError) error {
+	p.rwMutex.RLock()
+	db, space := p.meta.DB, p.meta.Space
+	p.rwMutex.RUnlock()
+	resp, err := p.server.updateSpaceMapping(db, space, dynamicErr.Update)
+	if err != nil {
+		return err
+	}
+	if resp.MappingVersion <= p.mappingVersion() {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(p.ctx, proposeMappingTimeout)
+	defer cancel()
+	return p.proposeMapping(ctx, resp.Mapping, resp.MappingVersion)
 }
 
 func (p *partition) deleteInternal(request *pspb.DeleteRequest, batch kernel.Batch) (*pspb.DeleteResponse, error) {
+	if err := checkVersion(batch, request.Id, request.IfVersion, request.IfSeqNo); err != nil {
+		return nil, err
+	}
+	// the sweeper deletes the document only if it is not written with a later expiration time after the proposal
+	if request.IfExpiredAt > 0 {
+		expireAt, err := batch.DocExpireAt(request.Id)
+		if err != nil {
+			return nil, err
+		}
+		if expireAt == 0 || expireAt > request.IfExpiredAt {
+			return &pspb.DeleteResponse{Id: request.Id, Result: pspb.WriteResult_NOOP}, nil
+		}
+	}
+	version, err := docVersion(batch, request.Id)
+	if err != nil {
+		return nil, err
+	}
 	n, err := batch.DeleteDocument(p.ctx, request.Id)
 	if err != nil {
 		return nil, err
@@ -253,5 +780,5 @@ func (p *partition) deleteInternal(request *pspb.DeleteRequest, batch kernel.Bat
 	if n > 0 {
 		result = pspb.WriteResult_DELETED
 	}
-	return &pspb.DeleteResponse{Id: request.Id, Result: result}, nil
+	return &pspb.DeleteResponse{Id: request.Id, Result: result, Version: version.Version, SeqNo: version.SeqNo}, nil
 }
@@ -0,0 +1,284 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/tiglabs/baudengine/kernel"
+	"github.com/tiglabs/baudengine/proto/metapb"
+	"github.com/tiglabs/baudengine/proto/pspb"
+	"github.com/tiglabs/baudengine/util/log"
+	"github.com/tiglabs/baudengine/util/routine"
+)
+
+const (
+	defaultByQueryBatchSize = 1000
+	maxByQueryBatchSize     = 10000
+	// the max number of the running by query tasks of a partition, every task holds a snapshot of the store
+	maxByQueryTasks = 10
+	// the finished tasks are kept for their status
+	byQueryTaskRetention = 10 * time.Minute
+)
+
+// byQueryTask is a background task deleting or updating the documents matched by a query in a snapshot
+type byQueryTask struct {
+	sync.Mutex
+	status pspb.ByQueryStatus
+	cancel context.CancelFunc
+}
+
+func (t *byQueryTask) getStatus() pspb.ByQueryStatus {
+	t.Lock()
+	defer t.Unlock()
+	return t.status
+}
+
+func (t *byQueryTask) finish(err error) {
+	t.Lock()
+	defer t.Unlock()
+	t.status.Done = true
+	t.status.EndTime = time.Now().UnixNano() / int64(time.Millisecond)
+	if err == context.Canceled {
+		t.status.Canceled = true
+	}
+	if err != nil {
+		t.status.Error = err.Error()
+	}
+}
+
+// byQueryInternal starts the task on the leader, the documents are matched in the snapshot opened now
+// and written by raft with the sequence number preconditions, so the documents changed later are not overwritten
+func (p *partition) byQueryInternal(request *pspb.ByQueryRequest, response *pspb.ByQueryResponse, update bool) {
+	if err := p.checkReadable(true); err != nil {
+		response.Error = *err
+		if err.NotLeader != nil {
+			response.Code = metapb.PS_RESP_CODE_NOT_LEADER
+			response.Message = fmt.Sprintf("node[%d] of partition[%d] is not leader", p.server.NodeID, request.Partition)
+		} else if err.NoLeader != nil {
+			response.Code = metapb.PS_RESP_CODE_NO_LEADER
+			response.Message = fmt.Sprintf("node[%d] of partition[%d] has no leader", p.server.NodeID, request.Partition)
+		} else if err.PartitionNotFound != nil {
+			response.Code = metapb.PS_RESP_CODE_NO_PARTITION
+			response.Message = fmt.Sprintf("node[%d] of partition[%d] has closed", p.server.NodeID, request.Partition)
+		}
+
+		log.Error("by query error:[%s],\n by query request is:[%s]", response.Message, request)
+		return
+	}
+
+	var err error
+	if update && len(request.Partial) == 0 && request.Script == nil {
+		err = errors.New("update by query needs the partial document or the script")
+	}
+	var query kernel.Query
+	if err == nil {
+		query, err = toKernelQuery(&request.Query)
+	}
+	if err == nil {
+		response.TaskId, err = p.startByQueryTask(request, query, update)
+	}
+	if err != nil {
+		response.Code = metapb.RESP_CODE_SERVER_ERROR
+		response.Message = err.Error()
+		log.Error("by query error:[%s],\n by query request is:[%s]", err, request)
+	}
+}
+
+func (p *partition) startByQueryTask(request *pspb.ByQueryRequest, query kernel.Query, update bool) (string, error) {
+	id, err := randomID()
+	if err != nil {
+		return "", err
+	}
+	now := time.Now()
+
+	p.taskMutex.Lock()
+	defer p.taskMutex.Unlock()
+	running := 0
+	for taskID, task := range p.tasks {
+		status := task.getStatus()
+		if !status.Done {
+			running++
+		} else if time.Duration(now.UnixNano()/int64(time.Millisecond)-status.EndTime)*time.Millisecond > byQueryTaskRetention {
+			delete(p.tasks, taskID)
+		}
+	}
+	if running >= maxByQueryTasks {
+		return "", fmt.Errorf("partition[%d] has too many running by query tasks", p.meta.ID)
+	}
+	snap, err := p.store.NewSnapshot()
+	if err != nil {
+		return "", err
+	}
+
+	task := &byQueryTask{status: pspb.ByQueryStatus{
+		TaskId:    id,
+		Update:    update,
+		StartTime: now.UnixNano() / int64(time.Millisecond),
+	}}
+	var ctx context.Context
+	ctx, task.cancel = context.WithCancel(p.ctx)
+	p.tasks[id] = task
+	p.taskGroup.Add(1)
+	err = routine.RunWorkAsync(fmt.Sprintf("PARTITION-BYQUERY-%d", p.meta.ID), func() {
+		defer p.taskGroup.Done()
+		defer snap.Close()
+		defer task.cancel()
+		err := p.runByQueryTask(ctx, task, snap, request, query)
+		if err != nil {
+			log.Error("partition[%d] by query task[%s] error: %s", p.meta.ID, id, err)
+		}
+		task.finish(err)
+	}, routine.LogPanic(false))
+	if err != nil {
+		delete(p.tasks, id)
+		p.taskGroup.Done()
+		task.cancel()
+		snap.Close()
+		return "", err
+	}
+	return id, nil
+}
+
+// runByQueryTask writes the matched documents in batches, throttled by the requests per second
+func (p *partition) runByQueryTask(ctx context.Context, task *byQueryTask, snap kernel.Snapshot, request *pspb.ByQueryRequest, query kernel.Query) error {
+	docIDs, err := snap.MatchDocuments(ctx, query)
+	if err != nil {
+		return err
+	}
+	task.Lock()
+	task.status.Total = uint64(len(docIDs))
+	task.Unlock()
+
+	batchSize := int(request.BatchSize)
+	if batchSize <= 0 {
+		batchSize = defaultByQueryBatchSize
+	} else if batchSize > maxByQueryBatchSize {
+		batchSize = maxByQueryBatchSize
+	}
+	for start := 0; start < len(docIDs); start += batchSize {
+		batchStart := time.Now()
+		p.rwMutex.RLock()
+		leader := p.meta.Status == metapb.PA_READWRITE
+		p.rwMutex.RUnlock()
+		if !leader {
+			return fmt.Errorf("node[%d] of partition[%d] is not leader", p.server.NodeID, p.meta.ID)
+		}
+
+		end := start + batchSize
+		if end > len(docIDs) {
+			end = len(docIDs)
+		}
+		requests, err := byQueryRequests(snap, docIDs[start:end], request, task.status.Update)
+		if err != nil {
+			return err
+		}
+		submitCtx, cancel := context.WithTimeout(ctx, time.Minute)
+		result, err, _ := p.submitMapped(submitCtx, requests)
+		cancel()
+		if err != nil {
+			return err
+		}
+		if err := task.count(result.([]pspb.BulkItemResponse), request.ProceedOnConflicts); err != nil {
+			return err
+		}
+
+		// throttle the writes by the requests per second
+		if request.RequestsPerSecond > 0 && end < len(docIDs) {
+			wait := time.Duration(float64(end-start)/float64(request.RequestsPerSecond)*float64(time.Second)) - time.Since(batchStart)
+			if wait > 0 {
+				timer := time.NewTimer(wait)
+				select {
+				case <-ctx.Done():
+					timer.Stop()
+					return ctx.Err()
+				case <-timer.C:
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// byQueryRequests returns the write commands of the documents with the sequence numbers in the snapshot
+func byQueryRequests(snap kernel.Snapshot, docIDs []metapb.Key, request *pspb.ByQueryRequest, update bool) ([]pspb.BulkItemRequest, error) {
+	requests := make([]pspb.BulkItemRequest, len(docIDs))
+	for i, docID := range docIDs {
+		version, _, err := snap.DocVersion(docID)
+		if err != nil {
+			return nil, err
+		}
+		if !update {
+			requests[i].OpType = pspb.OpType_DELETE
+			requests[i].Delete = &pspb.DeleteRequest{Id: docID, IfSeqNo: version.SeqNo}
+		} else {
+			requests[i].OpType = pspb.OpType_UPDATE
+			requests[i].Update = &pspb.UpdateRequest{
+				Doc:     pspb.Document{Id: docID},
+				Partial: request.Partial,
+				Script:  request.Script,
+				IfSeqNo: version.SeqNo,
+			}
+		}
+	}
+	return requests, nil
+}
+
+// count adds the results of a batch to the status, it returns the error aborting the task
+func (t *byQueryTask) count(responses []pspb.BulkItemResponse, proceedOnConflicts bool) error {
+	t.Lock()
+	defer t.Unlock()
+	t.status.Batches++
+	var conflict error
+	for _, resp := range responses {
+		switch {
+		case resp.Failure != nil && resp.Failure.VersionConflict:
+			t.status.VersionConflicts++
+			if !proceedOnConflicts && conflict == nil {
+				conflict = fmt.Errorf("version conflict of document[%s]", resp.Failure.Id)
+			}
+		case resp.Failure != nil:
+			t.status.Failures++
+			if t.status.Error == "" {
+				t.status.Error = resp.Failure.Cause
+			}
+		case resp.Delete != nil && resp.Delete.Result == pspb.WriteResult_DELETED:
+			t.status.Deleted++
+		case resp.Update != nil && resp.Update.Result == pspb.WriteResult_UPDATED:
+			t.status.Updated++
+		default:
+			t.status.Noops++
+		}
+	}
+	return conflict
+}
+
+func (p *partition) getByQueryTaskInternal(request *pspb.ByQueryTaskRequest, response *pspb.ByQueryTaskResponse) {
+	task := p.getByQueryTask(request.TaskId)
+	if task == nil {
+		response.Code = metapb.PS_RESP_CODE_NO_TASK
+		response.Message = fmt.Sprintf("task[%s] of partition[%d] is not found or expired", request.TaskId, request.Partition)
+		return
+	}
+	response.Status = task.getStatus()
+}
+
+// cancelByQueryTaskInternal cancels the running task, the batches written before are kept
+func (p *partition) cancelByQueryTaskInternal(request *pspb.ByQueryTaskRequest, response *pspb.ByQueryTaskResponse) {
+	task := p.getByQueryTask(request.TaskId)
+	if task == nil {
+		response.Code = metapb.PS_RESP_CODE_NO_TASK
+		response.Message = fmt.Sprintf("task[%s] of partition[%d] is not found or expired", request.TaskId, request.Partition)
+		return
+	}
+	task.cancel()
+	response.Status = task.getStatus()
+}
+
+func (p *partition) getByQueryTask(id string) *byQueryTask {
+	p.taskMutex.Lock()
+	defer p.taskMutex.Unlock()
+	return p.tasks[id]
+}
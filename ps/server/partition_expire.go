@@ -0,0 +1,89 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/tiglabs/baudengine/proto/metapb"
+	"github.com/tiglabs/baudengine/proto/pspb"
+	"github.com/tiglabs/baudengine/util/log"
+	"github.com/tiglabs/baudengine/util/routine"
+)
+
+const (
+	// the default interval of the expiration sweeper in milliseconds
+	defaultExpireSweepInterval = 60000
+	// the max number of the expired documents deleted by a raft command
+	expireSweepBatchSize = 500
+)
+
+// startExpireSweeper starts the sweeper deleting the expired documents of the partition,
+// it only works on the leader and proposes the deletes through raft
+func (p *partition) startExpireSweeper() {
+	interval := time.Millisecond * time.Duration(p.server.ExpireSweepInterval)
+	if interval <= 0 {
+		interval = time.Millisecond * defaultExpireSweepInterval
+	}
+	routine.RunWorkDaemon(fmt.Sprintf("PARTITION-EXPIRE-%d", p.meta.ID), func() {
+		timer := time.NewTimer(interval)
+		defer timer.Stop()
+		for {
+			select {
+			case <-p.ctx.Done():
+				return
+
+			case <-timer.C:
+				p.sweepExpired()
+				timer.Reset(interval)
+			}
+		}
+	}, p.ctx.Done())
+}
+
+// sweepExpired deletes the documents expired now in batches, until no document is expired
+// or the partition is not the leader
+func (p *partition) sweepExpired() {
+	for {
+		p.rwMutex.RLock()
+		leader := p.meta.Status == metapb.PA_READWRITE
+		p.rwMutex.RUnlock()
+		if !leader {
+			return
+		}
+
+		now := time.Now().UnixNano() / int64(time.Millisecond)
+		docIDs, err := p.store.ExpiredDocuments(p.ctx, now, expireSweepBatchSize)
+		if err != nil {
+			log.Error("partition[%d] find expired documents error: %s", p.meta.ID, err)
+			return
+		}
+		if len(docIDs) == 0 {
+			return
+		}
+		requests := make([]pspb.BulkItemRequest, len(docIDs))
+		for i, docID := range docIDs {
+			requests[i].OpType = pspb.OpType_DELETE
+			requests[i].Delete = &pspb.DeleteRequest{Id: docID, IfExpiredAt: now}
+		}
+
+		ctx, cancel := context.WithTimeout(p.ctx, time.Minute)
+		result, err, _ := p.submitWrite(ctx, requests)
+		cancel()
+		if err != nil {
+			log.Error("partition[%d] delete expired documents error: %s", p.meta.ID, err)
+			return
+		}
+		deleted := 0
+		for _, resp := range result.([]pspb.BulkItemResponse) {
+			if resp.Delete != nil && resp.Delete.Result == pspb.WriteResult_DELETED {
+				deleted++
+			}
+		}
+		log.Debug("partition[%d] deleted %d expired documents", p.meta.ID, deleted)
+		// the documents written with a later expiration time are kept
+		if len(docIDs) < expireSweepBatchSize || deleted == 0 {
+			return
+		}
+	}
+}
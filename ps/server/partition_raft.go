@@ -18,6 +18,9 @@ func (p *partition) Apply(command []byte, index uint64) (resp interface{}, err e
 	case raftpb.CmdType_WRITE:
 		resp, err = p.execWriteCommand(index, raftCmd.WriteCommands)
 
+	case raftpb.CmdType_MAPPING:
+		err = p.applyMapping(index, raftCmd.Mapping, raftCmd.MappingVersion)
+
 	default:
 		p.store.SetApplyID(index)
 		err = errorPartitonCommand
@@ -0,0 +1,241 @@
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/tiglabs/baudengine/kernel"
+	"github.com/tiglabs/baudengine/proto/metapb"
+	"github.com/tiglabs/baudengine/proto/pspb"
+	"github.com/tiglabs/baudengine/util/log"
+	"github.com/tiglabs/baudengine/util/routine"
+)
+
+const (
+	defaultScrollSize      = 100
+	maxScrollSize          = 10000
+	defaultScrollKeepAlive = time.Minute
+	maxScrollKeepAlive     = time.Hour
+	// the max number of the open scrolls of a partition, every scroll holds a snapshot of the store
+	maxScrolls = 100
+	// the interval of closing the idle scrolls
+	scrollReapInterval = 10 * time.Second
+)
+
+// scrollContext is an open scroll on a snapshot of the partition, the pages are read in doc ID order
+// after the document in the request, so that a page can be read again if the router fails to return it
+type scrollContext struct {
+	sync.Mutex
+	snap   kernel.Snapshot
+	fields []uint32
+	closed bool
+	// the scroll is closed if it is not read before the time, guarded by the scroll mutex of the partition
+	expireAt time.Time
+}
+
+func (sc *scrollContext) close() {
+	if !sc.closed {
+		sc.closed = true
+		sc.snap.Close()
+	}
+}
+
+// scrollInternal opens a scroll on the leader, the pages of an open scroll are read from its snapshot
+// on the same node, even if the leader is changed
+func (p *partition) scrollInternal(request *pspb.ScrollRequest, response *pspb.ScrollResponse) {
+	keepAlive := defaultScrollKeepAlive
+	if request.KeepAlive != "" {
+		var err error
+		if keepAlive, err = time.ParseDuration(request.KeepAlive); err != nil || keepAlive <= 0 || keepAlive > maxScrollKeepAlive {
+			response.Code = metapb.RESP_CODE_SERVER_ERROR
+			response.Message = fmt.Sprintf("invalid keep alive %s", request.KeepAlive)
+			return
+		}
+	}
+	size := int(request.Size_)
+	if size <= 0 {
+		size = defaultScrollSize
+	} else if size > maxScrollSize {
+		size = maxScrollSize
+	}
+
+	var sc *scrollContext
+	if request.ScrollId == "" {
+		if err := p.checkReadable(true); err != nil {
+			response.Error = *err
+			if err.NotLeader != nil {
+				response.Code = metapb.PS_RESP_CODE_NOT_LEADER
+				response.Message = fmt.Sprintf("node[%d] of partition[%d] is not leader", p.server.NodeID, request.Partition)
+			} else if err.NoLeader != nil {
+				response.Code = metapb.PS_RESP_CODE_NO_LEADER
+				response.Message = fmt.Sprintf("node[%d] of partition[%d] has no leader", p.server.NodeID, request.Partition)
+			} else if err.PartitionNotFound != nil {
+				response.Code = metapb.PS_RESP_CODE_NO_PARTITION
+				response.Message = fmt.Sprintf("node[%d] of partition[%d] has closed", p.server.NodeID, request.Partition)
+			}
+
+			log.Error("scroll error:[%s],\n scroll request is:[%s]", response.Message, request)
+			return
+		}
+		var err error
+		if response.ScrollId, sc, err = p.openScroll(request.Fields); err != nil {
+			response.Code = metapb.RESP_CODE_SERVER_ERROR
+			response.Message = err.Error()
+			log.Error("open scroll error:[%s],\n scroll request is:[%s]", err, request)
+			return
+		}
+	} else {
+		p.scrollMutex.Lock()
+		sc = p.scrolls[request.ScrollId]
+		p.scrollMutex.Unlock()
+		if sc == nil {
+			response.Code = metapb.PS_RESP_CODE_NO_SCROLL
+			response.Message = fmt.Sprintf("scroll[%s] of partition[%d] is not found or expired", request.ScrollId, request.Partition)
+			return
+		}
+		response.ScrollId = request.ScrollId
+	}
+
+	var (
+		cancel  context.CancelFunc
+		timeCtx = p.ctx
+	)
+	if request.Timeout != "" {
+		if timeout, err := time.ParseDuration(request.Timeout); err == nil {
+			timeCtx, cancel = context.WithTimeout(timeCtx, timeout)
+		}
+	}
+	sc.Lock()
+	var (
+		hits []*kernel.Hit
+		err  error
+	)
+	if sc.closed {
+		err = fmt.Errorf("scroll[%s] is closed", response.ScrollId)
+	} else {
+		hits, err = sc.snap.ScanDocuments(timeCtx, request.After, size, sc.fields)
+	}
+	sc.Unlock()
+	p.scrollMutex.Lock()
+	sc.expireAt = time.Now().Add(keepAlive)
+	p.scrollMutex.Unlock()
+	if cancel != nil {
+		cancel()
+	}
+
+	if err != nil {
+		if err == context.DeadlineExceeded {
+			response.Code = metapb.RESP_CODE_TIMEOUT
+			response.Message = "request timeout"
+		} else if err == context.Canceled {
+			response.Code = metapb.RESP_CODE_SERVER_STOP
+			response.Message = "during request processing, the server is shut down"
+		} else {
+			response.Code = metapb.RESP_CODE_SERVER_ERROR
+			response.Message = err.Error()
+		}
+		log.Error("scroll error:[%s],\n scroll request is:[%s]", err, request)
+		return
+	}
+	response.Docs = make([]pspb.ScrollDocument, len(hits))
+	for i, hit := range hits {
+		response.Docs[i] = pspb.ScrollDocument{Id: hit.DocID, Fields: hit.Fields}
+	}
+	// the scroll is closed by the router after the last page is returned
+	response.Done = len(hits) < size
+}
+
+// randomID returns a random hex ID of the scrolls and the tasks
+func randomID() (string, error) {
+	var buf [16]byte
+	if _, err := rand.Read(buf[:]); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf[:]), nil
+}
+
+func (p *partition) openScroll(fields []uint32) (string, *scrollContext, error) {
+	id, err := randomID()
+	if err != nil {
+		return "", nil, err
+	}
+
+	p.scrollMutex.Lock()
+	defer p.scrollMutex.Unlock()
+	if len(p.scrolls) >= maxScrolls {
+		return "", nil, fmt.Errorf("partition[%d] has too many open scrolls", p.meta.ID)
+	}
+	snap, err := p.store.NewSnapshot()
+	if err != nil {
+		return "", nil, err
+	}
+	sc := &scrollContext{snap: snap, fields: fields, expireAt: time.Now().Add(maxScrollKeepAlive)}
+	p.scrolls[id] = sc
+	return id, sc, nil
+}
+
+func (p *partition) clearScrollInternal(request *pspb.ClearScrollRequest, response *pspb.ClearScrollResponse) {
+	if !p.closeScroll(request.ScrollId) {
+		response.Code = metapb.PS_RESP_CODE_NO_SCROLL
+		response.Message = fmt.Sprintf("scroll[%s] of partition[%d] is not found or expired", request.ScrollId, request.Partition)
+	}
+}
+
+// closeScroll closes the scroll and releases its snapshot, it returns false if the scroll is not found
+func (p *partition) closeScroll(id string) bool {
+	p.scrollMutex.Lock()
+	sc := p.scrolls[id]
+	delete(p.scrolls, id)
+	p.scrollMutex.Unlock()
+	if sc == nil {
+		return false
+	}
+	sc.Lock()
+	sc.close()
+	sc.Unlock()
+	return true
+}
+
+// closeScrolls closes all the scrolls when the partition is closed
+func (p *partition) closeScrolls() {
+	p.scrollMutex.Lock()
+	scrolls := p.scrolls
+	p.scrolls = make(map[string]*scrollContext)
+	p.scrollMutex.Unlock()
+	for _, sc := range scrolls {
+		sc.Lock()
+		sc.close()
+		sc.Unlock()
+	}
+}
+
+// startScrollReaper starts closing the scrolls idle longer than their keep alive
+func (p *partition) startScrollReaper() {
+	routine.RunWorkDaemon(fmt.Sprintf("PARTITION-SCROLL-%d", p.meta.ID), func() {
+		ticker := time.NewTicker(scrollReapInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-p.ctx.Done():
+				return
+
+			case now := <-ticker.C:
+				var expired []string
+				p.scrollMutex.Lock()
+				for id, sc := range p.scrolls {
+					if sc.expireAt.Before(now) {
+						expired = append(expired, id)
+					}
+				}
+				p.scrollMutex.Unlock()
+				for _, id := range expired {
+					p.closeScroll(id)
+				}
+			}
+		}
+	}, p.ctx.Done())
+}
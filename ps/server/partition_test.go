@@ -0,0 +1,222 @@
+package server
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	_ "github.com/tiglabs/baudengine/kernel/analysis/analyzer/whitspace"
+	"github.com/tiglabs/baudengine/kernel/index"
+	"github.com/tiglabs/baudengine/kernel/store/kvstore/boltdb"
+	"github.com/tiglabs/baudengine/proto/metapb"
+	"github.com/tiglabs/baudengine/proto/pspb"
+	"github.com/tiglabs/raft"
+)
+
+var testMapping = []byte(`{"mappings": {"doc": {"_all": {"analyzer": "whitspace"}, "properties": {
+	"title": {"type": "text", "analyzer": "whitspace"},
+	"price": {"type": "long"}}}}}`)
+
+// newTestPartition opens the partition on a store in the test directory without raft,
+// the raft commands are applied by calling the apply directly
+func newTestPartition(t *testing.T, status metapb.PartitionStatus) *partition {
+	kvStore, err := boltdb.New(&boltdb.StoreConfig{Path: "test"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := &partition{
+		meta:  metapb.Partition{ID: 1, Status: status},
+		store: index.NewIndexDriver(kvStore),
+	}
+	p.ctx, p.ctxCancel = context.WithCancel(context.Background())
+	return p
+}
+
+func closeTestPartition(t *testing.T, p *partition) {
+	p.ctxCancel()
+	if err := p.store.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.RemoveAll("test"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestApplyMapping(t *testing.T) {
+	p := newTestPartition(t, metapb.PA_READONLY)
+	defer closeTestPartition(t, p)
+
+	if err := p.applyMapping(5, testMapping, 1); err != nil {
+		t.Fatalf("apply mapping failed, err %v", err)
+	}
+	if p.mappingVersion() != 1 || string(p.meta.Mapping) != string(testMapping) {
+		t.Fatalf("mapping version %d should be applied", 1)
+	}
+	if applyID, err := p.store.GetApplyID(); err != nil || applyID != 5 {
+		t.Fatalf("apply id should be 5, got %d err %v", applyID, err)
+	}
+	if _, err := p.store.MapDocument([]byte("1"), []byte(`{"title": "hello baud", "price": 10}`)); err != nil {
+		t.Fatalf("map document by the applied mapping failed, err %v", err)
+	}
+
+	// the versions proposed by an old leader are skipped
+	if err := p.applyMapping(6, []byte(`{"mappings": {"doc": {"_all": {"analyzer": "whitspace"}, "properties": {
+		"title": {"type": "text", "analyzer": "whitspace"}}}}}`), 1); err != nil {
+		t.Fatalf("apply mapping failed, err %v", err)
+	}
+	if p.mappingVersion() != 1 || string(p.meta.Mapping) != string(testMapping) {
+		t.Fatal("old mapping version should be skipped")
+	}
+	if applyID, err := p.store.GetApplyID(); err != nil || applyID != 6 {
+		t.Fatalf("apply id should be 6, got %d err %v", applyID, err)
+	}
+
+	// the invalid mapping fails on all the replicas, the command is still applied
+	if err := p.applyMapping(7, []byte(`{"mappings": {"doc": {"properties": {"title": {"type": "unknown"}}}}}`), 2); err == nil {
+		t.Fatal("invalid mapping should fail")
+	}
+	if p.mappingVersion() != 1 {
+		t.Fatal("invalid mapping should not be applied")
+	}
+	if applyID, err := p.store.GetApplyID(); err != nil || applyID != 7 {
+		t.Fatalf("apply id should be 7, got %d err %v", applyID, err)
+	}
+}
+
+func TestUpdateMappingFollower(t *testing.T) {
+	p := newTestPartition(t, metapb.PA_READONLY)
+	defer closeTestPartition(t, p)
+
+	// the mapping learned from master by heartbeat is proposed only by the leader
+	p.updateMapping(testMapping, 1)
+	if p.mappingVersion() != 0 {
+		t.Fatal("follower should not set the mapping outside raft")
+	}
+}
+
+func TestMapRequests(t *testing.T) {
+	p := newTestPartition(t, metapb.PA_READWRITE)
+	defer closeTestPartition(t, p)
+	if err := p.applyMapping(1, testMapping, 1); err != nil {
+		t.Fatalf("apply mapping failed, err %v", err)
+	}
+
+	requests := []pspb.BulkItemRequest{
+		{OpType: pspb.OpType_CREATE, Create: &pspb.CreateRequest{
+			Doc:    pspb.Document{Id: []byte("1"), ExpireAt: 100},
+			Source: []byte(`{"title": "hello baud", "price": 10}`),
+		}},
+		{OpType: pspb.OpType_UPDATE, Update: &pspb.UpdateRequest{
+			Doc:    pspb.Document{Id: []byte("2")},
+			Source: []byte(`{"title": `),
+		}},
+		{OpType: pspb.OpType_UPDATE, Update: &pspb.UpdateRequest{
+			Doc:     pspb.Document{Id: []byte("3")},
+			Partial: []byte(`{"price": 20}`),
+		}},
+		{OpType: pspb.OpType_DELETE, Delete: &pspb.DeleteRequest{Id: []byte("4")}},
+	}
+	failures := p.mapRequests(requests)
+
+	if failures[0] != nil {
+		t.Fatalf("map source failed, %v", failures[0])
+	}
+	create := requests[0].Create
+	if len(create.Source) != 0 || len(create.Doc.Fields) == 0 {
+		t.Fatal("source should be mapped into the fields of the document")
+	}
+	if string(create.Doc.Id) != "1" || create.Doc.ExpireAt != 100 {
+		t.Fatal("id and expiration time of the document should be kept")
+	}
+	if failures[1] == nil || string(failures[1].Id) != "2" {
+		t.Fatal("invalid source should fail on the leader")
+	}
+	if failures[2] != nil || requests[2].Update.MappingVersion != 1 {
+		t.Fatal("merge should be proposed with the mapping version of the leader")
+	}
+	if failures[3] != nil {
+		t.Fatal("delete has nothing to map")
+	}
+}
+
+func TestSubmitMappedFollower(t *testing.T) {
+	p := newTestPartition(t, metapb.PA_READONLY)
+	defer closeTestPartition(t, p)
+	if err := p.applyMapping(1, testMapping, 1); err != nil {
+		t.Fatalf("apply mapping failed, err %v", err)
+	}
+
+	// the new dynamic field is never sent to master by a follower
+	requests := []pspb.BulkItemRequest{
+		{OpType: pspb.OpType_CREATE, Create: &pspb.CreateRequest{
+			Doc:    pspb.Document{Id: []byte("1")},
+			Source: []byte(`{"title": "hello baud", "author": "baud"}`),
+		}},
+	}
+	if _, err, _ := p.submitMapped(context.Background(), requests); err != raft.ErrNotLeader {
+		t.Fatalf("follower should reject the requests, err %v", err)
+	}
+	if len(requests[0].Create.Source) == 0 || len(requests[0].Create.Doc.Fields) != 0 {
+		t.Fatal("follower should not map the source")
+	}
+	if p.mappingVersion() != 1 {
+		t.Fatal("follower should not change the mapping")
+	}
+}
+
+func TestApplyMappedWrites(t *testing.T) {
+	p := newTestPartition(t, metapb.PA_READONLY)
+	defer closeTestPartition(t, p)
+	if err := p.applyMapping(1, testMapping, 1); err != nil {
+		t.Fatalf("apply mapping failed, err %v", err)
+	}
+	doc, err := p.store.MapDocument([]byte("1"), []byte(`{"title": "hello baud", "price": 10}`))
+	if err != nil {
+		t.Fatalf("map document failed, err %v", err)
+	}
+
+	cmds := []pspb.BulkItemRequest{
+		{OpType: pspb.OpType_CREATE, Create: &pspb.CreateRequest{Doc: *doc}},
+		// the source not mapped by the leader fails on all the replicas
+		{OpType: pspb.OpType_CREATE, Create: &pspb.CreateRequest{
+			Doc:    pspb.Document{Id: []byte("2")},
+			Source: []byte(`{"title": "hello"}`),
+		}},
+		// the new field is not in the mapping version of the merge, the apply never asks master for it
+		{OpType: pspb.OpType_UPDATE, Update: &pspb.UpdateRequest{
+			Doc:            pspb.Document{Id: []byte("1")},
+			Partial:        []byte(`{"author": "baud"}`),
+			MappingVersion: 1,
+		}},
+		// the replica has not applied the mapping version of the merge
+		{OpType: pspb.OpType_UPDATE, Update: &pspb.UpdateRequest{
+			Doc:            pspb.Document{Id: []byte("1")},
+			Partial:        []byte(`{"price": 20}`),
+			MappingVersion: 2,
+		}},
+		{OpType: pspb.OpType_UPDATE, Update: &pspb.UpdateRequest{
+			Doc:            pspb.Document{Id: []byte("1")},
+			Partial:        []byte(`{"price": 20}`),
+			MappingVersion: 1,
+		}},
+	}
+	resp, err := p.execWriteCommand(2, cmds)
+	if err != nil {
+		t.Fatalf("apply write command failed, err %v", err)
+	}
+	if resp[0].Create == nil || resp[0].Create.Result != pspb.WriteResult_CREATED {
+		t.Fatalf("create mapped document failed, %v", resp[0].Failure)
+	}
+	if resp[1].Failure == nil || resp[1].Failure.Cause != errUnmappedSource.Error() {
+		t.Fatal("unmapped source should fail")
+	}
+	if resp[2].Failure == nil {
+		t.Fatal("merge of the unmapped field should fail")
+	}
+	if resp[3].Failure == nil {
+		t.Fatal("merge of the newer mapping version should fail")
+	}
+	if resp[4].Update == nil || resp[4].Update.Result != pspb.WriteResult_UPDATED {
+		t.Fatalf("merge failed, %v", resp[4].Failure)
+	}
+}
@@ -0,0 +1,239 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/tiglabs/baudengine/kernel"
+	"github.com/tiglabs/baudengine/kernel/analysis"
+	"github.com/tiglabs/baudengine/proto/pspb"
+)
+
+var errorEmptyQuery = errors.New("empty query")
+
+// toKernelQuery converts the query of the search request to the query of the kernel
+func toKernelQuery(query *pspb.Query) (kernel.Query, error) {
+	switch q := query.GetValue().(type) {
+	case *pspb.TermQuery:
+		return &kernel.TermQuery{FieldId: q.Field, Term: q.Term}, nil
+
+	case *pspb.TermsQuery:
+		return &kernel.TermsQuery{FieldId: q.Field, Terms: q.Terms}, nil
+
+	case *pspb.PhraseQuery:
+		return &kernel.PhraseQuery{FieldId: q.Field, Terms: q.Terms, Slop: int(q.Slop)}, nil
+
+	case *pspb.RangeQuery:
+		return &kernel.RangeQuery{FieldId: q.Field, Gt: q.Gt, Gte: q.Gte, Lt: q.Lt, Lte: q.Lte}, nil
+
+	case *pspb.PrefixQuery:
+		return &kernel.PrefixQuery{FieldId: q.Field, Prefix: q.Prefix, MaxExpansions: int(q.MaxExpansions)}, nil
+
+	case *pspb.WildcardQuery:
+		return &kernel.WildcardQuery{FieldId: q.Field, Pattern: q.Pattern, MaxExpansions: int(q.MaxExpansions)}, nil
+
+	case *pspb.RegexpQuery:
+		return &kernel.RegexpQuery{FieldId: q.Field, Pattern: q.Pattern, MaxExpansions: int(q.MaxExpansions)}, nil
+
+	case *pspb.FuzzyQuery:
+		return &kernel.FuzzyQuery{FieldId: q.Field, Term: q.Term, MaxEdits: int(q.MaxEdits),
+			PrefixLength: int(q.PrefixLength), MaxExpansions: int(q.MaxExpansions)}, nil
+
+	case *pspb.GeoBoundingBoxQuery:
+		if q.TopLeft == nil || q.BottomRight == nil {
+			return nil, errors.New("geo bounding box needs top_left and bottom_right")
+		}
+		return &kernel.GeoBoundingBoxQuery{FieldId: q.Field, TopLeft: toKernelGeoPoint(q.TopLeft),
+			BottomRight: toKernelGeoPoint(q.BottomRight)}, nil
+
+	case *pspb.GeoDistanceQuery:
+		if q.Origin == nil {
+			return nil, errors.New("geo distance needs origin")
+		}
+		return &kernel.GeoDistanceQuery{FieldId: q.Field, Origin: toKernelGeoPoint(q.Origin), Distance: q.Distance}, nil
+
+	case *pspb.GeoPolygonQuery:
+		points := make([]kernel.GeoPoint, 0, len(q.Points))
+		for _, point := range q.Points {
+			if point == nil {
+				return nil, errors.New("geo polygon has empty point")
+			}
+			points = append(points, toKernelGeoPoint(point))
+		}
+		return &kernel.GeoPolygonQuery{FieldId: q.Field, Points: points}, nil
+
+	case *pspb.MatchAllQuery:
+		return &kernel.MatchAllQuery{}, nil
+
+	case *pspb.BoolQuery:
+		boolQuery := &kernel.BooleanQuery{MinShould: int(q.MinShould)}
+		var err error
+		if boolQuery.Must, err = toKernelQueries(q.Must); err != nil {
+			return nil, err
+		}
+		if boolQuery.Should, err = toKernelQueries(q.Should); err != nil {
+			return nil, err
+		}
+		if boolQuery.MustNot, err = toKernelQueries(q.MustNot); err != nil {
+			return nil, err
+		}
+		return boolQuery, nil
+
+	case *pspb.NestedQuery:
+		sub, err := toKernelQuery(&q.Query)
+		if err != nil {
+			return nil, err
+		}
+		return &kernel.NestedQuery{FieldId: q.Field, Query: sub, ScoreMode: q.ScoreMode,
+			InnerHits: q.InnerHits, InnerHitsSize: int(q.InnerHitsSize)}, nil
+
+	case nil:
+		return nil, errorEmptyQuery
+
+	default:
+		return nil, fmt.Errorf("unsupported query type %T", q)
+	}
+}
+
+func toKernelGeoPoint(point *pspb.GeoPoint) kernel.GeoPoint {
+	return kernel.GeoPoint{Lat: point.Lat, Lon: point.Lon}
+}
+
+func toKernelQueries(queries []pspb.Query) ([]kernel.Query, error) {
+	if len(queries) == 0 {
+		return nil, nil
+	}
+	result := make([]kernel.Query, 0, len(queries))
+	for i := range queries {
+		q, err := toKernelQuery(&queries[i])
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, q)
+	}
+	return result, nil
+}
+
+func toKernelStatistics(stats *pspb.SearchStatistics) *kernel.Statistics {
+	if stats == nil {
+		return nil
+	}
+	result := kernel.NewStatistics()
+	for _, fs := range stats.Fields {
+		result.AddField(fs.Field, fs.DocCount, fs.SumLength)
+	}
+	for _, ts := range stats.Terms {
+		result.AddDocFreq(ts.Field, ts.Term, ts.DocFreq)
+	}
+	return result
+}
+
+func fromKernelStatistics(stats *kernel.Statistics) pspb.SearchStatistics {
+	var result pspb.SearchStatistics
+	for fieldId, fs := range stats.Fields {
+		result.Fields = append(result.Fields, pspb.FieldStatistics{Field: fieldId, DocCount: fs.DocCount, SumLength: fs.SumLength})
+	}
+	for fieldId, terms := range stats.DocFreqs {
+		for term, docFreq := range terms {
+			result.Terms = append(result.Terms, pspb.TermStatistics{Field: fieldId, Term: []byte(term), DocFreq: docFreq})
+		}
+	}
+	return result
+}
+
+func toKernelHighlight(highlight *pspb.Highlight) *kernel.Highlight {
+	if highlight == nil {
+		return nil
+	}
+	return &kernel.Highlight{
+		Fields:       highlight.Fields,
+		PreTag:       highlight.PreTag,
+		PostTag:      highlight.PostTag,
+		FragmentSize: int(highlight.FragmentSize),
+		NumFragments: int(highlight.NumberOfFragments),
+		Analyzers:    highlight.Analyzers,
+	}
+}
+
+func fromKernelHighlights(highlights map[uint32][]string) map[uint32]pspb.HighlightFragments {
+	if len(highlights) == 0 {
+		return nil
+	}
+	result := make(map[uint32]pspb.HighlightFragments, len(highlights))
+	for fieldId, fragments := range highlights {
+		result[fieldId] = pspb.HighlightFragments{Fragments: fragments}
+	}
+	return result
+}
+
+func fromKernelInnerHits(innerHits map[uint32][]*kernel.InnerHit) map[uint32]pspb.InnerHits {
+	if len(innerHits) == 0 {
+		return nil
+	}
+	result := make(map[uint32]pspb.InnerHits, len(innerHits))
+	for fieldId, hits := range innerHits {
+		nested := pspb.InnerHits{Hits: make([]pspb.InnerHit, 0, len(hits))}
+		for _, hit := range hits {
+			nested.Hits = append(nested.Hits, pspb.InnerHit{Offset: uint32(hit.Offset), Score: hit.Score, Fields: hit.Fields})
+		}
+		result[fieldId] = nested
+	}
+	return result
+}
+
+func fromAnalysisTokens(tokens analysis.TokenSet) []pspb.AnalyzeToken {
+	pbTokens := make([]pspb.AnalyzeToken, 0, len(tokens))
+	for _, token := range tokens {
+		pbTokens = append(pbTokens, pspb.AnalyzeToken{
+			Term:     string(token.Term),
+			Start:    int32(token.Start),
+			End:      int32(token.End),
+			Position: int32(token.Position),
+			Type:     token.Type.String(),
+		})
+	}
+	return pbTokens
+}
+
+func fromAnalysisStages(stages []*analysis.Stage) []pspb.AnalyzeStage {
+	if len(stages) == 0 {
+		return nil
+	}
+	pbStages := make([]pspb.AnalyzeStage, 0, len(stages))
+	for _, stage := range stages {
+		pbStage := pspb.AnalyzeStage{Name: stage.Name, Text: string(stage.Text)}
+		if stage.Tokens != nil {
+			pbStage.Tokens = fromAnalysisTokens(stage.Tokens)
+		}
+		pbStages = append(pbStages, pbStage)
+	}
+	return pbStages
+}
+
+func fromKernelTermVectors(fields map[uint32][]*kernel.TermVector) map[uint32]pspb.FieldTermVectors {
+	if len(fields) == 0 {
+		return nil
+	}
+	result := make(map[uint32]pspb.FieldTermVectors, len(fields))
+	for fieldId, vectors := range fields {
+		terms := make([]pspb.TermVector, 0, len(vectors))
+		for _, vector := range vectors {
+			term := pspb.TermVector{
+				Term:          vector.Term,
+				Freq:          int32(vector.Freq),
+				DocFreq:       vector.DocFreq,
+				TotalTermFreq: vector.TotalTermFreq,
+			}
+			for _, pos := range vector.Positions {
+				term.Positions = append(term.Positions, pspb.TermPosition{
+					Position: int32(pos.Position),
+					Start:    int32(pos.Start),
+					End:      int32(pos.End),
+				})
+			}
+			terms = append(terms, term)
+		}
+		result[fieldId] = pspb.FieldTermVectors{Terms: terms}
+	}
+	return result
+}
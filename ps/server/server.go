@@ -12,6 +12,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 
+	kernelconf "github.com/tiglabs/baudengine/kernel/config"
 	"github.com/tiglabs/baudengine/proto/masterpb"
 	"github.com/tiglabs/baudengine/proto/metapb"
 	"github.com/tiglabs/baudengine/proto/pspb"
@@ -29,7 +30,8 @@ import (
 )
 
 const (
-	registerTimeout = 10 * time.Second
+	registerTimeout      = 10 * time.Second
+	updateMappingTimeout = 10 * time.Second
 )
 
 // Server partition server
@@ -38,6 +40,7 @@ type Server struct {
 	meta *serverMeta
 
 	ip           string
+	masterLock   sync.RWMutex
 	masterLeader string
 	ctx          context.Context
 	ctxCancel    context.CancelFunc
@@ -86,6 +89,12 @@ func NewServer(conf *Config) *Server {
 	s.masterClient = rpc.NewClient(1, &clientOpt)
 	s.masterHeartbeat = newHeartbeatWork(s)
 
+	if conf.DictPath != "" {
+		if err := kernelconf.SetWordDictDir(conf.DictPath); err != nil {
+			log.Error("load word dicts from %s failed, err %v", conf.DictPath, err)
+		}
+	}
+
 	return s
 }
 
@@ -272,10 +281,7 @@ func (s *Server) register() (*masterpb.PSRegisterResponse, error) {
 	var response *masterpb.PSRegisterResponse
 
 	err := util.RetryMaxAttempt(&retryOpt, func() error {
-		masterAddr := s.MasterServer
-		if s.masterLeader != "" {
-			masterAddr = s.masterLeader
-		}
+		masterAddr := s.getMasterAddr()
 		masterClient, err := s.masterClient.GetGrpcClient(masterAddr)
 		if err != nil {
 			return fmt.Errorf("get master register rpc client[%s] error: %s", masterAddr, err)
@@ -289,11 +295,7 @@ func (s *Server) register() (*masterpb.PSRegisterResponse, error) {
 			return fmt.Errorf("master register requeset[%s] failed error: %s", request.ReqId, err)
 		}
 		if resp.Code != metapb.RESP_CODE_OK {
-			if resp.Error.NoLeader != nil {
-				s.masterLeader = ""
-			} else if resp.Error.NotLeader != nil {
-				s.masterLeader = resp.Error.NotLeader.LeaderAddr
-			}
+			s.updateMasterLeader(&resp.Error)
 			return fmt.Errorf("master register requeset[%s] ack code not ok, response is: %s", request.ReqId, resp)
 		}
 
@@ -307,6 +309,70 @@ func (s *Server) register() (*masterpb.PSRegisterResponse, error) {
 	return response, err
 }
 
+// updateSpaceMapping sends the dynamic fields of a partition to master and returns the merged space mapping
+func (s *Server) updateSpaceMapping(db metapb.DBID, space metapb.SpaceID, update []byte) (*masterpb.UpdateMappingResponse, error) {
+	retryOpt := util.DefaultRetryOption
+	retryOpt.MaxRetries = 3
+	retryOpt.Context = s.ctx
+
+	request := &masterpb.UpdateMappingRequest{
+		RequestHeader: metapb.RequestHeader{ReqId: uuid.FlakeUUID()},
+		DB:            db,
+		Space:         space,
+		Mapping:       update,
+	}
+	var response *masterpb.UpdateMappingResponse
+
+	err := util.RetryMaxAttempt(&retryOpt, func() error {
+		masterAddr := s.getMasterAddr()
+		masterClient, err := s.masterClient.GetGrpcClient(masterAddr)
+		if err != nil {
+			return fmt.Errorf("get master update mapping rpc client[%s] error: %s", masterAddr, err)
+		}
+
+		goCtx, cancel := context.WithTimeout(s.ctx, updateMappingTimeout)
+		resp, err := masterClient.(masterpb.MasterRpcClient).UpdateMapping(goCtx, request)
+		cancel()
+
+		if err != nil {
+			return fmt.Errorf("master update mapping requeset[%s] failed error: %s", request.ReqId, err)
+		}
+		if resp.Code != metapb.RESP_CODE_OK {
+			s.updateMasterLeader(&resp.Error)
+			return fmt.Errorf("master update mapping requeset[%s] ack code not ok, response is: %s", request.ReqId, resp)
+		}
+
+		response = resp
+		return nil
+	})
+
+	if err != nil {
+		log.Error(err.Error())
+	}
+	return response, err
+}
+
+// getMasterAddr returns the address of the master leader, or the configured master server if the leader is unknown
+func (s *Server) getMasterAddr() string {
+	s.masterLock.RLock()
+	defer s.masterLock.RUnlock()
+	if s.masterLeader != "" {
+		return s.masterLeader
+	}
+	return s.MasterServer
+}
+
+// updateMasterLeader records the master leader from the error of a master response
+func (s *Server) updateMasterLeader(err *metapb.Error) {
+	s.masterLock.Lock()
+	defer s.masterLock.Unlock()
+	if err.NoLeader != nil {
+		s.masterLeader = ""
+	} else if err.NotLeader != nil {
+		s.masterLeader = err.NotLeader.LeaderAddr
+	}
+}
+
 func (s *Server) recoverPartitions(partitions []metapb.Partition) {
 	// sort by partition id
 	sort.Sort(partitionByIDSlice(partitions))
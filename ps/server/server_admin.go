@@ -8,6 +8,8 @@ import (
 
 	"github.com/gogo/protobuf/proto"
 
+	kernelconf "github.com/tiglabs/baudengine/kernel/config"
+	"github.com/tiglabs/baudengine/kernel/registry"
 	"github.com/tiglabs/baudengine/proto/metapb"
 	"github.com/tiglabs/baudengine/proto/pspb"
 	"github.com/tiglabs/baudengine/util/log"
@@ -121,6 +123,45 @@ func (s *Server) ChangeLeader(ctx context.Context, request *pspb.ChangeLeaderReq
 	return response, nil
 }
 
+// ReloadDicts admin grpc service for reload the dicts of the analysis components on the node,
+// the components failed to reload keep the dicts loaded before
+func (s *Server) ReloadDicts(ctx context.Context, request *pspb.ReloadDictsRequest) (*pspb.ReloadDictsResponse, error) {
+	log.Debug("ReloadDicts recive request: %s", request)
+
+	response := &pspb.ReloadDictsResponse{
+		ResponseHeader: metapb.ResponseHeader{
+			ReqId: request.ReqId,
+			Code:  metapb.RESP_CODE_OK,
+		},
+		NodeID: s.NodeID,
+	}
+
+	if s.stopping.Get() {
+		response.Code = metapb.RESP_CODE_SERVER_STOP
+		response.Message = "server is stopping"
+		return response, nil
+	}
+	// the new dicts in the dir are found
+	if s.DictPath != "" {
+		if err := kernelconf.SetWordDictDir(s.DictPath); err != nil {
+			response.Code = metapb.RESP_CODE_SERVER_ERROR
+			response.Message = err.Error()
+			response.Versions = registry.Versions()
+			response.Errors = registry.LoadErrors()
+			return response, nil
+		}
+	}
+	if err := registry.Reload(request.Names...); err != nil {
+		log.Error("node[%d] reload dicts failed, err %v", s.NodeID, err)
+		response.Code = metapb.RESP_CODE_SERVER_ERROR
+		response.Message = err.Error()
+	}
+	response.Versions = registry.Versions()
+	response.Errors = registry.LoadErrors()
+
+	return response, nil
+}
+
 func (s *Server) doPartitionCreate(p metapb.Partition) {
 	partition := newPartition(s, p)
 	if _, ok := s.partitions.LoadOrStore(p.ID, partition); ok {
@@ -54,3 +54,234 @@ func (s *Server) BulkWrite(ctx context.Context, request *pspb.BulkRequest) (*psp
 
 	return response, nil
 }
+
+// Search grpc handler of Search service
+func (s *Server) Search(ctx context.Context, request *pspb.SearchRequest) (*pspb.SearchResponse, error) {
+	response := &pspb.SearchResponse{
+		ResponseHeader: metapb.ResponseHeader{
+			ReqId: request.ReqId,
+			Code:  metapb.RESP_CODE_OK,
+		},
+	}
+
+	if s.stopping.Get() {
+		response.Code = metapb.RESP_CODE_SERVER_STOP
+		response.Message = "the server is stopping, request is rejected"
+	} else if p, _ := s.partitions.Load(request.Partition); p == nil {
+		response.Code = metapb.PS_RESP_CODE_NO_PARTITION
+		response.Message = fmt.Sprintf("node[%d] has not found partition[%d]", s.NodeID, request.Partition)
+		response.Error = metapb.Error{PartitionNotFound: &metapb.PartitionNotFound{request.Partition}}
+	} else {
+		p.(*partition).searchInternal(request, response)
+	}
+
+	return response, nil
+}
+
+// SearchStatistics grpc handler of SearchStatistics service
+func (s *Server) SearchStatistics(ctx context.Context, request *pspb.SearchStatisticsRequest) (*pspb.SearchStatisticsResponse, error) {
+	response := &pspb.SearchStatisticsResponse{
+		ResponseHeader: metapb.ResponseHeader{
+			ReqId: request.ReqId,
+			Code:  metapb.RESP_CODE_OK,
+		},
+	}
+
+	if s.stopping.Get() {
+		response.Code = metapb.RESP_CODE_SERVER_STOP
+		response.Message = "the server is stopping, request is rejected"
+	} else if p, _ := s.partitions.Load(request.Partition); p == nil {
+		response.Code = metapb.PS_RESP_CODE_NO_PARTITION
+		response.Message = fmt.Sprintf("node[%d] has not found partition[%d]", s.NodeID, request.Partition)
+		response.Error = metapb.Error{PartitionNotFound: &metapb.PartitionNotFound{request.Partition}}
+	} else {
+		p.(*partition).searchStatisticsInternal(request, response)
+	}
+
+	return response, nil
+}
+
+// Analyze grpc handler of Analyze service
+func (s *Server) Analyze(ctx context.Context, request *pspb.AnalyzeRequest) (*pspb.AnalyzeResponse, error) {
+	response := &pspb.AnalyzeResponse{
+		ResponseHeader: metapb.ResponseHeader{
+			ReqId: request.ReqId,
+			Code:  metapb.RESP_CODE_OK,
+		},
+	}
+
+	if s.stopping.Get() {
+		response.Code = metapb.RESP_CODE_SERVER_STOP
+		response.Message = "the server is stopping, request is rejected"
+	} else if p, _ := s.partitions.Load(request.Partition); p == nil {
+		response.Code = metapb.PS_RESP_CODE_NO_PARTITION
+		response.Message = fmt.Sprintf("node[%d] has not found partition[%d]", s.NodeID, request.Partition)
+		response.Error = metapb.Error{PartitionNotFound: &metapb.PartitionNotFound{request.Partition}}
+	} else {
+		p.(*partition).analyzeInternal(request, response)
+	}
+
+	return response, nil
+}
+
+// TermVectors grpc handler of TermVectors service
+func (s *Server) TermVectors(ctx context.Context, request *pspb.TermVectorsRequest) (*pspb.TermVectorsResponse, error) {
+	response := &pspb.TermVectorsResponse{
+		ResponseHeader: metapb.ResponseHeader{
+			ReqId: request.ReqId,
+			Code:  metapb.RESP_CODE_OK,
+		},
+		Id: request.Id,
+	}
+
+	if s.stopping.Get() {
+		response.Code = metapb.RESP_CODE_SERVER_STOP
+		response.Message = "the server is stopping, request is rejected"
+	} else if p, _ := s.partitions.Load(request.Partition); p == nil {
+		response.Code = metapb.PS_RESP_CODE_NO_PARTITION
+		response.Message = fmt.Sprintf("node[%d] has not found partition[%d]", s.NodeID, request.Partition)
+		response.Error = metapb.Error{PartitionNotFound: &metapb.PartitionNotFound{request.Partition}}
+	} else {
+		p.(*partition).termVectorsInternal(request, response)
+	}
+
+	return response, nil
+}
+
+// Scroll grpc handler of Scroll service
+func (s *Server) Scroll(ctx context.Context, request *pspb.ScrollRequest) (*pspb.ScrollResponse, error) {
+	response := &pspb.ScrollResponse{
+		ResponseHeader: metapb.ResponseHeader{
+			ReqId: request.ReqId,
+			Code:  metapb.RESP_CODE_OK,
+		},
+	}
+
+	if s.stopping.Get() {
+		response.Code = metapb.RESP_CODE_SERVER_STOP
+		response.Message = "the server is stopping, request is rejected"
+	} else if p, _ := s.partitions.Load(request.Partition); p == nil {
+		response.Code = metapb.PS_RESP_CODE_NO_PARTITION
+		response.Message = fmt.Sprintf("node[%d] has not found partition[%d]", s.NodeID, request.Partition)
+		response.Error = metapb.Error{PartitionNotFound: &metapb.PartitionNotFound{request.Partition}}
+	} else {
+		p.(*partition).scrollInternal(request, response)
+	}
+
+	return response, nil
+}
+
+// ClearScroll grpc handler of ClearScroll service
+func (s *Server) ClearScroll(ctx context.Context, request *pspb.ClearScrollRequest) (*pspb.ClearScrollResponse, error) {
+	response := &pspb.ClearScrollResponse{
+		ResponseHeader: metapb.ResponseHeader{
+			ReqId: request.ReqId,
+			Code:  metapb.RESP_CODE_OK,
+		},
+	}
+
+	if s.stopping.Get() {
+		response.Code = metapb.RESP_CODE_SERVER_STOP
+		response.Message = "the server is stopping, request is rejected"
+	} else if p, _ := s.partitions.Load(request.Partition); p == nil {
+		response.Code = metapb.PS_RESP_CODE_NO_PARTITION
+		response.Message = fmt.Sprintf("node[%d] has not found partition[%d]", s.NodeID, request.Partition)
+		response.Error = metapb.Error{PartitionNotFound: &metapb.PartitionNotFound{request.Partition}}
+	} else {
+		p.(*partition).clearScrollInternal(request, response)
+	}
+
+	return response, nil
+}
+
+// DeleteByQuery grpc handler of DeleteByQuery service
+func (s *Server) DeleteByQuery(ctx context.Context, request *pspb.ByQueryRequest) (*pspb.ByQueryResponse, error) {
+	response := &pspb.ByQueryResponse{
+		ResponseHeader: metapb.ResponseHeader{
+			ReqId: request.ReqId,
+			Code:  metapb.RESP_CODE_OK,
+		},
+	}
+
+	if s.stopping.Get() {
+		response.Code = metapb.RESP_CODE_SERVER_STOP
+		response.Message = "the server is stopping, request is rejected"
+	} else if p, _ := s.partitions.Load(request.Partition); p == nil {
+		response.Code = metapb.PS_RESP_CODE_NO_PARTITION
+		response.Message = fmt.Sprintf("node[%d] has not found partition[%d]", s.NodeID, request.Partition)
+		response.Error = metapb.Error{PartitionNotFound: &metapb.PartitionNotFound{request.Partition}}
+	} else {
+		p.(*partition).byQueryInternal(request, response, false)
+	}
+
+	return response, nil
+}
+
+// UpdateByQuery grpc handler of UpdateByQuery service
+func (s *Server) UpdateByQuery(ctx context.Context, request *pspb.ByQueryRequest) (*pspb.ByQueryResponse, error) {
+	response := &pspb.ByQueryResponse{
+		ResponseHeader: metapb.ResponseHeader{
+			ReqId: request.ReqId,
+			Code:  metapb.RESP_CODE_OK,
+		},
+	}
+
+	if s.stopping.Get() {
+		response.Code = metapb.RESP_CODE_SERVER_STOP
+		response.Message = "the server is stopping, request is rejected"
+	} else if p, _ := s.partitions.Load(request.Partition); p == nil {
+		response.Code = metapb.PS_RESP_CODE_NO_PARTITION
+		response.Message = fmt.Sprintf("node[%d] has not found partition[%d]", s.NodeID, request.Partition)
+		response.Error = metapb.Error{PartitionNotFound: &metapb.PartitionNotFound{request.Partition}}
+	} else {
+		p.(*partition).byQueryInternal(request, response, true)
+	}
+
+	return response, nil
+}
+
+// GetByQueryTask grpc handler of GetByQueryTask service
+func (s *Server) GetByQueryTask(ctx context.Context, request *pspb.ByQueryTaskRequest) (*pspb.ByQueryTaskResponse, error) {
+	response := &pspb.ByQueryTaskResponse{
+		ResponseHeader: metapb.ResponseHeader{
+			ReqId: request.ReqId,
+			Code:  metapb.RESP_CODE_OK,
+		},
+	}
+
+	if s.stopping.Get() {
+		response.Code = metapb.RESP_CODE_SERVER_STOP
+		response.Message = "the server is stopping, request is rejected"
+	} else if p, _ := s.partitions.Load(request.Partition); p == nil {
+		response.Code = metapb.PS_RESP_CODE_NO_PARTITION
+		response.Message = fmt.Sprintf("node[%d] has not found partition[%d]", s.NodeID, request.Partition)
+		response.Error = metapb.Error{PartitionNotFound: &metapb.PartitionNotFound{request.Partition}}
+	} else {
+		p.(*partition).getByQueryTaskInternal(request, response)
+	}
+
+	return response, nil
+}
+
+// CancelByQueryTask grpc handler of CancelByQueryTask service
+func (s *Server) CancelByQueryTask(ctx context.Context, request *pspb.ByQueryTaskRequest) (*pspb.ByQueryTaskResponse, error) {
+	response := &pspb.ByQueryTaskResponse{
+		ResponseHeader: metapb.ResponseHeader{
+			ReqId: request.ReqId,
+			Code:  metapb.RESP_CODE_OK,
+		},
+	}
+
+	if s.stopping.Get() {
+		response.Code = metapb.RESP_CODE_SERVER_STOP
+		response.Message = "the server is stopping, request is rejected"
+	} else if p, _ := s.partitions.Load(request.Partition); p == nil {
+		response.Code = metapb.PS_RESP_CODE_NO_PARTITION
+		response.Message = fmt.Sprintf("node[%d] has not found partition[%d]", s.NodeID, request.Partition)
+		response.Error = metapb.Error{PartitionNotFound: &metapb.PartitionNotFound{request.Partition}}
+	} else {
+		p.(*partition).cancelByQueryTaskInternal(request, response)
+	}
+
+	return response, nil
+}
@@ -0,0 +1,157 @@
+package router
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/tiglabs/baudengine/proto/metapb"
+	"github.com/tiglabs/baudengine/proto/pspb"
+	"github.com/tiglabs/baudengine/util/log"
+	"github.com/tiglabs/baudengine/util/netutil"
+)
+
+// ByQueryRequest is the body of the delete and the update by query requests
+type ByQueryRequest struct {
+	Query pspb.Query `json:"query"`
+	// the partial document or the script of the update by query
+	Doc    json.RawMessage `json:"doc,omitempty"`
+	Script *ScriptRequest  `json:"script,omitempty"`
+	// the number of the documents written by a raft command of every partition
+	BatchSize uint32 `json:"batch_size,omitempty"`
+	// the max number of the documents written per second by every partition, unlimited if 0
+	RequestsPerSecond float32 `json:"requests_per_second,omitempty"`
+	// "proceed" counts the documents changed after the start as version conflicts, the task is aborted by default
+	Conflicts string `json:"conflicts,omitempty"`
+}
+
+var taskNotFound = &HttpReply{ERRCODE_TASK_NOT_FOUND, ErrTaskNotFound.Error(), nil}
+
+// PartitionTaskStatus is the status of the task of a partition in the task reply
+type PartitionTaskStatus struct {
+	Partition metapb.PartitionID `json:"_partition"`
+	*pspb.ByQueryStatus
+}
+
+func (router *Router) handleDeleteByQuery(writer http.ResponseWriter, request *http.Request, params netutil.UriParams) {
+	router.startByQuery(writer, request, params, false)
+}
+
+func (router *Router) handleUpdateByQuery(writer http.ResponseWriter, request *http.Request, params netutil.UriParams) {
+	router.startByQuery(writer, request, params, true)
+}
+
+// startByQuery starts the task on the leaders of all the partitions of the space, the reply has the task id
+// for the status and the cancel of the task. The tasks started are canceled if the task fails to start on a partition.
+func (router *Router) startByQuery(writer http.ResponseWriter, request *http.Request, params netutil.UriParams, update bool) {
+	defer router.catchPanic(writer)
+
+	_, space, _, _ := router.getParams(params, false)
+	var byQueryReq ByQueryRequest
+	if err := json.Unmarshal(router.readDocBody(request), &byQueryReq); err != nil {
+		panic(&HttpReply{ERRCODE_PARAM_ERROR, ErrParamError.Error(), nil})
+	}
+	// one of the partial document and the script for the update
+	if update && (len(byQueryReq.Doc) == 0) == (byQueryReq.Script == nil) {
+		panic(&HttpReply{ERRCODE_PARAM_ERROR, ErrParamError.Error(), nil})
+	}
+	if byQueryReq.Conflicts != "" && byQueryReq.Conflicts != "proceed" && byQueryReq.Conflicts != "abort" {
+		panic(&HttpReply{ERRCODE_PARAM_ERROR, ErrParamError.Error(), nil})
+	}
+	psRequest := pspb.ByQueryRequest{
+		Query:              byQueryReq.Query,
+		BatchSize:          byQueryReq.BatchSize,
+		RequestsPerSecond:  byQueryReq.RequestsPerSecond,
+		ProceedOnConflicts: byQueryReq.Conflicts == "proceed",
+	}
+	if update {
+		psRequest.Partial = byQueryReq.Doc
+		if byQueryReq.Script != nil {
+			psRequest.Script = &pspb.Script{Source: byQueryReq.Script.Source, Params: byQueryReq.Script.Params}
+		}
+	}
+
+	partitions := space.AllPartitions()
+	tasks := newPartitionCursors(partitions)
+	causes := make([]interface{}, len(tasks))
+	fanOut(len(tasks), func(i int) {
+		defer func() {
+			causes[i] = recover()
+		}()
+		tasks[i].Id, tasks[i].Addr = partitions[i].ByQuery(psRequest, update)
+	})
+	for _, cause := range causes {
+		if cause == nil {
+			continue
+		}
+		fanOut(len(tasks), func(i int) {
+			defer func() {
+				if p := recover(); p != nil {
+					log.Warn("cancel by query task of partition[%d] failed: %v", tasks[i].Partition, p)
+				}
+			}()
+			if tasks[i].Id != "" {
+				partitions[i].ByQueryTask(tasks[i].Addr, tasks[i].Id, true)
+			}
+		})
+		panic(cause)
+	}
+
+	respMap := map[string]interface{}{
+		"task_id": encodeCursors(tasks),
+	}
+	sendReply(writer, &HttpReply{ERRCODE_SUCCESS, ErrSuccess.Error(), respMap})
+}
+
+func (router *Router) handleGetTask(writer http.ResponseWriter, request *http.Request, params netutil.UriParams) {
+	router.byQueryTask(writer, request, params, false)
+}
+
+func (router *Router) handleCancelTask(writer http.ResponseWriter, request *http.Request, params netutil.UriParams) {
+	router.byQueryTask(writer, request, params, true)
+}
+
+// byQueryTask replies the progress of the task in the query parameter "task_id" summed over all the partitions,
+// with the status of every partition, the task is done when it is done on all the partitions
+func (router *Router) byQueryTask(writer http.ResponseWriter, request *http.Request, params netutil.UriParams, cancelTask bool) {
+	defer router.catchPanic(writer)
+
+	_, space, _, _ := router.getParams(params, false)
+	taskId := request.URL.Query().Get("task_id")
+	tasks, err := decodeCursors(taskId)
+	if err != nil || len(tasks) == 0 {
+		panic(&HttpReply{ERRCODE_PARAM_ERROR, ErrParamError.Error(), nil})
+	}
+	statuses := make([]PartitionTaskStatus, len(tasks))
+	fanOut(len(tasks), func(i int) {
+		partition := getCursorPartition(space, tasks[i], taskNotFound)
+		statuses[i] = PartitionTaskStatus{Partition: tasks[i].Partition, ByQueryStatus: partition.ByQueryTask(tasks[i].Addr, tasks[i].Id, cancelTask)}
+	})
+
+	var total, deleted, updated, noops, versionConflicts, failures, batches uint64
+	done, canceled := true, false
+	for _, status := range statuses {
+		total += status.Total
+		deleted += status.Deleted
+		updated += status.Updated
+		noops += status.Noops
+		versionConflicts += status.VersionConflicts
+		failures += status.Failures
+		batches += status.Batches
+		done = done && status.Done
+		canceled = canceled || status.Canceled
+	}
+	respMap := map[string]interface{}{
+		"task_id":           taskId,
+		"total":             total,
+		"deleted":           deleted,
+		"updated":           updated,
+		"noops":             noops,
+		"version_conflicts": versionConflicts,
+		"failures":          failures,
+		"batches":           batches,
+		"done":              done,
+		"canceled":          canceled,
+		"partitions":        statuses,
+	}
+	sendReply(writer, &HttpReply{ERRCODE_SUCCESS, ErrSuccess.Error(), respMap})
+}
@@ -0,0 +1,83 @@
+package router
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"sync"
+
+	"github.com/tiglabs/baudengine/proto/metapb"
+)
+
+// partitionCursor is the scroll or the task of a partition in the scroll id or the task id of the router,
+// the partition is found by its start slot and the cursor is read from the node holding it
+type partitionCursor struct {
+	Partition metapb.PartitionID `json:"p"`
+	StartSlot metapb.SlotID      `json:"s"`
+	Addr      string             `json:"a"`
+	Id        string             `json:"id"`
+	// the last document of the scroll returned by the router
+	After metapb.Key `json:"af,omitempty"`
+}
+
+func newPartitionCursors(partitions []*Partition) []partitionCursor {
+	cursors := make([]partitionCursor, len(partitions))
+	for i, partition := range partitions {
+		cursors[i] = partitionCursor{Partition: partition.meta.ID, StartSlot: partition.meta.StartSlot}
+	}
+	return cursors
+}
+
+func encodeCursors(cursors []partitionCursor) string {
+	if len(cursors) == 0 {
+		return ""
+	}
+	data, _ := json.Marshal(cursors)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeCursors(id string) ([]partitionCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(id)
+	if err != nil {
+		return nil, err
+	}
+	var cursors []partitionCursor
+	if err := json.Unmarshal(data, &cursors); err != nil {
+		return nil, err
+	}
+	return cursors, nil
+}
+
+// getCursorPartition returns the partition of the cursor, it panics with the not found reply
+// if the partition is split or merged
+func getCursorPartition(space *Space, cursor partitionCursor, notFound *HttpReply) *Partition {
+	partition := space.GetPartition(cursor.StartSlot)
+	if partition.meta.ID != cursor.Partition {
+		panic(notFound)
+	}
+	return partition
+}
+
+// fanOut calls the function for every index in parallel, it panics with the first panic of the calls
+func fanOut(n int, f func(i int)) {
+	var (
+		wg    sync.WaitGroup
+		once  sync.Once
+		cause interface{}
+	)
+	wg.Add(n)
+	for i := 0; i < n; i++ {
+		go func(i int) {
+			defer wg.Done()
+			defer func() {
+				if p := recover(); p != nil {
+					once.Do(func() { cause = p })
+				}
+			}()
+			f(i)
+		}(i)
+	}
+	wg.Wait()
+	if cause != nil {
+		panic(cause)
+	}
+}
@@ -6,7 +6,30 @@ create: PUT dbname/spacename
 read: GET dbname/spacename/docid
 update: POST dbname/spacename/docid
 delete: DELETE dbname/spacename/docid
+partial update: POST dbname/spacename/docid/_update, the body has the partial document in "doc" or the update script in "script"
+conditional update: the query parameters "if_version" and "if_seq_no" of the update and delete are the version and
+the sequence number of the document read before, the write fails with the version conflict code if the document is changed
+expiration: the "ttl" of the settings of the space mapping, like "7d", is the default time to live of the documents since
+their last write, the "expire_at" of the partial update in unix milliseconds sets the expiration time of the document.
+The expired documents are hidden from read and search, and deleted by the partition leader in the background
 Partial Update, Conditional Update
+
+## Scroll API
+scroll: POST scroll/dbname/spacename, the body has "size", "fields" and "keep_alive", the first request opens a snapshot
+on the leader of every partition of the space, and every reply has a page of the documents of every partition in doc id order
+and the "scroll_id" of the next page until "done". The scroll is closed if it is not read in the keep alive, 1m by default.
+A failed page can be read again by the same "scroll_id", the scroll id has the last document returned of every partition
+clear scroll: DELETE scroll/dbname/spacename?scroll_id=id
+
+## By Query API
+delete by query: POST delete_by_query/dbname/spacename, the body has the "query", "batch_size", "requests_per_second"
+and "conflicts", a background task on the leader of every partition deletes the documents matched in a snapshot by raft batches
+update by query: POST update_by_query/dbname/spacename, the body has the partial document in "doc" or the update script in
+"script" besides the options of the delete by query
+The documents changed after the start are version conflicts, which abort the task unless "conflicts" is "proceed".
+The reply has the "task_id" of the tasks of all the partitions
+task status: GET task/dbname/spacename?task_id=id, the progress summed over the partitions, done when all are done
+cancel task: DELETE task/dbname/spacename?task_id=id, the documents written before are kept
 http body as JSON format to contains document
 
 implementation:
@@ -7,6 +7,9 @@ var (
 	ErrInternalError 			= errors.New("internal error")
 	ErrSysBusy          		= errors.New("system busy")
 	ErrParamError				= errors.New("param error")
+	ErrVersionConflict			= errors.New("version conflict")
+	ErrScrollNotFound			= errors.New("scroll not found or expired")
+	ErrTaskNotFound				= errors.New("task not found or expired")
 )
 
 const (
@@ -14,6 +17,9 @@ const (
 	ERRCODE_INTERNAL_ERROR
 	ERRCODE_SYSBUSY
 	ERRCODE_PARAM_ERROR
+	ERRCODE_VERSION_CONFLICT
+	ERRCODE_SCROLL_NOT_FOUND
+	ERRCODE_TASK_NOT_FOUND
 )
 
 var Err2CodeMap = map[error]int32 {
@@ -21,4 +27,7 @@ var Err2CodeMap = map[error]int32 {
 	ErrInternalError: ERRCODE_INTERNAL_ERROR,
 	ErrSysBusy:       ERRCODE_SYSBUSY,
 	ErrParamError:    ERRCODE_PARAM_ERROR,
+	ErrVersionConflict: ERRCODE_VERSION_CONFLICT,
+	ErrScrollNotFound:  ERRCODE_SCROLL_NOT_FOUND,
+	ErrTaskNotFound:    ERRCODE_TASK_NOT_FOUND,
 }
@@ -61,7 +61,7 @@ func (partition *Partition) Create(docBody []byte) *metapb.DocID {
 	return docId
 }
 
-func (partition *Partition) Read(docId *metapb.DocID) metapb.Value {
+func (partition *Partition) Read(docId *metapb.DocID) *pspb.GetResponse {
 	request := &pspb.GetRequest{ActionRequestHeader: partition.requestHeader, Id:*docId}
 	ctx, cancel := partition.getContext()
 	defer cancel()
@@ -69,7 +69,7 @@ func (partition *Partition) Read(docId *metapb.DocID) metapb.Value {
 	if err != nil {
 		panic(err)
 	}
-	return resp.Fields
+	return resp
 }
 
 func (partition *Partition) Update(docId *metapb.DocID, docBody []byte) {
@@ -90,10 +90,29 @@ func (partition *Partition) Update(docId *metapb.DocID, docBody []byte) {
 	}
 }
 
-func (partition *Partition) Delete(docId *metapb.DocID) bool {
+// Merge updates the fields of the document by the partial document or the script
+func (partition *Partition) Merge(docId *metapb.DocID, mergeReq *MergeRequest, ifVersion, ifSeqNo uint64) *pspb.UpdateResponse {
+	updateReq := &pspb.UpdateRequest{Doc: pspb.Document{Id: *docId, ExpireAt: mergeReq.ExpireAt}, Partial: mergeReq.Doc, Upsert: mergeReq.Upsert,
+		IfVersion: ifVersion, IfSeqNo: ifSeqNo}
+	if mergeReq.Script != nil {
+		updateReq.Script = &pspb.Script{Source: mergeReq.Script.Source, Params: mergeReq.Script.Params}
+	}
+	request := &pspb.BulkRequest{
+		ActionRequestHeader: partition.requestHeader,
+		Requests:            []pspb.BulkItemRequest{{OpType: pspb.OpType_UPDATE, Update: updateReq}},
+	}
+	request.Partition = partition.meta.ID
+	ctx, cancel := partition.getContext()
+	defer cancel()
+	resp := partition.getSingleResponse(partition.getClient().BulkWrite(ctx, request))
+	checkFailure(resp.Failure)
+	return resp.Update
+}
+
+func (partition *Partition) Delete(docId *metapb.DocID, ifVersion, ifSeqNo uint64) bool {
 	deleteReq := pspb.BulkItemRequest{
 		OpType: pspb.OpType_DELETE,
-		Delete: &pspb.DeleteRequest{Id: *docId},
+		Delete: &pspb.DeleteRequest{Id: *docId, IfVersion: ifVersion, IfSeqNo: ifSeqNo},
 	}
 	request := &pspb.BulkRequest{
 		ActionRequestHeader: partition.requestHeader,
@@ -101,17 +120,253 @@ func (partition *Partition) Delete(docId *metapb.DocID) bool {
 	}
 	ctx, cancel := partition.getContext()
 	defer cancel()
-	resp := partition.getSingleResponse(partition.getClient().BulkWrite(ctx, request)).Delete
+	item := partition.getSingleResponse(partition.getClient().BulkWrite(ctx, request))
+	checkFailure(item.Failure)
+	resp := item.Delete
 	if resp.Result != pspb.WriteResult_DELETED {
 		return false
 	}
 	return true
 }
 
+func (partition *Partition) Analyze(analyzeReq *AnalyzeRequest) *pspb.AnalyzeResponse {
+	request := &pspb.AnalyzeRequest{
+		ActionRequestHeader: partition.requestHeader,
+		Analyzer:            analyzeReq.Analyzer,
+		Field:               analyzeReq.Field,
+		Text:                analyzeReq.Text,
+		Explain:             analyzeReq.Explain,
+	}
+	request.Partition = partition.meta.ID
+	ctx, cancel := partition.getContext()
+	defer cancel()
+	resp, err := partition.getClient().Analyze(ctx, request)
+	if err != nil {
+		log.Error("send analyze request failed: %s", err.Error())
+		panic(err)
+	}
+	if resp.Code != metapb.RESP_CODE_OK {
+		if resp.Code == metapb.PS_RESP_CODE_NO_LEADER || resp.Code == metapb.PS_RESP_CODE_NO_PARTITION {
+			partition.parent.Delete(partition.meta)
+		}
+		log.Error("analyze response failed(%d): %s", resp.Code, resp.Message)
+		panic(errors.New(resp.Message))
+	}
+	return resp
+}
+
+func (partition *Partition) TermVectors(docId *metapb.DocID, fields []uint32, termStatistics bool) *pspb.TermVectorsResponse {
+	request := &pspb.TermVectorsRequest{
+		ActionRequestHeader: partition.requestHeader,
+		Id:                  *docId,
+		Fields:              fields,
+		TermStatistics:      termStatistics,
+	}
+	request.Partition = partition.meta.ID
+	ctx, cancel := partition.getContext()
+	defer cancel()
+	resp, err := partition.getClient().TermVectors(ctx, request)
+	if err != nil {
+		log.Error("send term vectors request failed: %s", err.Error())
+		panic(err)
+	}
+	if resp.Code != metapb.RESP_CODE_OK {
+		if resp.Code == metapb.PS_RESP_CODE_NO_LEADER || resp.Code == metapb.PS_RESP_CODE_NO_PARTITION {
+			partition.parent.Delete(partition.meta)
+		} else if resp.Code == metapb.PS_RESP_CODE_NOT_LEADER {
+			partition.leaderAddr = resp.Error.NotLeader.LeaderAddr
+		}
+		log.Error("term vectors response failed(%d): %s", resp.Code, resp.Message)
+		panic(errors.New(resp.Message))
+	}
+	return resp
+}
+
+// Search searches the documents of the partition on the leader, the scores are computed by the statistics
+// of the request if they are set
+func (partition *Partition) Search(searchReq pspb.SearchRequest) *pspb.SearchResponse {
+	request := &searchReq
+	request.ActionRequestHeader = partition.requestHeader
+	request.Partition = partition.meta.ID
+	ctx, cancel := partition.getContext()
+	defer cancel()
+	resp, err := partition.getClient().Search(ctx, request)
+	if err != nil {
+		log.Error("send search request failed: %s", err.Error())
+		panic(err)
+	}
+	if resp.Code != metapb.RESP_CODE_OK {
+		if resp.Code == metapb.PS_RESP_CODE_NO_LEADER || resp.Code == metapb.PS_RESP_CODE_NO_PARTITION {
+			partition.parent.Delete(partition.meta)
+		} else if resp.Code == metapb.PS_RESP_CODE_NOT_LEADER {
+			partition.leaderAddr = resp.Error.NotLeader.LeaderAddr
+		}
+		log.Error("search response failed(%d): %s", resp.Code, resp.Message)
+		panic(errors.New(resp.Message))
+	}
+	return resp
+}
+
+// SearchStatistics reads the statistics of the fields and terms of the query on the leader
+func (partition *Partition) SearchStatistics(query pspb.Query) *pspb.SearchStatistics {
+	request := &pspb.SearchStatisticsRequest{
+		ActionRequestHeader: partition.requestHeader,
+		Query:               query,
+	}
+	request.Partition = partition.meta.ID
+	ctx, cancel := partition.getContext()
+	defer cancel()
+	resp, err := partition.getClient().SearchStatistics(ctx, request)
+	if err != nil {
+		log.Error("send search statistics request failed: %s", err.Error())
+		panic(err)
+	}
+	if resp.Code != metapb.RESP_CODE_OK {
+		if resp.Code == metapb.PS_RESP_CODE_NO_LEADER || resp.Code == metapb.PS_RESP_CODE_NO_PARTITION {
+			partition.parent.Delete(partition.meta)
+		} else if resp.Code == metapb.PS_RESP_CODE_NOT_LEADER {
+			partition.leaderAddr = resp.Error.NotLeader.LeaderAddr
+		}
+		log.Error("search statistics response failed(%d): %s", resp.Code, resp.Message)
+		panic(errors.New(resp.Message))
+	}
+	return &resp.Statistics
+}
+
+// Scroll reads the page after the document of the scroll of the partition, the scroll is opened on the leader
+// if the scroll id is empty, or read from the node holding it. It returns the response and the address of the node
+func (partition *Partition) Scroll(addr, scrollId string, after metapb.Key, size uint32, fields []uint32, keepAlive string) (*pspb.ScrollResponse, string) {
+	request := &pspb.ScrollRequest{
+		ActionRequestHeader: partition.requestHeader,
+		ScrollId:            scrollId,
+		Size_:               size,
+		Fields:              fields,
+		KeepAlive:           keepAlive,
+		After:               after,
+	}
+	request.Partition = partition.meta.ID
+	if addr == "" {
+		addr = partition.leaderAddr
+	}
+	ctx, cancel := partition.getContext()
+	defer cancel()
+	resp, err := partition.getClientOf(addr).Scroll(ctx, request)
+	if err != nil {
+		log.Error("send scroll request failed: %s", err.Error())
+		panic(err)
+	}
+	if resp.Code != metapb.RESP_CODE_OK {
+		if resp.Code == metapb.PS_RESP_CODE_NO_LEADER || resp.Code == metapb.PS_RESP_CODE_NO_PARTITION {
+			partition.parent.Delete(partition.meta)
+		} else if resp.Code == metapb.PS_RESP_CODE_NOT_LEADER {
+			partition.leaderAddr = resp.Error.NotLeader.LeaderAddr
+		}
+		log.Error("scroll response failed(%d): %s", resp.Code, resp.Message)
+		if resp.Code == metapb.PS_RESP_CODE_NO_SCROLL {
+			panic(&HttpReply{ERRCODE_SCROLL_NOT_FOUND, ErrScrollNotFound.Error(), nil})
+		}
+		panic(errors.New(resp.Message))
+	}
+	return resp, addr
+}
+
+// ClearScroll closes the scroll on the node holding it, it returns false if the scroll is not found
+func (partition *Partition) ClearScroll(addr, scrollId string) bool {
+	request := &pspb.ClearScrollRequest{
+		ActionRequestHeader: partition.requestHeader,
+		ScrollId:            scrollId,
+	}
+	request.Partition = partition.meta.ID
+	ctx, cancel := partition.getContext()
+	defer cancel()
+	resp, err := partition.getClientOf(addr).ClearScroll(ctx, request)
+	if err != nil {
+		log.Error("send clear scroll request failed: %s", err.Error())
+		panic(err)
+	}
+	if resp.Code == metapb.PS_RESP_CODE_NO_SCROLL {
+		return false
+	}
+	if resp.Code != metapb.RESP_CODE_OK {
+		log.Error("clear scroll response failed(%d): %s", resp.Code, resp.Message)
+		panic(errors.New(resp.Message))
+	}
+	return true
+}
+
+// ByQuery starts the delete or the update by query task on the leader, it returns the task id and the address of the leader
+func (partition *Partition) ByQuery(byQueryReq pspb.ByQueryRequest, update bool) (string, string) {
+	request := &byQueryReq
+	request.ActionRequestHeader = partition.requestHeader
+	request.Partition = partition.meta.ID
+	addr := partition.leaderAddr
+	ctx, cancel := partition.getContext()
+	defer cancel()
+	var (
+		resp *pspb.ByQueryResponse
+		err  error
+	)
+	if update {
+		resp, err = partition.getClientOf(addr).UpdateByQuery(ctx, request)
+	} else {
+		resp, err = partition.getClientOf(addr).DeleteByQuery(ctx, request)
+	}
+	if err != nil {
+		log.Error("send by query request failed: %s", err.Error())
+		panic(err)
+	}
+	if resp.Code != metapb.RESP_CODE_OK {
+		if resp.Code == metapb.PS_RESP_CODE_NO_LEADER || resp.Code == metapb.PS_RESP_CODE_NO_PARTITION {
+			partition.parent.Delete(partition.meta)
+		} else if resp.Code == metapb.PS_RESP_CODE_NOT_LEADER {
+			partition.leaderAddr = resp.Error.NotLeader.LeaderAddr
+		}
+		log.Error("by query response failed(%d): %s", resp.Code, resp.Message)
+		panic(errors.New(resp.Message))
+	}
+	return resp.TaskId, addr
+}
+
+// ByQueryTask returns the status of the by query task on the node running it, or cancels the task
+func (partition *Partition) ByQueryTask(addr, taskId string, cancelTask bool) *pspb.ByQueryStatus {
+	request := &pspb.ByQueryTaskRequest{
+		ActionRequestHeader: partition.requestHeader,
+		TaskId:              taskId,
+	}
+	request.Partition = partition.meta.ID
+	ctx, cancel := partition.getContext()
+	defer cancel()
+	var (
+		resp *pspb.ByQueryTaskResponse
+		err  error
+	)
+	if cancelTask {
+		resp, err = partition.getClientOf(addr).CancelByQueryTask(ctx, request)
+	} else {
+		resp, err = partition.getClientOf(addr).GetByQueryTask(ctx, request)
+	}
+	if err != nil {
+		log.Error("send by query task request failed: %s", err.Error())
+		panic(err)
+	}
+	if resp.Code == metapb.PS_RESP_CODE_NO_TASK {
+		panic(&HttpReply{ERRCODE_TASK_NOT_FOUND, ErrTaskNotFound.Error(), nil})
+	}
+	if resp.Code != metapb.RESP_CODE_OK {
+		log.Error("by query task response failed(%d): %s", resp.Code, resp.Message)
+		panic(errors.New(resp.Message))
+	}
+	return &resp.Status
+}
+
 func (partition *Partition) getClient() pspb.ApiGrpcClient {
-	psClient, err := partition.psClient.GetGrpcClient(partition.leaderAddr)
+	return partition.getClientOf(partition.leaderAddr)
+}
+
+func (partition *Partition) getClientOf(addr string) pspb.ApiGrpcClient {
+	psClient, err := partition.psClient.GetGrpcClient(addr)
 	if err != nil {
-		log.Warn("get ps client for %s failed", partition.leaderAddr)
+		log.Warn("get ps client for %s failed", addr)
 		panic(err)
 	}
 	return psClient.(pspb.ApiGrpcClient)
@@ -135,8 +390,22 @@ func (partition *Partition) getSingleResponse(resp *pspb.BulkResponse, err error
 		log.Error("bulk response failed(%d): %s", resp.Code, resp.Message)
 		panic(errors.New(resp.Message))
 	}
-	if len(resp.Responses) != 1 || resp.Responses[0].OpType != pspb.OpType_CREATE {
-		panic(errors.New("bad response for OpType_CREATE"))
+	if len(resp.Responses) != 1 {
+		panic(errors.New("bad response of the single request"))
 	}
 	return &resp.Responses[0]
 }
+
+// checkFailure panics with the failure of the write, the version conflict has the current version of the document
+func checkFailure(failure *pspb.Failure) {
+	if failure == nil {
+		return
+	}
+	if failure.VersionConflict {
+		panic(&HttpReply{ERRCODE_VERSION_CONFLICT, ErrVersionConflict.Error(), map[string]interface{}{
+			"_version": failure.CurrentVersion,
+			"_seq_no":  failure.CurrentSeqNo,
+		}})
+	}
+	panic(errors.New(failure.Cause))
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/tiglabs/baudengine/proto/masterpb"
 	"github.com/tiglabs/baudengine/proto/metapb"
+	"math"
 	"sort"
 	"sync"
 	"github.com/tiglabs/baudengine/util/log"
@@ -80,3 +81,17 @@ func (space *Space) Delete(partition metapb.Partition) {
 		space.partitions = append(space.partitions[:pos], space.partitions[pos + 1:]...)
 	}
 }
+
+// AllPartitions returns the partitions of all the slots of the space in slot order
+func (space *Space) AllPartitions() []*Partition {
+	var partitions []*Partition
+	var slotId metapb.SlotID
+	for {
+		partition := space.GetPartition(slotId)
+		partitions = append(partitions, partition)
+		if partition.meta.EndSlot == math.MaxUint32 {
+			return partitions
+		}
+		slotId = partition.meta.EndSlot + 1
+	}
+}
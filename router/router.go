@@ -5,9 +5,11 @@ import (
 	"github.com/spaolacci/murmur3"
 	"github.com/tiglabs/baudengine/common/keys"
 	"github.com/tiglabs/baudengine/proto/metapb"
+	"github.com/tiglabs/baudengine/proto/pspb"
 	"github.com/tiglabs/baudengine/util/log"
 	"net/http"
 	"strconv"
+	"strings"
 	"sync"
 	"github.com/tiglabs/baudengine/util/netutil"
 	"errors"
@@ -46,7 +48,17 @@ func (router *Router) Start(cfg *Config) error {
 	router.httpServer.Handle(netutil.PUT, "/doc/:db/:space", router.handleCreate)
 	router.httpServer.Handle(netutil.GET, "/doc/:db/:space/:docId", router.handleRead)
 	router.httpServer.Handle(netutil.POST,"/doc/:db/:space/:docId", router.handleUpdate)
+	router.httpServer.Handle(netutil.POST, "/doc/:db/:space/:docId/_update", router.handleMerge)
 	router.httpServer.Handle(netutil.DELETE, "/doc/:db/:space/:docId", router.handleDelete)
+	router.httpServer.Handle(netutil.POST, "/analyze/:db/:space", router.handleAnalyze)
+	router.httpServer.Handle(netutil.GET, "/termvectors/:db/:space/:docId", router.handleTermVectors)
+	router.httpServer.Handle(netutil.POST, "/search/:db/:space", router.handleSearch)
+	router.httpServer.Handle(netutil.POST, "/scroll/:db/:space", router.handleScroll)
+	router.httpServer.Handle(netutil.DELETE, "/scroll/:db/:space", router.handleClearScroll)
+	router.httpServer.Handle(netutil.POST, "/delete_by_query/:db/:space", router.handleDeleteByQuery)
+	router.httpServer.Handle(netutil.POST, "/update_by_query/:db/:space", router.handleUpdateByQuery)
+	router.httpServer.Handle(netutil.GET, "/task/:db/:space", router.handleGetTask)
+	router.httpServer.Handle(netutil.DELETE, "/task/:db/:space", router.handleCancelTask)
 
 	return router.httpServer.Run()
 }
@@ -99,8 +111,16 @@ func (router *Router) handleRead(writer http.ResponseWriter, request *http.Reque
 	defer router.catchPanic(writer)
 
 	_, _, partition, docId := router.getParams(params, true)
-	docBody := partition.Read(docId)
-	sendReply(writer, &HttpReply{ERRCODE_SUCCESS, ErrSuccess.Error(), docBody})
+	resp := partition.Read(docId)
+
+	respMap := map[string]interface{}{
+		"_docId":   docId,
+		"_version": resp.Version,
+		"_seq_no":  resp.SeqNo,
+		"found":    resp.Found,
+		"fields":   resp.Fields,
+	}
+	sendReply(writer, &HttpReply{ERRCODE_SUCCESS, ErrSuccess.Error(), respMap})
 }
 
 func (router *Router) handleUpdate(writer http.ResponseWriter, request *http.Request, params netutil.UriParams) {
@@ -112,17 +132,160 @@ func (router *Router) handleUpdate(writer http.ResponseWriter, request *http.Req
 	sendReply(writer, &HttpReply{ERRCODE_SUCCESS, ErrSuccess.Error(), nil})
 }
 
+// MergeRequest is the body of the partial update, the partial document in doc is merged into the document,
+// or the fields are set by the script, like {"script": {"source": "views += params.n", "params": {"n": 1}}}
+type MergeRequest struct {
+	Doc    json.RawMessage `json:"doc,omitempty"`
+	Script *ScriptRequest  `json:"script,omitempty"`
+	// create the document if it is not found
+	Upsert bool `json:"upsert,omitempty"`
+	// the new expiration time of the document in unix milliseconds
+	ExpireAt int64 `json:"expire_at,omitempty"`
+}
+
+type ScriptRequest struct {
+	Source string          `json:"source"`
+	Params json.RawMessage `json:"params,omitempty"`
+}
+
+func (router *Router) handleMerge(writer http.ResponseWriter, request *http.Request, params netutil.UriParams) {
+	defer router.catchPanic(writer)
+
+	_, _, partition, docId := router.getParams(params, true)
+	var mergeReq MergeRequest
+	if err := json.Unmarshal(router.readDocBody(request), &mergeReq); err != nil {
+		panic(&HttpReply{ERRCODE_PARAM_ERROR, ErrParamError.Error(), nil})
+	}
+	// one of the partial document and the script
+	if (len(mergeReq.Doc) == 0) == (mergeReq.Script == nil) {
+		panic(&HttpReply{ERRCODE_PARAM_ERROR, ErrParamError.Error(), nil})
+	}
+	ifVersion, ifSeqNo := router.getVersionParams(request)
+	resp := partition.Merge(docId, &mergeReq, ifVersion, ifSeqNo)
+
+	respMap := map[string]interface{}{
+		"_docId":   docId,
+		"_version": resp.Version,
+		"_seq_no":  resp.SeqNo,
+		"result":   strings.ToLower(resp.Result.String()),
+	}
+	sendReply(writer, &HttpReply{ERRCODE_SUCCESS, ErrSuccess.Error(), respMap})
+}
+
 func (router *Router) handleDelete(writer http.ResponseWriter, request *http.Request, params netutil.UriParams) {
 	defer router.catchPanic(writer)
 
 	_, _, partition, docId := router.getParams(params, true)
-	if ok := partition.Delete(docId); ok {
+	ifVersion, ifSeqNo := router.getVersionParams(request)
+	if ok := partition.Delete(docId, ifVersion, ifSeqNo); ok {
 		sendReply(writer, &HttpReply{ERRCODE_SUCCESS, ErrSuccess.Error(), nil})
 	} else {
 		sendReply(writer, &HttpReply{ERRCODE_INTERNAL_ERROR, "Cannot delete doc", nil})
 	}
 }
 
+// AnalyzeRequest is the body of the analyze request, the text is analyzed by the analyzer of the name,
+// or by the analyzer of the field in the mapping of the space
+type AnalyzeRequest struct {
+	Text     string `json:"text"`
+	Analyzer string `json:"analyzer,omitempty"`
+	Field    string `json:"field,omitempty"`
+	Explain  bool   `json:"explain,omitempty"`
+}
+
+func (router *Router) handleAnalyze(writer http.ResponseWriter, request *http.Request, params netutil.UriParams) {
+	defer router.catchPanic(writer)
+
+	_, space, _, _ := router.getParams(params, false)
+	var analyzeReq AnalyzeRequest
+	if err := json.Unmarshal(router.readDocBody(request), &analyzeReq); err != nil {
+		panic(&HttpReply{ERRCODE_PARAM_ERROR, ErrParamError.Error(), nil})
+	}
+	if analyzeReq.Analyzer == "" && analyzeReq.Field == "" {
+		panic(&HttpReply{ERRCODE_PARAM_ERROR, ErrParamError.Error(), nil})
+	}
+	// every partition has the mapping of the space
+	partition := space.GetPartition(metapb.SlotID(0))
+	resp := partition.Analyze(&analyzeReq)
+
+	respMap := map[string]interface{}{
+		"analyzer": resp.Analyzer,
+		"tokens":   resp.Tokens,
+	}
+	if analyzeReq.Explain {
+		respMap["stages"] = resp.Stages
+	}
+	sendReply(writer, &HttpReply{ERRCODE_SUCCESS, ErrSuccess.Error(), respMap})
+}
+
+// TermVector is an indexed term of a field in the term vectors reply
+type TermVector struct {
+	Term          string              `json:"term"`
+	Freq          int32               `json:"freq"`
+	Positions     []pspb.TermPosition `json:"positions,omitempty"`
+	DocFreq       int64               `json:"doc_freq,omitempty"`
+	TotalTermFreq int64               `json:"total_term_freq,omitempty"`
+}
+
+// handleTermVectors returns the indexed terms of the fields of the document, the fields are the field IDs
+// in the query parameter "fields" separated by commas, all the indexed fields if absent.
+// The query parameter "term_statistics=true" includes the document frequency and the total term frequency.
+func (router *Router) handleTermVectors(writer http.ResponseWriter, request *http.Request, params netutil.UriParams) {
+	defer router.catchPanic(writer)
+
+	_, _, partition, docId := router.getParams(params, true)
+	var fields []uint32
+	if fieldsParam := request.URL.Query().Get("fields"); fieldsParam != "" {
+		for _, f := range strings.Split(fieldsParam, ",") {
+			fieldId, err := strconv.ParseUint(strings.TrimSpace(f), 10, 32)
+			if err != nil {
+				panic(&HttpReply{ERRCODE_PARAM_ERROR, ErrParamError.Error(), nil})
+			}
+			fields = append(fields, uint32(fieldId))
+		}
+	}
+	termStatistics := request.URL.Query().Get("term_statistics") == "true"
+	resp := partition.TermVectors(docId, fields, termStatistics)
+
+	vectors := make(map[uint32][]TermVector, len(resp.Fields))
+	for fieldId, field := range resp.Fields {
+		terms := make([]TermVector, 0, len(field.Terms))
+		for _, term := range field.Terms {
+			terms = append(terms, TermVector{
+				Term:          string(term.Term),
+				Freq:          term.Freq,
+				Positions:     term.Positions,
+				DocFreq:       term.DocFreq,
+				TotalTermFreq: term.TotalTermFreq,
+			})
+		}
+		vectors[fieldId] = terms
+	}
+	respMap := map[string]interface{}{
+		"found":  resp.Found,
+		"fields": vectors,
+	}
+	sendReply(writer, &HttpReply{ERRCODE_SUCCESS, ErrSuccess.Error(), respMap})
+}
+
+// getVersionParams returns the preconditions of the version and the sequence number in the query parameters,
+// 0 if absent
+func (router *Router) getVersionParams(request *http.Request) (ifVersion, ifSeqNo uint64) {
+	query := request.URL.Query()
+	var err error
+	if v := query.Get("if_version"); v != "" {
+		if ifVersion, err = strconv.ParseUint(v, 10, 64); err != nil {
+			panic(&HttpReply{ERRCODE_PARAM_ERROR, ErrParamError.Error(), nil})
+		}
+	}
+	if v := query.Get("if_seq_no"); v != "" {
+		if ifSeqNo, err = strconv.ParseUint(v, 10, 64); err != nil {
+			panic(&HttpReply{ERRCODE_PARAM_ERROR, ErrParamError.Error(), nil})
+		}
+	}
+	return
+}
+
 func (router *Router) getParams(params netutil.UriParams, decodeDocId bool) (db *DB, space *Space, partition *Partition, docId *metapb.DocID) {
 	defer func() {
 		if p := recover(); p != nil {
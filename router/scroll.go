@@ -0,0 +1,155 @@
+package router
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/tiglabs/baudengine/proto/metapb"
+	"github.com/tiglabs/baudengine/proto/pspb"
+	"github.com/tiglabs/baudengine/util/log"
+	"github.com/tiglabs/baudengine/util/netutil"
+)
+
+type ScrollRequest struct {
+	// the scroll id of the previous page, a new scroll is opened if it is empty
+	ScrollId string `json:"scroll_id,omitempty"`
+	// the max number of the documents of every partition in a page
+	Size   uint32   `json:"size,omitempty"`
+	Fields []uint32 `json:"fields,omitempty"`
+	// the scroll is closed if it is not read in the keep alive, like "1m"
+	KeepAlive string `json:"keep_alive,omitempty"`
+}
+
+var scrollNotFound = &HttpReply{ERRCODE_SCROLL_NOT_FOUND, ErrScrollNotFound.Error(), nil}
+
+// ScrollDocument is a document in the scroll reply
+type ScrollDocument struct {
+	Partition metapb.PartitionID         `json:"_partition"`
+	DocId     metapb.Key                 `json:"_docId"`
+	Fields    map[uint32]pspb.FieldValue `json:"fields,omitempty"`
+}
+
+// handleScroll reads a page of the documents of all the partitions of the space in doc ID order of every partition,
+// a scroll is opened on a snapshot of every partition if the scroll id is empty. The reply has the scroll id of the
+// next page, and done is true when all the documents are read.
+func (router *Router) handleScroll(writer http.ResponseWriter, request *http.Request, params netutil.UriParams) {
+	defer router.catchPanic(writer)
+
+	_, space, _, _ := router.getParams(params, false)
+	var scrollReq ScrollRequest
+	if err := json.Unmarshal(router.readDocBody(request), &scrollReq); err != nil {
+		panic(&HttpReply{ERRCODE_PARAM_ERROR, ErrParamError.Error(), nil})
+	}
+	var scrolls []partitionCursor
+	var partitions []*Partition
+	if scrollReq.ScrollId == "" {
+		partitions = space.AllPartitions()
+		scrolls = newPartitionCursors(partitions)
+	} else {
+		var err error
+		if scrolls, err = decodeCursors(scrollReq.ScrollId); err != nil || len(scrolls) == 0 {
+			panic(&HttpReply{ERRCODE_PARAM_ERROR, ErrParamError.Error(), nil})
+		}
+		partitions = make([]*Partition, len(scrolls))
+		for i, scroll := range scrolls {
+			partitions[i] = getCursorPartition(space, scroll, scrollNotFound)
+		}
+	}
+
+	docs := make([][]ScrollDocument, len(scrolls))
+	done := make([]bool, len(scrolls))
+	causes := make([]interface{}, len(scrolls))
+	fanOut(len(scrolls), func(i int) {
+		defer func() {
+			causes[i] = recover()
+		}()
+		resp, addr := partitions[i].Scroll(scrolls[i].Addr, scrolls[i].Id, scrolls[i].After, scrollReq.Size, scrollReq.Fields, scrollReq.KeepAlive)
+		scrolls[i].Addr, scrolls[i].Id, done[i] = addr, resp.ScrollId, resp.Done
+		docs[i] = make([]ScrollDocument, len(resp.Docs))
+		for j, doc := range resp.Docs {
+			docs[i][j] = ScrollDocument{Partition: scrolls[i].Partition, DocId: doc.Id, Fields: doc.Fields}
+		}
+		if len(resp.Docs) > 0 {
+			scrolls[i].After = resp.Docs[len(resp.Docs)-1].Id
+		}
+	})
+	// the page fails if any partition fails, the scrolls continue from the documents in the scroll id,
+	// so the page can be read again by the same scroll id. The scrolls opened by the failed page are closed.
+	for _, cause := range causes {
+		if cause == nil {
+			continue
+		}
+		if scrollReq.ScrollId == "" {
+			clearScrolls(partitions, scrolls, func(i int) bool { return scrolls[i].Id != "" })
+		}
+		panic(cause)
+	}
+
+	var next []partitionCursor
+	var pageDocs []ScrollDocument
+	for i, scroll := range scrolls {
+		pageDocs = append(pageDocs, docs[i]...)
+		if !done[i] {
+			next = append(next, scroll)
+		}
+	}
+	// the scrolls of the partitions without more document are closed after their last page is returned
+	clearScrolls(partitions, scrolls, func(i int) bool { return done[i] })
+	respMap := map[string]interface{}{
+		"scroll_id": encodeCursors(next),
+		"docs":      pageDocs,
+		"done":      len(next) == 0,
+	}
+	sendReply(writer, &HttpReply{ERRCODE_SUCCESS, ErrSuccess.Error(), respMap})
+}
+
+// handleClearScroll closes the scroll in the query parameter "scroll_id" on all the partitions before it expires
+func (router *Router) handleClearScroll(writer http.ResponseWriter, request *http.Request, params netutil.UriParams) {
+	defer router.catchPanic(writer)
+
+	_, space, _, _ := router.getParams(params, false)
+	scrolls, err := decodeCursors(request.URL.Query().Get("scroll_id"))
+	if err != nil || len(scrolls) == 0 {
+		panic(&HttpReply{ERRCODE_PARAM_ERROR, ErrParamError.Error(), nil})
+	}
+	cleared := make([]bool, len(scrolls))
+	fanOut(len(scrolls), func(i int) {
+		defer func() {
+			// the scroll of a split or merged partition is closed when it expires
+			if p := recover(); p != nil {
+				log.Warn("clear scroll of partition[%d] failed: %v", scrolls[i].Partition, p)
+			}
+		}()
+		cleared[i] = getCursorPartition(space, scrolls[i], scrollNotFound).ClearScroll(scrolls[i].Addr, scrolls[i].Id)
+	})
+	num := 0
+	for _, c := range cleared {
+		if c {
+			num++
+		}
+	}
+
+	if num == 0 {
+		panic(scrollNotFound)
+	}
+
+	respMap := map[string]interface{}{
+		"cleared": num,
+	}
+	sendReply(writer, &HttpReply{ERRCODE_SUCCESS, ErrSuccess.Error(), respMap})
+}
+
+// clearScrolls closes the scrolls of the partitions selected, the scrolls failed to close expire later
+func clearScrolls(partitions []*Partition, scrolls []partitionCursor, selected func(i int) bool) {
+	fanOut(len(scrolls), func(i int) {
+		if !selected(i) {
+			return
+		}
+		defer func() {
+			if p := recover(); p != nil {
+				log.Warn("clear scroll of partition[%d] failed: %v", scrolls[i].Partition, p)
+			}
+		}()
+		partitions[i].ClearScroll(scrolls[i].Addr, scrolls[i].Id)
+	})
+}
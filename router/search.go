@@ -0,0 +1,152 @@
+package router
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+
+	"github.com/tiglabs/baudengine/kernel"
+	"github.com/tiglabs/baudengine/proto/metapb"
+	"github.com/tiglabs/baudengine/proto/pspb"
+	"github.com/tiglabs/baudengine/util/netutil"
+)
+
+// SearchRequest is the body of the search request, the hits of all the partitions are ordered by score
+type SearchRequest struct {
+	Query pspb.Query `json:"query"`
+	From  uint32     `json:"from,omitempty"`
+	// kernel.DefaultSearchSize when 0
+	Size uint32 `json:"size,omitempty"`
+	// stored fields returned with the hits, all the stored fields if empty
+	Fields []uint32 `json:"fields,omitempty"`
+	// similarity names of the fields, BM25 for the fields not set
+	Similarities map[uint32]string `json:"similarities,omitempty"`
+	// the statistics of all the partitions are read before the search for the scores consistent across
+	// the partitions, every partition scores by its own statistics by default
+	DistributedFrequencies bool `json:"dfs,omitempty"`
+	// aggregations computed over all the matched documents by name
+	Aggregations map[string]pspb.Aggregation `json:"aggregations,omitempty"`
+}
+
+// SearchHit is a hit in the search reply
+type SearchHit struct {
+	Partition metapb.PartitionID         `json:"_partition"`
+	DocId     metapb.Key                 `json:"_docId"`
+	Score     float64                    `json:"_score"`
+	Fields    map[uint32]pspb.FieldValue `json:"fields,omitempty"`
+}
+
+// handleSearch searches all the partitions of the space, every partition returns its top from+size hits
+// and the page of the hits merged by score is replied. The partial aggregation results of the partitions are merged,
+// the bucket keys in the reply are value encoded as the data of the fields.
+func (router *Router) handleSearch(writer http.ResponseWriter, request *http.Request, params netutil.UriParams) {
+	defer router.catchPanic(writer)
+
+	_, space, _, _ := router.getParams(params, false)
+	var searchReq SearchRequest
+	if err := json.Unmarshal(router.readDocBody(request), &searchReq); err != nil {
+		panic(&HttpReply{ERRCODE_PARAM_ERROR, ErrParamError.Error(), nil})
+	}
+	aggs, err := kernel.AggregationsFromPB(searchReq.Aggregations)
+	if err != nil {
+		panic(&HttpReply{ERRCODE_PARAM_ERROR, ErrParamError.Error(), nil})
+	}
+	size := searchReq.Size
+	if size == 0 {
+		size = kernel.DefaultSearchSize
+	}
+	psRequest := pspb.SearchRequest{
+		Query:        searchReq.Query,
+		Size_:        searchReq.From + size,
+		Fields:       searchReq.Fields,
+		Similarities: searchReq.Similarities,
+		Aggregations: searchReq.Aggregations,
+	}
+
+	partitions := space.AllPartitions()
+	if searchReq.DistributedFrequencies {
+		stats := make([]*pspb.SearchStatistics, len(partitions))
+		fanOut(len(partitions), func(i int) {
+			stats[i] = partitions[i].SearchStatistics(searchReq.Query)
+		})
+		psRequest.Statistics = mergeStatistics(stats)
+	}
+	responses := make([]*pspb.SearchResponse, len(partitions))
+	fanOut(len(partitions), func(i int) {
+		responses[i] = partitions[i].Search(psRequest)
+	})
+
+	var (
+		total  uint32
+		merged map[string]*kernel.AggregationResult
+	)
+	for _, resp := range responses {
+		total += resp.Total
+		if merged, err = kernel.MergeAggregations(merged, kernel.AggregationResultsFromPB(resp.Aggregations)); err != nil {
+			panic(err)
+		}
+	}
+	respMap := map[string]interface{}{
+		"total": total,
+		"hits":  mergeHits(partitions, responses, int(searchReq.From), int(size)),
+	}
+	if len(aggs) > 0 {
+		kernel.ReduceAggregations(aggs, merged, false)
+		respMap["aggregations"] = kernel.AggregationResultsToPB(merged)
+	}
+	sendReply(writer, &HttpReply{ERRCODE_SUCCESS, ErrSuccess.Error(), respMap})
+}
+
+// mergeStatistics sums the statistics of the fields and terms of all the partitions
+func mergeStatistics(stats []*pspb.SearchStatistics) *pspb.SearchStatistics {
+	type termKey struct {
+		field uint32
+		term  string
+	}
+	merged := &pspb.SearchStatistics{}
+	fields := make(map[uint32]int)
+	terms := make(map[termKey]int)
+	for _, s := range stats {
+		for _, fs := range s.Fields {
+			if i, ok := fields[fs.Field]; ok {
+				merged.Fields[i].DocCount += fs.DocCount
+				merged.Fields[i].SumLength += fs.SumLength
+				continue
+			}
+			fields[fs.Field] = len(merged.Fields)
+			merged.Fields = append(merged.Fields, fs)
+		}
+		for _, ts := range s.Terms {
+			key := termKey{field: ts.Field, term: string(ts.Term)}
+			if i, ok := terms[key]; ok {
+				merged.Terms[i].DocFreq += ts.DocFreq
+				continue
+			}
+			terms[key] = len(merged.Terms)
+			merged.Terms = append(merged.Terms, ts)
+		}
+	}
+	return merged
+}
+
+// mergeHits orders the hits of all the partitions by score and returns the page of the size from the offset,
+// the hits with the same score keep the order of the partitions
+func mergeHits(partitions []*Partition, responses []*pspb.SearchResponse, from, size int) []SearchHit {
+	var hits []SearchHit
+	for i, resp := range responses {
+		for _, hit := range resp.Hits {
+			hits = append(hits, SearchHit{Partition: partitions[i].meta.ID, DocId: hit.Id, Score: hit.Score, Fields: hit.Fields})
+		}
+	}
+	sort.SliceStable(hits, func(i, j int) bool {
+		return hits[i].Score > hits[j].Score
+	})
+	if from >= len(hits) {
+		return []SearchHit{}
+	}
+	hits = hits[from:]
+	if len(hits) > size {
+		hits = hits[:size]
+	}
+	return hits
+}
@@ -0,0 +1,191 @@
+package geo
+
+import (
+	"errors"
+	"math"
+
+	"github.com/tiglabs/baudengine/util/encoding"
+)
+
+const (
+	// EarthRadius is the mean radius of the earth in meters
+	EarthRadius = 6371008.8
+
+	// MaxGeohashPrecision is the length of the longest geohash
+	MaxGeohashPrecision = 12
+)
+
+const base32 = "0123456789bcdefghjkmnpqrstuvwxyz"
+
+// Rect is a box of latitude and longitude, it never crosses the dateline
+type Rect struct {
+	MinLat, MinLon float64
+	MaxLat, MaxLon float64
+}
+
+// Contains reports whether the point is inside the box, the edges included
+func (r Rect) Contains(lat, lon float64) bool {
+	return lat >= r.MinLat && lat <= r.MaxLat && lon >= r.MinLon && lon <= r.MaxLon
+}
+
+func ValidPoint(lat, lon float64) error {
+	if math.IsNaN(lat) || lat < -90 || lat > 90 {
+		return errors.New("latitude must be between -90 and 90")
+	}
+	if math.IsNaN(lon) || lon < -180 || lon > 180 {
+		return errors.New("longitude must be between -180 and 180")
+	}
+	return nil
+}
+
+// EncodePoint appends the point to the data of a geo point field,
+// a point is encoded as the float latitude followed by the float longitude
+func EncodePoint(data []byte, lat, lon float64) []byte {
+	data = encoding.EncodeFloatValue(data, 0, lat)
+	return encoding.EncodeFloatValue(data, 0, lon)
+}
+
+// EncodeGeohash returns the geohash of the point with the precision
+func EncodeGeohash(lat, lon float64, precision int) string {
+	if precision <= 0 || precision > MaxGeohashPrecision {
+		precision = MaxGeohashPrecision
+	}
+	minLat, maxLat := -90.0, 90.0
+	minLon, maxLon := -180.0, 180.0
+	hash := make([]byte, 0, precision)
+	var ch, bit int
+	even := true
+	for len(hash) < precision {
+		if even {
+			mid := (minLon + maxLon) / 2
+			if lon >= mid {
+				ch = ch<<1 | 1
+				minLon = mid
+			} else {
+				ch <<= 1
+				maxLon = mid
+			}
+		} else {
+			mid := (minLat + maxLat) / 2
+			if lat >= mid {
+				ch = ch<<1 | 1
+				minLat = mid
+			} else {
+				ch <<= 1
+				maxLat = mid
+			}
+		}
+		even = !even
+		if bit++; bit == 5 {
+			hash = append(hash, base32[ch])
+			ch, bit = 0, 0
+		}
+	}
+	return string(hash)
+}
+
+// cellSize returns the height and width in degrees of the geohash cells of the precision
+func cellSize(precision int) (height, width float64) {
+	bits := 5 * precision
+	lonBits := (bits + 1) / 2
+	latBits := bits / 2
+	return 180 / math.Exp2(float64(latBits)), 360 / math.Exp2(float64(lonBits))
+}
+
+// GeohashCells returns the geohash cells covering the box, the precision is the highest
+// so that the number of the cells is not greater than maxCells
+func GeohashCells(r Rect, maxCells int) []string {
+	precision := 1
+	for p := MaxGeohashPrecision; p > 1; p-- {
+		if countCells(r, p) <= maxCells {
+			precision = p
+			break
+		}
+	}
+	height, width := cellSize(precision)
+	minLatIdx, maxLatIdx := cellIndex(r.MinLat+90, height, 180), cellIndex(r.MaxLat+90, height, 180)
+	minLonIdx, maxLonIdx := cellIndex(r.MinLon+180, width, 360), cellIndex(r.MaxLon+180, width, 360)
+	cells := make([]string, 0, (maxLatIdx-minLatIdx+1)*(maxLonIdx-minLonIdx+1))
+	for i := minLatIdx; i <= maxLatIdx; i++ {
+		for j := minLonIdx; j <= maxLonIdx; j++ {
+			// the center of the cell
+			lat := (float64(i)+0.5)*height - 90
+			lon := (float64(j)+0.5)*width - 180
+			cells = append(cells, EncodeGeohash(lat, lon, precision))
+		}
+	}
+	return cells
+}
+
+func countCells(r Rect, precision int) int {
+	height, width := cellSize(precision)
+	rows := cellIndex(r.MaxLat+90, height, 180) - cellIndex(r.MinLat+90, height, 180) + 1
+	cols := cellIndex(r.MaxLon+180, width, 360) - cellIndex(r.MinLon+180, width, 360) + 1
+	return rows * cols
+}
+
+// cellIndex returns the index of the cell of the offset in the range of the total,
+// the max edge of the range belongs to the last cell
+func cellIndex(offset, size, total float64) int {
+	idx := int(math.Floor(offset / size))
+	if last := int(math.Round(total/size)) - 1; idx > last {
+		idx = last
+	}
+	if idx < 0 {
+		idx = 0
+	}
+	return idx
+}
+
+// Distance returns the haversine distance in meters between the two points
+func Distance(lat1, lon1, lat2, lon2 float64) float64 {
+	phi1, phi2 := lat1*math.Pi/180, lat2*math.Pi/180
+	dPhi := (lat2 - lat1) * math.Pi / 180
+	dLambda := (lon2 - lon1) * math.Pi / 180
+	a := math.Sin(dPhi/2)*math.Sin(dPhi/2) + math.Cos(phi1)*math.Cos(phi2)*math.Sin(dLambda/2)*math.Sin(dLambda/2)
+	return 2 * EarthRadius * math.Asin(math.Min(1, math.Sqrt(a)))
+}
+
+// DistanceRects returns the boxes covering the circle around the point,
+// the circle crossing the dateline is split into two boxes
+func DistanceRects(lat, lon, distance float64) []Rect {
+	dLat := distance / EarthRadius * 180 / math.Pi
+	minLat, maxLat := lat-dLat, lat+dLat
+	// the circle covers a pole, so all the longitudes
+	if minLat <= -90 || maxLat >= 90 {
+		return []Rect{{MinLat: math.Max(minLat, -90), MinLon: -180, MaxLat: math.Min(maxLat, 90), MaxLon: 180}}
+	}
+	dLon := math.Asin(math.Min(1, math.Sin(distance/EarthRadius)/math.Cos(lat*math.Pi/180))) * 180 / math.Pi
+	return BoxRects(maxLat, lon-dLon, minLat, lon+dLon)
+}
+
+// BoxRects returns the boxes of the bounding box, the box crossing the dateline
+// (left greater than right, or out of -180 and 180) is split into two boxes
+func BoxRects(top, left, bottom, right float64) []Rect {
+	if left < -180 {
+		left += 360
+	}
+	if right > 180 {
+		right -= 360
+	}
+	if left <= right {
+		return []Rect{{MinLat: bottom, MinLon: left, MaxLat: top, MaxLon: right}}
+	}
+	return []Rect{
+		{MinLat: bottom, MinLon: left, MaxLat: top, MaxLon: 180},
+		{MinLat: bottom, MinLon: -180, MaxLat: top, MaxLon: right},
+	}
+}
+
+// PolygonContains reports whether the point is inside the polygon by ray casting,
+// the polygon is the points in order and it is closed automatically
+func PolygonContains(lats, lons []float64, lat, lon float64) bool {
+	inside := false
+	for i, j := 0, len(lats)-1; i < len(lats); j, i = i, i+1 {
+		if (lats[i] > lat) != (lats[j] > lat) &&
+			lon < (lons[j]-lons[i])*(lat-lats[i])/(lats[j]-lats[i])+lons[i] {
+			inside = !inside
+		}
+	}
+	return inside
+}
@@ -0,0 +1,62 @@
+package geo
+
+import (
+	"math"
+	"testing"
+)
+
+func TestEncodeGeohash(t *testing.T) {
+	if hash := EncodeGeohash(57.64911, 10.40744, 11); hash != "u4pruydqqvj" {
+		t.Fatalf("geohash failed, got %s", hash)
+	}
+	if hash := EncodeGeohash(-90, -180, 3); hash != "000" {
+		t.Fatalf("geohash of min point failed, got %s", hash)
+	}
+	if hash := EncodeGeohash(90, 180, 3); hash != "zzz" {
+		t.Fatalf("geohash of max point failed, got %s", hash)
+	}
+}
+
+func TestGeohashCells(t *testing.T) {
+	r := Rect{MinLat: 39.9, MinLon: 116.3, MaxLat: 40.0, MaxLon: 116.5}
+	cells := GeohashCells(r, 16)
+	if len(cells) == 0 || len(cells) > 16 {
+		t.Fatalf("cells failed, got %v", cells)
+	}
+	// every point of the box is in a cell
+	for _, p := range [][2]float64{{39.9, 116.3}, {40.0, 116.5}, {39.95, 116.4}} {
+		hash := EncodeGeohash(p[0], p[1], len(cells[0]))
+		found := false
+		for _, c := range cells {
+			if c == hash {
+				found = true
+			}
+		}
+		if !found {
+			t.Fatalf("point %v of cell %s is not covered by %v", p, hash, cells)
+		}
+	}
+}
+
+func TestDistance(t *testing.T) {
+	// Beijing to Shanghai
+	d := Distance(39.9042, 116.4074, 31.2304, 121.4737)
+	if math.Abs(d-1067000) > 5000 {
+		t.Fatalf("distance failed, got %v", d)
+	}
+	rects := DistanceRects(0, 179.9, 50000)
+	if len(rects) != 2 || !rects[1].Contains(0, -179.9) {
+		t.Fatalf("distance rects across the dateline failed, got %v", rects)
+	}
+}
+
+func TestPolygonContains(t *testing.T) {
+	lats := []float64{0, 0, 10, 10}
+	lons := []float64{0, 10, 10, 0}
+	if !PolygonContains(lats, lons, 5, 5) {
+		t.Fatal("point should be inside")
+	}
+	if PolygonContains(lats, lons, 15, 5) {
+		t.Fatal("point should be outside")
+	}
+}